@@ -0,0 +1,83 @@
+package minimock
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Blocker makes the calls of a mocked method wait until they're released, it's used by the Block helper of the mocks.
+// The zero value doesn't block the calls
+type Blocker struct {
+	mutex   sync.Mutex
+	gate    chan struct{}
+	release func()
+	blocked uint64
+	//blocking is set while the gate is closed, so Wait doesn't take the lock if the calls aren't blocked
+	blocking int32
+}
+
+// Block makes the subsequent calls to Wait block until the returned function is called,
+// the function can be called several times and the same function is returned until it's called
+func (b *Blocker) Block() (release func()) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.release != nil {
+		return b.release
+	}
+
+	gate := make(chan struct{})
+	var once sync.Once
+	b.gate = gate
+	atomic.StoreInt32(&b.blocking, 1)
+	b.release = func() {
+		once.Do(func() {
+			b.mutex.Lock()
+			b.gate, b.release = nil, nil
+			atomic.StoreInt32(&b.blocking, 0)
+			b.mutex.Unlock()
+			close(gate)
+		})
+	}
+
+	return b.release
+}
+
+// Wait blocks the call until it's released if Block is called, the notifier is notified when the call is blocked
+func (b *Blocker) Wait(n *Notifier) {
+	if atomic.LoadInt32(&b.blocking) == 0 {
+		return
+	}
+
+	b.mutex.Lock()
+	gate := b.gate
+	b.mutex.Unlock()
+
+	if gate != nil {
+		atomic.AddUint64(&b.blocked, 1)
+		n.Notify()
+		<-gate
+		atomic.AddUint64(&b.blocked, ^uint64(0))
+	}
+}
+
+// WaitUntilBlocked waits until at least want calls are blocked within the timeout and returns the number of the blocked calls
+func (b *Blocker) WaitUntilBlocked(n *Notifier, want uint64, timeout time.Duration) uint64 {
+	return n.WaitFor(&b.blocked, want, timeout)
+}
+
+// Release releases the blocked calls and returns their number
+func (b *Blocker) Release() uint64 {
+	b.mutex.Lock()
+	release := b.release
+	b.mutex.Unlock()
+
+	if release == nil {
+		return 0
+	}
+
+	blocked := atomic.LoadUint64(&b.blocked)
+	release()
+	return blocked
+}
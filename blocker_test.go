@@ -0,0 +1,55 @@
+package minimock
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlocker(t *testing.T) {
+	var (
+		b  Blocker
+		n  Notifier
+		wg sync.WaitGroup
+	)
+
+	b.Wait(&n) //calls aren't blocked until Block is called
+	assert.Equal(t, uint64(0), b.Release())
+
+	release := b.Block()
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			b.Wait(&n)
+		}()
+	}
+
+	assert.Equal(t, uint64(2), b.WaitUntilBlocked(&n, 2, time.Second))
+	release()
+	release()
+	wg.Wait()
+
+	assert.Equal(t, uint64(0), b.WaitUntilBlocked(&n, 1, 0))
+	assert.Equal(t, uint64(0), b.Release(), "released calls are released again")
+}
+
+func TestBlocker_Release(t *testing.T) {
+	var (
+		b    Blocker
+		n    Notifier
+		done = make(chan struct{})
+	)
+
+	b.Block()
+	go func() {
+		defer close(done)
+		b.Wait(&n)
+	}()
+
+	b.WaitUntilBlocked(&n, 1, time.Second)
+	assert.Equal(t, uint64(1), b.Release())
+	<-done
+}
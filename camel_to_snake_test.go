@@ -11,6 +11,7 @@ func Test_CamelToSnake(t *testing.T) {
 	assert.Equal(t, "i_love_json", CamelToSnake("ILoveJSON"))
 	assert.Equal(t, "json", CamelToSnake("json"))
 	assert.Equal(t, "json", CamelToSnake("JSON"))
+	assert.Equal(t, "http_client", CamelToSnake("HTTPClient"))
 	assert.Equal(t, "привет_мир", CamelToSnake("ПриветМир"))
 }
 
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+// configFileName is the name of the config file minimock looks for when it's run without arguments
+const configFileName = ".minimock.yaml"
+
+type (
+	// config describes all mocks that have to be generated in one minimock run
+	config struct {
+		// Package is a default destination package name
+		Package string `yaml:"package"`
+
+		// Header contains lines that are put into the header comment of every generated mock
+		Header []string `yaml:"header"`
+
+		Mocks []mockConfig `yaml:"mocks"`
+	}
+
+	// mockConfig describes a single mock
+	mockConfig struct {
+		// Package is an import path or a path of the source package relative to the config file
+		Package string `yaml:"package"`
+
+		// Interface is a name of the interface, the * and ~regexp notations are supported
+		Interface string `yaml:"interface"`
+
+		// Output is a destination file name or a destination directory relative to the config file
+		Output string `yaml:"output"`
+
+		// StructName overrides default <interface name>Mock name of the mock struct
+		StructName string `yaml:"structName"`
+	}
+)
+
+// loadConfig reads the configuration file
+func loadConfig(fileName string) (*config, error) {
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read config")
+	}
+
+	doc, err := parseYAML(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse config %s", fileName)
+	}
+
+	if _, ok := doc.(map[string]interface{}); !ok {
+		return nil, errors.Errorf("failed to parse config %s: mapping is expected", fileName)
+	}
+
+	var c config
+	if err := decodeYAML("", doc, reflect.ValueOf(&c).Elem()); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse config %s", fileName)
+	}
+
+	if len(c.Mocks) == 0 {
+		return nil, errors.Errorf("no mocks are defined in %s", fileName)
+	}
+
+	return &c, nil
+}
+
+// findConfig looks for the config file in the directory and its parents up to the module root,
+// it returns an empty string if there is no config file
+func findConfig(dir string) (string, error) {
+	for {
+		fileName := filepath.Join(dir, configFileName)
+		if _, err := os.Stat(fileName); err == nil {
+			return fileName, nil
+		} else if !os.IsNotExist(err) {
+			return "", err
+		}
+
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return "", nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+// interfaces converts mocks described in the config into the list of interfaces to mock,
+// relative paths are resolved against the directory of the config file
+func (c config) interfaces(dir string) ([]interfaceInfo, error) {
+	var result []interfaceInfo
+
+	for i, m := range c.Mocks {
+		if m.Interface == "" {
+			return nil, errors.Errorf("mocks[%d]: interface is required", i)
+		}
+
+		//mocks of the local packages are put into the package directories by default,
+		//the mocks of the packages given by the import paths are put into the config directory
+		importPath, output := m.Package, ""
+		if importPath == "" || isLocalPath(importPath) {
+			importPath = filepath.Join(dir, importPath)
+		} else {
+			output = dir
+		}
+
+		if m.Output != "" {
+			output = m.Output
+			if !filepath.IsAbs(output) {
+				output = filepath.Join(dir, output)
+			}
+		}
+
+		info, err := makeInterfaceInfo(importPath+"."+m.Interface, output)
+		if err != nil {
+			return nil, err
+		}
+
+		if m.StructName != "" {
+			if info.Pattern != nil || info.Type == "*" {
+				return nil, errors.Errorf("structName can't be used with multiple interfaces: %s", m.Interface)
+			}
+			info.MockName = m.StructName
+		}
+
+		result = append(result, *info)
+	}
+
+	return result, nil
+}
+
+// applyConfig replaces the list of interfaces to mock by the mocks from the config file
+func (o *options) applyConfig(fileName string) error {
+	c, err := loadConfig(fileName)
+	if err != nil {
+		return err
+	}
+
+	dir, err := filepath.Abs(filepath.Dir(fileName))
+	if err != nil {
+		return err
+	}
+
+	if o.interfaces, err = c.interfaces(dir); err != nil {
+		return err
+	}
+
+	var outputs []string
+	for _, in := range o.interfaces {
+		outputs = append(outputs, in.WriteTo)
+	}
+
+	if err := checkDuplicateOutputFiles(outputs); err != nil {
+		return err
+	}
+
+	if o.packageName == "" {
+		o.packageName = c.Package
+	}
+	o.headerLines = c.Header
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseYAML(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want interface{}
+		err  string
+	}{
+		{
+			name: "mapping",
+			data: "# comment\n---\npackage: mocks # trailing comment\nheader:\n  - 'it''s #1'\n  - \"a: \\\"b\\\"\"\n",
+			want: map[string]interface{}{"package": "mocks", "header": []interface{}{"it's #1", `a: "b"`}},
+		},
+		{
+			name: "sequence of mappings",
+			data: "mocks:\n- package: ./src\n  interface: Getter\n\n- interface: Setter\n  output: ~\n  url: http://x#y\n",
+			want: map[string]interface{}{"mocks": []interface{}{
+				map[string]interface{}{"package": "./src", "interface": "Getter"},
+				map[string]interface{}{"interface": "Setter", "output": nil, "url": "http://x#y"},
+			}},
+		},
+		{
+			name: "flow sequence",
+			data: "header: [a, 'b, c', \"d\"]\nempty: []\nnone:\n",
+			want: map[string]interface{}{"header": []interface{}{"a", "b, c", "d"}, "empty": []interface{}{}, "none": nil},
+		},
+		{name: "duplicate key", data: "a: 1\na: 2\n", err: `line 2: duplicate key "a"`},
+		{name: "indentation", data: "a: 1\n  b: 2\n", err: "line 2: unexpected indentation"},
+		{name: "tabs", data: "a:\n\tb: 2\n", err: "line 2: tabs can't be used for indentation"},
+		{name: "flow mapping", data: "a: {b: 1}\n", err: "line 1: unsupported YAML syntax: {b: 1}"},
+		{name: "unterminated", data: "a: \"b\n", err: `line 1: invalid quoted scalar: "b`},
+		{name: "mixed", data: "a: 1\n- b\n", err: "line 2: mapping key is expected"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := parseYAML([]byte(tt.data))
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, doc)
+		})
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := tempDir(t)
+	fileName := filepath.Join(dir, configFileName)
+
+	require.NoError(t, ioutil.WriteFile(fileName, []byte("package: mocks\nmocks:\n  - interface: Getter\n    name: X\n"), 0644))
+	_, err := loadConfig(fileName)
+	assert.EqualError(t, err, "failed to parse config "+fileName+`: mocks[0]: unknown field "name"`)
+
+	require.NoError(t, ioutil.WriteFile(fileName, []byte("header: line\nmocks:\n  - interface: Getter\n"), 0644))
+	_, err = loadConfig(fileName)
+	assert.EqualError(t, err, "failed to parse config "+fileName+": header: sequence is expected")
+
+	require.NoError(t, ioutil.WriteFile(fileName, []byte("package: mocks\n"), 0644))
+	_, err = loadConfig(fileName)
+	assert.EqualError(t, err, "no mocks are defined in "+fileName)
+}
+
+var configSource = map[string]string{
+	"src/src.go": "package src\n\ntype Getter interface{ Get() int }\n\ntype Setter interface{ Set(int) }\n",
+	configFileName: `# mocks of the project
+package: mocks
+header:
+  - "Regenerate with: minimock"
+mocks:
+  - package: ./src
+    interface: Getter
+    output: ./mocks/
+  - package: ./src
+    interface: Setter
+    output: ./mocks/setter.go
+    structName: FakeSetter
+`,
+}
+
+func TestRun_Config(t *testing.T) {
+	dir := tempDir(t)
+	writeFiles(t, dir, configSource)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "src", "nested"), 0755))
+
+	//the config is found in the parent directory and the paths are relative to the config
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, runIn(t, filepath.Join(dir, "src", "nested")))
+
+	cwd, err := os.Getwd()
+	require.NoError(t, err)
+	assert.Equal(t, wd, cwd)
+
+	getter, err := ioutil.ReadFile(filepath.Join(dir, "mocks", "getter_mock_test.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(getter), "// Regenerate with: minimock\n")
+	assert.Contains(t, string(getter), "package mocks\n")
+	assert.Contains(t, string(getter), "/src.Getter -o ./getter_mock_test.go -p mocks -header-line \"Regenerate with: minimock\"\n")
+
+	setter, err := ioutil.ReadFile(filepath.Join(dir, "mocks", "setter.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(setter), "/src.Setter -o ./setter.go -t FakeSetter -p mocks -header-line \"Regenerate with: minimock\"\n")
+
+	//the mock is regenerated on its own by the instruction in its header
+	fileName := filepath.Join(dir, "mocks", "setter.go")
+	header := strings.SplitN(string(setter), "\n\nimport", 2)[0]
+	require.NoError(t, ioutil.WriteFile(fileName, []byte(header+"\n"), 0644))
+	require.NoError(t, scan(filepath.Join(dir, "mocks")+"/...", ioutil.Discard, ioutil.Discard))
+
+	regenerated, err := ioutil.ReadFile(fileName)
+	require.NoError(t, err)
+	assert.Equal(t, string(setter), string(regenerated))
+}
+
+func TestRun_ConfigFlag(t *testing.T) {
+	dir := tempDir(t)
+	writeFiles(t, dir, configSource)
+
+	require.NoError(t, runIn(t, filepath.Join(dir, "src"), "-config", "../"+configFileName))
+	assert.FileExists(t, filepath.Join(dir, "mocks", "getter_mock_test.go"))
+	assert.FileExists(t, filepath.Join(dir, "mocks", "setter.go"))
+
+	_, err := processArgs([]string{"-config", filepath.Join(dir, configFileName), "-i", "io.Reader"}, ioutil.Discard, ioutil.Discard)
+	assert.EqualError(t, err, "-config flag can't be used along with -i, -o and -t flags")
+}
@@ -5,25 +5,50 @@ import (
 	"flag"
 	"fmt"
 	"go/ast"
+	"go/build/constraint"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/printer"
+	"go/scanner"
 	"go/token"
+	"go/types"
 	"io"
 	"io/ioutil"
 	"os"
+	"path"
 	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"text/template"
+	"unicode"
 
 	"github.com/gojuno/minimock"
 	"github.com/hexdigest/gowrap/generator"
 	"github.com/hexdigest/gowrap/pkg"
 	"github.com/pkg/errors"
+	"golang.org/x/tools/go/ast/astutil"
 	"golang.org/x/tools/go/packages"
+	"golang.org/x/tools/imports"
 )
 
 var version = "dev" //do not modify! version var is modified during the build via ldflags option
 
+// latestTemplateVersion is the latest version of the generated code supported by -template-version flag,
+// the version is bumped when the generated code changes its semantics so the existing mocks keep working
+const latestTemplateVersion = 2
+
 var helpers = template.FuncMap{
-	"title": strings.Title,
+	"arg":           quoteArg,
+	"base":          filepath.Base,
+	"checkReserved": checkReserved,
+	"declaration":   declaration,
+	"exported":      ast.IsExported,
+	"title":         strings.Title,
 	"in": func(s string, in ...string) bool {
 		s = strings.Trim(s, " ")
 		for _, i := range in {
@@ -33,171 +58,2344 @@ var helpers = template.FuncMap{
 		}
 		return false
 	},
+	"members":     members,
+	"packageName": packageName,
+}
+
+// quoteArg quotes the argument of the go:generate instruction if it has to be quoted,
+// the go generate splits the instruction into arguments by spaces unless they're double-quoted
+func quoteArg(s string) string {
+	if s == "" || strings.ContainsAny(s, " \t\"\\") {
+		return strconv.Quote(s)
+	}
+
+	return s
+}
+
+// mockMembers contains names of the exported mock members generated for the interface method
+type mockMembers struct {
+	Mock              string
+	AfterCounter      string
+	BeforeCounter     string
+	Calls             string
+	CallCount         string
+	LastParams        string
+	CallTimes         string
+	NotCalled         string
+	MaxInFlight       string
+	UnexpectedCounter string
+}
+
+// members returns names of the mock members for each of the interface methods,
+// if the default name (i.e. GetMock) is taken by one of the interface methods
+// the name is prefixed with the reserved Minimock prefix (MinimockGetMock)
+func members(list map[string]generator.Method) map[string]mockMembers {
+	taken := map[string]bool{}
+	for name := range list {
+		taken[name] = true
+	}
+
+	memberName := func(name string) string {
+		if !taken[name] {
+			return name
+		}
+
+		for name = "Minimock" + name; taken[name]; name += "_" {
+		}
+		return name
+	}
+
+	result := make(map[string]mockMembers, len(list))
+	for name := range list {
+		result[name] = mockMembers{
+			Mock:              memberName(name + "Mock"),
+			AfterCounter:      memberName(name + "AfterCounter"),
+			BeforeCounter:     memberName(name + "BeforeCounter"),
+			Calls:             memberName(name + "Calls"),
+			CallCount:         memberName(name + "CallCount"),
+			LastParams:        memberName(name + "LastParams"),
+			CallTimes:         memberName(name + "CallTimes"),
+			NotCalled:         memberName(name + "NotCalled"),
+			MaxInFlight:       memberName(name + "MaxInFlight"),
+			UnexpectedCounter: memberName(name + "UnexpectedCounter"),
+		}
+	}
+
+	return result
+}
+
+// checkReserved returns an error if any of the interface methods has the same name
+// as one of the helper methods or the fields of the mock
+func checkReserved(list map[string]generator.Method) (string, error) {
+	reserved := map[string]bool{
+		//fields of the mock collide with the unexported methods of the interfaces declared in the same package
+		"state": true, "mutex": true, "delegate": true,
+
+		"MinimockAssertNotCalled": true, "MinimockFinish": true, "MinimockLenientCalls": true, "MinimockReset": true, "MinimockResetAll": true, "MinimockSetAutoFinish": true, "MinimockSetClock": true, "MinimockSetComparer": true, "MinimockSetDelegate": true, "MinimockSetLenient": true, "MinimockSetSequence": true, "MinimockWait": true,
+		"minimockDelegate": true, "minimockDone": true,
+	}
+	for name := range list {
+		reserved["Minimock"+name+"Done"] = true
+		reserved["Minimock"+name+"Inspect"] = true
+		reserved["func"+name] = true
+	}
+
+	var collisions []string
+	for name := range list {
+		if reserved[name] {
+			collisions = append(collisions, name)
+		}
+	}
+
+	if len(collisions) > 0 {
+		sort.Strings(collisions)
+		return "", errors.Errorf("interface methods %s collide with the helper methods or the fields of the mock", strings.Join(collisions, ", "))
+	}
+
+	return "", nil
+}
+
+// methods gives names to the blank parameters and results of the interface methods
+// since they have to be referred to in the generated code, the names are based on
+// the position of the parameter (p0, p1, ..., r0, r1, ...)
+func methods(list map[string]generator.Method) map[string]generator.Method {
+	result := make(map[string]generator.Method, len(list))
+	for name, m := range list {
+		used := map[string]bool{}
+		for _, p := range append(append([]generator.Param{}, m.Params...), m.Results...) {
+			used[p.Name] = true
+		}
+
+		m.Params = nameBlanks(m.Params, "p", used)
+		m.Results = nameBlanks(m.Results, "r", used)
+		result[name] = m
+	}
+
+	return result
+}
+
+func nameBlanks(params generator.ParamsSlice, prefix string, used map[string]bool) generator.ParamsSlice {
+	result := make(generator.ParamsSlice, len(params))
+	for i, p := range params {
+		if p.Name == "_" {
+			p.Name = fmt.Sprintf("%s%d", prefix, i)
+			for used[p.Name] {
+				p.Name += "_"
+			}
+			used[p.Name] = true
+		}
+		result[i] = p
+	}
+
+	return result
+}
+
+// fieldNames returns exported names of the Params or Results struct fields for the parameters of the method,
+// declared names are capitalized and the names of unnamed and blank parameters are based on their position (P0, P1, ..., R0, R1, ...)
+func fieldNames(list *ast.FieldList, prefix string) []string {
+	var names []string
+	if list == nil {
+		return names
+	}
+
+	used := map[string]bool{}
+	add := func(name string) {
+		if name == "" || name == "_" {
+			name = fmt.Sprintf("%s%d", prefix, len(names))
+		}
+
+		name = strings.Title(name)
+		for used[name] {
+			name += "_"
+		}
+		used[name] = true
+
+		names = append(names, name)
+	}
+
+	for _, field := range list.List {
+		if len(field.Names) == 0 {
+			add("")
+		}
+
+		for _, name := range field.Names {
+			add(name.Name)
+		}
+	}
+
+	return names
+}
+
+// fieldsStruct returns the struct type with the fields of the given names and the types of the params,
+// variadic params are turned into slices
+func fieldsStruct(names []string, params generator.ParamsSlice) string {
+	fields := make([]string, len(params))
+	for i, f := range paramFields(names, params) {
+		fields[i] = f.Name + " " + f.Type
+	}
+
+	return "struct{\n" + strings.Join(fields, "\n") + "}"
+}
+
+// paramField is a field of the Params struct, Index is the 1-based position of the param
+type paramField struct {
+	Name  string
+	Type  string
+	Index int
+}
+
+// paramFields returns the fields of the Params struct with the given names and the types of the params,
+// variadic params are turned into slices
+func paramFields(names []string, params generator.ParamsSlice) []paramField {
+	fields := make([]paramField, len(params))
+	for i, p := range params {
+		typ := p.Type
+		if p.Variadic {
+			typ = strings.Replace(typ, "...", "[]", 1)
+		}
+		fields[i] = paramField{Name: names[i], Type: typ, Index: i + 1}
+	}
+
+	return fields
+}
+
+// returnFields returns the return statement with the results taken from the fields of the Results struct
+func returnFields(names []string, from string) string {
+	if len(names) == 0 {
+		return "return"
+	}
+
+	results := make([]string, len(names))
+	for i, name := range names {
+		results[i] = from + "." + name
+	}
+
+	return "return " + strings.Join(results, ", ")
+}
+
+// packageName turns destination directory name into a valid package name
+// when there are no Go files in the destination directory
+func packageName(dir string) string {
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			return r
+		}
+		return '_'
+	}, dir)
+
+	if name == "" || unicode.IsDigit([]rune(name)[0]) {
+		name = "_" + name
+	}
+
+	return name
+}
+
+type (
+	options struct {
+		buildTags       string
+		check           bool
+		copyConstraints bool
+		dryRun          bool
+		force           bool
+		interfaces      []interfaceInfo
+		exclude         map[string]bool
+		goarch          string
+		goos            string
+		headerLines     []string
+		extraHeader     []string
+		license         string
+		licenseFile     string
+		noGenerate      bool
+		packageName     string
+		scan            string
+		suffix          string
+		tags            string
+		verbose         bool
+
+		//templateVersion selects the semantics of the generated code, 0 means the first version
+		templateVersion int
+
+		//log is where minimock reports what it does, it's switched to stderr
+		//when the generated code is written to stdout
+		log io.Writer
+
+		//stdout receives the generated code written with -o - and the list of the methods printed by -dry-run
+		stdout io.Writer
+
+		//cache of the loaded source packages that can be shared between several runs
+		cache map[string]*sourcePackage
+
+		//destinations are the packages the mocks are generated into by their directories,
+		//unlike the source packages they're loaded again by every run since the mocks are written into them
+		destinations map[string]*packages.Package
+	}
+
+	interfaceInfo struct {
+		Type       string
+		ImportPath string
+		WriteTo    string
+
+		//MockName overrides default <interface name>Mock name of the mock struct
+		MockName string
+
+		//Pattern is set when the Type is a regular expression (~pattern notation)
+		Pattern *regexp.Regexp
+	}
+)
+
+// stringList is a flag that can be repeated, every value is appended to the list
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ", ")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+func main() {
+	opts, err := processArgs(os.Args[1:], os.Stdout, os.Stderr)
+	if err != nil {
+		if err == errInvalidArguments {
+			os.Exit(2)
+		}
+
+		die("%v", err)
+	}
+
+	if opts == nil { //help requested
+		os.Exit(0)
+	}
+
+	if opts.scan != "" {
+		err = scan(opts.scan, os.Stdout, os.Stderr)
+	} else {
+		err = run(opts)
+	}
+
+	if err != nil {
+		die("%v", err)
+	}
+}
+
+type (
+	sourcePackage struct {
+		pkg  *packages.Package
+		ast  *ast.Package
+		fset *token.FileSet
+		info *types.Info //is filled by the constantValue on the first call
+
+		//types is filled by the typesPackage on the first call
+		types *types.Package
+
+		//exports imports the dependencies of the package from their export data, it's loaded
+		//by the typesPackage on the first call since the types are only needed to compare the signatures
+		exports *exportData
+
+		//excluded are the files of the package excluded by the build constraints,
+		//they're only used to hint that the build tags are missing when the interface isn't found
+		excluded *ast.Package
+	}
+
+	generateTask struct {
+		source     *sourcePackage
+		interfaces []string
+		writeTo    string
+		mockName   string
+	}
+
+	mock struct {
+		options generator.Options
+		imports []string
+		writeTo string
+		code    []byte
+		err     error
+
+		source      *packages.Package //package declaring the interface
+		destination *packages.Package
+		config      packages.Config //is used to load the dot imported packages
+		methods     map[string]generator.Method
+	}
+)
+
+func run(opts *options) (err error) {
+	var (
+		tasks    []generateTask
+		failures []string
+		excluded bool
+	)
+
+	if opts.cache == nil {
+		opts.cache = map[string]*sourcePackage{}
+	}
+	opts.destinations = map[string]*packages.Package{}
+
+	//every source package is loaded only once no matter how many interfaces are taken from it
+	for _, in := range opts.interfaces {
+		sp, err := opts.loadCached(in.ImportPath)
+		if err != nil {
+			failures = append(failures, err.Error())
+			continue
+		}
+
+		interfaces, err := findInterfaces(sp.ast, in)
+		if err != nil {
+			failures = append(failures, buildTagsHint(sp, in, err).Error())
+			continue
+		}
+
+		//entries that are excluded entirely are skipped, the run fails only if nothing is left to generate
+		if interfaces = opts.filter(interfaces); len(interfaces) == 0 {
+			excluded = true
+			continue
+		}
+
+		if in.Pattern != nil {
+			opts.logf("%s matched %s", in.Type, strings.Join(interfaces, ", "))
+		}
+
+		writeTo := in.WriteTo
+		if writeTo == "" && isLocalPath(in.ImportPath) {
+			if writeTo, err = packageDir(sp.pkg); err != nil {
+				return err
+			}
+		}
+
+		tasks = append(tasks, generateTask{source: sp, interfaces: interfaces, writeTo: writeTo, mockName: in.MockName})
+	}
+
+	if excluded && len(tasks) == 0 && len(failures) == 0 {
+		return errors.New("all interfaces are excluded by -x flag, nothing to generate")
+	}
+
+	if err := checkStdout(tasks); err != nil {
+		return err
+	}
+
+	var mocks []*mock
+
+	//failure of one interface doesn't prevent generation of the others, all failures are reported at once
+	for _, task := range tasks {
+		for _, name := range task.interfaces {
+			m, err := opts.newMock(task, name)
+			if err != nil {
+				failures = append(failures, errors.Wrapf(err, "failed to generate mock for %s", name).Error())
+				continue
+			}
+
+			if err := checkTestInterface(task.source, name, m.options.OutputFile); err != nil {
+				failures = append(failures, err.Error())
+				continue
+			}
+
+			mocks = append(mocks, m)
+		}
+	}
+
+	renderMocks(mocks)
+
+	//mocks are written in the same order they were requested
+	for _, m := range mocks {
+		if m.err == nil {
+			m.err = opts.output(m)
+		}
+
+		if m.err != nil {
+			failures = append(failures, m.err.Error())
+		}
+	}
+
+	if len(failures) > 0 {
+		return errors.New(strings.Join(failures, "; "))
+	}
+
+	return nil
+}
+
+// checkStdout returns an error if more than one mock is written to stdout,
+// every mock is a complete Go file so several of them can't be written to the same stream
+func checkStdout(tasks []generateTask) error {
+	var names []string
+	for _, task := range tasks {
+		if task.writeTo == writeToStdout {
+			names = append(names, task.interfaces...)
+		}
+	}
+
+	if len(names) > 1 {
+		return errors.Errorf("only one mock can be written to stdout, but %d interfaces are selected: %s", len(names), strings.Join(names, ", "))
+	}
+
+	return nil
+}
+
+// newMock returns a mock of the interface with the generator options set up,
+// aliases and named types are resolved to the interfaces they refer to
+func (o *options) newMock(task generateTask, interfaceName string) (*mock, error) {
+	origin, originName, err := o.resolveInterface(task.source, interfaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	gopts := generator.Options{
+		InterfaceName:      originName,
+		SourcePackage:      origin.pkg.PkgPath,
+		SourcePackageAlias: "mm_" + origin.pkg.Name,
+		HeaderTemplate:     minimock.HeaderTemplate,
+		BodyTemplate:       minimock.BodyTemplate,
+		HeaderVars: map[string]interface{}{
+			"BuildTags":           o.buildTags,
+			"CopyConstraints":     o.copyConstraints,
+			"GenerateInstruction": !o.noGenerate,
+			"GOARCH":              o.goarch,
+			"GOOS":                o.goos,
+			"HeaderLines":         append(append([]string{}, o.headerLines...), o.extraHeader...),
+			"License":             o.license,
+			"MockName":            task.mockName,
+			"PackageName":         o.packageName,
+			"SourceInterface":     task.source.pkg.PkgPath + "." + interfaceName,
+			"Tags":                o.tags,
+			"TemplateVersion":     o.templateVersion,
+			"Version":             version,
+		},
+		Vars: map[string]interface{}{
+			"MockName":        task.mockName,
+			"TemplateVersion": o.templateVersion,
+		},
+	}
+
+	var copied string
+	if o.copyConstraints {
+		_, fileName := findTypeSpec(task.source.ast, interfaceName)
+		if copied, err = fileConstraint(fileName); err != nil {
+			return nil, err
+		}
+	}
+
+	if gopts.HeaderVars["BuildConstraint"], err = buildConstraint(copied, o.buildTags, o.goos, o.goarch); err != nil {
+		return nil, err
+	}
+
+	if o.dryRun {
+		gopts.HeaderTemplate = dryRunHeaderTemplate
+		gopts.BodyTemplate = dryRunBodyTemplate
+	}
+
+	if gopts.OutputFile, err = destinationFile(interfaceName, task.writeTo, o.suffix); err != nil {
+		return nil, err
+	}
+
+	outputDir, err := filepath.Abs(filepath.Dir(gopts.OutputFile))
+	if err != nil {
+		return nil, err
+	}
+
+	//license file is referred to relative to the mock since go generate runs the instruction in the mock directory
+	if o.licenseFile != "" {
+		rel, err := filepath.Rel(outputDir, o.licenseFile)
+		if err != nil {
+			return nil, err
+		}
+		gopts.HeaderVars["LicenseFile"] = filepath.ToSlash(rel)
+	}
+
+	//mock of the alias or the named type is described in terms of the requested type
+	if originName != interfaceName || origin != task.source {
+		gopts.Vars["InterfaceName"] = interfaceName
+		gopts.Vars["InterfaceType"] = task.source.pkg.Name + "." + interfaceName
+		if outputDir == pkg.Dir(task.source.pkg) {
+			gopts.Vars["InterfaceType"] = interfaceName
+		}
+	}
+
+	var alias string
+	if outputDir != pkg.Dir(origin.pkg) {
+		alias = gopts.SourcePackageAlias
+	}
+
+	//the mock is checked to implement the interface at compile time, the requested type is used
+	//when it's declared in the destination package, otherwise the interface it refers to
+	switch {
+	case outputDir == pkg.Dir(task.source.pkg):
+		gopts.Vars["InterfaceRef"] = interfaceName
+	case alias == "":
+		gopts.Vars["InterfaceRef"] = originName
+	default:
+		gopts.Vars["InterfaceRef"] = alias + "." + originName
+		gopts.Vars["ImportCycle"] = importsPackage(origin.ast, destinationPath(gopts.OutputFile))
+	}
+
+	ts, _ := findTypeSpec(origin.ast, originName)
+	if ts.TypeParams != nil {
+		if gopts.Vars["TypeParams"], gopts.Vars["TypeArgs"], err = typeParams(origin.ast, ts.TypeParams, alias); err != nil {
+			return nil, err
+		}
+	}
+
+	set, err := o.interfaceMethods(origin, ts, alias)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(set.methods) == 0 {
+		return nil, errors.New("interface has no methods")
+	}
+
+	for name := range set.methods {
+		if alias != "" && !ast.IsExported(name) {
+			return nil, errors.Errorf("%s: unexported method", name)
+		}
+	}
+
+	//documentation of the requested type is preferred over the documentation of the interface it refers to
+	if gopts.Vars["InterfaceDoc"] = docComment(typeDoc(task.source.ast, interfaceName)); gopts.Vars["InterfaceDoc"] == "" {
+		gopts.Vars["InterfaceDoc"] = docComment(typeDoc(origin.ast, originName))
+	}
+
+	//methods found by the generator are replaced since it looks for the interface in all files of the package
+	//regardless of the build constraints and can't print some of the types, i.e. bidirectional channels
+	gopts.Funcs = template.FuncMap{
+		"methods": func(map[string]generator.Method) map[string]generator.Method {
+			return methods(set.methods)
+		},
+		"doc": func(method string) string {
+			return set.docs[method]
+		},
+		"paramsStruct": func(m generator.Method) string {
+			return fieldsStruct(set.fields[m.Name].params, m.Params)
+		},
+		"paramFields": func(m generator.Method) []paramField {
+			return paramFields(set.fields[m.Name].params, m.Params)
+		},
+		"resultsStruct": func(m generator.Method) string {
+			return fieldsStruct(set.fields[m.Name].results, m.Results)
+		},
+		"returnResults": func(m generator.Method, from string) string {
+			return returnFields(set.fields[m.Name].results, from)
+		},
+	}
+	for name, helper := range helpers {
+		gopts.Funcs[name] = helper
+	}
+
+	//the mock written to stdout goes to the package given by -p flag, so the destination package isn't loaded
+	dst := &packages.Package{Name: o.packageName}
+	if task.writeTo != writeToStdout {
+		if dst, err = o.destinationPackage(outputDir, task.source, origin); err != nil {
+			return nil, err
+		}
+	}
+
+	return &mock{options: gopts, imports: set.imports, writeTo: task.writeTo, source: origin.pkg, destination: dst, methods: set.methods,
+		config: o.packagesConfig()}, nil
 }
 
-type (
-	options struct {
-		interfaces []interfaceInfo
-		noGenerate bool
-		suffix     string
+// interfaceMethods returns the interface methods including the embedded ones, their documentation and the imports of the files declaring them,
+// the types of the source package are qualified with the alias when it's given, the types are rendered the same way
+// they are declared in the source
+func (o *options) interfaceMethods(sp *sourcePackage, ts *ast.TypeSpec, alias string) (*methodSet, error) {
+	declared := map[string]bool{}
+	if ts.TypeParams != nil {
+		for _, field := range ts.TypeParams.List {
+			for _, name := range field.Names {
+				declared[name.Name] = true
+			}
+		}
+	}
+
+	set := &methodSet{
+		methods: map[string]generator.Method{},
+		docs:    map[string]string{},
+		fields:  map[string]structFields{},
+		origins: map[string]methodOrigin{},
+		names:   map[string]bool{},
+		visited: map[string]bool{},
+		source:  sp,
+	}
+	if err := o.collectMethods(sp, ts.Name.Name, alias, declared, set); err != nil {
+		return nil, err
+	}
+
+	return set, nil
+}
+
+// methodSet accumulates the methods of the interface and the imports of the files they're declared in
+type methodSet struct {
+	methods map[string]generator.Method
+	docs    map[string]string //documentation comments of the methods
+	fields  map[string]structFields
+	origins map[string]methodOrigin
+	imports []string
+	names   map[string]bool //names of the imported packages
+	visited map[string]bool
+	source  *sourcePackage //package declaring the interface
+}
+
+// methodOrigin is the interface declaring the method, the signatures of the methods
+// with the same name embedded from different interfaces are compared by their origins
+type methodOrigin struct {
+	sp   *sourcePackage
+	name string
+}
+
+// String returns the name of the interface qualified with its package unless it's declared in the source package
+func (o methodOrigin) String(source *sourcePackage) string {
+	if o.sp == source {
+		return o.name
+	}
+
+	return o.sp.pkg.Name + "." + o.name
+}
+
+// structFields contains names of the Params and Results struct fields of the method
+type structFields struct {
+	params  []string
+	results []string
+}
+
+// addImport adds the import unless the package name is already taken, the imports of the file
+// declaring the interface are added first so they win over the imports of the embedded interfaces
+func (s *methodSet) addImport(name, importPath string) {
+	key := name
+	if key == "" {
+		key = path.Base(importPath)
+	}
+
+	//blank imports are never used by the mock and cgo import is not allowed in the test files
+	if key == "_" || importPath == "C" || s.names[key] {
+		return
+	}
+	s.names[key] = true
+
+	s.imports = append(s.imports, name+" "+strconv.Quote(importPath))
+}
+
+func (s *methodSet) addImports(f *ast.File) error {
+	for _, spec := range f.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return err
+		}
+
+		var name string
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+
+		s.addImport(name, importPath)
+	}
+
+	return nil
+}
+
+// collectMethods adds the interface methods to the set, methods of the interfaces embedded
+// from other packages are qualified with the name of their package the same way the generator does it
+func (o *options) collectMethods(sp *sourcePackage, name, alias string, typeParams map[string]bool, set *methodSet) error {
+	if set.visited[sp.pkg.PkgPath+"."+name] {
+		return nil
+	}
+	set.visited[sp.pkg.PkgPath+"."+name] = true
+
+	ts, fileName := findTypeSpec(sp.ast, name)
+	if ts == nil {
+		return nil
+	}
+
+	it, ok := ts.Type.(*ast.InterfaceType)
+	if !ok || it.Methods == nil {
+		return nil
+	}
+
+	//the generator takes the imports from the first file where it finds the interface declaration,
+	//which is random when the interface is declared in several files for different platforms
+	if err := set.addImports(sp.ast.Files[fileName]); err != nil {
+		return err
+	}
+
+	for _, field := range it.Methods.List {
+		switch t := field.Type.(type) {
+		case *ast.FuncType:
+			if len(field.Names) == 0 {
+				continue
+			}
+
+			if name := unexportedType(sp.ast, t, typeParams); name != "" && alias != "" {
+				return errors.Errorf("method %s uses unexported type %s of the %s package, the mock can only be generated into the %s package",
+					field.Names[0].Name, name, sp.pkg.Name, sp.pkg.Name)
+			}
+
+			m, err := generator.NewMethod(field.Names[0].Name, t, typePrinter{sp: sp, alias: alias, typeParams: typeParams})
+			if err != nil {
+				return errors.Wrapf(err, "failed to print signature of %s", field.Names[0].Name)
+			}
+
+			origin := methodOrigin{sp: sp, name: name}
+			if first, ok := set.origins[m.Name]; ok {
+				//the same method can be embedded several times since Go 1.14, i.e. with io.Reader and io.ReadCloser
+				if err := o.checkSignatures(set.source, m.Name, first, origin); err != nil {
+					return err
+				}
+				continue
+			}
+
+			set.methods[m.Name] = *m
+			set.origins[m.Name] = origin
+			set.docs[m.Name] = docComment(field.Doc)
+			set.fields[m.Name] = structFields{params: fieldNames(t.Params, "P"), results: fieldNames(t.Results, "R")}
+		case *ast.Ident:
+			if err := o.collectMethods(sp, t.Name, alias, typeParams, set); err != nil {
+				return err
+			}
+		case *ast.SelectorExpr:
+			x, ok := t.X.(*ast.Ident)
+			if !ok {
+				continue
+			}
+
+			importPath, err := o.importPath(sp.ast.Files[fileName], x.Name)
+			if err != nil {
+				return err
+			}
+
+			embedded, err := o.loadCached(importPath)
+			if err != nil {
+				return err
+			}
+
+			//types of the embedded package are qualified with its name even if it's imported under another one
+			set.addImport(embedded.pkg.Name, embedded.pkg.PkgPath)
+
+			if err := o.collectMethods(embedded, t.Sel.Name, embedded.pkg.Name, nil, set); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkSignatures returns an error if the methods with the same name declared by different interfaces have
+// different signatures, the packages are type checked only when the interface has such methods
+func (o *options) checkSignatures(source *sourcePackage, method string, first, second methodOrigin) error {
+	firstSignature, err := o.signature(source, first, method)
+	if err != nil {
+		return err
+	}
+
+	secondSignature, err := o.signature(source, second, method)
+	if err != nil {
+		return err
+	}
+
+	if types.Identical(firstSignature, secondSignature) {
+		return nil
+	}
+
+	qualifier := func(p *types.Package) string {
+		if p.Path() == source.pkg.PkgPath {
+			return ""
+		}
+		return p.Name()
+	}
+
+	return errors.Errorf("%s: conflicting signatures %q (from %s) and %q (from %s)", method,
+		types.TypeString(firstSignature, qualifier), first.String(source),
+		types.TypeString(secondSignature, qualifier), second.String(source))
+}
+
+// signature returns the type of the method declared by the interface
+func (o *options) signature(source *sourcePackage, origin methodOrigin, method string) (*types.Signature, error) {
+	p, err := o.typesPackage(source, origin.sp)
+	if err != nil {
+		return nil, err
+	}
+
+	if tn, ok := p.Scope().Lookup(origin.name).(*types.TypeName); ok {
+		if it, ok := tn.Type().Underlying().(*types.Interface); ok {
+			for i := 0; i < it.NumExplicitMethods(); i++ {
+				if m := it.ExplicitMethod(i); m.Name() == method {
+					return m.Type().(*types.Signature), nil
+				}
+			}
+		}
+	}
+
+	return nil, errors.Errorf("failed to find the type of %s.%s", origin.String(source), method)
+}
+
+// typesPackage returns the type checked package, the source package is checked on its own since it might not compile,
+// i.e. because of the very interface with the conflicting methods, the embedded packages are imported from the export
+// data by the same importer as the dependencies of the source package, so the types they share are identical
+func (o *options) typesPackage(source, sp *sourcePackage) (*types.Package, error) {
+	if source.exports == nil {
+		exports, err := loadExportData(o.packagesConfig(), source)
+		if err != nil {
+			return nil, err
+		}
+		source.exports = exports
+	}
+
+	if sp != source {
+		p, err := source.exports.Import(sp.pkg.PkgPath)
+		return p, errors.Wrapf(err, "failed to import %s", sp.pkg.PkgPath)
+	}
+
+	if sp.types == nil {
+		var names []string
+		for name := range sp.ast.Files {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var files []*ast.File
+		for _, name := range names {
+			files = append(files, sp.ast.Files[name])
+		}
+
+		conf := types.Config{Importer: sp.exports, Error: func(error) {}}
+		sp.types, _ = conf.Check(sp.pkg.PkgPath, sp.fset, files, nil) //errors are expected since the package might not compile
+	}
+
+	return sp.types, nil
+}
+
+// exportData imports the dependencies of the source package from the export data compiled by the go command,
+// so neither the syntax nor the types of the dependencies are loaded from their sources
+type exportData struct {
+	imports  map[string]string //package paths of the source package imports by the paths they're imported with
+	files    map[string]string //export data files of the dependencies by their package paths
+	importer types.Importer
+}
+
+// loadExportData lists the dependencies of the source package along with their export data,
+// the go command compiles the dependencies missing from the build cache
+func loadExportData(cfg packages.Config, source *sourcePackage) (*exportData, error) {
+	cfg.Mode = packages.LoadImports
+	cfg.BuildFlags = append(append([]string{}, cfg.BuildFlags...), "-export")
+
+	//errors of the source package itself are ignored since it might not compile
+	pkgs, err := loadPackages(&cfg, pkg.Dir(source.pkg))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load dependencies of %s", source.pkg.PkgPath)
+	}
+
+	d := &exportData{imports: map[string]string{}, files: map[string]string{}}
+	d.importer = importer.ForCompiler(token.NewFileSet(), "gc", d.lookup)
+
+	var walk func(p *packages.Package)
+	walk = func(p *packages.Package) {
+		if _, ok := d.files[p.PkgPath]; ok {
+			return
+		}
+
+		d.files[p.PkgPath] = p.ExportFile
+		for _, dep := range p.Imports {
+			walk(dep)
+		}
+	}
+
+	for _, p := range pkgs {
+		for importPath, dep := range p.Imports {
+			d.imports[importPath] = dep.PkgPath
+			walk(dep)
+		}
+	}
+
+	return d, nil
+}
+
+// Import implements types.Importer
+func (d *exportData) Import(importPath string) (*types.Package, error) {
+	if packagePath, ok := d.imports[importPath]; ok {
+		importPath = packagePath
+	}
+
+	return d.importer.Import(importPath)
+}
+
+func (d *exportData) lookup(packagePath string) (io.ReadCloser, error) {
+	fileName := d.files[packagePath]
+	if fileName == "" {
+		return nil, errors.Errorf("export data of %s is not found", packagePath)
+	}
+
+	return os.Open(fileName)
+}
+
+// unexportedType returns the name of the first unexported type of the package used in the method signature,
+// types used inside the exported types (i.e. as struct fields) are not checked since they're not referred to by the mock
+func unexportedType(p *ast.Package, ft *ast.FuncType, typeParams map[string]bool) (name string) {
+	var find func(n ast.Node) bool
+	find = func(n ast.Node) bool {
+		switch v := n.(type) {
+		case *ast.SelectorExpr:
+			return false
+		case *ast.Field:
+			ast.Inspect(v.Type, find)
+			return false
+		case *ast.Ident:
+			if ts, _ := findTypeSpec(p, v.Name); ts != nil && !typeParams[v.Name] && !ast.IsExported(v.Name) && name == "" {
+				name = v.Name
+			}
+		}
+		return name == ""
+	}
+	ast.Inspect(ft, find)
+
+	return name
+}
+
+// typePrinter prints types of the method parameters and results in the form
+// they have to be written in the destination package
+type typePrinter struct {
+	sp         *sourcePackage
+	alias      string
+	typeParams map[string]bool
+}
+
+// PrintType implements the type printer of the generator
+func (p typePrinter) PrintType(node ast.Node) (string, error) {
+	expr, ok := node.(ast.Expr)
+	if !ok {
+		return "", errors.Errorf("unexpected type node %T", node)
+	}
+
+	var variadic string
+	if ellipsis, ok := expr.(*ast.Ellipsis); ok {
+		expr, variadic = ellipsis.Elt, "..."
+	}
+
+	typ, err := printExpr(expr)
+	if err != nil {
+		return "", err
+	}
+
+	if p.alias != "" {
+		if typ, err = qualifyExpr(p.sp.ast, typ, p.alias, p.typeParams, arrayLengths(p.sp, expr)); err != nil {
+			return "", err
+		}
+	}
+
+	return variadic + typ, nil
+}
+
+// buildConstraint returns the build constraint of the generated mock that combines the constraint
+// copied from the source file, the one given with -build-tags flag and the platform the mock is generated for
+func buildConstraint(terms ...string) (string, error) {
+	var (
+		result constraint.Expr
+		added  = map[string]bool{}
+	)
+
+	for _, term := range terms {
+		if term == "" {
+			continue
+		}
+
+		e, err := constraint.Parse("//go:build " + term)
+		if err != nil {
+			return "", errors.Wrapf(err, "invalid build constraint %s", term)
+		}
+
+		//operands that are already required by the copied constraint (i.e. linux && integration) are skipped
+		for _, operand := range andOperands(e) {
+			if added[operand.String()] {
+				continue
+			}
+			added[operand.String()] = true
+
+			if result == nil {
+				result = operand
+			} else {
+				result = &constraint.AndExpr{X: result, Y: operand}
+			}
+		}
+	}
+
+	if result == nil {
+		return "", nil
+	}
+
+	return result.String(), nil
+}
+
+func andOperands(e constraint.Expr) []constraint.Expr {
+	if and, ok := e.(*constraint.AndExpr); ok {
+		return append(andOperands(and.X), andOperands(and.Y)...)
+	}
+
+	return []constraint.Expr{e}
+}
+
+// fileConstraint returns the build constraint of the Go file, the //go:build line takes precedence
+// over the // +build lines the same way it does for the go command
+func fileConstraint(fileName string) (string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), fileName, nil, parser.PackageClauseOnly|parser.ParseComments)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse %s", fileName)
+	}
+
+	var plusBuild constraint.Expr
+	for _, group := range f.Comments {
+		//constraints are only recognized before the package clause
+		if group.Pos() > f.Package {
+			break
+		}
+
+		for _, c := range group.List {
+			if !constraint.IsGoBuild(c.Text) && !constraint.IsPlusBuild(c.Text) {
+				continue
+			}
+
+			e, err := constraint.Parse(c.Text)
+			if err != nil {
+				return "", errors.Wrapf(err, "invalid build constraint in %s", fileName)
+			}
+
+			if constraint.IsGoBuild(c.Text) {
+				return e.String(), nil
+			}
+
+			if plusBuild == nil {
+				plusBuild = e
+			} else {
+				plusBuild = &constraint.AndExpr{X: plusBuild, Y: e}
+			}
+		}
+	}
+
+	if plusBuild == nil {
+		return "", nil
+	}
+
+	return plusBuild.String(), nil
+}
+
+// typeParams returns the type parameters list of the generic interface (i.e. [K comparable, V any])
+// and the list of the type arguments to instantiate the mock with (i.e. [K, V]),
+// when the alias is given the types of the source package used in constraints are qualified with it
+func typeParams(p *ast.Package, params *ast.FieldList, alias string) (string, string, error) {
+	declared := map[string]bool{}
+	for _, field := range params.List {
+		for _, name := range field.Names {
+			declared[name.Name] = true
+		}
+	}
+
+	var list, names []string
+	for _, field := range params.List {
+		constraint, err := printExpr(field.Type)
+		if err != nil {
+			return "", "", err
+		}
+
+		if alias != "" {
+			if constraint, err = qualifyExpr(p, constraint, alias, declared, nil); err != nil {
+				return "", "", err
+			}
+		}
+
+		var fieldNames []string
+		for _, name := range field.Names {
+			fieldNames = append(fieldNames, name.Name)
+		}
+
+		list = append(list, strings.Join(fieldNames, ", ")+" "+constraint)
+		names = append(names, fieldNames...)
+	}
+
+	return "[" + strings.Join(list, ", ") + "]", "[" + strings.Join(names, ", ") + "]", nil
+}
+
+// arrayLengths returns the lengths of the arrays used in the type in the order they appear in it,
+// the lengths that refer to the constants of the package are evaluated since the constants
+// may be unexported, the other lengths are left empty
+func arrayLengths(sp *sourcePackage, typ ast.Expr) []string {
+	var lengths []string
+	ast.Inspect(typ, func(n ast.Node) bool {
+		if a, ok := n.(*ast.ArrayType); ok && a.Len != nil {
+			var length string
+			if _, literal := a.Len.(*ast.BasicLit); !literal {
+				length = sp.constantValue(a.Len)
+			}
+			lengths = append(lengths, length)
+		}
+		return true
+	})
+
+	return lengths
+}
+
+// qualifyExpr prefixes names of the types declared in the package with the alias,
+// non-empty array lengths replace the lengths of the arrays in the order they appear in the expression
+func qualifyExpr(p *ast.Package, expr, alias string, typeParams map[string]bool, lengths []string) (string, error) {
+	e, err := parser.ParseExpr(expr)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse %s", expr)
+	}
+
+	var qualify func(n ast.Node) bool
+	qualify = func(n ast.Node) bool {
+		switch v := n.(type) {
+		case *ast.ArrayType:
+			if v.Len != nil && len(lengths) > 0 {
+				if lengths[0] != "" {
+					v.Len = &ast.BasicLit{Kind: token.INT, Value: lengths[0]}
+				}
+				lengths = lengths[1:]
+			}
+		case *ast.SelectorExpr: //types from other packages are already qualified
+			return false
+		case *ast.Field: //names of the fields, methods and parameters are never qualified
+			ast.Inspect(v.Type, qualify)
+			return false
+		case *ast.Ident:
+			if ts, _ := findTypeSpec(p, v.Name); ts != nil && !typeParams[v.Name] {
+				v.Name = alias + "." + v.Name
+			}
+		}
+		return true
+	}
+	ast.Inspect(e, qualify)
+
+	return printExpr(e)
+}
+
+func printExpr(e ast.Expr) (string, error) {
+	buf := bytes.NewBuffer([]byte{})
+	if err := printer.Fprint(buf, token.NewFileSet(), e); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// maxAliasDepth limits the chain of aliases and named types that is followed to find the interface
+const maxAliasDepth = 10
+
+// resolveInterface follows aliases (type A = b.B) and named types (type A b.B) declared in the source package
+// and returns the package and the name of the interface declaration they refer to
+func (o *options) resolveInterface(sp *sourcePackage, name string) (*sourcePackage, string, error) {
+	for i := 0; i < maxAliasDepth; i++ {
+		ts, fileName := findTypeSpec(sp.ast, name)
+		if ts == nil {
+			return nil, "", errors.Errorf("type %s is not found in %s", name, sp.pkg.Name)
+		}
+
+		switch t := ts.Type.(type) {
+		case *ast.InterfaceType:
+			if err := checkEmbedded(sp.ast, name, t); err != nil {
+				return nil, "", err
+			}
+			return sp, name, nil
+		case *ast.Ident:
+			name = t.Name
+		case *ast.SelectorExpr:
+			x, ok := t.X.(*ast.Ident)
+			if !ok {
+				return nil, "", errors.Errorf("%s is not an interface", name)
+			}
+
+			importPath, err := o.importPath(sp.ast.Files[fileName], x.Name)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if sp, err = o.loadCached(importPath); err != nil {
+				return nil, "", err
+			}
+			name = t.Sel.Name
+		default:
+			return nil, "", errors.Errorf("%s is not an interface", name)
+		}
+	}
+
+	return nil, "", errors.Errorf("too many aliases to follow for %s", name)
+}
+
+// checkEmbedded returns an error if the interface embeds types that the generator can't process
+func checkEmbedded(p *ast.Package, name string, it *ast.InterfaceType) error {
+	if terms := typeTerms(p, it); len(terms) > 0 {
+		message := fmt.Sprintf("%s is a constraint interface with type terms %s, constraint interfaces can't be mocked", name, strings.Join(terms, ", "))
+		if methods := methodNames(p, it, map[string]bool{}); len(methods) > 0 {
+			message += fmt.Sprintf(" (methods found: %s)", strings.Join(methods, ", "))
+		}
+		return errors.New(message)
+	}
+
+	for _, e := range embeddedTypes(p, it, map[string]bool{}) {
+		switch v := e.(type) {
+		case *ast.Ident:
+			if ts, _ := findTypeSpec(p, v.Name); ts == nil && v.Name == "error" {
+				return errors.Errorf("%s embeds predeclared error interface which is not supported yet, declare Error() string method explicitly instead", name)
+			}
+		case *ast.IndexExpr, *ast.IndexListExpr:
+			embedded, err := printExpr(v)
+			if err != nil {
+				return err
+			}
+			return errors.Errorf("%s embeds instantiated generic interface %s which is not supported yet, declare its methods explicitly instead", name, embedded)
+		}
+	}
+
+	return nil
+}
+
+// typeTerms returns type set terms (~int, int | string, etc) found in the interface and
+// in the interfaces it embeds, such interfaces can only be used as type constraints
+func typeTerms(p *ast.Package, it *ast.InterfaceType) []string {
+	var terms []string
+	for _, e := range embeddedTypes(p, it, map[string]bool{}) {
+		switch v := e.(type) {
+		case *ast.Ident:
+			ts, _ := findTypeSpec(p, v.Name)
+			if ts == nil && v.Name == "error" || ts != nil && isInterfaceReference(ts.Type) {
+				continue
+			}
+		case *ast.SelectorExpr, *ast.IndexExpr, *ast.IndexListExpr:
+			//types from other packages and instantiated generics are treated as interfaces
+			continue
+		}
+
+		term, err := printExpr(e)
+		if err == nil {
+			terms = append(terms, term)
+		}
+	}
+
+	return terms
+}
+
+// isInterfaceReference returns true if the type expression might be an interface,
+// aliases and named types are not resolved
+func isInterfaceReference(e ast.Expr) bool {
+	switch e.(type) {
+	case *ast.InterfaceType, *ast.Ident, *ast.SelectorExpr, *ast.IndexExpr, *ast.IndexListExpr:
+		return true
+	}
+
+	return false
+}
+
+// methodNames returns sorted names of the methods declared in the interface
+// and in the interfaces from the same package it embeds
+func methodNames(p *ast.Package, it *ast.InterfaceType, visited map[string]bool) []string {
+	if it.Methods == nil {
+		return nil
+	}
+
+	var names []string
+	for _, field := range it.Methods.List {
+		if len(field.Names) > 0 {
+			names = append(names, field.Names[0].Name)
+			continue
+		}
+
+		if ident, ok := field.Type.(*ast.Ident); ok && !visited[ident.Name] {
+			visited[ident.Name] = true
+			if ts, _ := findTypeSpec(p, ident.Name); ts != nil {
+				if embedded, ok := ts.Type.(*ast.InterfaceType); ok {
+					names = append(names, methodNames(p, embedded, visited)...)
+				}
+			}
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// embeddedTypes returns the types embedded into the interface directly
+// or via the other interfaces declared in the same package
+func embeddedTypes(p *ast.Package, it *ast.InterfaceType, visited map[string]bool) []ast.Expr {
+	if it.Methods == nil {
+		return nil
+	}
+
+	var result []ast.Expr
+	for _, field := range it.Methods.List {
+		if len(field.Names) > 0 {
+			continue
+		}
+		result = append(result, field.Type)
+
+		ident, ok := field.Type.(*ast.Ident)
+		if !ok || visited[ident.Name] {
+			continue
+		}
+		visited[ident.Name] = true
+
+		if ts, _ := findTypeSpec(p, ident.Name); ts != nil {
+			if embedded, ok := ts.Type.(*ast.InterfaceType); ok {
+				result = append(result, embeddedTypes(p, embedded, visited)...)
+			}
+		}
+	}
+
+	return result
+}
+
+// importPath returns the import path of the package that is referred by the selector in the file
+func (o *options) importPath(f *ast.File, selector string) (string, error) {
+	var candidates []string
+	for _, i := range f.Imports {
+		importPath, err := strconv.Unquote(i.Path.Value)
+		if err != nil {
+			return "", err
+		}
+
+		if i.Name != nil {
+			if i.Name.Name == selector {
+				return importPath, nil
+			}
+			continue
+		}
+
+		//package name usually matches the last element of the import path so such imports are checked first
+		if path.Base(importPath) == selector {
+			candidates = append([]string{importPath}, candidates...)
+		} else {
+			candidates = append(candidates, importPath)
+		}
+	}
+
+	for _, importPath := range candidates {
+		if sp, err := o.loadCached(importPath); err == nil && sp.pkg.Name == selector {
+			return importPath, nil
+		}
+	}
+
+	return "", errors.Errorf("failed to find the import of the %s package", selector)
+}
+
+// loadCached returns the package from the cache or loads it if it's not there yet
+func (o *options) loadCached(importPath string) (*sourcePackage, error) {
+	key, err := o.cacheKey(importPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if sp, ok := o.cache[key]; ok {
+		return sp, nil
+	}
+
+	sp, err := loadSourcePackage(o.packagesConfig(), importPath)
+	if err != nil {
+		return nil, err
+	}
+
+	o.cache[key] = sp
+	return sp, nil
+}
+
+// findTypeSpec returns the type declaration and the name of the file where it's declared
+func findTypeSpec(p *ast.Package, name string) (*ast.TypeSpec, string) {
+	for fileName, f := range p.Files {
+		for _, d := range f.Decls {
+			if gd, ok := d.(*ast.GenDecl); ok && gd.Tok == token.TYPE {
+				for _, spec := range gd.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok && ts.Name.Name == name {
+						return ts, fileName
+					}
+				}
+			}
+		}
+	}
+
+	return nil, ""
+}
+
+// importsPackage returns true if any file of the package imports the package with the given import path
+func importsPackage(p *ast.Package, importPath string) bool {
+	for _, f := range p.Files {
+		for _, spec := range f.Imports {
+			if path, err := strconv.Unquote(spec.Path.Value); err == nil && path == importPath {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// typeDoc returns the documentation comment of the type declaration, the comment of the declaration group
+// is taken when the type is the only one declared in the group
+func typeDoc(p *ast.Package, name string) *ast.CommentGroup {
+	for _, f := range p.Files {
+		for _, d := range f.Decls {
+			gd, ok := d.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range gd.Specs {
+				if ts, ok := spec.(*ast.TypeSpec); ok && ts.Name.Name == name {
+					if ts.Doc == nil && len(gd.Specs) == 1 {
+						return gd.Doc
+					}
+					return ts.Doc
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// docComment renders the documentation comment as line comments, the directives are dropped
+// and the block comments are converted, so the copied comment can't break the generated code
+func docComment(doc *ast.CommentGroup) string {
+	text := strings.TrimSpace(doc.Text())
+	if text == "" {
+		return ""
+	}
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if line = strings.TrimRight(line, " \t"); line == "" {
+			lines[i] = "//"
+		} else {
+			lines[i] = "// " + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// renderMocks generates code of the mocks concurrently,
+// each mock is rendered with its own templates so they don't share any mutable state
+func renderMocks(mocks []*mock) {
+	var (
+		wg        sync.WaitGroup
+		semaphore = make(chan struct{}, runtime.NumCPU())
+	)
+
+	for _, m := range mocks {
+		wg.Add(1)
+		semaphore <- struct{}{}
+
+		go func(m *mock) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			if m.code, m.err = generate(m); m.err != nil {
+				m.err = errors.Wrapf(m.err, "failed to generate mock for %s", m.options.InterfaceName)
+			}
+		}(m)
+	}
+
+	wg.Wait()
+}
+
+// filter removes interfaces listed in the -x flag
+func (o *options) filter(interfaces []string) []string {
+	var result []string
+	for _, name := range interfaces {
+		if o.exclude[name] {
+			if o.verbose {
+				o.logf("%s is excluded", name)
+			}
+			continue
+		}
+		result = append(result, name)
+	}
+
+	return result
+}
+
+func (o *options) logf(format string, args ...interface{}) {
+	fmt.Fprintf(o.log, "minimock: "+format+"\n", args...)
+}
+
+// packagesConfig returns the configuration of go list the packages are loaded with, the build tags and the target
+// platform are passed to go list by every run on its own, so they don't leak into the runs of the other instructions
+func (o *options) packagesConfig() packages.Config {
+	cfg := packages.Config{Mode: packages.LoadFiles}
+	if o.tags != "" {
+		cfg.BuildFlags = []string{"-tags=" + o.tags}
+	}
+
+	if o.goos != "" || o.goarch != "" {
+		cfg.Env = os.Environ()
+		if o.goos != "" {
+			cfg.Env = append(cfg.Env, "GOOS="+o.goos)
+		}
+		if o.goarch != "" {
+			cfg.Env = append(cfg.Env, "GOARCH="+o.goarch)
+		}
+	}
+
+	return cfg
+}
+
+func loadSourcePackage(cfg packages.Config, importPath string) (*sourcePackage, error) {
+	p, files, err := loadPackage(cfg, importPath)
+	if err != nil {
+		if strings.Contains(err.Error(), "build constraints exclude all Go files") {
+			return nil, errors.Wrapf(err, "failed to load %s, build tags might be required (see -tags flag) "+
+				"or cgo has to be enabled with CGO_ENABLED=1 if the package uses cgo", importPath)
+		}
+		return nil, err
+	}
+
+	//comments are parsed to copy the documentation of the interface into the mock
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, pkg.Dir(p), nil, parser.DeclarationErrors|parser.ParseComments)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load package sources")
+	}
+
+	astPackage, ok := pkgs[p.Name]
+	if !ok {
+		astPackage = &ast.Package{Name: p.Name, Files: map[string]*ast.File{}}
+	}
+
+	//all files of the directory are parsed, so the files excluded by the build constraints are removed,
+	//otherwise declarations from the files of other platforms (i.e. watcher_darwin.go on linux) are mixed up
+	excluded := &ast.Package{Name: p.Name, Files: map[string]*ast.File{}}
+	for fileName, f := range astPackage.Files {
+		if !files[fileName] {
+			excluded.Files[fileName] = f
+			delete(astPackage.Files, fileName)
+		}
+	}
+
+	return &sourcePackage{pkg: p, ast: astPackage, fset: fset, excluded: excluded}, nil
+}
+
+// buildTagsHint adds the hint about the build tags to the error of the interface lookup
+// when the interface is declared in a file of the package excluded by the build constraints
+func buildTagsHint(sp *sourcePackage, in interfaceInfo, err error) error {
+	names, _ := findInterfaces(sp.excluded, in)
+	if len(names) == 0 {
+		return err
+	}
+
+	_, fileName := findTypeSpec(sp.excluded, names[0])
+	return errors.Errorf("%v, %s is declared in %s which is excluded by the build constraints, "+
+		"build tags might be required (see -tags flag) or the target platform (see -goos and -goarch flags)", err, names[0], filepath.Base(fileName))
+}
+
+// loadPackages runs go list, it's replaced by the tests counting how many times the packages are loaded
+var loadPackages = packages.Load
+
+// loadPackage loads the package along with its test files and returns the package
+// and the set of its files that satisfy the build constraints, including the _test.go files
+func loadPackage(cfg packages.Config, importPath string) (*packages.Package, map[string]bool, error) {
+	cfg.Tests = true
+
+	pkgs, err := loadPackages(&cfg, importPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(pkgs) == 0 {
+		return nil, nil, errors.Errorf("package %s is not found", importPath)
+	}
+
+	//test variants of the package (i.e. "p [p.test]") are listed along with the package itself
+	p := pkgs[0]
+	for _, candidate := range pkgs {
+		if candidate.ID == candidate.PkgPath {
+			p = candidate
+			break
+		}
+	}
+
+	if len(p.Errors) > 0 {
+		return nil, nil, p.Errors[0]
+	}
+
+	files := map[string]bool{}
+	for _, variant := range pkgs {
+		if variant.PkgPath == p.PkgPath {
+			for _, fileName := range append(variant.GoFiles, variant.OtherFiles...) {
+				files[fileName] = true
+			}
+		}
+	}
+
+	return p, files, nil
+}
+
+// constantValue returns the value of the constant expression or an empty string if it can't be evaluated,
+// the package is type checked on the first call without resolving its imports, so only the constants
+// that don't depend on the other packages are evaluated
+func (sp *sourcePackage) constantValue(e ast.Expr) string {
+	if sp.info == nil {
+		var names []string
+		for name := range sp.ast.Files {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var files []*ast.File
+		for _, name := range names {
+			files = append(files, sp.ast.Files[name])
+		}
+
+		sp.info = &types.Info{Types: map[ast.Expr]types.TypeAndValue{}}
+		conf := types.Config{Importer: unsafeImporter{}, Error: func(error) {}}
+		conf.Check(sp.pkg.PkgPath, sp.fset, files, sp.info) //errors of the unresolved imports are expected
+	}
+
+	if tv, ok := sp.info.Types[e]; ok && tv.Value != nil {
+		return tv.Value.ExactString()
+	}
+
+	return ""
+}
+
+// unsafeImporter resolves the unsafe package only
+type unsafeImporter struct{}
+
+func (unsafeImporter) Import(importPath string) (*types.Package, error) {
+	if importPath == "unsafe" {
+		return types.Unsafe, nil
+	}
+
+	return nil, errors.Errorf("%s is not resolved", importPath)
+}
+
+// checkTestInterface returns an error if the interface is declared in a _test.go file and
+// the destination is not a _test.go file in the same package, since test files are not visible outside
+func checkTestInterface(sp *sourcePackage, interfaceName, outputFile string) error {
+	_, testFile := findTypeSpec(sp.ast, interfaceName)
+	if !strings.HasSuffix(testFile, "_test.go") {
+		return nil
+	}
+
+	outputDir, err := filepath.Abs(filepath.Dir(outputFile))
+	if err != nil {
+		return err
+	}
+
+	if outputDir != filepath.Dir(testFile) || !strings.HasSuffix(outputFile, "_test.go") {
+		return errors.Errorf("%s is declared in %s, its mock can only be generated into a _test.go file in the same directory", interfaceName, testFile)
+	}
+
+	return nil
+}
+
+// cacheKey returns absolute path for the local packages and import path for the others
+func (o *options) cacheKey(importPath string) (string, error) {
+	key := importPath
+	if isLocalPath(importPath) {
+		abs, err := filepath.Abs(importPath)
+		if err != nil {
+			return "", err
+		}
+		key = abs
+	}
+
+	//the same package loaded for different platforms or with different build tags has different files,
+	//the platform and the tags given by the flags take precedence over the environment
+	return fmt.Sprintf("%s %s/%s %s %s/%s -tags=%s", key, os.Getenv("GOOS"), os.Getenv("GOARCH"), os.Getenv("GOFLAGS"),
+		o.goos, o.goarch, o.tags), nil
+}
+
+func isLocalPath(importPath string) bool {
+	return strings.HasPrefix(importPath, ".") || filepath.IsAbs(importPath)
+}
+
+// packageDir returns the directory of the package relative to the current working directory
+func packageDir(p *packages.Package) (string, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Rel(wd, pkg.Dir(p))
+}
+
+// output writes generated code of the mock to the destination
+func (o *options) output(m *mock) error {
+	if o.dryRun {
+		fmt.Fprint(o.stdout, dryRunListing(m.code))
+		return nil
+	}
+
+	if o.check {
+		return checkUpToDate(m.options.OutputFile, m.code)
+	}
+
+	if m.writeTo == writeToStdout {
+		if _, err := o.stdout.Write(m.code); err != nil {
+			return errors.Wrap(err, "failed to write generated code")
+		}
+		return nil
+	}
+
+	//unchanged mocks are not rewritten so their modification time doesn't trigger rebuilds
+	if !o.force {
+		upToDate, err := isUpToDate(m.options.OutputFile, m.code)
+		if err != nil {
+			return err
+		}
+
+		if upToDate {
+			if o.verbose {
+				o.logf("%s is up to date", m.options.OutputFile)
+			}
+			return nil
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(m.options.OutputFile), 0755); err != nil {
+		return errors.Wrap(err, "failed to create destination directory")
+	}
+
+	if err := writeFile(m.options.OutputFile, m.code); err != nil {
+		return errors.Wrapf(err, "failed to write %s", m.options.OutputFile)
+	}
+
+	o.logf("%s", m.options.OutputFile)
+	return nil
+}
+
+// writeFile writes the code to a temporary file in the destination directory and renames it to the file name,
+// so the file is never left partially written, permissions of the existing file are preserved
+func writeFile(fileName string, code []byte) (err error) {
+	mode := os.FileMode(0644)
+	if stat, err := os.Stat(fileName); err == nil {
+		mode = stat.Mode().Perm()
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(fileName), "."+filepath.Base(fileName)+".")
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
+
+	if _, err = f.Write(code); err != nil {
+		return err
+	}
+
+	if err = f.Sync(); err != nil {
+		return err
+	}
+
+	if err = f.Chmod(mode); err != nil {
+		return err
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), fileName)
+}
+
+// dry run templates render the list of the interface methods as comments,
+// signatures are rendered by the declaration helper exactly as they appear in the generated mocks
+const (
+	dryRunHeaderTemplate = `package {{packageName $.Package.Name}}`
+
+	dryRunBodyTemplate = `
+		// {{$.Interface.Type}}
+		{{range $method := (methods $.Interface.Methods)}}
+			//	{{declaration $method}}
+		{{end}}
+	`
+)
+
+// declaration returns the declaration of the method formatted the same way as the methods of the generated mocks,
+// i.e. the empty list of the results is omitted
+func declaration(m generator.Method) (string, error) {
+	code, err := format.Source([]byte("package p\nfunc " + m.Declaration() + " {}"))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to format declaration of %s", m.Name)
+	}
+
+	decl := strings.SplitN(string(code), "\nfunc ", 2)[1]
+	return strings.TrimSuffix(decl, " {}\n"), nil
+}
+
+// dryRunListing extracts the list of the methods from the code generated with the dry run templates
+func dryRunListing(code []byte) string {
+	buf := bytes.NewBuffer([]byte{})
+	for _, line := range strings.Split(string(code), "\n") {
+		if strings.HasPrefix(line, "//") {
+			fmt.Fprintln(buf, strings.TrimPrefix(strings.TrimPrefix(line, "//"), " "))
+		}
+	}
+
+	return buf.String()
+}
+
+// checkUpToDate returns an error if the content of the file differs from the generated code
+func checkUpToDate(fileName string, code []byte) error {
+	upToDate, err := isUpToDate(fileName, code)
+	if err != nil {
+		return err
+	}
+
+	if !upToDate {
+		return errors.Errorf("mock is out of date: %s", fileName)
+	}
+
+	return nil
+}
+
+// isUpToDate returns true if the file exists and its content is equal to the generated code
+func isUpToDate(fileName string, code []byte) (bool, error) {
+	content, err := ioutil.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(content, code), nil
+}
+
+func isGoFile(path string) (bool, error) {
+	stat, err := os.Stat(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return false, err
+		}
+
+		dir := filepath.Dir(path)
+		if stat, err = os.Stat(dir); err != nil {
+			if !os.IsNotExist(err) {
+				return false, err
+			}
+
+			//destination directory doesn't exist yet and will be created
+			return strings.HasSuffix(path, ".go"), nil
+		}
+
+		if !stat.IsDir() {
+			return false, errors.Errorf("not a directory: %s", dir)
+		}
+
+		return strings.HasSuffix(path, ".go"), nil
+	}
+
+	return strings.HasSuffix(path, ".go") && !stat.IsDir(), nil
+}
+
+// writeToStdout is a special value of the -o flag that makes minimock to write generated code to stdout
+const writeToStdout = "-"
+
+func destinationFile(interfaceName, writeTo, suffix string) (string, error) {
+	//when the generated code goes to stdout the destination file in the current
+	//directory is only used to resolve the imports of the generated code
+	if writeTo == writeToStdout {
+		writeTo = ""
+	}
+
+	ok, err := isGoFile(writeTo)
+	if err != nil {
+		return "", err
+	}
+
+	var path string
+
+	if ok {
+		path = writeTo
+	} else {
+		path = filepath.Join(writeTo, minimock.CamelToSnake(interfaceName)+suffix)
+	}
+
+	if !strings.HasPrefix(path, "/") && !strings.HasPrefix(path, ".") {
+		path = "./" + path
+	}
+
+	return path, nil
+}
+
+// generate renders the templates with the same inputs the generator passes to them, the generator itself isn't used
+// since it loads the source package again to look for the methods of the interface on its own and fails on the interfaces
+// embedding the same method more than once
+func generate(m *mock) ([]byte, error) {
+	o := m.options
+
+	headerTemplate, err := template.New("header").Funcs(o.Funcs).Parse(o.HeaderTemplate)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse header template")
+	}
+
+	bodyTemplate, err := template.New("body").Funcs(o.Funcs).Parse(o.BodyTemplate)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse body template")
 	}
 
-	interfaceInfo struct {
-		Type       string
-		ImportPath string
-		WriteTo    string
+	interfaceType := m.source.Name + "." + o.InterfaceName
+	if m.source.PkgPath == m.destination.PkgPath {
+		interfaceType = o.InterfaceName
 	}
-)
 
-func main() {
-	opts, err := processArgs(os.Args[1:], os.Stdout, os.Stderr)
-	if err != nil {
-		if err == errInvalidArguments {
-			os.Exit(2)
-		}
+	o.Imports = m.imports
 
-		die("%v", err)
+	buf := bytes.NewBuffer([]byte{})
+
+	err = headerTemplate.Execute(buf, map[string]interface{}{
+		"SourcePackage": m.source,
+		"Package":       m.destination,
+		"Vars":          o.Vars,
+		"Options":       o,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	if opts == nil { //help requested
-		os.Exit(0)
+	err = bodyTemplate.Execute(buf, generator.TemplateInputs{
+		Interface: generator.TemplateInputInterface{Name: o.InterfaceName, Type: interfaceType, Methods: m.methods},
+		Vars:      o.Vars,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	if err = run(opts); err != nil {
-		die("%v", err)
+	code, err := imports.Process(o.OutputFile, buf.Bytes(), nil)
+	if err != nil {
+		return nil, syntaxError(err, buf.Bytes())
 	}
+
+	return fixImports(o.OutputFile, code, m.config)
 }
 
-func run(opts *options) (err error) {
-	var (
-		sourcePackage *packages.Package
-		astPackage    *ast.Package
-		fs            *token.FileSet
-	)
+// destinationPackage returns the package in the directory the mock is generated into, the source packages
+// are reused when the mock goes next to the interface, other directories are loaded once per run,
+// the package is named after the directory when there are no Go files in it yet
+func (o *options) destinationPackage(dir string, sources ...*sourcePackage) (*packages.Package, error) {
+	for _, sp := range sources {
+		if dir == pkg.Dir(sp.pkg) {
+			return sp.pkg, nil
+		}
+	}
 
-	for i, in := range opts.interfaces {
-		if i == 0 || in.ImportPath != opts.interfaces[i-1].ImportPath {
-			if sourcePackage, err = pkg.Load(in.ImportPath); err != nil {
-				return err
-			}
+	if p, ok := o.destinations[dir]; ok {
+		return p, nil
+	}
 
-			fs = token.NewFileSet()
-			if astPackage, err = pkg.AST(fs, sourcePackage); err != nil {
-				return errors.Wrap(err, "failed to load package sources")
-			}
-		}
+	p, err := loadDestination(o.packagesConfig(), dir)
+	if err != nil {
+		return nil, err
+	}
 
-		interfaces, err := findInterfaces(astPackage, in.Type)
-		if err != nil {
-			return err
-		}
+	o.destinations[dir] = p
+	return p, nil
+}
 
-		gopts := generator.Options{
-			SourcePackage:      sourcePackage.PkgPath,
-			SourcePackageAlias: "mm_" + sourcePackage.Name,
-			HeaderTemplate:     minimock.HeaderTemplate,
-			BodyTemplate:       minimock.BodyTemplate,
-			HeaderVars: map[string]interface{}{
-				"GenerateInstruction": !opts.noGenerate,
-				"Version":             version,
-			},
-			Funcs: helpers,
-		}
+func loadDestination(cfg packages.Config, dir string) (*packages.Package, error) {
+	pkgs, err := loadPackages(&cfg, dir)
+	if err == nil && len(pkgs) > 0 && len(pkgs[0].Errors) == 0 {
+		return pkgs[0], nil
+	}
 
-		if err := processPackage(gopts, interfaces, in.WriteTo, opts.suffix); err != nil {
-			return err
+	if name := filepath.Base(dir); name != string(filepath.Separator) && name != "." {
+		return &packages.Package{Name: name}, nil
+	}
+
+	return nil, errors.Errorf("failed to determine the destination package name: %s", dir)
+}
+
+// syntaxError returns the error followed by the numbered lines of the code around the error location
+func syntaxError(err error, code []byte) error {
+	var line int
+	switch e := err.(type) {
+	case scanner.ErrorList:
+		if len(e) > 0 {
+			line = e[0].Pos.Line
 		}
+	case *scanner.Error:
+		line = e.Pos.Line
 	}
 
-	return nil
+	if line == 0 {
+		return errors.Wrap(err, "generated code is invalid")
+	}
+
+	return errors.Errorf("generated code is invalid: %v\n%s", err, codeExcerpt(code, line))
 }
 
-func processPackage(opts generator.Options, interfaces []string, writeTo, suffix string) (err error) {
-	for _, name := range interfaces {
-		opts.InterfaceName = name
-		opts.OutputFile, err = destinationFile(name, writeTo, suffix)
-		if err != nil {
-			return errors.Wrapf(err, "failed to generate mock for %s", name)
+// excerptLines is a number of lines shown before and after the line with the error
+const excerptLines = 5
+
+// codeExcerpt returns numbered lines of the code around the given line, the line itself is marked with >
+func codeExcerpt(code []byte, line int) string {
+	lines := strings.Split(string(code), "\n")
+
+	buf := bytes.NewBuffer([]byte{})
+	for i := line - excerptLines; i <= line+excerptLines; i++ {
+		if i < 1 || i > len(lines) {
+			continue
 		}
 
-		if err := generate(opts); err != nil {
-			return err
+		marker := " "
+		if i == line {
+			marker = ">"
 		}
 
-		fmt.Printf("minimock: %s\n", opts.OutputFile)
+		fmt.Fprintf(buf, "%s%5d | %s\n", marker, i, lines[i-1])
 	}
 
-	return nil
+	return buf.String()
 }
 
-func isGoFile(path string) (bool, error) {
-	stat, err := os.Stat(path)
+// fixImports parses the generated code and fixes the imports that can't be used in the destination package,
+// an error is returned for the imports that can't be fixed
+func fixImports(fileName string, code []byte, cfg packages.Config) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, fileName, code, parser.ParseComments)
 	if err != nil {
-		if !os.IsNotExist(err) {
+		return nil, syntaxError(err, code)
+	}
+
+	selfImport, err := removeSelfImport(fset, f, fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	dotImports, err := replaceDotImports(fset, f, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkInternalImports(f, fileName); err != nil {
+		return nil, err
+	}
+
+	if !selfImport && !dotImports {
+		return code, nil
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	if err := format.Node(buf, fset, f); err != nil {
+		return nil, errors.Wrap(err, "failed to format generated code")
+	}
+
+	return buf.Bytes(), nil
+}
+
+// removeSelfImport removes the import of the destination package from the generated code
+// and the qualifiers of the destination package types, such import appears when the interface
+// refers to the types declared in the package where the mock is generated into
+func removeSelfImport(fset *token.FileSet, f *ast.File, fileName string) (bool, error) {
+	for _, spec := range f.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
 			return false, err
 		}
 
-		dir := filepath.Dir(path)
-		if stat, err = os.Stat(dir); err != nil {
-			return false, err
+		//only imports of the packages with the same name as the destination package are checked,
+		//so the destination package is loaded only when it's really necessary
+		if spec.Name != nil || path.Base(importPath) != f.Name.Name {
+			continue
 		}
 
-		if !stat.IsDir() {
-			return false, errors.Errorf("not a directory: %s", dir)
+		if destinationPath(fileName) != importPath {
+			continue
 		}
 
-		return strings.HasSuffix(path, ".go"), nil
+		astutil.Apply(f, func(c *astutil.Cursor) bool {
+			if se, ok := c.Node().(*ast.SelectorExpr); ok {
+				if x, ok := se.X.(*ast.Ident); ok && x.Name == f.Name.Name && x.Obj == nil {
+					c.Replace(se.Sel)
+				}
+			}
+			return true
+		}, nil)
+
+		return astutil.DeleteImport(fset, f, importPath), nil
 	}
 
-	return strings.HasSuffix(path, ".go") && !stat.IsDir(), nil
+	return false, nil
 }
 
-func destinationFile(interfaceName, writeTo, suffix string) (string, error) {
-	ok, err := isGoFile(writeTo)
+// destinationPath returns the import path of the package the file belongs to
+// or an empty string if it can't be determined, the destination directory
+// doesn't have to exist or contain any Go files when it's a part of a module
+func destinationPath(fileName string) string {
+	dir, err := filepath.Abs(filepath.Dir(fileName))
 	if err != nil {
-		return "", err
+		return ""
 	}
 
-	var path string
+	if root, modulePath := findModule(dir); root != "" {
+		rel, err := filepath.Rel(root, dir)
+		if err != nil {
+			return ""
+		}
+		return path.Join(modulePath, filepath.ToSlash(rel))
+	}
 
-	if ok {
-		path = writeTo
-	} else {
-		path = filepath.Join(writeTo, minimock.CamelToSnake(interfaceName)+suffix)
+	//errors are ignored since the previously generated mock may break the package
+	dst, err := packages.Load(&packages.Config{Mode: packages.LoadFiles}, dir)
+	if err != nil || len(dst) == 0 {
+		return ""
 	}
 
-	if !strings.HasPrefix(path, "/") && !strings.HasPrefix(path, ".") {
-		path = "./" + path
+	return dst[0].PkgPath
+}
+
+// findModule returns the root directory and the path of the module the directory belongs to
+func findModule(dir string) (string, string) {
+	for ; ; dir = filepath.Dir(dir) {
+		if data, err := ioutil.ReadFile(filepath.Join(dir, "go.mod")); err == nil {
+			return dir, moduleDirective(data)
+		}
+
+		if dir == filepath.Dir(dir) {
+			return "", ""
+		}
 	}
+}
 
-	return path, nil
+// moduleDirective returns the module path declared in the go.mod file
+func moduleDirective(data []byte) string {
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(strings.SplitN(line, "//", 2)[0])
+		if len(fields) != 2 || fields[0] != "module" {
+			continue
+		}
+
+		if modulePath, err := strconv.Unquote(fields[1]); err == nil {
+			return modulePath
+		}
+		return fields[1]
+	}
+
+	return ""
 }
 
-func generate(o generator.Options) (err error) {
-	g, err := generator.NewGenerator(o)
-	if err != nil {
-		return err
+// checkInternalImports returns an error if the generated code imports internal packages
+// that are not visible from the destination package
+func checkInternalImports(f *ast.File, fileName string) error {
+	var dst string
+	for _, spec := range f.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return err
+		}
+
+		parent, ok := internalParent(importPath)
+		if !ok {
+			continue
+		}
+
+		//destination package is loaded only when there are internal imports
+		if dst == "" {
+			if dst = destinationPath(fileName); dst == "" {
+				return nil
+			}
+		}
+
+		if dst != parent && !strings.HasPrefix(dst, parent+"/") {
+			if parent == "" {
+				return errors.Errorf("internal package %s can't be imported by the %s package the mock is generated into", importPath, dst)
+			}
+			return errors.Errorf("internal package %s can't be imported by the %s package the mock is generated into, "+
+				"the mock has to be generated into %s or one of its subpackages", importPath, dst, parent)
+		}
 	}
 
-	buf := bytes.NewBuffer([]byte{})
+	return nil
+}
+
+// internalParent returns the path of the package that contains the nearest internal element of the import path,
+// only this package and its subpackages can import the packages of the internal tree
+func internalParent(importPath string) (string, bool) {
+	switch {
+	case strings.HasSuffix(importPath, "/internal"):
+		return strings.TrimSuffix(importPath, "/internal"), true
+	case strings.Contains(importPath, "/internal/"):
+		return importPath[:strings.LastIndex(importPath, "/internal/")], true
+	case importPath == "internal" || strings.HasPrefix(importPath, "internal/"):
+		return "", true
+	}
+
+	return "", false
+}
+
+// replaceDotImports replaces dot imports copied from the source file with the regular imports
+// and qualifies the types of the dot imported packages, so they don't clash with the declarations
+// of the destination package
+func replaceDotImports(fset *token.FileSet, f *ast.File, cfg packages.Config) (bool, error) {
+	var replaced bool
+	for _, spec := range f.Imports {
+		if spec.Name == nil || spec.Name.Name != "." {
+			continue
+		}
+
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return false, err
+		}
+
+		sp, err := loadSourcePackage(cfg, importPath)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to load dot imported package %s", importPath)
+		}
+
+		astutil.Apply(f, func(c *astutil.Cursor) bool {
+			ident, ok := c.Node().(*ast.Ident)
+			if !ok || ident.Obj != nil || !ast.IsExported(ident.Name) {
+				return true
+			}
 
-	if err = g.Generate(buf); err != nil {
-		return errors.Wrap(err, "failed to generate mock")
+			//names of the methods, selected fields and keys of the composite literals are never qualified
+			switch c.Parent().(type) {
+			case *ast.SelectorExpr, *ast.FuncDecl, *ast.KeyValueExpr:
+				return true
+			}
+
+			if ts, _ := findTypeSpec(sp.ast, ident.Name); ts != nil {
+				c.Replace(&ast.SelectorExpr{X: ast.NewIdent(sp.pkg.Name), Sel: ast.NewIdent(ident.Name)})
+			}
+			return true
+		}, nil)
+
+		astutil.DeleteNamedImport(fset, f, ".", importPath)
+		astutil.AddImport(fset, f, importPath)
+		replaced = true
 	}
 
-	return ioutil.WriteFile(o.OutputFile, buf.Bytes(), 0644)
+	return replaced, nil
 }
 
-func findInterfaces(p *ast.Package, pattern string) ([]string, error) {
+func findInterfaces(p *ast.Package, in interfaceInfo) ([]string, error) {
 	var names []string
 	for _, f := range p.Files {
 		for _, d := range f.Decls {
 			if gd, ok := d.(*ast.GenDecl); ok && gd.Tok == token.TYPE {
 				for _, spec := range gd.Specs {
 					if ts, ok := spec.(*ast.TypeSpec); ok {
-						if _, ok := ts.Type.(*ast.InterfaceType); ok && match(ts.Name.Name, pattern) {
+						if it, ok := ts.Type.(*ast.InterfaceType); ok && in.match(ts.Name.Name) {
+							//wildcard and regexp don't pick up unexported, empty and constraint interfaces,
+							//they still can be mocked by specifying their names explicitly
+							if (in.Type == "*" || in.Pattern != nil) && (!ast.IsExported(ts.Name.Name) || it.Methods == nil || len(it.Methods.List) == 0 || len(typeTerms(p, it)) > 0) {
+								continue
+							}
 							names = append(names, ts.Name.Name)
 						}
+
+						//aliases and named types referring to interfaces are resolved later,
+						//so they're only picked up when they're requested explicitly
+						switch ts.Type.(type) {
+						case *ast.Ident, *ast.SelectorExpr:
+							if in.Type == ts.Name.Name {
+								names = append(names, ts.Name.Name)
+							}
+						}
 					}
 				}
 			}
@@ -205,14 +2403,20 @@ func findInterfaces(p *ast.Package, pattern string) ([]string, error) {
 	}
 
 	if len(names) == 0 {
-		return nil, errors.Errorf("failed to find any interfaces matching %s in %s", pattern, p.Name)
+		return nil, errors.Errorf("failed to find any interfaces matching %s in %s", in.Type, p.Name)
 	}
 
+	sort.Strings(names)
+
 	return names, nil
 }
 
-func match(s, pattern string) bool {
-	return pattern == "*" || s == pattern
+func (i interfaceInfo) match(name string) bool {
+	if i.Pattern != nil {
+		return i.Pattern.MatchString(name)
+	}
+
+	return i.Type == "*" || i.Type == name
 }
 
 func usage(fs *flag.FlagSet, w io.Writer) {
@@ -220,15 +2424,21 @@ func usage(fs *flag.FlagSet, w io.Writer) {
 {{.}}
 Examples:
 
-  Generate mocks for all interfaces that can be found in the current directory:
+  Generate mocks for all interfaces that can be found in the current directory when run from the command line:
     {{bold "minimock"}}
 
+  Generate mock for the interface declared right after the instruction when run by go generate:
+    //go:generate {{bold "minimock"}}
+
   Generate mock for the io.Writer interface and put it into the "./buffer" package:
     {{bold "minimock"}} {{bold "-i"}} io.Writer {{bold "-o"}} ./buffer
 
   Generate mocks for the fmt.Stringer and all interfaces from the "io" package and put them into the "./buffer" package:
     {{bold "minimock"}} {{bold "-i"}} fmt.Stringer,io.* {{bold "-o"}} ./buffer
 
+  Generate mocks for all interfaces from the "./repos" package which names end with "Repository":
+    {{bold "minimock"}} {{bold "-i"}} ./repos.~Repository$ {{bold "-o"}} ./mocks
+
 For more information please visit https://github.com/gojuno/minimock
 `
 
@@ -253,11 +2463,29 @@ func processArgs(args []string, stdout, stderr io.Writer) (*options, error) {
 
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
+	fs.StringVar(&opts.buildTags, "build-tags", "", "build constraint to put into the generated mock, i.e. \"!prod\" or \"integration && linux\"")
+	fs.BoolVar(&opts.check, "check", false, "don't write generated mocks, exit with non-zero code if any of the existing mocks is out of date")
+	fs.BoolVar(&opts.copyConstraints, "copy-constraints", false, "put the build constraints of the file declaring the interface into the generated mock")
+	fs.BoolVar(&opts.dryRun, "dry-run", false, "don't generate mocks, print the list of methods that would be mocked")
+	fs.BoolVar(&opts.force, "force", false, "write generated mocks even if the existing files are up to date")
 	fs.BoolVar(&opts.noGenerate, "g", false, "don't put go:generate instruction into the generated code")
+	fs.StringVar(&opts.goarch, "goarch", "", "target architecture used to load source packages, i.e. arm64,\nthe generated mock is built only for this architecture")
+	fs.Var((*stringList)(&opts.extraHeader), "header-line", "comment line to put into the header of the generated mock, can be repeated")
+	licenseFile := fs.String("license-file", "", "file with the license or copyright notice to put at the beginning of the generated mock,\nlines that aren't comments are commented out")
+	fs.StringVar(&opts.goos, "goos", "", "target operating system used to load source packages, i.e. linux,\nthe generated mock is built only for this operating system")
+	fs.StringVar(&opts.packageName, "p", "", "destination package name, by default it's detected from the destination directory")
 	fs.StringVar(&opts.suffix, "s", "_mock_test.go", "mock file suffix")
-
-	input := fs.String("i", "*", "comma-separated names of the interfaces to mock, i.e fmt.Stringer,io.Reader\nuse io.* notation to generate mocks for all interfaces in the \"io\" package")
-	output := fs.String("o", "", "comma-separated destination file names or packages to put the generated mocks in,\nby default the generated mock is placed in the source package directory")
+	mockName := fs.String("t", "", "mock struct name, by default it's <interface name>Mock\nunexported name makes the constructor unexported too, i.e. repoMock is created with newRepoMock")
+	fs.StringVar(&opts.tags, "tags", "", "comma-separated list of build tags that are used to load source packages, i.e. integration,linux")
+	fs.IntVar(&opts.templateVersion, "template-version", 1, "version of the generated code, version 2 mocks report the unexpected calls made by the goroutines\nthat didn't create the mock with t.Errorf and return zero values instead of calling t.Fatalf")
+	fs.BoolVar(&opts.verbose, "v", false, "verbose output")
+
+	input := fs.String("i", "*", "comma-separated names of the interfaces to mock, i.e fmt.Stringer,io.Reader\nuse io.* notation to generate mocks for all exported interfaces in the \"io\" package\nuse io.~regexp notation to generate mocks for the interfaces with names matching the regexp")
+	output := fs.String("o", "", "comma-separated destination file names or packages to put the generated mocks in,\nby default the generated mock is placed in the source package directory\nuse - to write the generated code of a single mock to stdout, the package is set by -p flag")
+	fs.StringVar(&opts.scan, "scan", "", "run all minimock go:generate instructions found in the directory in one process, i.e. ./...")
+	configFile := fs.String("config", "", "YAML file describing all mocks to generate, -i, -o and -t flags can't be used with -config\n"+
+		"when minimock is run without -i, -o, -t and -scan flags "+configFileName+" is looked up in the current directory\nand its parents up to the module root")
+	exclude := fs.String("x", "", "comma-separated names of the interfaces to exclude from generation, i.e. Marker,Stringer")
 	help := fs.Bool("h", false, "show this help message")
 
 	fs.Usage = func() { usage(fs, stderr) }
@@ -271,6 +2499,77 @@ func processArgs(args []string, stdout, stderr io.Writer) (*options, error) {
 		return nil, nil
 	}
 
+	explicit := map[string]bool{}
+	fs.Visit(func(f *flag.Flag) { explicit[f.Name] = true })
+
+	if opts.templateVersion < 1 || opts.templateVersion > latestTemplateVersion {
+		return nil, fmt.Errorf("unsupported template version %d, the latest version is %d", opts.templateVersion, latestTemplateVersion)
+	}
+
+	if *exclude != "" {
+		opts.exclude = map[string]bool{}
+		for _, name := range strings.Split(*exclude, ",") {
+			opts.exclude[name] = true
+		}
+	}
+
+	opts.log = stdout
+	opts.stdout = stdout
+
+	if *licenseFile != "" {
+		license, err := licenseComment(*licenseFile)
+		if err != nil {
+			return nil, err
+		}
+
+		if opts.licenseFile, err = filepath.Abs(*licenseFile); err != nil {
+			return nil, err
+		}
+		opts.license = license
+	}
+
+	//the config is discovered unless the interfaces to mock are given by the flags or by go generate
+	if !explicit["config"] && !explicit["i"] && !explicit["o"] && !explicit["t"] && !explicit["scan"] && os.Getenv("GOFILE") == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			return nil, err
+		}
+
+		if *configFile, err = findConfig(wd); err != nil {
+			return nil, err
+		}
+
+		if *configFile != "" {
+			opts.logf("generating mocks described in %s", *configFile)
+		}
+	}
+
+	if *configFile != "" {
+		if explicit["i"] || explicit["o"] || explicit["t"] {
+			return nil, errors.New("-config flag can't be used along with -i, -o and -t flags")
+		}
+
+		if err := opts.applyConfig(*configFile); err != nil {
+			return nil, err
+		}
+
+		return &opts, nil
+	}
+
+	//when minimock is run by go generate the interface declared right after
+	//the go:generate instruction is mocked unless -i flag is given
+	if goFile, goLine := os.Getenv("GOFILE"), os.Getenv("GOLINE"); !explicit["i"] && goFile != "" && goLine != "" {
+		name, err := goGenerateInterface(goFile, goLine)
+		if err != nil {
+			return nil, err
+		}
+		*input = name
+
+		if goPackage := os.Getenv("GOPACKAGE"); !explicit["p"] && (!explicit["o"] || *output == writeToStdout) {
+			opts.packageName = goPackage
+		}
+	}
+
 	interfaces := strings.Split(*input, ",")
 
 	var writeTo = make([]string, len(interfaces))
@@ -285,6 +2584,17 @@ func processArgs(args []string, stdout, stderr io.Writer) (*options, error) {
 		}
 	}
 
+	for _, to := range writeTo {
+		if to == writeToStdout {
+			opts.log = stderr
+
+			//the destination package can't be derived from the path of the generated file
+			if opts.packageName == "" {
+				return nil, errors.New("-p flag is required when the generated code is written to stdout")
+			}
+		}
+	}
+
 	if len(writeTo) != len(interfaces) {
 		return nil, errors.Errorf("count of the source interfaces doesn't match the output files count")
 	}
@@ -302,9 +2612,77 @@ func processArgs(args []string, stdout, stderr io.Writer) (*options, error) {
 		opts.interfaces = append(opts.interfaces, *info)
 	}
 
+	if *mockName != "" {
+		if len(opts.interfaces) != 1 || opts.interfaces[0].Type == "*" || opts.interfaces[0].Pattern != nil {
+			return nil, errors.New("-t flag can only be used when a single interface is mocked")
+		}
+		opts.interfaces[0].MockName = *mockName
+	}
+
 	return &opts, nil
 }
 
+// licenseComment reads the license file and returns its content as a comment,
+// lines that aren't comments already are prefixed with //
+func licenseComment(fileName string) (string, error) {
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read license file")
+	}
+
+	text := strings.TrimSpace(strings.Replace(string(data), "\r\n", "\n", -1))
+	if text == "" {
+		return "", errors.Errorf("license file %s is empty", fileName)
+	}
+
+	if strings.HasPrefix(text, "/*") && strings.HasSuffix(text, "*/") {
+		return text, nil
+	}
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		line = strings.TrimRight(line, " \t")
+		switch {
+		case strings.HasPrefix(strings.TrimSpace(line), "//"):
+			lines[i] = strings.TrimSpace(line)
+		case line == "":
+			lines[i] = "//"
+		default:
+			lines[i] = "// " + line
+		}
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// goGenerateInterface returns the name of the first interface declared in the file after the given line
+func goGenerateInterface(fileName, line string) (string, error) {
+	lineNumber, err := strconv.Atoi(line)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid GOLINE: %s", line)
+	}
+
+	fs := token.NewFileSet()
+	f, err := parser.ParseFile(fs, fileName, nil, 0)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse %s", fileName)
+	}
+
+	for _, d := range f.Decls {
+		if gd, ok := d.(*ast.GenDecl); ok && gd.Tok == token.TYPE {
+			for _, spec := range gd.Specs {
+				if ts, ok := spec.(*ast.TypeSpec); ok {
+					if _, ok := ts.Type.(*ast.InterfaceType); ok && fs.Position(ts.Pos()).Line > lineNumber {
+						return ts.Name.Name, nil
+					}
+				}
+			}
+		}
+	}
+
+	return "", errors.Errorf("failed to find any interface declared after the line %d of %s, use -i flag to specify the interface", lineNumber, fileName)
+}
+
 // checkDuplicateOutputFiles finds first non-unique Go file
 func checkDuplicateOutputFiles(fileNames []string) error {
 	for i := range fileNames {
@@ -340,6 +2718,27 @@ func checkDuplicateOutputFiles(fileNames []string) error {
 func makeInterfaceInfo(typ, writeTo string) (*interfaceInfo, error) {
 	info := interfaceInfo{WriteTo: writeTo}
 
+	//everything after the ~ is a regular expression, i.e. ./repos.~(Repository|Service)$
+	if tilde := strings.Index(typ, "~"); tilde >= 0 {
+		if tilde > 0 && typ[tilde-1] != '.' {
+			return nil, errors.Errorf("invalid interface type: %s", typ)
+		}
+
+		re, err := regexp.Compile(typ[tilde+1:])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid interface pattern: %s", typ)
+		}
+
+		info.Type = typ[tilde:]
+		info.Pattern = re
+		info.ImportPath = "./"
+		if tilde > 0 {
+			info.ImportPath = typ[:tilde-1]
+		}
+
+		return &info, nil
+	}
+
 	dot := strings.LastIndex(typ, ".")
 	slash := strings.LastIndex(typ, "/")
 
@@ -0,0 +1,582 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hexdigest/gowrap/generator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+)
+
+func TestRun_Deterministic(t *testing.T) {
+	//the same mock is generated from different working directories using relative and full source package paths
+	first := generateIn(t, tempDir(t), "-i", "../../../tests.Formatter", "-o", "./mocks/formatter_mock.go")
+	second := generateIn(t, tempDir(t), "-i", "github.com/gojuno/minimock/tests.Formatter", "-o", "./mocks/formatter_mock.go")
+
+	assert.Equal(t, string(first), string(second))
+	assert.Contains(t, string(first), "//go:generate minimock -i github.com/gojuno/minimock/tests.Formatter -o ./formatter_mock.go")
+}
+
+func TestRun_ImplementsAssertion(t *testing.T) {
+	code := string(generateIn(t, tempDir(t), "-i", "github.com/gojuno/minimock/tests.Formatter", "-o", "./mocks/formatter_mock.go"))
+
+	assert.Contains(t, code, "\nvar _ mm_tests.Formatter = (*FormatterMock)(nil)\n")
+}
+
+func TestRun_NoTestingImport(t *testing.T) {
+	//the mocks can be used outside of the tests without the testing package and its flags
+	code := string(generateIn(t, tempDir(t), "-i", "github.com/gojuno/minimock/tests.Formatter", "-o", "./mocks/formatter_mock.go"))
+	assert.NotContains(t, code, `"testing"`)
+
+	pkg, err := build.ImportDir("../..", 0)
+	require.NoError(t, err)
+	assert.NotContains(t, pkg.Imports, "testing")
+}
+
+func TestRun_Header(t *testing.T) {
+	code := string(generateIn(t, tempDir(t), "-i", "github.com/gojuno/minimock/tests.Formatter", "-o", "./mocks/formatter_mock.go",
+		"-build-tags", "!prod", "-header-line", "Copyright (c) Acme", "-header-line", "Regenerate with go generate"))
+
+	lines := strings.Split(code, "\n")
+	require.True(t, len(lines) > 6)
+
+	//go vet and linters recognize generated files by the first line
+	assert.Regexp(t, `^// Code generated .* DO NOT EDIT\.$`, lines[0])
+	assert.Equal(t, []string{"// Copyright (c) Acme", "// Regenerate with go generate", "", "//go:build !prod", "", "package mocks"}, lines[1:7])
+	assert.Contains(t, code, `-build-tags !prod -header-line "Copyright (c) Acme" -header-line "Regenerate with go generate"`)
+}
+
+func TestRun_License(t *testing.T) {
+	dir := tempDir(t)
+	licenseFile := filepath.Join(dir, "LICENSE")
+	require.NoError(t, ioutil.WriteFile(licenseFile, []byte("Copyright (c) Acme\n\n// All rights reserved.\n"), 0644))
+
+	args := []string{"-i", "github.com/gojuno/minimock/tests.Formatter", "-o", "./mocks/formatter_mock.go", "-license-file", "LICENSE"}
+	code := string(generateIn(t, dir, args...))
+
+	assert.True(t, strings.HasPrefix(code, "// Copyright (c) Acme\n//\n// All rights reserved.\n\n// Code generated by"), code)
+	assert.Contains(t, code, "-license-file ../LICENSE\n")
+
+	//license is a part of the generated code so the mock is out of date when the license is changed
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	defer os.Chdir(wd)
+
+	require.NoError(t, ioutil.WriteFile(licenseFile, []byte("Copyright (c) Acme Corp."), 0644))
+	opts, err := processArgs(append(args, "-check"), ioutil.Discard, ioutil.Discard)
+	require.NoError(t, err)
+	assert.Error(t, run(opts))
+
+	require.NoError(t, ioutil.WriteFile(licenseFile, nil, 0644))
+	_, err = processArgs(args, ioutil.Discard, ioutil.Discard)
+	assert.EqualError(t, err, "license file LICENSE is empty")
+}
+
+func TestRun_UpToDate(t *testing.T) {
+	dir := tempDir(t)
+	fileName := filepath.Join(dir, "mocks", "formatter_mock.go")
+	modified := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	generateIn(t, dir, "-i", "github.com/gojuno/minimock/tests.Formatter", "-o", "./mocks/formatter_mock.go")
+	require.NoError(t, os.Chtimes(fileName, modified, modified))
+
+	generateIn(t, dir, "-i", "github.com/gojuno/minimock/tests.Formatter", "-o", "./mocks/formatter_mock.go")
+	stat, err := os.Stat(fileName)
+	require.NoError(t, err)
+	assert.True(t, stat.ModTime().Equal(modified), "up to date mock is rewritten")
+
+	generateIn(t, dir, "-i", "github.com/gojuno/minimock/tests.Formatter", "-o", "./mocks/formatter_mock.go", "-force")
+	stat, err = os.Stat(fileName)
+	require.NoError(t, err)
+	assert.True(t, stat.ModTime().After(modified), "mock is not rewritten with -force")
+}
+
+func TestRun_KeepsPermissions(t *testing.T) {
+	dir := tempDir(t)
+	fileName := filepath.Join(dir, "mocks", "formatter_mock.go")
+
+	generateIn(t, dir, "-i", "github.com/gojuno/minimock/tests.Formatter", "-o", "./mocks/formatter_mock.go")
+	require.NoError(t, os.Chmod(fileName, 0600))
+
+	generateIn(t, dir, "-i", "github.com/gojuno/minimock/tests.Formatter", "-o", "./mocks/formatter_mock.go", "-force")
+	stat, err := os.Stat(fileName)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), stat.Mode().Perm())
+
+	//temporary file is renamed to the mock so nothing is left behind
+	files, err := ioutil.ReadDir(filepath.Join(dir, "mocks"))
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+}
+
+// tempDir creates a temporary directory inside the module so the source packages can be loaded from it
+func tempDir(t testing.TB) string {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir(wd, "_")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	return dir
+}
+
+// generateIn runs minimock with the given arguments in the working directory and returns the code of the formatter mock
+func generateIn(t *testing.T, dir string, args ...string) []byte {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+
+	require.NoError(t, os.Chdir(dir))
+	defer os.Chdir(wd)
+
+	opts, err := processArgs(args, ioutil.Discard, ioutil.Discard)
+	require.NoError(t, err)
+	require.NoError(t, run(opts))
+
+	code, err := ioutil.ReadFile(filepath.Join(dir, "mocks", "formatter_mock.go"))
+	require.NoError(t, err)
+
+	return code
+}
+
+func TestRun_TemplateVersion(t *testing.T) {
+	code := string(generateIn(t, tempDir(t), "-i", "github.com/gojuno/minimock/tests.Formatter", "-o", "./mocks/formatter_mock.go",
+		"-template-version", "2"))
+
+	assert.Contains(t, code, "-template-version 2")
+	assert.Contains(t, code, "minimock.GoroutineID()")
+
+	code = string(generateIn(t, tempDir(t), "-i", "github.com/gojuno/minimock/tests.Formatter", "-o", "./mocks/formatter_mock.go"))
+	assert.NotContains(t, code, "-template-version")
+	assert.NotContains(t, code, "minimock.GoroutineID()")
+
+	_, err := processArgs([]string{"-template-version", "3"}, ioutil.Discard, ioutil.Discard)
+	assert.EqualError(t, err, "unsupported template version 3, the latest version is 2")
+}
+
+func TestProcessArgs_Usage(t *testing.T) {
+	var stdout bytes.Buffer
+	opts, err := processArgs([]string{"-h"}, &stdout, ioutil.Discard)
+	require.NoError(t, err)
+	assert.Nil(t, opts)
+
+	assert.Contains(t, stdout.String(), "all interfaces that can be found in the current directory when run from the command line")
+	assert.Contains(t, stdout.String(), "the interface declared right after the instruction when run by go generate")
+}
+
+func TestCheckReserved_Fields(t *testing.T) {
+	_, err := checkReserved(map[string]generator.Method{"state": {Name: "state"}, "Get": {Name: "Get"}, "funcGet": {Name: "funcGet"}})
+	assert.EqualError(t, err, "interface methods funcGet, state collide with the helper methods or the fields of the mock")
+
+	_, err = checkReserved(map[string]generator.Method{"State": {Name: "State"}})
+	assert.NoError(t, err)
+}
+
+var embeddingSource = map[string]string{"src/src.go": `package src
+
+import "io"
+
+type ReadCloser interface {
+	io.Reader
+	io.ReadCloser
+	Close() error
+}
+
+type A interface{ Get() int }
+type A2 interface{ Get() string }
+
+type Getter interface {
+	A
+	A2
+}
+
+type Closer interface {
+	io.Closer
+	Close()
+}
+`}
+
+// writeFiles writes the files of the source packages into the directory
+func writeFiles(t testing.TB, dir string, files map[string]string) {
+	for name, content := range files {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0755))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+}
+
+// runIn runs minimock with the given arguments in the working directory
+func runIn(t testing.TB, dir string, args ...string) error {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	defer os.Chdir(wd)
+
+	opts, err := processArgs(args, ioutil.Discard, ioutil.Discard)
+	require.NoError(t, err)
+
+	return run(opts)
+}
+
+func TestRun_EmbeddedSameMethods(t *testing.T) {
+	dir := tempDir(t)
+	writeFiles(t, dir, embeddingSource)
+	require.NoError(t, runIn(t, dir, "-i", "./src.ReadCloser", "-o", "./mocks/"))
+
+	code, err := ioutil.ReadFile(filepath.Join(dir, "mocks", "read_closer_mock_test.go"))
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, strings.Count(string(code), "func (mmRead *ReadCloserMock) Read(p []byte) (n int, err error) {"))
+	assert.Equal(t, 1, strings.Count(string(code), "func (mmClose *ReadCloserMock) Close() (err error) {"))
+}
+
+func TestRun_EmbeddedConflictingMethods(t *testing.T) {
+	dir := tempDir(t)
+	writeFiles(t, dir, embeddingSource)
+
+	err := runIn(t, dir, "-i", "./src.Getter,./src.Closer", "-o", "./mocks/")
+
+	assert.EqualError(t, err, `failed to generate mock for Getter: Get: conflicting signatures "func() int" (from A) and "func() string" (from A2); `+
+		`failed to generate mock for Closer: Close: conflicting signatures "func() error" (from io.Closer) and "func()" (from Closer)`)
+}
+
+func TestRun_LoadsPackagesOnce(t *testing.T) {
+	loads := map[string]int{}
+	loadPackages = func(cfg *packages.Config, patterns ...string) ([]*packages.Package, error) {
+		loads[strings.Join(patterns, " ")]++
+		return packages.Load(cfg, patterns...)
+	}
+	defer func() { loadPackages = packages.Load }()
+
+	dir := tempDir(t)
+	generateIn(t, dir, "-i", "github.com/gojuno/minimock/tests.Formatter,github.com/gojuno/minimock/tests.Service,github.com/gojuno/minimock/tests.Handler",
+		"-o", "./mocks/formatter_mock.go,./mocks/service_mock.go,./mocks/handler_mock.go")
+
+	//the source package, the packages of the embedded interfaces and the destination package
+	assert.Equal(t, map[string]int{
+		"github.com/gojuno/minimock/tests": 1,
+		"fmt":                              1,
+		"io":                               1,
+		filepath.Join(dir, "mocks"):        1,
+	}, loads)
+}
+
+var taggedSource = map[string]string{
+	"src/alpha.go":   "//go:build alpha\n\npackage src\n\ntype Tagged interface{ Alpha() }\n",
+	"src/default.go": "//go:build !alpha\n\npackage src\n\ntype Tagged interface{ Default() }\n",
+}
+
+// assertTaggedMocks checks that the mock in the alpha directory is generated with the alpha tag
+// and the mock in the plain directory is generated without it
+func assertTaggedMocks(t *testing.T, dir string) {
+	alpha, err := ioutil.ReadFile(filepath.Join(dir, "alpha", "tagged_mock_test.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(alpha), "func (mmAlpha *TaggedMock) Alpha() {")
+	assert.NotContains(t, string(alpha), "Default()")
+
+	plain, err := ioutil.ReadFile(filepath.Join(dir, "plain", "tagged_mock_test.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(plain), "func (mmDefault *TaggedMock) Default() {")
+	assert.NotContains(t, string(plain), "Alpha()")
+}
+
+func TestRun_TagsPerRun(t *testing.T) {
+	goflags := os.Getenv("GOFLAGS")
+	dir := tempDir(t)
+	writeFiles(t, dir, taggedSource)
+
+	require.NoError(t, runIn(t, dir, "-i", "./src.Tagged", "-o", "./alpha/", "-tags", "alpha", "-goos", "darwin"))
+	require.NoError(t, runIn(t, dir, "-i", "./src.Tagged", "-o", "./plain/"))
+
+	assertTaggedMocks(t, dir)
+	assert.Equal(t, goflags, os.Getenv("GOFLAGS"))
+	assert.Equal(t, "", os.Getenv("GOOS"))
+}
+
+func TestScan_TagsPerDirective(t *testing.T) {
+	dir := tempDir(t)
+	writeFiles(t, dir, taggedSource)
+	writeFiles(t, dir, map[string]string{
+		"gen/gen.go": "package gen\n\n" +
+			"//go:generate minimock -i ../src.Tagged -o ./alpha/ -tags alpha\n" +
+			"//go:generate minimock -i ../src.Tagged -o ./plain/\n",
+	})
+
+	require.NoError(t, scan(dir+"/...", ioutil.Discard, ioutil.Discard))
+
+	//the tags of the first instruction are not used by the second one
+	assertTaggedMocks(t, filepath.Join(dir, "gen"))
+}
+
+var mixedTagsSource = map[string]string{
+	"src/plain.go":       "package src\n\ntype Plain interface{ Plain() }\n",
+	"src/integration.go": "//go:build integration\n\npackage src\n\ntype Tagged interface{ Tagged() }\n",
+}
+
+func TestRun_BuildTagsHint(t *testing.T) {
+	dir := tempDir(t)
+	writeFiles(t, dir, mixedTagsSource)
+
+	err := runIn(t, dir, "-i", "./src.Tagged", "-o", "./mocks/")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to find any interfaces matching Tagged in src, Tagged is declared in integration.go "+
+		"which is excluded by the build constraints, build tags might be required (see -tags flag)")
+
+	err = runIn(t, dir, "-i", "./src.Missing", "-o", "./mocks/")
+	assert.EqualError(t, err, "failed to find any interfaces matching Missing in src")
+
+	require.NoError(t, runIn(t, dir, "-i", "./src.Tagged", "-o", "./mocks/", "-tags", "integration"))
+}
+
+func TestScan_RegeneratesTaggedMock(t *testing.T) {
+	dir := tempDir(t)
+	writeFiles(t, dir, mixedTagsSource)
+	require.NoError(t, runIn(t, dir, "-i", "./src.Tagged", "-o", "./mocks/tagged_mock.go", "-tags", "integration"))
+
+	fileName := filepath.Join(dir, "mocks", "tagged_mock.go")
+	code, err := ioutil.ReadFile(fileName)
+	require.NoError(t, err)
+	assert.Contains(t, string(code), "/src.Tagged -o ./tagged_mock.go -tags integration\n")
+	assert.NotContains(t, string(code), "//go:build", "tags used to load the interface aren't copied into the mock")
+
+	//the mock is regenerated by the instruction in its header
+	header := strings.SplitN(string(code), "\n\nimport", 2)[0]
+	require.NoError(t, ioutil.WriteFile(fileName, []byte(header+"\n"), 0644))
+	require.NoError(t, scan(filepath.Join(dir, "mocks")+"/...", ioutil.Discard, ioutil.Discard))
+
+	regenerated, err := ioutil.ReadFile(fileName)
+	require.NoError(t, err)
+	assert.Equal(t, string(code), string(regenerated))
+}
+
+func TestRun_Stdout(t *testing.T) {
+	dir := tempDir(t)
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	defer os.Chdir(wd)
+
+	var stdout, stderr bytes.Buffer
+	opts, err := processArgs([]string{"-i", "github.com/gojuno/minimock/tests.Formatter", "-o", "-", "-p", "mocks"}, &stdout, &stderr)
+	require.NoError(t, err)
+	require.NoError(t, run(opts))
+
+	f, err := parser.ParseFile(token.NewFileSet(), "formatter_mock.go", stdout.Bytes(), parser.ParseComments)
+	require.NoError(t, err)
+	assert.Equal(t, "mocks", f.Name.Name)
+	assert.Equal(t, 1, strings.Count(stdout.String(), "// Code generated by"))
+	assert.Empty(t, stderr.String())
+
+	//nothing is written next to the working directory
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+
+	_, err = processArgs([]string{"-i", "github.com/gojuno/minimock/tests.Formatter", "-o", "-"}, ioutil.Discard, ioutil.Discard)
+	assert.EqualError(t, err, "-p flag is required when the generated code is written to stdout")
+
+	for _, input := range []string{"github.com/gojuno/minimock/tests.Formatter,github.com/gojuno/minimock/tests.Handler", "github.com/gojuno/minimock/tests.~^(Formatter|Handler)$"} {
+		opts, err = processArgs([]string{"-i", input, "-o", "-", "-p", "mocks"}, ioutil.Discard, ioutil.Discard)
+		require.NoError(t, err)
+		assert.EqualError(t, run(opts), "only one mock can be written to stdout, but 2 interfaces are selected: Formatter, Handler")
+	}
+}
+
+func TestRun_DryRun(t *testing.T) {
+	dir := tempDir(t)
+	writeFiles(t, dir, map[string]string{"src/src.go": `package src
+
+type Mixed interface {
+	C() (int, error)
+	B(x ...int)
+	A()
+}
+`})
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	defer os.Chdir(wd)
+
+	var stdout bytes.Buffer
+	opts, err := processArgs([]string{"-i", "./src.Mixed", "-o", "./mocks/", "-dry-run"}, &stdout, ioutil.Discard)
+	require.NoError(t, err)
+	require.NoError(t, run(opts))
+
+	//methods without results are listed the same way they're declared in the mock
+	assert.Equal(t, "src.Mixed\n\tA()\n\tB(x ...int)\n\tC() (i1 int, err error)\n", stdout.String())
+
+	_, err = os.Stat(filepath.Join(dir, "mocks"))
+	assert.True(t, os.IsNotExist(err), "dry run writes nothing")
+}
+
+func TestRun_Exclude(t *testing.T) {
+	dir := tempDir(t)
+	writeFiles(t, dir, embeddingSource)
+
+	//the entry excluded entirely doesn't fail the run as long as the other entries are generated
+	require.NoError(t, runIn(t, dir, "-i", "./src.A,./src.A2", "-o", "./mocks/", "-x", "A"))
+
+	_, err := os.Stat(filepath.Join(dir, "mocks", "a2_mock_test.go"))
+	assert.NoError(t, err)
+	_, err = os.Stat(filepath.Join(dir, "mocks", "a_mock_test.go"))
+	assert.True(t, os.IsNotExist(err))
+
+	err = runIn(t, dir, "-i", "./src.A,./src.A2", "-o", "./mocks/", "-x", "A,A2")
+	assert.EqualError(t, err, "all interfaces are excluded by -x flag, nothing to generate")
+}
+
+// vendoredSource is a module that builds with -mod=vendor, the vendored package differs from any published
+// version of github.com/foo/bar, so the signatures of the mock can only come from vendor/
+var vendoredSource = map[string]string{
+	"go.mod":             "module example.com/app\n\ngo 1.16\n\nrequire github.com/foo/bar v1.0.0\n",
+	"vendor/modules.txt": "# github.com/foo/bar v1.0.0\n## explicit\ngithub.com/foo/bar\n",
+	"vendor/github.com/foo/bar/bar.go": `package bar
+
+type VendoredKey string
+
+type Store interface {
+	Get(key VendoredKey) (value []byte, err error)
+}
+`,
+}
+
+func TestRun_Vendor(t *testing.T) {
+	dir, err := ioutil.TempDir("", "minimock")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	writeFiles(t, dir, vendoredSource)
+
+	goflags := os.Getenv("GOFLAGS")
+	require.NoError(t, os.Setenv("GOFLAGS", "-mod=vendor"))
+	defer os.Setenv("GOFLAGS", goflags)
+
+	require.NoError(t, runIn(t, dir, "-i", "github.com/foo/bar.Store", "-o", "./mocks/store_mock.go"))
+
+	code, err := ioutil.ReadFile(filepath.Join(dir, "mocks", "store_mock.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(code), `mm_bar "github.com/foo/bar"`)
+	assert.Contains(t, string(code), "func (mmGet *StoreMock) Get(key mm_bar.VendoredKey) (value []byte, err error) {")
+}
+
+// workspaceSource is a workspace of two modules, the interface is declared in the module that isn't published,
+// so it can only be resolved through the go.work in the parent directory of the module generating the mock
+var workspaceSource = map[string]string{
+	"go.work":                  "go 1.18\n\nuse (\n\t./app\n\t./other\n)\n",
+	"app/go.mod":               "module example.com/app\n\ngo 1.18\n",
+	"other/go.mod":             "module github.com/acme/other-module\n\ngo 1.18\n",
+	"other/storage/storage.go": "package storage\n\ntype Store interface {\n\tGet(key string) ([]byte, error)\n}\n",
+}
+
+func TestRun_Workspace(t *testing.T) {
+	dir, err := ioutil.TempDir("", "minimock")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	writeFiles(t, dir, workspaceSource)
+
+	//-mod flag can't be used in the workspace mode and GOWORK=off disables it
+	for _, name := range []string{"GOFLAGS", "GOWORK"} {
+		value, ok := os.LookupEnv(name)
+		require.NoError(t, os.Unsetenv(name))
+		if ok {
+			defer os.Setenv(name, value)
+		}
+	}
+
+	require.NoError(t, runIn(t, filepath.Join(dir, "app"), "-i", "github.com/acme/other-module/storage.Store", "-o", "./mocks/store_mock.go"))
+
+	//the mock is generated into the other module and imports the interface by the path of its module
+	code, err := ioutil.ReadFile(filepath.Join(dir, "app", "mocks", "store_mock.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(code), "\npackage mocks\n")
+	assert.Contains(t, string(code), `mm_storage "github.com/acme/other-module/storage"`)
+	assert.Contains(t, string(code), "var _ mm_storage.Store = (*StoreMock)(nil)")
+}
+
+// interfacesSource returns the package declaring n interfaces importing the packages of the standard library
+func interfacesSource(n int) map[string]string {
+	code := "package src\n\nimport (\n\t\"context\"\n\t\"io\"\n\t\"net/http\"\n)\n"
+	for i := 0; i < n; i++ {
+		code += fmt.Sprintf("\ntype Store%d interface {\n\tGet(ctx context.Context, key string) (io.ReadCloser, error)\n"+
+			"\tPut(ctx context.Context, key string, r io.Reader) error\n\tServe(w http.ResponseWriter, r *http.Request)\n}\n", i)
+	}
+
+	return map[string]string{"src/src.go": code}
+}
+
+// BenchmarkRun_NInterfaces compares generating the mocks of N interfaces declared in the same package by one run,
+// which loads the package once, with running minimock for each of the interfaces separately
+func BenchmarkRun_NInterfaces(b *testing.B) {
+	for _, n := range []int{1, 5, 20} {
+		dir := tempDir(b)
+		writeFiles(b, dir, interfacesSource(n))
+
+		var interfaces []string
+		for i := 0; i < n; i++ {
+			interfaces = append(interfaces, fmt.Sprintf("./src.Store%d", i))
+		}
+
+		b.Run(fmt.Sprintf("%d/one_run", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				require.NoError(b, runIn(b, dir, "-i", strings.Join(interfaces, ","), "-o", "./mocks/", "-force"))
+			}
+		})
+
+		b.Run(fmt.Sprintf("%d/run_per_interface", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for _, in := range interfaces {
+					require.NoError(b, runIn(b, dir, "-i", in, "-o", "./mocks/", "-force"))
+				}
+			}
+		})
+	}
+}
+
+// heavySource declares the interface embedding the same method twice, so the signatures are type checked,
+// the package imports the packages with many dependencies the interface doesn't even refer to
+var heavySource = map[string]string{"src/src.go": `package src
+
+import (
+	"crypto/tls"
+	"database/sql"
+	"encoding/xml"
+	"go/types"
+	"io"
+	"net"
+	"net/http"
+
+	"github.com/hexdigest/gowrap/generator"
+	"golang.org/x/tools/go/packages"
+)
+
+type Conn interface {
+	net.Conn
+	io.Closer
+}
+
+type Unused interface {
+	Handle(http.ResponseWriter, *http.Request, *sql.DB, *tls.Config, xml.Name, types.Type, generator.Options, *packages.Package)
+}
+`}
+
+// BenchmarkRun_HeavyDependencies generates the mock of the interface declared in the package with heavy dependencies
+func BenchmarkRun_HeavyDependencies(b *testing.B) {
+	dir := tempDir(b)
+	writeFiles(b, dir, heavySource)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		require.NoError(b, runIn(b, dir, "-i", "./src.Conn", "-o", "./mocks/", "-force"))
+	}
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// directive is a minimock go:generate instruction found in a Go source file
+type directive struct {
+	file string
+	line int
+	args []string
+}
+
+const goGeneratePrefix = "//go:generate "
+
+// scan finds all minimock go:generate instructions in the given directory and runs them
+// one by one in the same way the go generate does: in the directory of the file containing
+// the instruction and with GOFILE, GOLINE and GOPACKAGE environment variables set
+func scan(pattern string, stdout, stderr io.Writer) error {
+	root, recursive := strings.TrimSuffix(pattern, "/..."), strings.HasSuffix(pattern, "/...")
+
+	directives, err := findDirectives(root, recursive)
+	if err != nil {
+		return err
+	}
+
+	if len(directives) == 0 {
+		return errors.Errorf("failed to find any minimock go:generate instructions in %s", pattern)
+	}
+
+	//packages are cached by their absolute paths so they're loaded once for all instructions
+	cache := map[string]*sourcePackage{}
+
+	var failed int
+	for _, d := range directives {
+		if err := runDirective(d, cache, stdout, stderr); err != nil {
+			failed++
+			fmt.Fprintf(stderr, "minimock: %s:%d: %v\n", d.file, d.line, err)
+			continue
+		}
+
+		fmt.Fprintf(stdout, "minimock: %s:%d: ok\n", d.file, d.line)
+	}
+
+	if failed > 0 {
+		return errors.Errorf("%d of %d go:generate instructions failed", failed, len(directives))
+	}
+
+	return nil
+}
+
+func runDirective(d directive, cache map[string]*sourcePackage, stdout, stderr io.Writer) (err error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	packageName, err := filePackageName(d.file)
+	if err != nil {
+		return err
+	}
+
+	env := map[string]string{
+		"GOFILE":    filepath.Base(d.file),
+		"GOLINE":    strconv.Itoa(d.line),
+		"GOPACKAGE": packageName,
+	}
+
+	restoreEnv, err := setEnv(env)
+	if err != nil {
+		return err
+	}
+	defer restoreEnv()
+
+	if err := os.Chdir(filepath.Dir(d.file)); err != nil {
+		return err
+	}
+
+	defer func() {
+		if chdirErr := os.Chdir(wd); chdirErr != nil && err == nil {
+			err = chdirErr
+		}
+	}()
+
+	opts, err := processArgs(d.args, stdout, stderr)
+	if err != nil {
+		return err
+	}
+
+	if opts == nil { //help requested
+		return nil
+	}
+
+	opts.cache = cache
+
+	return run(opts)
+}
+
+// setEnv sets environment variables and returns a function that restores their previous values
+func setEnv(env map[string]string) (func(), error) {
+	previous := map[string]string{}
+	for name, value := range env {
+		previous[name] = os.Getenv(name)
+		if err := os.Setenv(name, value); err != nil {
+			return nil, err
+		}
+	}
+
+	return func() {
+		for name, value := range previous {
+			os.Setenv(name, value)
+		}
+	}, nil
+}
+
+func filePackageName(fileName string) (string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), fileName, nil, parser.PackageClauseOnly)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse %s", fileName)
+	}
+
+	return f.Name.Name, nil
+}
+
+// findDirectives returns minimock go:generate instructions from all Go files in the directory,
+// directories ignored by the go tool (vendor, testdata, names starting with . and _) are skipped
+func findDirectives(root string, recursive bool) ([]directive, error) {
+	var result []directive
+
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			if path == root {
+				return nil
+			}
+
+			name := info.Name()
+			if !recursive || name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+
+		directives, err := fileDirectives(path)
+		if err != nil {
+			return err
+		}
+
+		result = append(result, directives...)
+		return nil
+	})
+
+	return result, err
+}
+
+func fileDirectives(fileName string) ([]directive, error) {
+	f, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var result []directive
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if !strings.HasPrefix(text, goGeneratePrefix) {
+			continue
+		}
+
+		words, err := splitArgs(strings.TrimPrefix(text, goGeneratePrefix))
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s:%d", fileName, line)
+		}
+
+		if len(words) == 0 || filepath.Base(words[0]) != "minimock" {
+			continue
+		}
+
+		result = append(result, directive{file: fileName, line: line, args: words[1:]})
+	}
+
+	return result, scanner.Err()
+}
+
+// splitArgs splits go:generate instruction into words, double-quoted strings are treated
+// as single words the same way the go generate does it
+func splitArgs(s string) ([]string, error) {
+	var words []string
+
+	for s = strings.TrimSpace(s); s != ""; s = strings.TrimSpace(s) {
+		if s[0] != '"' {
+			end := strings.IndexAny(s, " \t")
+			if end < 0 {
+				end = len(s)
+			}
+			words = append(words, s[:end])
+			s = s[end:]
+			continue
+		}
+
+		end := 1
+		for ; end < len(s) && s[end] != '"'; end++ {
+			if s[end] == '\\' {
+				end++
+			}
+		}
+
+		if end >= len(s) {
+			return nil, errors.Errorf("unterminated quoted string: %s", s)
+		}
+
+		word, err := strconv.Unquote(s[:end+1])
+		if err != nil {
+			return nil, err
+		}
+
+		words = append(words, word)
+		s = s[end+1:]
+	}
+
+	return words, nil
+}
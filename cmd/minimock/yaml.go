@@ -0,0 +1,378 @@
+package main
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// yamlLine is a line of the YAML document with the comment and the indentation stripped
+	yamlLine struct {
+		number int
+		indent int
+		text   string
+	}
+
+	yamlParser struct {
+		lines []yamlLine
+		pos   int
+	}
+)
+
+// parseYAML parses the subset of YAML the config is written in: block mappings and sequences, plain and quoted
+// scalars and flow sequences of scalars. Mappings are returned as map[string]interface{}, sequences as []interface{}
+// and scalars as strings, empty and null values are nil. Anchors, tags, flow mappings and multi-line scalars aren't supported
+func parseYAML(data []byte) (interface{}, error) {
+	var p yamlParser
+
+	for i, line := range strings.Split(string(data), "\n") {
+		text := strings.TrimRight(stripYAMLComment(strings.TrimSuffix(line, "\r")), " \t")
+		if trimmed := strings.TrimLeft(text, " "); trimmed == "" || (len(p.lines) == 0 && trimmed == "---") {
+			continue
+		} else if trimmed[0] == '\t' {
+			return nil, errors.Errorf("line %d: tabs can't be used for indentation", i+1)
+		} else {
+			p.lines = append(p.lines, yamlLine{number: i + 1, indent: len(text) - len(trimmed), text: trimmed})
+		}
+	}
+
+	if len(p.lines) == 0 {
+		return nil, nil
+	}
+
+	v, err := p.parseNode()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos < len(p.lines) {
+		return nil, errors.Errorf("line %d: unexpected indentation", p.lines[p.pos].number)
+	}
+
+	return v, nil
+}
+
+// stripYAMLComment cuts the # comment off the line unless the # is quoted or is a part of a plain scalar
+func stripYAMLComment(line string) string {
+	var quote byte
+
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case quote == '"' && c == '\\':
+			i++
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return line[:i]
+		}
+	}
+
+	return line
+}
+
+// parseNode parses the mapping, the sequence or the scalar starting at the current line
+func (p *yamlParser) parseNode() (interface{}, error) {
+	l := p.lines[p.pos]
+
+	if isYAMLSequenceItem(l.text) {
+		return p.parseSequence(l.indent)
+	}
+
+	if _, _, ok, err := splitYAMLKey(l); err != nil {
+		return nil, err
+	} else if ok {
+		return p.parseMapping(l.indent)
+	}
+
+	p.pos++
+
+	return parseYAMLScalar(l.number, l.text)
+}
+
+func (p *yamlParser) parseMapping(indent int) (map[string]interface{}, error) {
+	m := map[string]interface{}{}
+
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		if l.indent < indent {
+			break
+		}
+
+		if l.indent > indent {
+			return nil, errors.Errorf("line %d: unexpected indentation", l.number)
+		}
+
+		key, value, ok, err := splitYAMLKey(l)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			return nil, errors.Errorf("line %d: mapping key is expected", l.number)
+		}
+
+		if _, ok := m[key]; ok {
+			return nil, errors.Errorf("line %d: duplicate key %q", l.number, key)
+		}
+
+		p.pos++
+
+		if value != "" {
+			if m[key], err = parseYAMLScalar(l.number, value); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		m[key] = nil
+
+		//the value is the block on the next lines, the sequence can be indented the same way as its key
+		if p.pos < len(p.lines) {
+			if next := p.lines[p.pos]; next.indent > indent || (next.indent == indent && isYAMLSequenceItem(next.text)) {
+				if m[key], err = p.parseNode(); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (p *yamlParser) parseSequence(indent int) ([]interface{}, error) {
+	s := []interface{}{}
+
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		if l.indent < indent || (l.indent == indent && !isYAMLSequenceItem(l.text)) {
+			break
+		}
+
+		if l.indent > indent {
+			return nil, errors.Errorf("line %d: unexpected indentation", l.number)
+		}
+
+		item := strings.TrimLeft(l.text[1:], " ")
+		if item == "" {
+			p.pos++
+
+			var v interface{}
+			if p.pos < len(p.lines) && p.lines[p.pos].indent > indent {
+				var err error
+				if v, err = p.parseNode(); err != nil {
+					return nil, err
+				}
+			}
+
+			s = append(s, v)
+			continue
+		}
+
+		//the item is parsed as if it started on its own line at the column it's written at,
+		//so the keys of the mapping on the next lines are aligned with the first one
+		p.lines[p.pos] = yamlLine{number: l.number, indent: indent + len(l.text) - len(item), text: item}
+
+		v, err := p.parseNode()
+		if err != nil {
+			return nil, err
+		}
+
+		s = append(s, v)
+	}
+
+	return s, nil
+}
+
+func isYAMLSequenceItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// splitYAMLKey splits the "key: value" line, ok is false if the line isn't a mapping entry
+func splitYAMLKey(l yamlLine) (key, value string, ok bool, err error) {
+	text := l.text
+
+	if text[0] == '"' || text[0] == '\'' {
+		end := quotedYAMLScalarEnd(text)
+		if end < 0 {
+			return "", "", false, errors.Errorf("line %d: unterminated quoted scalar", l.number)
+		}
+
+		rest := strings.TrimLeft(text[end:], " ")
+		if rest != ":" && !strings.HasPrefix(rest, ": ") {
+			return "", "", false, nil
+		}
+
+		k, err := parseYAMLScalar(l.number, text[:end])
+		if err != nil {
+			return "", "", false, err
+		}
+
+		return k.(string), strings.TrimSpace(rest[1:]), true, nil
+	}
+
+	colon := strings.Index(text, ": ")
+	if colon < 0 {
+		if !strings.HasSuffix(text, ":") {
+			return "", "", false, nil
+		}
+		colon = len(text) - 1
+	}
+
+	return strings.TrimRight(text[:colon], " "), strings.TrimSpace(text[colon+1:]), true, nil
+}
+
+// quotedYAMLScalarEnd returns the position right after the closing quote of the scalar the text starts with
+func quotedYAMLScalarEnd(text string) int {
+	for i := 1; i < len(text); i++ {
+		switch {
+		case text[0] == '"' && text[i] == '\\':
+			i++
+		case text[i] == text[0] && text[0] == '\'' && i+1 < len(text) && text[i+1] == '\'':
+			i++
+		case text[i] == text[0]:
+			return i + 1
+		}
+	}
+
+	return -1
+}
+
+func parseYAMLScalar(line int, text string) (interface{}, error) {
+	switch text[0] {
+	case '"', '\'':
+		if quotedYAMLScalarEnd(text) != len(text) {
+			return nil, errors.Errorf("line %d: invalid quoted scalar: %s", line, text)
+		}
+
+		if text[0] == '\'' {
+			return strings.Replace(text[1:len(text)-1], "''", "'", -1), nil
+		}
+
+		s, err := strconv.Unquote(text)
+		if err != nil {
+			return nil, errors.Errorf("line %d: invalid quoted scalar: %s", line, text)
+		}
+
+		return s, nil
+	case '[':
+		return parseYAMLFlowSequence(line, text)
+	case '{', '&', '*', '!', '|', '>', '%', '@', '`':
+		return nil, errors.Errorf("line %d: unsupported YAML syntax: %s", line, text)
+	}
+
+	switch text {
+	case "~", "null", "Null", "NULL":
+		return nil, nil
+	}
+
+	return text, nil
+}
+
+// parseYAMLFlowSequence parses [a, "b", 'c'] sequence, the nested flow collections aren't supported
+func parseYAMLFlowSequence(line int, text string) ([]interface{}, error) {
+	s := []interface{}{}
+
+	if !strings.HasSuffix(text, "]") {
+		return nil, errors.Errorf("line %d: unterminated flow sequence: %s", line, text)
+	}
+
+	items := strings.TrimSpace(text[1 : len(text)-1])
+	for items != "" {
+		end := strings.Index(items, ",")
+		if items[0] == '"' || items[0] == '\'' {
+			if end = quotedYAMLScalarEnd(items); end < 0 {
+				return nil, errors.Errorf("line %d: unterminated quoted scalar: %s", line, items)
+			}
+
+			if rest := strings.TrimLeft(items[end:], " "); rest != "" && rest[0] != ',' {
+				return nil, errors.Errorf("line %d: invalid flow sequence: %s", line, text)
+			}
+		} else if end < 0 {
+			end = len(items)
+		}
+
+		item := strings.TrimSpace(items[:end])
+		if item == "" || item[0] == '[' {
+			return nil, errors.Errorf("line %d: invalid flow sequence: %s", line, text)
+		}
+
+		v, err := parseYAMLScalar(line, item)
+		if err != nil {
+			return nil, err
+		}
+		s = append(s, v)
+
+		items = strings.TrimLeft(items[end:], " ")
+		items = strings.TrimSpace(strings.TrimPrefix(items, ","))
+	}
+
+	return s, nil
+}
+
+// decodeYAML stores the parsed YAML document in the string, the slice or the struct with yaml field tags,
+// unknown keys of the mappings are reported as errors
+func decodeYAML(path string, v interface{}, dst reflect.Value) error {
+	if v == nil {
+		return nil
+	}
+
+	switch dst.Kind() {
+	case reflect.String:
+		s, ok := v.(string)
+		if !ok {
+			return errors.Errorf("%s: string is expected", path)
+		}
+		dst.SetString(s)
+	case reflect.Slice:
+		items, ok := v.([]interface{})
+		if !ok {
+			return errors.Errorf("%s: sequence is expected", path)
+		}
+
+		dst.Set(reflect.MakeSlice(dst.Type(), len(items), len(items)))
+		for i, item := range items {
+			if err := decodeYAML(path+"["+strconv.Itoa(i)+"]", item, dst.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return errors.Errorf("%s: mapping is expected", path)
+		}
+
+		fields := map[string]int{}
+		for i := 0; i < dst.NumField(); i++ {
+			fields[dst.Type().Field(i).Tag.Get("yaml")] = i
+		}
+
+		keys := make([]string, 0, len(m))
+		for key := range m {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			i, ok := fields[key]
+			if !ok {
+				return errors.Errorf("%s: unknown field %q", path, key)
+			}
+
+			if err := decodeYAML(strings.TrimPrefix(path+"."+key, "."), m[key], dst.Field(i)); err != nil {
+				return err
+			}
+		}
+	default:
+		return errors.Errorf("%s: unsupported type %s", path, dst.Type())
+	}
+
+	return nil
+}
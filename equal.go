@@ -1,7 +1,10 @@
 package minimock
 
 import (
+	"bytes"
+	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/pmezard/go-difflib/difflib"
@@ -22,6 +25,73 @@ func Equal(a, b interface{}) bool {
 	return reflect.DeepEqual(a, b)
 }
 
+// FieldsDiff returns the list of the fields of the e and a structs that don't match,
+// the values are formatted with %#v and the pointers are dereferenced, the matchers
+// are printed by their descriptions. The checked fields are listed as well if some of
+// the fields are matched by Anything in the matchers map. The rest of the fields are
+// compared by the compare function or by Equal if it's nil
+func FieldsDiff(e, a interface{}, matchers map[string]Matcher, compare Comparer) string {
+	ev, av := reflect.ValueOf(e), reflect.ValueOf(a)
+	if !isStructPair(ev, av) {
+		return ""
+	}
+
+	var checked []string
+	partial := false
+
+	buf := bytes.NewBuffer([]byte{})
+	for i := 0; i < ev.NumField(); i++ {
+		field := ev.Type().Field(i)
+		if field.PkgPath != "" { //values of the unexported fields can't be taken
+			continue
+		}
+
+		name := field.Name
+		if m, ok := matchers[name]; ok && m == Anything {
+			partial = true
+		} else {
+			checked = append(checked, name)
+		}
+
+		if fieldMatches(ev, av, i, matchers, compare) {
+			continue
+		}
+
+		want := formatValue(ev.Field(i))
+		if m, ok := matchers[name]; ok {
+			want = m.String()
+		}
+
+		fmt.Fprintf(buf, "  %s: want: %s, got: %s\n", name, want, formatValue(av.Field(i)))
+	}
+
+	if buf.Len() == 0 {
+		return ""
+	}
+
+	if partial {
+		fmt.Fprintf(buf, "Checked params: %s\n", strings.Join(checked, ", "))
+	}
+
+	return "\n\nMismatched params:\n" + buf.String()
+}
+
+func formatValue(v reflect.Value) string {
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+
+	if !v.IsValid() {
+		return "nil"
+	}
+
+	if v.Kind() == reflect.Ptr && !v.IsNil() {
+		return "&" + formatValue(v.Elem())
+	}
+
+	return fmt.Sprintf("%#v", v.Interface())
+}
+
 // Diff returns unified diff of the textual representations of e and a
 func Diff(e, a interface{}) string {
 	if e == nil || a == nil {
@@ -0,0 +1,48 @@
+package minimock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fieldsDiffParams struct {
+	Name  string
+	Count *int
+	Tags  []string
+	skip  int
+}
+
+func TestFieldsDiff(t *testing.T) {
+	one, two := 1, 2
+
+	diff := FieldsDiff(
+		fieldsDiffParams{Name: "name", Count: &one, Tags: []string{"a"}, skip: 1},
+		fieldsDiffParams{Name: "name", Count: &two, Tags: []string{"b"}, skip: 2},
+		nil,
+		nil,
+	)
+
+	assert.Equal(t, "\n\nMismatched params:\n  Count: want: &1, got: &2\n  Tags: want: []string{\"a\"}, got: []string{\"b\"}\n", diff)
+}
+
+func TestFieldsDiff_NilPointer(t *testing.T) {
+	one := 1
+
+	diff := FieldsDiff(fieldsDiffParams{Count: &one}, fieldsDiffParams{}, nil, nil)
+	assert.Equal(t, "\n\nMismatched params:\n  Count: want: &1, got: (*int)(nil)\n", diff)
+}
+
+func TestFieldsDiff_Equal(t *testing.T) {
+	assert.Equal(t, "", FieldsDiff(fieldsDiffParams{Name: "name"}, fieldsDiffParams{Name: "name"}, nil, nil))
+}
+
+func TestFieldsDiff_NotStructs(t *testing.T) {
+	assert.Equal(t, "", FieldsDiff(1, 2, nil, nil))
+	assert.Equal(t, "", FieldsDiff(fieldsDiffParams{}, nil, nil, nil))
+}
+
+func TestFieldsDiff_CheckedFields(t *testing.T) {
+	diff := FieldsDiff(fieldsDiffParams{Name: "name"}, fieldsDiffParams{Name: "other", Tags: []string{"a"}}, map[string]Matcher{"Count": Anything, "Tags": Anything}, nil)
+	assert.Equal(t, "\n\nMismatched params:\n  Name: want: \"name\", got: \"other\"\nChecked params: Name\n", diff)
+}
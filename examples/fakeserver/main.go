@@ -0,0 +1,75 @@
+// Command fakeserver shows how to use the mocks generated by minimock outside of the tests,
+// i.e. to run a dev server in the fake mode without the database:
+//
+//	go run ./examples/fakeserver -fake
+//	curl 'localhost:8080/users?id=1'
+//
+// The mock is created with minimock.PanicTester, so the calls the mock isn't set up for
+// panic with the descriptive message instead of failing a test. The panic is recovered
+// by net/http and logged along with the stack trace, the server keeps running.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gojuno/minimock"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:8080", "address to listen on")
+	fake := flag.Bool("fake", false, "use the fake user store instead of the database")
+	flag.Parse()
+
+	if !*fake {
+		log.Fatal("the database isn't configured in this example, run the server with -fake flag")
+	}
+
+	http.Handle("/users", &server{store: fakeUserStore()})
+	log.Fatal(http.ListenAndServe(*addr, nil))
+}
+
+// fakeUserStore returns the mock of the UserStore serving the fixed set of users,
+// Rename isn't set up so its calls panic with "Unexpected call to UserStoreMock.Rename..."
+func fakeUserStore() UserStore {
+	users := map[int]string{1: "Alice", 2: "Bob"}
+
+	return NewUserStoreMock(minimock.PanicTester()).NameMock.Set(func(ctx context.Context, id int) (string, error) {
+		if name, ok := users[id]; ok {
+			return name, nil
+		}
+		return "", errors.New("user not found")
+	})
+}
+
+type server struct {
+	store UserStore
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.URL.Query().Get("id"))
+	if err != nil {
+		http.Error(w, "invalid id", http.StatusBadRequest)
+		return
+	}
+
+	if r.Method == http.MethodPost {
+		if err := s.store.Rename(r.Context(), id, r.FormValue("name")); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	name, err := s.store.Name(r.Context(), id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	fmt.Fprintln(w, name)
+}
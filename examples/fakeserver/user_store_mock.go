@@ -0,0 +1,1285 @@
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+package main
+
+//go:generate minimock -i github.com/gojuno/minimock/examples/fakeserver.UserStore -o ./user_store_mock.go -p main
+
+import (
+	"context"
+	mm_sync "sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock"
+)
+
+// UserStoreMock implements UserStore
+//
+// UserStore is the storage of the users, the fake mode of the server uses its mock instead of the database
+type UserStoreMock struct {
+	state    minimock.MockState
+	mutex    mm_sync.RWMutex
+	delegate UserStore
+
+	funcName func(ctx context.Context, id int) (s1 string, err error)
+	NameMock *mUserStoreMockName
+
+	funcRename func(ctx context.Context, id int, name string) (err error)
+	RenameMock *mUserStoreMockRename
+}
+
+var _ UserStore = (*UserStoreMock)(nil)
+
+// NewUserStoreMock returns a mock for UserStore
+func NewUserStoreMock(t minimock.Tester) *UserStoreMock {
+	m := &UserStoreMock{}
+	m.state.Init(m, t, "UserStoreMock", 0)
+
+	m.NameMock = &mUserStoreMockName{mock: m}
+	m.NameMock.state.Init(&m.state, "Name")
+
+	m.RenameMock = &mUserStoreMockRename{mock: m}
+	m.RenameMock.state.Init(&m.state, "Rename")
+
+	return m
+}
+
+type mUserStoreMockName struct {
+	mock               *UserStoreMock
+	state              minimock.MethodState
+	defaultExpectation *UserStoreMockNameExpectation
+	expectations       []*UserStoreMockNameExpectation
+	inspectName        func(ctx context.Context, id int)
+	compare            minimock.Comparer
+	calls              []UserStoreMockNameParams
+	called             chan UserStoreMockNameParams
+	queued             []*UserStoreMockNameResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*UserStoreMockNameResults
+}
+
+// UserStoreMockNameExpectation specifies expectation struct of the UserStore.Name
+type UserStoreMockNameExpectation struct {
+	mock     *UserStoreMock
+	params   *UserStoreMockNameParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *UserStoreMockNameResults
+	Counter  uint64
+}
+
+// UserStoreMockNameParams contains parameters of the UserStore.Name
+type UserStoreMockNameParams struct {
+	Ctx context.Context
+	Id  int
+}
+
+// UserStoreMockNameResults contains results of the UserStore.Name
+type UserStoreMockNameResults struct {
+	R0 string
+	R1 error
+}
+
+// Expect sets up expected params for UserStore.Name
+func (mmName *mUserStoreMockName) Expect(ctx context.Context, id int) *mUserStoreMockName {
+	if _, mm_func := mmName.current(); mm_func != nil {
+		mmName.mock.state.T().Fatalf("UserStoreMock.Name mock is already set by Set")
+	}
+
+	mm_params := &UserStoreMockNameParams{ctx, id}
+	mmName.updateDefault(func(e *UserStoreMockNameExpectation) {
+		if e.partial {
+			mmName.mock.state.T().Fatalf("UserStoreMock.Name params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmName.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmName.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmName
+}
+
+// updateDefault replaces the default expectation of UserStore.Name by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmName *mUserStoreMockName) updateDefault(update func(e *UserStoreMockNameExpectation)) {
+	mmName.state.Mutex.Lock()
+	defer mmName.state.Mutex.Unlock()
+
+	e := &UserStoreMockNameExpectation{mock: mmName.mock}
+	if previous := mmName.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmName.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for UserStore.Name
+func (mmName *mUserStoreMockName) current() (*UserStoreMockNameExpectation, func(ctx context.Context, id int) (s1 string, err error)) {
+	mmName.state.Mutex.RLock()
+	defer mmName.state.Mutex.RUnlock()
+
+	return mmName.defaultExpectation, mmName.mock.funcName
+}
+
+// partialParams updates the default expectation of UserStore.Name by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmName *mUserStoreMockName) partialParams(update func(e *UserStoreMockNameExpectation)) {
+	if _, mm_func := mmName.current(); mm_func != nil {
+		mmName.mock.state.T().Fatalf("UserStoreMock.Name mock is already set by Set")
+	}
+
+	mmName.updateDefault(func(e *UserStoreMockNameExpectation) {
+		if e.params == nil {
+			e.params = &UserStoreMockNameParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"Ctx": minimock.Anything,
+				"Id":  minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectCtxParam1 sets up the expected value of the param #1 of UserStore.Name,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmName *mUserStoreMockName) ExpectCtxParam1(Ctx context.Context) *mUserStoreMockName {
+	mmName.partialParams(func(e *UserStoreMockNameExpectation) {
+		e.params.Ctx = Ctx
+		delete(e.matchers, "Ctx")
+	})
+	return mmName
+}
+
+// MatchCtxParam1 sets up the predicate matching the param #1 of UserStore.Name,
+// it's used instead of the value of the param set by Expect
+func (mmName *mUserStoreMockName) MatchCtxParam1(f func(got context.Context) bool) *mUserStoreMockName {
+	mm_matcher := minimock.Predicate("predicate func(got context.Context) bool", func(v interface{}) bool {
+		got, _ := v.(context.Context)
+		return f(got)
+	})
+	mmName.partialParams(func(e *UserStoreMockNameExpectation) {
+		e.matchers["Ctx"] = mm_matcher
+	})
+	return mmName
+}
+
+// ExpectIdParam2 sets up the expected value of the param #2 of UserStore.Name,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmName *mUserStoreMockName) ExpectIdParam2(Id int) *mUserStoreMockName {
+	mmName.partialParams(func(e *UserStoreMockNameExpectation) {
+		e.params.Id = Id
+		delete(e.matchers, "Id")
+	})
+	return mmName
+}
+
+// MatchIdParam2 sets up the predicate matching the param #2 of UserStore.Name,
+// it's used instead of the value of the param set by Expect
+func (mmName *mUserStoreMockName) MatchIdParam2(f func(got int) bool) *mUserStoreMockName {
+	mm_matcher := minimock.Predicate("predicate func(got int) bool", func(v interface{}) bool {
+		got, _ := v.(int)
+		return f(got)
+	})
+	mmName.partialParams(func(e *UserStoreMockNameExpectation) {
+		e.matchers["Id"] = mm_matcher
+	})
+	return mmName
+}
+
+// Return sets up results that will be returned by UserStore.Name
+func (mmName *mUserStoreMockName) Return(s1 string, err error) *UserStoreMock {
+	if _, mm_func := mmName.current(); mm_func != nil {
+		mmName.mock.state.T().Fatalf("UserStoreMock.Name mock is already set by Set")
+	}
+
+	mm_results := &UserStoreMockNameResults{s1, err}
+	mmName.updateDefault(func(e *UserStoreMockNameExpectation) { e.results = mm_results })
+
+	return mmName.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of UserStore.Name,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmName *mUserStoreMockName) ReturnOnce(s1 string, err error) *mUserStoreMockName {
+	mmName.state.Mutex.Lock()
+	mmName.queued = append(mmName.queued, &UserStoreMockNameResults{s1, err})
+	mmName.state.Mutex.Unlock()
+	mmName.state.PushResults()
+	return mmName
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmName *mUserStoreMockName) popResults() *UserStoreMockNameResults {
+	mm_i, mm_ok := mmName.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmName.state.Mutex.RLock()
+	defer mmName.state.Mutex.RUnlock()
+
+	return mmName.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of UserStore.Name instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmName *mUserStoreMockName) SetComparer(compare minimock.Comparer) *mUserStoreMockName {
+	mmName.state.Mutex.Lock()
+	mmName.compare = compare
+	mmName.state.Mutex.Unlock()
+	return mmName
+}
+
+// whenExpectations returns the expectations of UserStore.Name set by When,
+// the expectations can be set while the method is called concurrently
+func (mmName *mUserStoreMockName) whenExpectations() []*UserStoreMockNameExpectation {
+	mmName.state.Mutex.RLock()
+	defer mmName.state.Mutex.RUnlock()
+
+	return mmName.expectations
+}
+
+// Inspect sets up the function called with the params of every UserStore.Name call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmName *mUserStoreMockName) Inspect(f func(ctx context.Context, id int)) *mUserStoreMockName {
+	mmName.state.Mutex.Lock()
+	mmName.inspectName = f
+	mmName.state.Mutex.Unlock()
+	return mmName
+}
+
+// expected returns everything set up for the UserStore.Name call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmName *mUserStoreMockName) expected() (*UserStoreMockNameExpectation, func(ctx context.Context, id int) (s1 string, err error), func(ctx context.Context, id int), minimock.Comparer, []*UserStoreMockNameExpectation, []*UserStoreMockNameResults) {
+	mmName.state.Mutex.RLock()
+	defer mmName.state.Mutex.RUnlock()
+
+	return mmName.defaultExpectation, mmName.mock.funcName, mmName.inspectName, mmName.compare, mmName.expectations, mmName.whenResults
+}
+
+// setup returns the expectations and the function set up for UserStore.Name to be checked when the mock is finished
+func (mmName *mUserStoreMockName) setup() (setup minimock.Setup) {
+	mmName.state.Mutex.RLock()
+	defer mmName.state.Mutex.RUnlock()
+
+	setup.Func = mmName.mock.funcName != nil
+	if e := mmName.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmName.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of UserStore.Name, the params of the calls are removed from the history as well
+func (mmName *mUserStoreMockName) reset() {
+	mmName.state.Mutex.Lock()
+	mmName.defaultExpectation = nil
+	mmName.expectations = nil
+	mmName.queued = nil
+	mmName.whenResults = nil
+	mmName.state.Mutex.Unlock()
+
+	mmName.state.History.Lock()
+	mmName.calls = nil
+	mmName.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmName *mUserStoreMockName) MethodName() string {
+	return mmName.state.Name()
+}
+
+// CallSequence returns the numbers of the UserStore.Name calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmName *mUserStoreMockName) CallSequence() []uint64 {
+	return mmName.state.CallSequence()
+}
+
+// WaitForCalls waits until UserStore.Name is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmName *mUserStoreMockName) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmName.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent UserStore.Name calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmName *mUserStoreMockName) Block() (release func()) {
+	return mmName.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n UserStore.Name calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmName *mUserStoreMockName) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmName.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n UserStore.Name calls are in flight at once
+func (mmName *mUserStoreMockName) LimitConcurrency(n int) *mUserStoreMockName {
+	mmName.state.SetLimit(n)
+	return mmName
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each UserStore.Name call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmName *mUserStoreMockName) CaptureCalls(buffer int) *mUserStoreMockName {
+	mmName.state.History.Lock()
+	mmName.called = make(chan UserStoreMockNameParams, buffer)
+	mmName.state.History.Unlock()
+	return mmName
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each UserStore.Name call
+func (mmName *mUserStoreMockName) Called() <-chan UserStoreMockNameParams {
+	mmName.state.History.Lock()
+	defer mmName.state.History.Unlock()
+
+	if mmName.called == nil {
+		mmName.mock.state.T().Fatalf("Calls of UserStoreMock.Name aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmName.called
+}
+
+// DroppedCalls returns the number of the UserStore.Name calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmName *mUserStoreMockName) DroppedCalls() uint64 {
+	return mmName.state.DroppedCalls()
+}
+
+// Times sets the exact number of the UserStore.Name calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmName *mUserStoreMockName) Times(n uint64) *mUserStoreMockName {
+	mmName.state.SetTimes(n)
+	return mmName
+}
+
+// Optional excludes UserStore.Name from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmName *mUserStoreMockName) Optional() *mUserStoreMockName {
+	mmName.state.SetOptional()
+	return mmName
+}
+
+// Set uses given function f to mock the UserStore.Name method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmName *mUserStoreMockName) Set(f func(ctx context.Context, id int) (s1 string, err error)) *UserStoreMock {
+	mmName.state.Mutex.Lock()
+	defer mmName.state.Mutex.Unlock()
+
+	if mmName.defaultExpectation != nil {
+		mmName.mock.state.T().Fatalf("Default expectation is already set for the UserStore.Name method")
+	}
+
+	if len(mmName.expectations) > 0 {
+		mmName.mock.state.T().Fatalf("Some expectations are already set for the UserStore.Name method")
+	}
+
+	mmName.mock.funcName = f
+	return mmName.mock
+}
+
+// When sets expectation for the UserStore.Name which will trigger the result defined by the following
+// Then helper
+func (mmName *mUserStoreMockName) When(ctx context.Context, id int) *UserStoreMockNameExpectation {
+	if _, mm_func := mmName.current(); mm_func != nil {
+		mmName.mock.state.T().Fatalf("UserStoreMock.Name mock is already set by Set")
+	}
+
+	expectation := &UserStoreMockNameExpectation{
+		mock:   mmName.mock,
+		params: &UserStoreMockNameParams{ctx, id},
+	}
+	mmName.state.Mutex.Lock()
+	mmName.expectations = append(mmName.expectations, expectation)
+	mmName.whenResults = append(mmName.whenResults, nil)
+	mmName.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up UserStore.Name return parameters for the expectation previously defined by the When method
+func (mmExpectation *UserStoreMockNameExpectation) Then(s1 string, err error) *UserStoreMock {
+	mm_handle := mmExpectation.mock.NameMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &UserStoreMockNameResults{s1, err}
+	mm_results := make([]*UserStoreMockNameResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Name implements UserStore
+func (mmName *UserStoreMock) Name(ctx context.Context, id int) (s1 string, err error) {
+	mm_method := mmName.NameMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := UserStoreMockNameParams{ctx, id}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcName, mm_inspectName, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectName != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectName(ctx, id)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).R0, (*mm_results).R1
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).R0, (*mm_results).R1
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcName == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0, (*mm_expectation.results).R1
+	}
+	if mm_funcName != nil {
+		return mm_funcName(ctx, id)
+	}
+	if mm_delegate := mmName.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Name(ctx, id)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, ctx, id)
+	return
+}
+
+// NameAfterCounter returns a count of finished UserStoreMock.Name invocations
+func (mmName *UserStoreMock) NameAfterCounter() uint64 {
+	return mmName.NameMock.state.AfterCalls()
+}
+
+// NameBeforeCounter returns a count of UserStoreMock.Name invocations
+func (mmName *UserStoreMock) NameBeforeCounter() uint64 {
+	return mmName.NameMock.state.BeforeCalls()
+}
+
+// NameCalls returns the params of all UserStoreMock.Name calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmName *UserStoreMock) NameCalls() []UserStoreMockNameParams {
+	mmName.NameMock.state.History.Lock()
+	defer mmName.NameMock.state.History.Unlock()
+
+	calls := make([]UserStoreMockNameParams, len(mmName.NameMock.calls))
+	copy(calls, mmName.NameMock.calls)
+	return calls
+}
+
+// NameLastParams returns the params of the latest UserStoreMock.Name call and false if there were no calls
+func (mmName *UserStoreMock) NameLastParams() (params UserStoreMockNameParams, ok bool) {
+	mmName.NameMock.state.History.Lock()
+	defer mmName.NameMock.state.History.Unlock()
+
+	if n := len(mmName.NameMock.calls); n > 0 {
+		return mmName.NameMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// NameCallTimes returns the times of all UserStoreMock.Name calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmName *UserStoreMock) NameCallTimes() []mm_time.Time {
+	return mmName.NameMock.state.CallTimes()
+}
+
+// NameMaxInFlight returns the maximum number of the UserStoreMock.Name calls that have been in flight at once
+func (mmName *UserStoreMock) NameMaxInFlight() int {
+	return mmName.NameMock.state.MaxInFlight()
+}
+
+// NameUnexpectedCounter returns a count of UserStoreMock.Name invocations made without an implementation
+func (mmName *UserStoreMock) NameUnexpectedCounter() uint64 {
+	return mmName.NameMock.state.UnexpectedCalls()
+}
+
+// NameNotCalled returns true if UserStoreMock.Name hasn't been called
+func (mmName *UserStoreMock) NameNotCalled() bool {
+	return mmName.NameMock.state.BeforeCalls() == 0
+}
+
+// NameCallCount returns a count of UserStoreMock.Name invocations, it's the same as NameBeforeCounter
+func (mmName *UserStoreMock) NameCallCount() uint64 {
+	return mmName.NameMock.state.BeforeCalls()
+}
+
+// MinimockNameDone returns true if the count of the Name invocations corresponds
+// the number of defined expectations
+func (mmName *UserStoreMock) MinimockNameDone() bool {
+	return mmName.NameMock.state.Done(mmName.NameMock.setup())
+}
+
+// MinimockNameInspect logs each unmet expectation
+func (mmName *UserStoreMock) MinimockNameInspect() {
+	mmName.NameMock.state.Inspect(mmName.NameMock.setup())
+}
+
+type mUserStoreMockRename struct {
+	mock               *UserStoreMock
+	state              minimock.MethodState
+	defaultExpectation *UserStoreMockRenameExpectation
+	expectations       []*UserStoreMockRenameExpectation
+	inspectRename      func(ctx context.Context, id int, name string)
+	compare            minimock.Comparer
+	calls              []UserStoreMockRenameParams
+	called             chan UserStoreMockRenameParams
+	queued             []*UserStoreMockRenameResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*UserStoreMockRenameResults
+}
+
+// UserStoreMockRenameExpectation specifies expectation struct of the UserStore.Rename
+type UserStoreMockRenameExpectation struct {
+	mock     *UserStoreMock
+	params   *UserStoreMockRenameParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *UserStoreMockRenameResults
+	Counter  uint64
+}
+
+// UserStoreMockRenameParams contains parameters of the UserStore.Rename
+type UserStoreMockRenameParams struct {
+	Ctx  context.Context
+	Id   int
+	Name string
+}
+
+// UserStoreMockRenameResults contains results of the UserStore.Rename
+type UserStoreMockRenameResults struct {
+	R0 error
+}
+
+// Expect sets up expected params for UserStore.Rename
+func (mmRename *mUserStoreMockRename) Expect(ctx context.Context, id int, name string) *mUserStoreMockRename {
+	if _, mm_func := mmRename.current(); mm_func != nil {
+		mmRename.mock.state.T().Fatalf("UserStoreMock.Rename mock is already set by Set")
+	}
+
+	mm_params := &UserStoreMockRenameParams{ctx, id, name}
+	mmRename.updateDefault(func(e *UserStoreMockRenameExpectation) {
+		if e.partial {
+			mmRename.mock.state.T().Fatalf("UserStoreMock.Rename params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmRename.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmRename.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmRename
+}
+
+// updateDefault replaces the default expectation of UserStore.Rename by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmRename *mUserStoreMockRename) updateDefault(update func(e *UserStoreMockRenameExpectation)) {
+	mmRename.state.Mutex.Lock()
+	defer mmRename.state.Mutex.Unlock()
+
+	e := &UserStoreMockRenameExpectation{mock: mmRename.mock}
+	if previous := mmRename.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmRename.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for UserStore.Rename
+func (mmRename *mUserStoreMockRename) current() (*UserStoreMockRenameExpectation, func(ctx context.Context, id int, name string) (err error)) {
+	mmRename.state.Mutex.RLock()
+	defer mmRename.state.Mutex.RUnlock()
+
+	return mmRename.defaultExpectation, mmRename.mock.funcRename
+}
+
+// partialParams updates the default expectation of UserStore.Rename by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmRename *mUserStoreMockRename) partialParams(update func(e *UserStoreMockRenameExpectation)) {
+	if _, mm_func := mmRename.current(); mm_func != nil {
+		mmRename.mock.state.T().Fatalf("UserStoreMock.Rename mock is already set by Set")
+	}
+
+	mmRename.updateDefault(func(e *UserStoreMockRenameExpectation) {
+		if e.params == nil {
+			e.params = &UserStoreMockRenameParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"Ctx":  minimock.Anything,
+				"Id":   minimock.Anything,
+				"Name": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectCtxParam1 sets up the expected value of the param #1 of UserStore.Rename,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmRename *mUserStoreMockRename) ExpectCtxParam1(Ctx context.Context) *mUserStoreMockRename {
+	mmRename.partialParams(func(e *UserStoreMockRenameExpectation) {
+		e.params.Ctx = Ctx
+		delete(e.matchers, "Ctx")
+	})
+	return mmRename
+}
+
+// MatchCtxParam1 sets up the predicate matching the param #1 of UserStore.Rename,
+// it's used instead of the value of the param set by Expect
+func (mmRename *mUserStoreMockRename) MatchCtxParam1(f func(got context.Context) bool) *mUserStoreMockRename {
+	mm_matcher := minimock.Predicate("predicate func(got context.Context) bool", func(v interface{}) bool {
+		got, _ := v.(context.Context)
+		return f(got)
+	})
+	mmRename.partialParams(func(e *UserStoreMockRenameExpectation) {
+		e.matchers["Ctx"] = mm_matcher
+	})
+	return mmRename
+}
+
+// ExpectIdParam2 sets up the expected value of the param #2 of UserStore.Rename,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmRename *mUserStoreMockRename) ExpectIdParam2(Id int) *mUserStoreMockRename {
+	mmRename.partialParams(func(e *UserStoreMockRenameExpectation) {
+		e.params.Id = Id
+		delete(e.matchers, "Id")
+	})
+	return mmRename
+}
+
+// MatchIdParam2 sets up the predicate matching the param #2 of UserStore.Rename,
+// it's used instead of the value of the param set by Expect
+func (mmRename *mUserStoreMockRename) MatchIdParam2(f func(got int) bool) *mUserStoreMockRename {
+	mm_matcher := minimock.Predicate("predicate func(got int) bool", func(v interface{}) bool {
+		got, _ := v.(int)
+		return f(got)
+	})
+	mmRename.partialParams(func(e *UserStoreMockRenameExpectation) {
+		e.matchers["Id"] = mm_matcher
+	})
+	return mmRename
+}
+
+// ExpectNameParam3 sets up the expected value of the param #3 of UserStore.Rename,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmRename *mUserStoreMockRename) ExpectNameParam3(Name string) *mUserStoreMockRename {
+	mmRename.partialParams(func(e *UserStoreMockRenameExpectation) {
+		e.params.Name = Name
+		delete(e.matchers, "Name")
+	})
+	return mmRename
+}
+
+// MatchNameParam3 sets up the predicate matching the param #3 of UserStore.Rename,
+// it's used instead of the value of the param set by Expect
+func (mmRename *mUserStoreMockRename) MatchNameParam3(f func(got string) bool) *mUserStoreMockRename {
+	mm_matcher := minimock.Predicate("predicate func(got string) bool", func(v interface{}) bool {
+		got, _ := v.(string)
+		return f(got)
+	})
+	mmRename.partialParams(func(e *UserStoreMockRenameExpectation) {
+		e.matchers["Name"] = mm_matcher
+	})
+	return mmRename
+}
+
+// Return sets up results that will be returned by UserStore.Rename
+func (mmRename *mUserStoreMockRename) Return(err error) *UserStoreMock {
+	if _, mm_func := mmRename.current(); mm_func != nil {
+		mmRename.mock.state.T().Fatalf("UserStoreMock.Rename mock is already set by Set")
+	}
+
+	mm_results := &UserStoreMockRenameResults{err}
+	mmRename.updateDefault(func(e *UserStoreMockRenameExpectation) { e.results = mm_results })
+
+	return mmRename.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of UserStore.Rename,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmRename *mUserStoreMockRename) ReturnOnce(err error) *mUserStoreMockRename {
+	mmRename.state.Mutex.Lock()
+	mmRename.queued = append(mmRename.queued, &UserStoreMockRenameResults{err})
+	mmRename.state.Mutex.Unlock()
+	mmRename.state.PushResults()
+	return mmRename
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmRename *mUserStoreMockRename) popResults() *UserStoreMockRenameResults {
+	mm_i, mm_ok := mmRename.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmRename.state.Mutex.RLock()
+	defer mmRename.state.Mutex.RUnlock()
+
+	return mmRename.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of UserStore.Rename instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmRename *mUserStoreMockRename) SetComparer(compare minimock.Comparer) *mUserStoreMockRename {
+	mmRename.state.Mutex.Lock()
+	mmRename.compare = compare
+	mmRename.state.Mutex.Unlock()
+	return mmRename
+}
+
+// whenExpectations returns the expectations of UserStore.Rename set by When,
+// the expectations can be set while the method is called concurrently
+func (mmRename *mUserStoreMockRename) whenExpectations() []*UserStoreMockRenameExpectation {
+	mmRename.state.Mutex.RLock()
+	defer mmRename.state.Mutex.RUnlock()
+
+	return mmRename.expectations
+}
+
+// Inspect sets up the function called with the params of every UserStore.Rename call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmRename *mUserStoreMockRename) Inspect(f func(ctx context.Context, id int, name string)) *mUserStoreMockRename {
+	mmRename.state.Mutex.Lock()
+	mmRename.inspectRename = f
+	mmRename.state.Mutex.Unlock()
+	return mmRename
+}
+
+// expected returns everything set up for the UserStore.Rename call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmRename *mUserStoreMockRename) expected() (*UserStoreMockRenameExpectation, func(ctx context.Context, id int, name string) (err error), func(ctx context.Context, id int, name string), minimock.Comparer, []*UserStoreMockRenameExpectation, []*UserStoreMockRenameResults) {
+	mmRename.state.Mutex.RLock()
+	defer mmRename.state.Mutex.RUnlock()
+
+	return mmRename.defaultExpectation, mmRename.mock.funcRename, mmRename.inspectRename, mmRename.compare, mmRename.expectations, mmRename.whenResults
+}
+
+// setup returns the expectations and the function set up for UserStore.Rename to be checked when the mock is finished
+func (mmRename *mUserStoreMockRename) setup() (setup minimock.Setup) {
+	mmRename.state.Mutex.RLock()
+	defer mmRename.state.Mutex.RUnlock()
+
+	setup.Func = mmRename.mock.funcRename != nil
+	if e := mmRename.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmRename.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of UserStore.Rename, the params of the calls are removed from the history as well
+func (mmRename *mUserStoreMockRename) reset() {
+	mmRename.state.Mutex.Lock()
+	mmRename.defaultExpectation = nil
+	mmRename.expectations = nil
+	mmRename.queued = nil
+	mmRename.whenResults = nil
+	mmRename.state.Mutex.Unlock()
+
+	mmRename.state.History.Lock()
+	mmRename.calls = nil
+	mmRename.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmRename *mUserStoreMockRename) MethodName() string {
+	return mmRename.state.Name()
+}
+
+// CallSequence returns the numbers of the UserStore.Rename calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmRename *mUserStoreMockRename) CallSequence() []uint64 {
+	return mmRename.state.CallSequence()
+}
+
+// WaitForCalls waits until UserStore.Rename is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmRename *mUserStoreMockRename) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmRename.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent UserStore.Rename calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmRename *mUserStoreMockRename) Block() (release func()) {
+	return mmRename.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n UserStore.Rename calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmRename *mUserStoreMockRename) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmRename.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n UserStore.Rename calls are in flight at once
+func (mmRename *mUserStoreMockRename) LimitConcurrency(n int) *mUserStoreMockRename {
+	mmRename.state.SetLimit(n)
+	return mmRename
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each UserStore.Rename call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmRename *mUserStoreMockRename) CaptureCalls(buffer int) *mUserStoreMockRename {
+	mmRename.state.History.Lock()
+	mmRename.called = make(chan UserStoreMockRenameParams, buffer)
+	mmRename.state.History.Unlock()
+	return mmRename
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each UserStore.Rename call
+func (mmRename *mUserStoreMockRename) Called() <-chan UserStoreMockRenameParams {
+	mmRename.state.History.Lock()
+	defer mmRename.state.History.Unlock()
+
+	if mmRename.called == nil {
+		mmRename.mock.state.T().Fatalf("Calls of UserStoreMock.Rename aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmRename.called
+}
+
+// DroppedCalls returns the number of the UserStore.Rename calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmRename *mUserStoreMockRename) DroppedCalls() uint64 {
+	return mmRename.state.DroppedCalls()
+}
+
+// Times sets the exact number of the UserStore.Rename calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmRename *mUserStoreMockRename) Times(n uint64) *mUserStoreMockRename {
+	mmRename.state.SetTimes(n)
+	return mmRename
+}
+
+// Optional excludes UserStore.Rename from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmRename *mUserStoreMockRename) Optional() *mUserStoreMockRename {
+	mmRename.state.SetOptional()
+	return mmRename
+}
+
+// Set uses given function f to mock the UserStore.Rename method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmRename *mUserStoreMockRename) Set(f func(ctx context.Context, id int, name string) (err error)) *UserStoreMock {
+	mmRename.state.Mutex.Lock()
+	defer mmRename.state.Mutex.Unlock()
+
+	if mmRename.defaultExpectation != nil {
+		mmRename.mock.state.T().Fatalf("Default expectation is already set for the UserStore.Rename method")
+	}
+
+	if len(mmRename.expectations) > 0 {
+		mmRename.mock.state.T().Fatalf("Some expectations are already set for the UserStore.Rename method")
+	}
+
+	mmRename.mock.funcRename = f
+	return mmRename.mock
+}
+
+// When sets expectation for the UserStore.Rename which will trigger the result defined by the following
+// Then helper
+func (mmRename *mUserStoreMockRename) When(ctx context.Context, id int, name string) *UserStoreMockRenameExpectation {
+	if _, mm_func := mmRename.current(); mm_func != nil {
+		mmRename.mock.state.T().Fatalf("UserStoreMock.Rename mock is already set by Set")
+	}
+
+	expectation := &UserStoreMockRenameExpectation{
+		mock:   mmRename.mock,
+		params: &UserStoreMockRenameParams{ctx, id, name},
+	}
+	mmRename.state.Mutex.Lock()
+	mmRename.expectations = append(mmRename.expectations, expectation)
+	mmRename.whenResults = append(mmRename.whenResults, nil)
+	mmRename.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up UserStore.Rename return parameters for the expectation previously defined by the When method
+func (mmExpectation *UserStoreMockRenameExpectation) Then(err error) *UserStoreMock {
+	mm_handle := mmExpectation.mock.RenameMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &UserStoreMockRenameResults{err}
+	mm_results := make([]*UserStoreMockRenameResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Rename implements UserStore
+func (mmRename *UserStoreMock) Rename(ctx context.Context, id int, name string) (err error) {
+	mm_method := mmRename.RenameMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := UserStoreMockRenameParams{ctx, id, name}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcRename, mm_inspectRename, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectRename != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectRename(ctx, id, name)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).R0
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcRename == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcRename != nil {
+		return mm_funcRename(ctx, id, name)
+	}
+	if mm_delegate := mmRename.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Rename(ctx, id, name)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, ctx, id, name)
+	return
+}
+
+// RenameAfterCounter returns a count of finished UserStoreMock.Rename invocations
+func (mmRename *UserStoreMock) RenameAfterCounter() uint64 {
+	return mmRename.RenameMock.state.AfterCalls()
+}
+
+// RenameBeforeCounter returns a count of UserStoreMock.Rename invocations
+func (mmRename *UserStoreMock) RenameBeforeCounter() uint64 {
+	return mmRename.RenameMock.state.BeforeCalls()
+}
+
+// RenameCalls returns the params of all UserStoreMock.Rename calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmRename *UserStoreMock) RenameCalls() []UserStoreMockRenameParams {
+	mmRename.RenameMock.state.History.Lock()
+	defer mmRename.RenameMock.state.History.Unlock()
+
+	calls := make([]UserStoreMockRenameParams, len(mmRename.RenameMock.calls))
+	copy(calls, mmRename.RenameMock.calls)
+	return calls
+}
+
+// RenameLastParams returns the params of the latest UserStoreMock.Rename call and false if there were no calls
+func (mmRename *UserStoreMock) RenameLastParams() (params UserStoreMockRenameParams, ok bool) {
+	mmRename.RenameMock.state.History.Lock()
+	defer mmRename.RenameMock.state.History.Unlock()
+
+	if n := len(mmRename.RenameMock.calls); n > 0 {
+		return mmRename.RenameMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// RenameCallTimes returns the times of all UserStoreMock.Rename calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmRename *UserStoreMock) RenameCallTimes() []mm_time.Time {
+	return mmRename.RenameMock.state.CallTimes()
+}
+
+// RenameMaxInFlight returns the maximum number of the UserStoreMock.Rename calls that have been in flight at once
+func (mmRename *UserStoreMock) RenameMaxInFlight() int {
+	return mmRename.RenameMock.state.MaxInFlight()
+}
+
+// RenameUnexpectedCounter returns a count of UserStoreMock.Rename invocations made without an implementation
+func (mmRename *UserStoreMock) RenameUnexpectedCounter() uint64 {
+	return mmRename.RenameMock.state.UnexpectedCalls()
+}
+
+// RenameNotCalled returns true if UserStoreMock.Rename hasn't been called
+func (mmRename *UserStoreMock) RenameNotCalled() bool {
+	return mmRename.RenameMock.state.BeforeCalls() == 0
+}
+
+// RenameCallCount returns a count of UserStoreMock.Rename invocations, it's the same as RenameBeforeCounter
+func (mmRename *UserStoreMock) RenameCallCount() uint64 {
+	return mmRename.RenameMock.state.BeforeCalls()
+}
+
+// MinimockRenameDone returns true if the count of the Rename invocations corresponds
+// the number of defined expectations
+func (mmRename *UserStoreMock) MinimockRenameDone() bool {
+	return mmRename.RenameMock.state.Done(mmRename.RenameMock.setup())
+}
+
+// MinimockRenameInspect logs each unmet expectation
+func (mmRename *UserStoreMock) MinimockRenameInspect() {
+	mmRename.RenameMock.state.Inspect(mmRename.RenameMock.setup())
+}
+
+// MinimockSetComparer sets up the function comparing the expected and the actual params of all UserStoreMock methods instead of minimock.Equal,
+// the params matched by the matchers aren't compared
+func (m *UserStoreMock) MinimockSetComparer(compare minimock.Comparer) *UserStoreMock {
+	m.state.SetComparer(compare)
+	return m
+}
+
+// MinimockSetSequence sets up the sequence numbering the UserStoreMock calls, the mocks sharing the sequence
+// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+func (m *UserStoreMock) MinimockSetSequence(sequence *minimock.Sequence) *UserStoreMock {
+	m.state.SetSequence(sequence)
+	return m
+}
+
+// MinimockSetClock sets up the function returning the current time for the history of UserStoreMock calls instead of time.Now
+func (m *UserStoreMock) MinimockSetClock(clock func() mm_time.Time) *UserStoreMock {
+	m.state.SetClock(clock)
+	return m
+}
+
+// MinimockSetAutoFinish enables or disables the check of UserStoreMock made by the Cleanup of the tester passed to NewUserStoreMock,
+// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+func (m *UserStoreMock) MinimockSetAutoFinish(enabled bool) *UserStoreMock {
+	m.state.SetAutoFinish(enabled)
+	return m
+}
+
+// MinimockSetDelegate sets up the implementation the calls of the UserStoreMock methods are forwarded to when the methods
+// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+func (m *UserStoreMock) MinimockSetDelegate(impl UserStore) *UserStoreMock {
+	m.mutex.Lock()
+	m.delegate = impl
+	m.mutex.Unlock()
+	return m
+}
+
+func (m *UserStoreMock) minimockDelegate() UserStore {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.delegate
+}
+
+// MinimockSetLenient enables or disables the lenient mode of UserStoreMock: the calls of the methods that have neither
+// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+func (m *UserStoreMock) MinimockSetLenient(enabled bool) *UserStoreMock {
+	m.state.SetLenient(enabled)
+	return m
+}
+
+// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (m *UserStoreMock) MinimockLenientCalls() map[string]uint64 {
+	return m.state.LenientCalls()
+}
+
+// MinimockAssertNotCalled fails the test without stopping it if any of the UserStoreMock methods with the given names has been called,
+// it doesn't depend on the expectations and the functions set up for the methods
+func (m *UserStoreMock) MinimockAssertNotCalled(methodNames ...string) {
+	m.state.AssertNotCalled(methodNames...)
+}
+
+// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+// queued by ReturnOnce of all UserStoreMock methods, the functions set by Set and Inspect are kept.
+// It fails the test if any of the methods is being called
+func (m *UserStoreMock) MinimockReset() {
+	m.state.Reset(
+		m.NameMock.reset,
+		m.RenameMock.reset,
+	)
+}
+
+// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+func (m *UserStoreMock) MinimockResetAll() {
+	m.MinimockReset()
+	m.NameMock.state.Mutex.Lock()
+	m.funcName = nil
+	m.NameMock.inspectName = nil
+	m.NameMock.state.Mutex.Unlock()
+	m.RenameMock.state.Mutex.Lock()
+	m.funcRename = nil
+	m.RenameMock.inspectRename = nil
+	m.RenameMock.state.Mutex.Unlock()
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *UserStoreMock) MinimockFinish() {
+	m.state.Finish(m.minimockDone,
+		m.MinimockNameInspect,
+		m.MinimockRenameInspect,
+	)
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *UserStoreMock) MinimockWait(timeout mm_time.Duration) {
+	m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
+}
+
+func (m *UserStoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockNameDone() &&
+		m.MinimockRenameDone()
+}
@@ -0,0 +1,9 @@
+package main
+
+import "context"
+
+// UserStore is the storage of the users, the fake mode of the server uses its mock instead of the database
+type UserStore interface {
+	Name(ctx context.Context, id int) (string, error)
+	Rename(ctx context.Context, id int, name string) error
+}
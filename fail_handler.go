@@ -0,0 +1,108 @@
+package minimock
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// FailHandler is the signature of the fail handlers of the BDD frameworks, i.e. ginkgo.Fail and gomega.Fail
+type FailHandler func(message string, callerSkip ...int)
+
+// FailHandlerTester returns a Tester reporting the failures of the mocks with the fail handler,
+// so they show up as the usual failures of the spec at the location of the mocked method call.
+// Since ginkgo.Fail stops the spec by panicking, the spec is stopped by the first reported error
+// and the code calling the mocks from its own goroutines has to defer ginkgo.GinkgoRecover():
+//
+//	mc := minimock.NewController(minimock.FailHandlerTester(ginkgo.Fail))
+func FailHandlerTester(fail FailHandler) Tester {
+	return &failHandlerTester{fail: fail}
+}
+
+type failHandlerTester struct {
+	fail FailHandler
+}
+
+// failHandlerCallerSkip skips the frame of the tester so the failure is reported at the location of its caller,
+// it's used when the frames of minimock can't be told from the rest of the stack
+const failHandlerCallerSkip = 1
+
+// callerSkip returns the number of the frames above the method of the tester the fail handler has to skip
+// to report the failure at the location of the call to the mock: the frames of the Controller, the generated mocks
+// and the runtime (i.e. the panics recovered by the mocks) are skipped
+func callerSkip() int {
+	pcs := make([]uintptr, 64)
+	//skip runtime.Callers, callerSkip and the method of the tester
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+
+	for skip := failHandlerCallerSkip; ; skip++ {
+		frame, more := frames.Next()
+		if !isMinimockFrame(frame) {
+			return skip
+		}
+
+		if !more {
+			return failHandlerCallerSkip
+		}
+	}
+}
+
+const minimockPackage = "github.com/gojuno/minimock."
+
+func isMinimockFrame(frame runtime.Frame) bool {
+	switch {
+	case strings.HasPrefix(frame.Function, "runtime."):
+		return true
+	case strings.HasPrefix(frame.Function, minimockPackage):
+		return !strings.HasSuffix(frame.File, "_test.go")
+	}
+
+	return isMockMethod(frame.Function)
+}
+
+// isMockMethod returns true if the function is a method of the types generated by minimock, i.e. the mock
+// itself (FormatterMock), the helpers of its methods (mFormatterMockFormat) and their expectations
+// (FormatterMockFormatExpectation), the closures declared in the methods are taken into account as well.
+// The frames are told by the names of the functions since the sources of the mocks aren't always available
+func isMockMethod(function string) bool {
+	//the package path may contain dots, so the receiver is looked for after the last slash
+	name := function[strings.LastIndex(function, "/")+1:]
+
+	start := strings.Index(name, ".(*")
+	if start < 0 {
+		return false
+	}
+
+	receiver := name[start+len(".(*"):]
+	if end := strings.IndexAny(receiver, "[)"); end >= 0 {
+		receiver = receiver[:end]
+	}
+
+	return strings.Contains(receiver, "Mock")
+}
+
+// Error implements Tester
+func (t *failHandlerTester) Error(args ...interface{}) {
+	t.fail(fmt.Sprint(args...), callerSkip())
+}
+
+// Errorf implements Tester
+func (t *failHandlerTester) Errorf(format string, args ...interface{}) {
+	t.fail(fmt.Sprintf(format, args...), callerSkip())
+}
+
+// Fatal implements Tester
+func (t *failHandlerTester) Fatal(args ...interface{}) {
+	t.fail(fmt.Sprint(args...), callerSkip())
+}
+
+// Fatalf implements Tester
+func (t *failHandlerTester) Fatalf(format string, args ...interface{}) {
+	t.fail(fmt.Sprintf(format, args...), callerSkip())
+}
+
+// FailNow implements Tester, it reports the failure with the handler as well, so the spec is failed
+// even if the handler didn't stop it when the preceding errors were reported
+func (t *failHandlerTester) FailNow() {
+	t.fail("minimock: FailNow is called", callerSkip())
+}
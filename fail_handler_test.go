@@ -0,0 +1,49 @@
+package minimock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type failure struct {
+	message    string
+	callerSkip []int
+}
+
+func TestFailHandlerTester(t *testing.T) {
+	var failures []failure
+	tester := FailHandlerTester(func(message string, callerSkip ...int) {
+		failures = append(failures, failure{message: message, callerSkip: callerSkip})
+	})
+
+	tester.Error("Expected call to ", "FormatterMock.Format")
+	tester.Errorf("Expected call to %s", "ReaderMock.Read")
+	tester.FailNow()
+	tester.Fatalf("Unexpected call to %s", "FormatterMock.Format")
+
+	assert.Equal(t, []failure{
+		{message: "Expected call to FormatterMock.Format", callerSkip: []int{1}},
+		{message: "Expected call to ReaderMock.Read", callerSkip: []int{1}},
+		{message: "minimock: FailNow is called", callerSkip: []int{1}},
+		{message: "Unexpected call to FormatterMock.Format", callerSkip: []int{1}},
+	}, failures)
+}
+
+func TestIsMockMethod(t *testing.T) {
+	for function, expected := range map[string]bool{
+		"github.com/acme/app/mocks.(*FormatterMock).Format":                true,
+		"github.com/acme/app/mocks.(*FormatterMock).Format.func1":          true,
+		"github.com/acme/app/mocks.(*mFormatterMockFormat).recoverInspect": true,
+		"github.com/acme/app/mocks.(*FormatterMockFormatExpectation).Then": true,
+		"github.com/acme/app/mocks.(*GenericMock[...]).Get":                true,
+		"github.com/acme/app.v2/mocks.(*repoMock).Get":                     true,
+		"github.com/acme/app/mocks.TestFormatter":                          false,
+		"github.com/acme/app/mocks.TestFormatter.func1":                    false,
+		"github.com/acme/app/mocks.(*Service).Mock":                        false,
+		"github.com/acme/mocks.(*Service).Run":                             false,
+		"main.main":                                                        false,
+	} {
+		assert.Equal(t, expected, isMockMethod(function), function)
+	}
+}
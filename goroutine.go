@@ -0,0 +1,42 @@
+package minimock
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+)
+
+// GoroutineID returns the id of the current goroutine parsed from the header
+// of its stack trace, i.e. "goroutine 18 [running]:", or 0 if it can't be parsed
+func GoroutineID() uint64 {
+	var buf [64]byte
+	header := buf[:runtime.Stack(buf[:], false)]
+
+	header = bytes.TrimPrefix(header, []byte("goroutine "))
+	if i := bytes.IndexByte(header, ' '); i > 0 {
+		header = header[:i]
+	}
+
+	id, err := strconv.ParseUint(string(header), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return id
+}
+
+// UnexpectedCall reports the unexpected call of the mock created by the goroutine with the given id.
+// The call made by the same goroutine fails the test with t.Fatalf. Since t.FailNow only stops
+// the goroutine calling it, the call made by any other goroutine is reported by t.Errorf along with
+// its stack trace instead, so the caller gets zero values and the test fails when the mock is finished
+func UnexpectedCall(t Tester, goroutine uint64, format string, args ...interface{}) {
+	id := GoroutineID()
+	if id == goroutine || id == 0 || goroutine == 0 {
+		t.Fatalf(format, args...)
+		return
+	}
+
+	t.Errorf("%s\nthe call is made by goroutine #%d that didn't create the mock:\n%s", fmt.Sprintf(format, args...), id, debug.Stack())
+}
@@ -0,0 +1,62 @@
+package minimock
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type unexpectedCallTester struct {
+	Tester
+	fatal  string
+	errors []string
+}
+
+func (t *unexpectedCallTester) Fatalf(format string, args ...interface{}) {
+	t.fatal = fmt.Sprintf(format, args...)
+}
+
+func (t *unexpectedCallTester) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func TestGoroutineID(t *testing.T) {
+	id := GoroutineID()
+	assert.NotZero(t, id)
+	assert.Equal(t, id, GoroutineID())
+
+	other := make(chan uint64)
+	go func() { other <- GoroutineID() }()
+
+	otherID := <-other
+	assert.NotZero(t, otherID)
+	assert.NotEqual(t, id, otherID)
+}
+
+func TestUnexpectedCall_SameGoroutine(t *testing.T) {
+	tester := &unexpectedCallTester{}
+
+	UnexpectedCall(tester, GoroutineID(), "Unexpected call to %s", "FormatterMock.Format")
+
+	assert.Equal(t, "Unexpected call to FormatterMock.Format", tester.fatal)
+	assert.Empty(t, tester.errors)
+}
+
+func TestUnexpectedCall_OtherGoroutine(t *testing.T) {
+	tester := &unexpectedCallTester{}
+	id := GoroutineID()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		UnexpectedCall(tester, id, "Unexpected call to %s", "FormatterMock.Format")
+	}()
+	<-done
+
+	assert.Empty(t, tester.fatal)
+	if assert.Len(t, tester.errors, 1) {
+		assert.Contains(t, tester.errors[0], "Unexpected call to FormatterMock.Format\nthe call is made by goroutine #")
+		assert.Contains(t, tester.errors[0], "TestUnexpectedCall_OtherGoroutine")
+	}
+}
@@ -0,0 +1,58 @@
+package minimock
+
+import (
+	"sync"
+	"time"
+)
+
+// CallHistory keeps the times and the numbers in the sequence of the calls of a mocked method,
+// the generated code keeps the typed params of the calls along with them under the lock of the history
+type CallHistory struct {
+	sync.Mutex
+
+	times    []time.Time
+	sequence []uint64
+}
+
+// Add records the call made at the given time with the number n in the sequence, zero n means the call
+// isn't numbered since the mock isn't attached to any sequence. The history has to be locked by the caller.
+// The number is taken before the history is locked, so the numbers of the concurrent calls are kept sorted
+func (h *CallHistory) Add(now time.Time, n uint64) {
+	h.times = append(h.times, now)
+	if n == 0 {
+		return
+	}
+
+	i := len(h.sequence)
+	h.sequence = append(h.sequence, n)
+	for ; i > 0 && h.sequence[i-1] > n; i-- {
+		h.sequence[i] = h.sequence[i-1]
+	}
+	h.sequence[i] = n
+}
+
+// Reset removes all recorded calls, the history has to be locked by the caller
+func (h *CallHistory) Reset() {
+	h.times = nil
+	h.sequence = nil
+}
+
+// Times returns the times of the calls in the order they were made
+func (h *CallHistory) Times() []time.Time {
+	h.Lock()
+	defer h.Unlock()
+
+	times := make([]time.Time, len(h.times))
+	copy(times, h.times)
+	return times
+}
+
+// Sequence returns the numbers of the calls in the sequence in the order they were made
+func (h *CallHistory) Sequence() []uint64 {
+	h.Lock()
+	defer h.Unlock()
+
+	sequence := make([]uint64, len(h.sequence))
+	copy(sequence, h.sequence)
+	return sequence
+}
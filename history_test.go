@@ -0,0 +1,42 @@
+package minimock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallHistory(t *testing.T) {
+	now := time.Now()
+	h := &CallHistory{}
+
+	h.Lock()
+	h.Add(now, 2)
+	h.Add(now.Add(time.Second), 5)
+	h.Unlock()
+
+	times := h.Times()
+	assert.Equal(t, []time.Time{now, now.Add(time.Second)}, times)
+	assert.Equal(t, []uint64{2, 5}, h.Sequence())
+
+	times[0] = time.Time{}
+	assert.Equal(t, now, h.Times()[0], "history is changed by the caller")
+
+	h.Lock()
+	h.Add(now, 0)
+	h.Unlock()
+	assert.Len(t, h.Times(), 3)
+	assert.Equal(t, []uint64{2, 5}, h.Sequence(), "call that isn't numbered is added to the sequence")
+
+	h.Lock()
+	h.Add(now, 4)
+	h.Unlock()
+	assert.Equal(t, []uint64{2, 4, 5}, h.Sequence(), "numbers of the concurrent calls aren't sorted")
+
+	h.Lock()
+	h.Reset()
+	h.Unlock()
+	assert.Empty(t, h.Times())
+	assert.Empty(t, h.Sequence())
+}
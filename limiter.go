@@ -0,0 +1,50 @@
+package minimock
+
+import "sync/atomic"
+
+// Limiter counts the calls of a mocked method in flight and checks them against the limit
+// set by the LimitConcurrency helper of the mocks. The zero value has no limit
+type Limiter struct {
+	inFlight int64
+	max      int64
+	limit    int64
+}
+
+// SetLimit sets the maximum number of the calls in flight, zero means no limit
+func (l *Limiter) SetLimit(n int) {
+	atomic.StoreInt64(&l.limit, int64(n))
+}
+
+// Limit returns the maximum number of the calls in flight set by SetLimit
+func (l *Limiter) Limit() int64 {
+	return atomic.LoadInt64(&l.limit)
+}
+
+// Enter counts the call in flight and returns the number of the calls in flight including this one,
+// ok is false if the limit is exceeded
+func (l *Limiter) Enter() (inFlight int64, ok bool) {
+	n := atomic.AddInt64(&l.inFlight, 1)
+	for max := atomic.LoadInt64(&l.max); n > max; max = atomic.LoadInt64(&l.max) {
+		if atomic.CompareAndSwapInt64(&l.max, max, n) {
+			break
+		}
+	}
+
+	limit := atomic.LoadInt64(&l.limit)
+	return n, limit <= 0 || n <= limit
+}
+
+// Leave removes the finished call from the calls in flight
+func (l *Limiter) Leave() {
+	atomic.AddInt64(&l.inFlight, -1)
+}
+
+// Max returns the maximum number of the calls that have been in flight at once since the last Reset
+func (l *Limiter) Max() int {
+	return int(atomic.LoadInt64(&l.max))
+}
+
+// Reset resets the maximum number of the calls in flight, the limit is kept
+func (l *Limiter) Reset() {
+	atomic.StoreInt64(&l.max, 0)
+}
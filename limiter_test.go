@@ -0,0 +1,30 @@
+package minimock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimiter(t *testing.T) {
+	var l Limiter
+
+	inFlight, ok := l.Enter()
+	assert.Equal(t, int64(1), inFlight)
+	assert.True(t, ok, "limit is exceeded without the limit")
+
+	l.SetLimit(1)
+	inFlight, ok = l.Enter()
+	assert.Equal(t, int64(2), inFlight)
+	assert.False(t, ok)
+	assert.Equal(t, int64(1), l.Limit())
+
+	l.Leave()
+	l.Leave()
+	assert.Equal(t, 2, l.Max())
+
+	l.Reset()
+	assert.Equal(t, 0, l.Max())
+	_, ok = l.Enter()
+	assert.True(t, ok)
+}
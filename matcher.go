@@ -0,0 +1,132 @@
+package minimock
+
+import (
+	"context"
+	"reflect"
+	"time"
+)
+
+// Matcher is implemented by the expected values that match the actual
+// parameters of the call in a custom way instead of being compared by Equal
+type Matcher interface {
+	// Matches returns true if the actual value matches the expectation
+	Matches(v interface{}) bool
+	// String returns the description of the matcher printed instead of the expected value
+	String() string
+}
+
+// Anything matches any value including nil, it can be passed as an expected value of any parameter of an interface type
+var Anything Matcher = anything{}
+
+type anything struct{}
+
+func (anything) Matches(interface{}) bool { return true }
+func (anything) String() string           { return "minimock.Anything" }
+func (anything) GoString() string         { return "minimock.Anything" }
+
+// AnyContext matches any non-nil context, it can be passed as an expected value of the context.Context parameter
+var AnyContext context.Context = anyContext{}
+
+type anyContext struct{}
+
+func (anyContext) Deadline() (deadline time.Time, ok bool) { return }
+func (anyContext) Done() <-chan struct{}                   { return nil }
+func (anyContext) Err() error                              { return nil }
+func (anyContext) Value(interface{}) interface{}           { return nil }
+
+func (anyContext) Matches(v interface{}) bool {
+	_, ok := v.(context.Context)
+	return ok
+}
+
+func (anyContext) String() string   { return "minimock.AnyContext" }
+func (anyContext) GoString() string { return "minimock.AnyContext" }
+
+// Predicate returns a matcher that matches the values for which the function f returns true,
+// the description is printed instead of the expected value when the value doesn't match
+func Predicate(description string, f func(v interface{}) bool) Matcher {
+	return predicate{description: description, f: f}
+}
+
+type predicate struct {
+	description string
+	f           func(v interface{}) bool
+}
+
+func (p predicate) Matches(v interface{}) bool { return p.f(v) }
+func (p predicate) String() string             { return p.description }
+func (p predicate) GoString() string           { return p.description }
+
+// Comparer returns true if the actual value of the param equals the expected one,
+// it replaces Equal for the params that aren't matched by the matchers
+type Comparer func(want, got interface{}) bool
+
+// Match returns true if the actual params match the expected ones. The fields of the want
+// struct holding a Matcher and the fields having a matcher in the matchers map
+// are matched by the matcher, the rest of the fields are compared by the compare
+// function or by Equal if it's nil
+func Match(want, got interface{}, matchers map[string]Matcher, compare Comparer) bool {
+	wv, gv := reflect.ValueOf(want), reflect.ValueOf(got)
+	if !isStructPair(wv, gv) {
+		return equal(want, got, compare)
+	}
+
+	for i := 0; i < wv.NumField(); i++ {
+		if !fieldMatches(wv, gv, i, matchers, compare) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isStructPair(wv, gv reflect.Value) bool {
+	return wv.IsValid() && gv.IsValid() && wv.Type() == gv.Type() && wv.Kind() == reflect.Struct
+}
+
+func fieldMatches(wv, gv reflect.Value, i int, matchers map[string]Matcher, compare Comparer) bool {
+	field := wv.Type().Field(i)
+	if field.PkgPath != "" { //values of the unexported fields can't be taken
+		return true
+	}
+
+	if m, ok := matchers[field.Name]; ok {
+		return m.Matches(gv.Field(i).Interface())
+	}
+
+	return valueMatches(wv.Field(i), gv.Field(i), compare)
+}
+
+// valueMatches matches the actual value by the expected one if it's a Matcher,
+// the elements of the slices of interfaces (i.e. variadic params) are matched one by one
+func valueMatches(want, got reflect.Value, compare Comparer) bool {
+	if want.Kind() == reflect.Interface && !want.IsNil() {
+		if m, ok := want.Interface().(Matcher); ok {
+			return m.Matches(got.Interface())
+		}
+	}
+
+	if want.Kind() == reflect.Slice && want.Type().Elem().Kind() == reflect.Interface && !want.IsNil() && !got.IsNil() {
+		if want.Len() != got.Len() {
+			return false
+		}
+
+		for i := 0; i < want.Len(); i++ {
+			if !valueMatches(want.Index(i), got.Index(i), compare) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return equal(want.Interface(), got.Interface(), compare)
+}
+
+func equal(want, got interface{}, compare Comparer) bool {
+	if compare != nil {
+		return compare(want, got)
+	}
+
+	return Equal(want, got)
+}
@@ -0,0 +1,70 @@
+package minimock
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type matchParams struct {
+	Ctx  context.Context
+	ID   int
+	Args []interface{}
+}
+
+func TestMatch(t *testing.T) {
+	want := matchParams{Ctx: AnyContext, ID: 1, Args: []interface{}{"a", Anything}}
+
+	assert.True(t, Match(want, matchParams{Ctx: context.Background(), ID: 1, Args: []interface{}{"a", 2}}, nil, nil))
+	assert.False(t, Match(want, matchParams{Ctx: nil, ID: 1, Args: []interface{}{"a", 2}}, nil, nil))
+	assert.False(t, Match(want, matchParams{Ctx: context.Background(), ID: 2, Args: []interface{}{"a", 2}}, nil, nil))
+	assert.False(t, Match(want, matchParams{Ctx: context.Background(), ID: 1, Args: []interface{}{"b", 2}}, nil, nil))
+	assert.False(t, Match(want, matchParams{Ctx: context.Background(), ID: 1, Args: []interface{}{"a"}}, nil, nil))
+}
+
+func TestMatch_Matchers(t *testing.T) {
+	positive := Predicate("positive", func(v interface{}) bool { return v.(int) > 0 })
+	want := matchParams{ID: 0}
+
+	assert.True(t, Match(want, matchParams{ID: 5}, map[string]Matcher{"ID": positive}, nil))
+	assert.False(t, Match(want, matchParams{ID: -5}, map[string]Matcher{"ID": positive}, nil))
+	assert.False(t, Match(want, matchParams{ID: 5}, nil, nil))
+}
+
+func TestMatch_NotStructs(t *testing.T) {
+	assert.True(t, Match(1, 1, nil, nil))
+	assert.False(t, Match(1, 2, nil, nil))
+}
+
+func TestFieldsDiff_Matchers(t *testing.T) {
+	positive := Predicate("positive", func(v interface{}) bool { return v.(int) > 0 })
+
+	diff := FieldsDiff(
+		matchParams{Ctx: AnyContext, Args: []interface{}{Anything, "b"}},
+		matchParams{ID: -1, Args: []interface{}{1, "c"}},
+		map[string]Matcher{"ID": positive},
+		nil,
+	)
+
+	assert.Equal(t, "\n\nMismatched params:\n  Ctx: want: minimock.AnyContext, got: nil\n  ID: want: positive, got: -1\n  Args: want: []interface {}{minimock.Anything, \"b\"}, got: []interface {}{1, \"c\"}\n", diff)
+}
+
+func TestAnything(t *testing.T) {
+	assert.True(t, Anything.Matches(nil))
+	assert.True(t, Anything.Matches(1))
+	assert.Equal(t, "minimock.Anything", fmt.Sprintf("%#v", Anything))
+}
+
+func TestMatch_Comparer(t *testing.T) {
+	positive := Predicate("positive", func(v interface{}) bool { return v.(int) > 0 })
+	never := func(want, got interface{}) bool { return false }
+
+	assert.False(t, Match(matchParams{ID: 1}, matchParams{ID: 1}, nil, never))
+	assert.True(t, Match(matchParams{ID: 1}, matchParams{ID: 2}, nil, func(want, got interface{}) bool { return true }))
+
+	//matchers win for the fields they cover
+	only := map[string]Matcher{"Ctx": Anything, "ID": positive, "Args": Anything}
+	assert.True(t, Match(matchParams{}, matchParams{ID: 1}, only, never))
+}
@@ -0,0 +1,409 @@
+package minimock
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type (
+	// MethodState is the state of a mocked method that doesn't depend on the types of its params and results: the counters
+	// of the calls, the history, the checks set up by Times and Optional and the helpers waiting for the calls and blocking them.
+	// The generated code keeps the typed expectations under Mutex and the typed params of the calls under the lock of History
+	MethodState struct {
+		mock   *MockState
+		name   string
+		method string
+
+		beforeCalls     uint64
+		afterCalls      uint64
+		unexpectedCalls uint64
+		lenientCalls    uint64
+		droppedCalls    uint64
+		//firstUnexpected are the params of the first unexpected call, it's kept under the lock of History
+		firstUnexpected interface{}
+
+		// Mutex protects the expectations of the method
+		Mutex         sync.RWMutex
+		expectedCalls *uint64
+		optional      bool
+
+		// History keeps the times and the numbers in the sequence of the calls
+		History CallHistory
+
+		notifier Notifier
+		blocker  Blocker
+		limiter  Limiter
+		queue    ResultsQueue
+	}
+
+	// Setup describes the expectations and the function set up for a mocked method, it's checked by MethodState.Done
+	// and MethodState.Inspect when the mock is finished
+	Setup struct {
+		// Default is true if the default expectation is set by Expect or Return
+		Default bool
+
+		// DefaultParams are the params of the default expectation set by Expect
+		DefaultParams interface{}
+
+		// Func is true if the function is set by Set
+		Func bool
+
+		// When are the expectations set by When
+		When []WhenSetup
+	}
+
+	// WhenSetup describes the expectation set by When
+	WhenSetup struct {
+		// Counter is the number of the calls matching the expectation
+		Counter *uint64
+
+		// Params are the params the expectation is set for
+		Params interface{}
+	}
+)
+
+// Init attaches the method with the given name to the mock
+func (s *MethodState) Init(mock *MockState, method string) {
+	s.mock = mock
+	s.method = method
+	s.name = mock.name + "." + method
+	mock.methods = append(mock.methods, s)
+}
+
+// Name returns the name of the mocked method along with the name of the mock, i.e. "ReaderMock.Read"
+func (s *MethodState) Name() string {
+	return s.name
+}
+
+// Enter counts the call being made and returns its number, it fails the test without stopping it if the calls
+// in flight exceed the limit set by SetLimit. Leave has to be deferred right after Enter
+func (s *MethodState) Enter() uint64 {
+	n := atomic.AddUint64(&s.beforeCalls, 1)
+	if inFlight, ok := s.limiter.Enter(); !ok {
+		s.mock.t.Errorf("Expected at most %d concurrent calls to "+s.name+", but %d goroutines are calling it", s.limiter.Limit(), inFlight)
+	}
+
+	return n
+}
+
+// Leave counts the finished call and wakes up the goroutines waiting for the calls
+func (s *MethodState) Leave() {
+	s.limiter.Leave()
+	atomic.AddUint64(&s.afterCalls, 1)
+	s.notifier.Notify()
+}
+
+// Record locks History, records the call to it and returns the comparer set up for the mock, the caller records
+// the typed params of the call and unlocks History. The time and the number of the call are taken before History is locked,
+// so the clock set by SetClock can call the mock
+func (s *MethodState) Record() Comparer {
+	now, n, comparer := s.mock.call()
+	s.History.Lock()
+	s.History.Add(now, n)
+
+	return comparer
+}
+
+// Drop counts the call that can't be sent to the channel of the captured calls since its buffer is full
+func (s *MethodState) Drop() {
+	atomic.AddUint64(&s.droppedCalls, 1)
+}
+
+// Wait blocks the call until it's released if the calls are blocked by Block
+func (s *MethodState) Wait() {
+	s.blocker.Wait(&s.notifier)
+}
+
+// RecoverInspect fails the test if the function set by Inspect panics, it has to be deferred
+func (s *MethodState) RecoverInspect() {
+	if r := recover(); r != nil {
+		s.mock.t.Errorf(s.name+" inspector panicked: %v", r)
+	}
+}
+
+// CheckParams fails the test without stopping it if the params of the call don't match the expected ones
+func (s *MethodState) CheckParams(want, got interface{}, matchers map[string]Matcher, comparer Comparer) {
+	if !Match(want, got, matchers, comparer) {
+		s.mock.t.Errorf(s.name+" got unexpected parameters, want: %#v, got: %#v%s%s\n", want, got, FieldsDiff(want, got, matchers, comparer), Diff(want, got))
+	}
+}
+
+// NoResults fails the test if the call matches the expectation without the results
+func (s *MethodState) NoResults() {
+	if s.mock.goroutine == 0 {
+		s.mock.t.Fatal("No results are set for the " + s.name)
+		return
+	}
+
+	UnexpectedCall(s.mock.t, s.mock.goroutine, "No results are set for the "+s.name)
+}
+
+// PushResults counts the results queued by ReturnOnce, the generated code appends the typed results
+// to its own slice under Mutex before PushResults is called
+func (s *MethodState) PushResults() {
+	s.queue.Push()
+}
+
+// PopResults returns the index of the next queued results in the slice kept by the generated code,
+// ok is false if there are no results queued
+func (s *MethodState) PopResults() (i int, ok bool) {
+	return s.queue.Pop()
+}
+
+// QueueExhausted fails the test if the excess call is made after all results queued by ReturnOnce are returned,
+// it returns false if no results have been queued. The params are nil if the method has no params
+func (s *MethodState) QueueExhausted(call uint64, params interface{}) bool {
+	queued, report := s.queue.Exhausted()
+	if queued == 0 {
+		return false
+	}
+
+	s.unexpected(params)
+	if !report {
+		return true
+	}
+
+	if params != nil {
+		UnexpectedCall(s.mock.t, s.mock.goroutine, "Unexpected call #%d to "+s.name+", only %d results are queued by ReturnOnce, params: %#v", call, queued, params)
+	} else {
+		UnexpectedCall(s.mock.t, s.mock.goroutine, "Unexpected call #%d to "+s.name+", only %d results are queued by ReturnOnce", call, queued)
+	}
+
+	return true
+}
+
+// Queued returns true if any results have been queued by ReturnOnce since the last reset
+func (s *MethodState) Queued() bool {
+	return s.queue.Total() > 0
+}
+
+// Lenient returns true and counts the call if the mock is in the lenient mode, the call returns zero values then
+func (s *MethodState) Lenient() bool {
+	if !s.mock.isLenient() {
+		return false
+	}
+
+	atomic.AddUint64(&s.lenientCalls, 1)
+	return true
+}
+
+// UnexpectedCall fails the test since the call is made without an implementation, params are recorded
+// to be reported by Inspect and args are printed along with the name of the method
+func (s *MethodState) UnexpectedCall(params interface{}, args ...interface{}) {
+	s.unexpected(params)
+
+	format := "Unexpected call to " + s.name + "."
+	for range args {
+		format += " %v"
+	}
+
+	UnexpectedCall(s.mock.t, s.mock.goroutine, format, args...)
+}
+
+func (s *MethodState) unexpected(params interface{}) {
+	if params != nil {
+		s.History.Lock()
+		if s.firstUnexpected == nil {
+			s.firstUnexpected = params
+		}
+		s.History.Unlock()
+	}
+
+	atomic.AddUint64(&s.unexpectedCalls, 1)
+}
+
+// BeforeCalls returns the number of the calls
+func (s *MethodState) BeforeCalls() uint64 {
+	return atomic.LoadUint64(&s.beforeCalls)
+}
+
+// AfterCalls returns the number of the finished calls
+func (s *MethodState) AfterCalls() uint64 {
+	return atomic.LoadUint64(&s.afterCalls)
+}
+
+// UnexpectedCalls returns the number of the calls made without an implementation
+func (s *MethodState) UnexpectedCalls() uint64 {
+	return atomic.LoadUint64(&s.unexpectedCalls)
+}
+
+// DroppedCalls returns the number of the calls that haven't been sent to the channel of the captured calls
+func (s *MethodState) DroppedCalls() uint64 {
+	return atomic.LoadUint64(&s.droppedCalls)
+}
+
+// CallTimes returns the times of the calls in the order they were made
+func (s *MethodState) CallTimes() []time.Time {
+	return s.History.Times()
+}
+
+// CallSequence returns the numbers of the calls in the sequence
+func (s *MethodState) CallSequence() []uint64 {
+	return s.History.Sequence()
+}
+
+// MaxInFlight returns the maximum number of the calls that have been in flight at once
+func (s *MethodState) MaxInFlight() int {
+	return s.limiter.Max()
+}
+
+// SetLimit sets the maximum number of the calls in flight
+func (s *MethodState) SetLimit(n int) {
+	s.limiter.SetLimit(n)
+}
+
+// SetTimes sets the exact number of the calls expected when the mock is finished
+func (s *MethodState) SetTimes(n uint64) {
+	s.Mutex.Lock()
+	s.expectedCalls = &n
+	s.Mutex.Unlock()
+}
+
+// SetOptional excludes the method from the checks made when the mock is finished
+func (s *MethodState) SetOptional() {
+	s.Mutex.Lock()
+	s.optional = true
+	s.Mutex.Unlock()
+}
+
+// WaitForCalls waits until the method is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (s *MethodState) WaitForCalls(n uint64, timeout time.Duration) {
+	if got := s.notifier.WaitFor(&s.afterCalls, n, timeout); got < n {
+		s.mock.t.Fatalf("Expected %d calls to "+s.name+" within %v, but got %d", n, timeout, got)
+	}
+}
+
+// Block makes the subsequent calls wait until the returned function is called
+func (s *MethodState) Block() (release func()) {
+	return s.blocker.Block()
+}
+
+// WaitUntilBlocked waits until at least n calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (s *MethodState) WaitUntilBlocked(n uint64, timeout time.Duration) {
+	if got := s.blocker.WaitUntilBlocked(&s.notifier, n, timeout); got < n {
+		s.mock.t.Fatalf("Expected %d blocked calls to "+s.name+" within %v, but got %d", n, timeout, got)
+	}
+}
+
+// Done returns true if the method has been called the expected number of times
+func (s *MethodState) Done(setup Setup) bool {
+	if atomic.LoadUint64(&s.unexpectedCalls) > 0 {
+		return false
+	}
+
+	want, optional := s.checks()
+	if optional {
+		return true
+	}
+
+	for _, e := range setup.When {
+		if atomic.LoadUint64(e.Counter) < 1 {
+			return false
+		}
+	}
+
+	//if the number of calls was set by Times then it's checked instead of the default expectation and func
+	if want != nil {
+		if s.dispatched() != *want {
+			return false
+		}
+	} else if (setup.Default || setup.Func) && atomic.LoadUint64(&s.afterCalls) < 1 {
+		return false
+	}
+
+	//all results queued by ReturnOnce should be returned
+	return s.queue.Len() == 0
+}
+
+// Inspect logs each unmet expectation
+func (s *MethodState) Inspect(setup Setup) {
+	t := s.mock.t
+
+	if unexpected := atomic.LoadUint64(&s.unexpectedCalls); unexpected > 0 {
+		s.History.Lock()
+		first := s.firstUnexpected
+		s.History.Unlock()
+
+		if first != nil {
+			t.Errorf(s.name+" was called %d times without an implementation, first call params: %#v", unexpected, first)
+		} else {
+			t.Errorf(s.name+" was called %d times without an implementation", unexpected)
+		}
+	}
+
+	want, optional := s.checks()
+	if optional {
+		t.Errorf("Expectations of "+s.name+" are optional and aren't checked, it's called %d times", atomic.LoadUint64(&s.afterCalls))
+		return
+	}
+
+	for _, e := range setup.When {
+		if atomic.LoadUint64(e.Counter) < 1 {
+			s.expectedCall(e.Params)
+		}
+	}
+
+	if want != nil {
+		if got := s.dispatched(); got != *want {
+			t.Errorf("Expected %d calls to "+s.name+", but got %d", *want, got)
+		}
+	} else if atomic.LoadUint64(&s.afterCalls) < 1 {
+		//params are not set when the results are set by Return without Expect
+		if setup.Default {
+			s.expectedCall(setup.DefaultParams)
+		}
+		if setup.Func {
+			s.expectedCall(nil)
+		}
+	}
+
+	if queued := s.queue.Len(); queued > 0 {
+		t.Errorf("Expected %d more calls to "+s.name+" to return the results queued by ReturnOnce", queued)
+	}
+}
+
+func (s *MethodState) expectedCall(params interface{}) {
+	if params != nil {
+		s.mock.t.Errorf("Expected call to "+s.name+" with params: %#v", params)
+		return
+	}
+
+	s.mock.t.Error("Expected call to " + s.name)
+}
+
+// checks returns the number of the calls set by Times and whether the method is optional
+func (s *MethodState) checks() (*uint64, bool) {
+	s.Mutex.RLock()
+	defer s.Mutex.RUnlock()
+
+	return s.expectedCalls, s.optional
+}
+
+// dispatched returns the number of the finished calls made with an implementation
+func (s *MethodState) dispatched() uint64 {
+	return atomic.LoadUint64(&s.afterCalls) - atomic.LoadUint64(&s.unexpectedCalls)
+}
+
+// reset removes the checks, the queued results and the history of the calls and resets the counters
+func (s *MethodState) reset() {
+	s.Mutex.Lock()
+	s.expectedCalls = nil
+	s.optional = false
+	s.Mutex.Unlock()
+
+	s.queue.Reset()
+
+	s.History.Lock()
+	s.History.Reset()
+	s.firstUnexpected = nil
+	s.History.Unlock()
+
+	s.limiter.Reset()
+	atomic.StoreUint64(&s.unexpectedCalls, 0)
+	atomic.StoreUint64(&s.lenientCalls, 0)
+	atomic.StoreUint64(&s.beforeCalls, 0)
+	atomic.StoreUint64(&s.afterCalls, 0)
+}
@@ -0,0 +1,111 @@
+package minimock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeParams struct {
+	A int
+}
+
+func TestMethodState_Calls(t *testing.T) {
+	tester := &stateTester{}
+	s, methods, _ := newState(tester, "Get")
+	s.SetClock(func() time.Time { return time.Unix(1, 0) })
+
+	m := methods[0]
+	assert.Equal(t, "FakeMock.Get", m.Name())
+
+	m.SetLimit(1)
+	assert.Equal(t, uint64(1), m.Enter())
+	assert.Equal(t, uint64(2), m.Enter())
+	assert.Equal(t, []string{"Expected at most 1 concurrent calls to FakeMock.Get, but 2 goroutines are calling it"}, tester.errors)
+
+	m.Record()
+	m.History.Unlock()
+	m.Leave()
+	m.Leave()
+
+	assert.Equal(t, uint64(2), m.AfterCalls())
+	assert.Equal(t, 2, m.MaxInFlight())
+	assert.Equal(t, []time.Time{time.Unix(1, 0)}, m.CallTimes())
+	m.WaitForCalls(2, 0)
+	assert.Empty(t, tester.fatal)
+
+	m.WaitForCalls(3, 0)
+	assert.Equal(t, "Expected 3 calls to FakeMock.Get within 0s, but got 2", tester.fatal)
+}
+
+func TestMethodState_UnexpectedCall(t *testing.T) {
+	tester := &stateTester{}
+	_, methods, _ := newState(tester, "Get")
+
+	m := methods[0]
+	m.UnexpectedCall(fakeParams{A: 1}, 1)
+	assert.Equal(t, "Unexpected call to FakeMock.Get. 1", tester.fatal)
+
+	assert.False(t, m.Done(Setup{}))
+	m.Inspect(Setup{})
+	assert.Equal(t, []string{"FakeMock.Get was called 1 times without an implementation, first call params: minimock.fakeParams{A:1}"}, tester.errors)
+}
+
+func TestMethodState_QueueExhausted(t *testing.T) {
+	tester := &stateTester{}
+	_, methods, _ := newState(tester, "Get")
+
+	m := methods[0]
+	assert.False(t, m.QueueExhausted(1, nil))
+	assert.False(t, m.Queued())
+
+	m.PushResults()
+	i, ok := m.PopResults()
+	assert.Equal(t, 0, i)
+	assert.True(t, ok)
+	assert.True(t, m.Queued())
+
+	assert.True(t, m.QueueExhausted(2, fakeParams{A: 2}))
+	assert.Equal(t, "Unexpected call #2 to FakeMock.Get, only 1 results are queued by ReturnOnce, params: minimock.fakeParams{A:2}", tester.fatal)
+}
+
+func TestMethodState_Done(t *testing.T) {
+	tester := &stateTester{}
+	_, methods, _ := newState(tester, "Get")
+
+	m := methods[0]
+	var counter uint64
+	setup := Setup{Default: true, DefaultParams: fakeParams{A: 1}, When: []WhenSetup{{Counter: &counter, Params: fakeParams{A: 2}}}}
+	assert.False(t, m.Done(setup))
+
+	m.Inspect(setup)
+	assert.Equal(t, []string{
+		"Expected call to FakeMock.Get with params: minimock.fakeParams{A:2}",
+		"Expected call to FakeMock.Get with params: minimock.fakeParams{A:1}",
+	}, tester.errors)
+
+	counter = 1
+	m.Enter()
+	m.Leave()
+	assert.True(t, m.Done(setup))
+
+	m.SetTimes(2)
+	assert.False(t, m.Done(setup))
+
+	m.SetOptional()
+	assert.True(t, m.Done(setup))
+}
+
+func TestMethodState_CheckParams(t *testing.T) {
+	tester := &stateTester{}
+	_, methods, _ := newState(tester, "Get")
+
+	methods[0].CheckParams(fakeParams{A: 1}, fakeParams{A: 1}, nil, nil)
+	assert.Empty(t, tester.errors)
+
+	methods[0].CheckParams(fakeParams{A: 1}, fakeParams{A: 2}, nil, nil)
+	if assert.Len(t, tester.errors, 1) {
+		assert.Contains(t, tester.errors[0], "FakeMock.Get got unexpected parameters, want: minimock.fakeParams{A:1}, got: minimock.fakeParams{A:2}")
+	}
+}
@@ -2,6 +2,7 @@ package minimock
 
 import (
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -35,11 +36,19 @@ type Controller struct {
 	Tester
 	sync.Mutex
 
-	mockers []Mocker
+	mockers  []Mocker
+	orders   []Calls
+	sequence Sequence
+	finished bool
+
+	//failures of the mockers reported during Finish are aggregated so all mockers are checked
+	finishing int32
+	failed    int32
 }
 
-// Check if Controller supports MockController interface
+// Check if Controller supports MockController and Sequencer interfaces
 var _ MockController = &Controller{}
+var _ Sequencer = &Controller{}
 
 //NewController returns an instance of Controller
 func NewController(t Tester) *Controller {
@@ -53,20 +62,93 @@ func (c *Controller) RegisterMocker(m Mocker) {
 	c.Unlock()
 }
 
+//Sequence returns the sequence of the calls shared by all mocks created with the controller
+func (c *Controller) Sequence() *Sequence {
+	return &c.sequence
+}
+
+//ExpectOrder sets up the order of the calls checked by Finish, the calls of the methods are numbered
+//by the sequence shared by the mocks created with the controller:
+//mockController.ExpectOrder(txMock.BeginMock, minimock.Unordered(connMock.ExecMock, cacheMock.DeleteMock), txMock.CommitMock)
+func (c *Controller) ExpectOrder(calls ...Calls) {
+	c.Lock()
+	c.orders = append(c.orders, Ordered(calls...))
+	c.Unlock()
+}
+
 //Finish calls to MinimockFinish method for all registered mockers
+//and checks the order of the calls set up by ExpectOrder. All mockers are checked
+//before the test is stopped, the subsequent calls to Finish do nothing
 func (c *Controller) Finish() {
+	if !c.finishMockers() {
+		return
+	}
+
+	if !c.checkOrders() || atomic.LoadInt32(&c.failed) == 1 {
+		c.Tester.FailNow()
+	}
+}
+
+//finishMockers calls MinimockFinish of all registered mockers and returns false if the controller is already finished,
+//the lock is released even if the tester panics, i.e. the one returned by PanicTester
+func (c *Controller) finishMockers() bool {
 	c.Lock()
+	defer c.Unlock()
+
+	if c.finished {
+		return false
+	}
+	c.finished = true
+
+	atomic.StoreInt32(&c.finishing, 1)
+	defer atomic.StoreInt32(&c.finishing, 0)
+
 	for _, m := range c.mockers {
 		m.MinimockFinish()
 	}
+
+	return true
+}
+
+// checkOrders reports the violations of the orders set up by ExpectOrder and returns false if there are any
+func (c *Controller) checkOrders() bool {
+	c.Lock()
+	orders := c.orders
 	c.Unlock()
+
+	ok := true
+	for _, o := range orders {
+		if err := CheckOrder(o); err != nil {
+			c.Error(err.Error())
+			ok = false
+		}
+	}
+
+	return ok
+}
+
+//FailNow implements Tester, the failures reported by the mockers during Finish
+//don't stop the test until the rest of the mockers are checked
+func (c *Controller) FailNow() {
+	if atomic.LoadInt32(&c.finishing) == 1 {
+		atomic.StoreInt32(&c.failed, 1)
+		return
+	}
+
+	c.Tester.FailNow()
 }
 
 //Wait calls to MinimockWait method for all registered mockers
+//and checks the order of the calls set up by ExpectOrder after all of them are called
 func (c *Controller) Wait(d time.Duration) {
+	c.Lock()
+	mockers := make([]Mocker, len(c.mockers))
+	copy(mockers, c.mockers)
+	c.Unlock()
+
 	wg := sync.WaitGroup{}
-	wg.Add(len(c.mockers))
-	for _, m := range c.mockers {
+	wg.Add(len(mockers))
+	for _, m := range mockers {
 		go func(m Mocker) {
 			defer wg.Done()
 			m.MinimockWait(d)
@@ -74,4 +156,8 @@ func (c *Controller) Wait(d time.Duration) {
 	}
 
 	wg.Wait()
+
+	if !c.checkOrders() {
+		c.FailNow()
+	}
 }
@@ -8,6 +8,9 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+//the mocks can be created in the tests, benchmarks and fuzz targets
+var _ Tester = testing.TB(nil)
+
 func TestNewController(t *testing.T) {
 	c := NewController(t)
 	assert.Equal(t, &safeTester{Tester: t}, c.Tester)
@@ -42,6 +45,38 @@ func TestController_Finish(t *testing.T) {
 	assert.Equal(t, int32(2), atomic.LoadInt32(&dm.finishCounter))
 }
 
+func TestController_FinishIdempotent(t *testing.T) {
+	dm := &dummyMocker{}
+	c := &Controller{
+		mockers: []Mocker{dm},
+	}
+
+	c.Finish()
+	c.Finish()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&dm.finishCounter))
+}
+
+func TestController_FinishAggregatesFailures(t *testing.T) {
+	tester := &unsafeTester{}
+	c := &Controller{Tester: tester}
+
+	dm := &dummyMocker{}
+	c.mockers = []Mocker{&failingMocker{tester: c}, dm, &failingMocker{tester: c}}
+
+	c.Finish()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&dm.finishCounter), "mocker registered after the failed one is not checked")
+	assert.True(t, tester.finished)
+}
+
+type failingMocker struct {
+	Mocker
+	tester Tester
+}
+
+func (fm *failingMocker) MinimockFinish() {
+	fm.tester.FailNow()
+}
+
 func TestController_Wait(t *testing.T) {
 	dm := &dummyMocker{}
 	c := &Controller{
@@ -52,6 +87,29 @@ func TestController_Wait(t *testing.T) {
 	assert.Equal(t, int32(2), atomic.LoadInt32(&dm.waitCounter))
 }
 
+func TestController_WaitOrder(t *testing.T) {
+	tester := &finishTester{}
+	c := &Controller{Tester: tester}
+
+	c.ExpectOrder(fakeCalls{name: "B", sequence: []uint64{2}}, fakeCalls{name: "A", sequence: []uint64{1}})
+	c.Wait(0)
+	assert.True(t, tester.failed)
+	assert.Len(t, tester.errors, 1)
+}
+
+func TestController_WaitWhileRegistering(t *testing.T) {
+	c := &Controller{}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.RegisterMocker(&dummyMocker{})
+	}()
+
+	c.Wait(0) //shouldn't produce data races
+	<-done
+}
+
 func TestController_WaitConcurrent(t *testing.T) {
 	um1 := &unsafeMocker{}
 	um2 := &unsafeMocker{}
@@ -0,0 +1,214 @@
+package minimock
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// MockState is the state of a generated mock shared by its methods: the tester, the settings changed by the MinimockSet*
+// helpers and the flag set when the mock is checked. The generated mocks are thin typed wrappers around MockState and
+// the MethodState of every mocked method, the zero value has to be initialized by Init
+type MockState struct {
+	t    Tester
+	name string
+	//goroutine created the mock, the unexpected calls made by the other goroutines don't stop the test if it's set
+	goroutine uint64
+	methods   []*MethodState
+
+	mutex        sync.RWMutex
+	comparer     Comparer
+	clock        func() time.Time
+	sequence     *Sequence
+	noAutoFinish bool
+	lenient      bool
+
+	finished uint32
+}
+
+// Init registers the mock with the given name in the controller, the registry and the Cleanup of the tester.
+// Goroutine is the id of the goroutine that created the mock, the unexpected calls made by the other goroutines
+// are reported by UnexpectedCall, zero goroutine makes all unexpected calls fail the test with t.Fatalf
+func (s *MockState) Init(m Mocker, t Tester, name string, goroutine uint64) {
+	s.t = t
+	s.name = name
+	s.goroutine = goroutine
+	s.sequence = SequenceOf(t)
+
+	if controller, ok := t.(MockController); ok {
+		controller.RegisterMocker(m)
+	}
+	Register(t, m)
+	if cleaner, ok := t.(interface{ Cleanup(func()) }); ok {
+		cleaner.Cleanup(func() { s.autoFinish(m) })
+	}
+}
+
+// T returns the tester the mock is created with
+func (s *MockState) T() Tester {
+	return s.t
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of all methods instead of Equal
+func (s *MockState) SetComparer(compare Comparer) {
+	s.mutex.Lock()
+	s.comparer = compare
+	s.mutex.Unlock()
+}
+
+// SetSequence sets up the sequence numbering the calls, nil sequence detaches the mock from any sequence
+func (s *MockState) SetSequence(sequence *Sequence) {
+	s.mutex.Lock()
+	s.sequence = sequence
+	s.mutex.Unlock()
+}
+
+// SetClock sets up the function returning the time of the calls recorded to the history instead of time.Now
+func (s *MockState) SetClock(clock func() time.Time) {
+	s.mutex.Lock()
+	s.clock = clock
+	s.mutex.Unlock()
+}
+
+// SetAutoFinish enables or disables the check of the mock made by the Cleanup of the tester
+func (s *MockState) SetAutoFinish(enabled bool) {
+	s.mutex.Lock()
+	s.noAutoFinish = !enabled
+	s.mutex.Unlock()
+}
+
+// SetLenient enables or disables the lenient mode, the unconfigured calls return zero values in this mode
+func (s *MockState) SetLenient(enabled bool) {
+	s.mutex.Lock()
+	s.lenient = enabled
+	s.mutex.Unlock()
+}
+
+// LenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (s *MockState) LenientCalls() map[string]uint64 {
+	calls := map[string]uint64{}
+	for _, m := range s.methods {
+		if n := atomic.LoadUint64(&m.lenientCalls); n > 0 {
+			calls[m.method] = n
+		}
+	}
+
+	return calls
+}
+
+// AssertNotCalled fails the test without stopping it if any of the methods with the given names has been called
+func (s *MockState) AssertNotCalled(methodNames ...string) {
+	for _, name := range methodNames {
+		m := s.method(name)
+		if m == nil {
+			s.t.Errorf(s.name+" has no method %s", name)
+			continue
+		}
+
+		if calls := atomic.LoadUint64(&m.beforeCalls); calls > 0 {
+			s.t.Errorf("Expected "+s.name+".%s not to be called, but it's called %d times", name, calls)
+		}
+	}
+}
+
+// Reset resets the state of the methods, the reset functions clear the typed state kept by the generated code.
+// It fails the test if any of the methods is being called
+func (s *MockState) Reset(resets ...func()) {
+	for _, m := range s.methods {
+		if atomic.LoadUint64(&m.beforeCalls) != atomic.LoadUint64(&m.afterCalls) {
+			s.t.Fatalf(s.name + ".MinimockReset is called while " + m.name + " is being called")
+		}
+	}
+
+	for i, m := range s.methods {
+		if i < len(resets) {
+			resets[i]()
+		}
+		m.reset()
+	}
+
+	atomic.StoreUint32(&s.finished, 0)
+}
+
+// Finish marks the mock as checked, releases the calls blocked by Block and fails the test if done returns false,
+// the unmet expectations are logged by the inspect functions before
+func (s *MockState) Finish(done func() bool, inspect ...func()) {
+	atomic.StoreUint32(&s.finished, 1)
+
+	logger, _ := s.t.(interface{ Logf(string, ...interface{}) })
+	for _, m := range s.methods {
+		if blocked := m.blocker.Release(); blocked > 0 && logger != nil {
+			logger.Logf("%d calls to "+m.name+" blocked by Block are released by "+s.name+".MinimockFinish", blocked)
+		}
+		if lenient := atomic.LoadUint64(&m.lenientCalls); lenient > 0 && logger != nil {
+			logger.Logf(m.name+" is called %d times in the lenient mode and returned zero values", lenient)
+		}
+	}
+
+	if !done() {
+		for _, f := range inspect {
+			f()
+		}
+		s.t.FailNow()
+	}
+}
+
+// Wait waits until done returns true and marks the mock as checked, finish is called if it doesn't happen within the timeout
+func (s *MockState) Wait(timeout time.Duration, done func() bool, finish func()) {
+	timeoutCh := time.After(timeout)
+	for {
+		if done() {
+			atomic.StoreUint32(&s.finished, 1)
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+			finish()
+			return
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func (s *MockState) autoFinish(m Mocker) {
+	s.mutex.RLock()
+	noAutoFinish := s.noAutoFinish
+	s.mutex.RUnlock()
+
+	if !noAutoFinish && atomic.LoadUint32(&s.finished) == 0 {
+		m.MinimockFinish()
+	}
+}
+
+// call returns the time and the number in the sequence of the call being recorded along with the comparer
+// set by SetComparer, the settings are read under a single read lock
+func (s *MockState) call() (now time.Time, n uint64, comparer Comparer) {
+	s.mutex.RLock()
+	clock, sequence, comparer := s.clock, s.sequence, s.comparer
+	s.mutex.RUnlock()
+
+	if clock == nil {
+		clock = time.Now
+	}
+
+	return clock(), sequence.Next(), comparer
+}
+
+func (s *MockState) isLenient() bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.lenient
+}
+
+func (s *MockState) method(name string) *MethodState {
+	for _, m := range s.methods {
+		if m.method == name {
+			return m
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package minimock
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stateTester struct {
+	cleanupTester
+	errors []string
+	fatal  string
+	logs   []string
+	failed bool
+}
+
+func (t *stateTester) Error(args ...interface{}) { t.errors = append(t.errors, fmt.Sprint(args...)) }
+func (t *stateTester) Fatal(args ...interface{}) { t.fatal = fmt.Sprint(args...) }
+func (t *stateTester) FailNow()                  { t.failed = true }
+
+func (t *stateTester) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *stateTester) Fatalf(format string, args ...interface{}) {
+	t.fatal = fmt.Sprintf(format, args...)
+}
+
+func (t *stateTester) Logf(format string, args ...interface{}) {
+	t.logs = append(t.logs, fmt.Sprintf(format, args...))
+}
+
+// cleanup calls the functions registered by Cleanup in the reverse order the same way testing.T does
+func (t *stateTester) cleanup() {
+	for i := len(t.cleanups) - 1; i >= 0; i-- {
+		t.cleanups[i]()
+	}
+}
+
+func newState(t Tester, methods ...string) (*MockState, []*MethodState, *dummyMocker) {
+	s, m := &MockState{}, &dummyMocker{}
+	s.Init(m, t, "FakeMock", 0)
+
+	var list []*MethodState
+	for _, name := range methods {
+		method := &MethodState{}
+		method.Init(s, name)
+		list = append(list, method)
+	}
+
+	return s, list, m
+}
+
+func TestMockState_AutoFinish(t *testing.T) {
+	tester := &stateTester{}
+	_, _, mocker := newState(tester)
+	tester.cleanup()
+	assert.Equal(t, int32(1), mocker.finishCounter)
+
+	tester = &stateTester{}
+	s, _, mocker := newState(tester)
+	s.SetAutoFinish(false)
+	tester.cleanup()
+	assert.Equal(t, int32(0), mocker.finishCounter)
+
+	tester = &stateTester{}
+	s, _, mocker = newState(tester)
+	s.Finish(func() bool { return true })
+	tester.cleanup()
+	assert.Equal(t, int32(0), mocker.finishCounter, "mock checked by Finish is checked by Cleanup")
+}
+
+func TestMockState_AssertNotCalled(t *testing.T) {
+	tester := &stateTester{}
+	s, methods, _ := newState(tester, "Get", "Set")
+
+	methods[0].Enter()
+	methods[0].Leave()
+	s.AssertNotCalled("Get", "Set", "Put")
+
+	assert.Equal(t, []string{
+		"Expected FakeMock.Get not to be called, but it's called 1 times",
+		"FakeMock has no method Put",
+	}, tester.errors)
+}
+
+func TestMockState_Reset(t *testing.T) {
+	tester := &stateTester{}
+	s, methods, _ := newState(tester, "Get")
+
+	methods[0].Enter()
+	s.Reset()
+	assert.Equal(t, "FakeMock.MinimockReset is called while FakeMock.Get is being called", tester.fatal)
+
+	var reset bool
+	methods[0].Leave()
+	methods[0].SetTimes(1)
+	s.Reset(func() { reset = true })
+
+	assert.True(t, reset)
+	assert.Zero(t, methods[0].BeforeCalls())
+	assert.Zero(t, methods[0].AfterCalls())
+	assert.True(t, methods[0].Done(Setup{}), "Times isn't removed by Reset")
+}
+
+func TestMockState_Finish(t *testing.T) {
+	tester := &stateTester{}
+	s, methods, _ := newState(tester, "Get")
+	s.SetLenient(true)
+
+	release := methods[0].Block()
+	defer release()
+
+	methods[0].Enter()
+	assert.True(t, methods[0].Lenient())
+	methods[0].Leave()
+	assert.Equal(t, map[string]uint64{"Get": 1}, s.LenientCalls())
+
+	var inspected bool
+	s.Finish(func() bool { return false }, func() { inspected = true })
+
+	assert.True(t, inspected)
+	assert.True(t, tester.failed)
+	assert.Equal(t, []string{"FakeMock.Get is called 1 times in the lenient mode and returned zero values"}, tester.logs)
+}
+
+func TestMockState_Wait(t *testing.T) {
+	tester := &stateTester{}
+	s, _, mocker := newState(tester)
+
+	var finished bool
+	s.Wait(time.Millisecond, func() bool { return false }, func() { finished = true })
+	assert.True(t, finished)
+
+	s.Wait(time.Second, func() bool { return true }, func() { t.Fatal("mock is finished by Wait") })
+	tester.cleanup()
+	assert.Equal(t, int32(0), mocker.finishCounter, "mock checked by Wait is checked by Cleanup")
+}
@@ -0,0 +1,59 @@
+package minimock
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Notifier wakes up the goroutines waiting for the counters of a mocked method, i.e. the number of the calls,
+// to reach the given values. The zero value is ready to use
+type Notifier struct {
+	mutex   sync.Mutex
+	notify  chan struct{}
+	waiting int32
+}
+
+// Notify wakes up the goroutines waiting in WaitFor, it has to be called after the counter is changed.
+// It doesn't take the lock if nobody waits, so the calls of the mocked method aren't serialized by it
+func (n *Notifier) Notify() {
+	//the waiters are counted before they check the counter, so the uncounted ones see its new value
+	if atomic.LoadInt32(&n.waiting) == 0 {
+		return
+	}
+
+	n.mutex.Lock()
+	if n.notify != nil {
+		close(n.notify)
+		n.notify = nil
+	}
+	n.mutex.Unlock()
+}
+
+// WaitFor waits until the counter reaches want within the timeout and returns its value,
+// the zero timeout checks the counter once
+func (n *Notifier) WaitFor(counter *uint64, want uint64, timeout time.Duration) uint64 {
+	atomic.AddInt32(&n.waiting, 1)
+	defer atomic.AddInt32(&n.waiting, -1)
+
+	deadline := time.After(timeout)
+	for {
+		n.mutex.Lock()
+		got := atomic.LoadUint64(counter)
+		if got >= want || timeout <= 0 {
+			n.mutex.Unlock()
+			return got
+		}
+		if n.notify == nil {
+			n.notify = make(chan struct{})
+		}
+		notify := n.notify
+		n.mutex.Unlock()
+
+		select {
+		case <-notify:
+		case <-deadline:
+			return atomic.LoadUint64(counter)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package minimock
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotifier_WaitFor(t *testing.T) {
+	var (
+		n       Notifier
+		counter uint64
+	)
+
+	assert.Equal(t, uint64(0), n.WaitFor(&counter, 1, 0), "zero timeout waits")
+	assert.Equal(t, uint64(0), n.WaitFor(&counter, 1, time.Millisecond))
+
+	go func() {
+		for i := 0; i < 2; i++ {
+			atomic.AddUint64(&counter, 1)
+			n.Notify()
+		}
+	}()
+
+	assert.Equal(t, uint64(2), n.WaitFor(&counter, 2, time.Second))
+}
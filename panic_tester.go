@@ -0,0 +1,50 @@
+package minimock
+
+import "fmt"
+
+// PanicTester returns a Tester for the mocks used outside of the tests, i.e. by the fake mode of a dev server.
+// The failures reported by the mocks (unexpected calls and params, unmet expectations checked by MinimockFinish)
+// panic with the message of the failure, so the caller of the mock can recover from it:
+//
+//	store := NewStoreMock(minimock.PanicTester()).GetMock.Set(fakeGet)
+func PanicTester() Tester {
+	return panicTester{}
+}
+
+// PanicError is the value the mocks created with PanicTester panic with
+type PanicError struct {
+	Message string
+}
+
+// Error implements error
+func (e PanicError) Error() string {
+	return e.Message
+}
+
+type panicTester struct{}
+
+// Error implements Tester
+func (panicTester) Error(args ...interface{}) {
+	panic(PanicError{Message: fmt.Sprint(args...)})
+}
+
+// Errorf implements Tester
+func (panicTester) Errorf(format string, args ...interface{}) {
+	panic(PanicError{Message: fmt.Sprintf(format, args...)})
+}
+
+// Fatal implements Tester
+func (panicTester) Fatal(args ...interface{}) {
+	panic(PanicError{Message: fmt.Sprint(args...)})
+}
+
+// Fatalf implements Tester
+func (panicTester) Fatalf(format string, args ...interface{}) {
+	panic(PanicError{Message: fmt.Sprintf(format, args...)})
+}
+
+// FailNow implements Tester, the failures are reported by the preceding calls of Error and Errorf,
+// so it panics only if it's called on its own
+func (panicTester) FailNow() {
+	panic(PanicError{Message: "minimock: FailNow is called"})
+}
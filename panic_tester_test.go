@@ -0,0 +1,38 @@
+package minimock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPanicTester(t *testing.T) {
+	tester := PanicTester()
+
+	assert.PanicsWithValue(t, PanicError{Message: "Expected call to FormatterMock.Format"}, func() {
+		tester.Error("Expected call to ", "FormatterMock.Format")
+	})
+	assert.PanicsWithValue(t, PanicError{Message: "Expected call to ReaderMock.Read"}, func() {
+		tester.Errorf("Expected call to %s", "ReaderMock.Read")
+	})
+	assert.PanicsWithValue(t, PanicError{Message: "No results are set for the FormatterMock.Format"}, func() {
+		tester.Fatal("No results are set for the FormatterMock.Format")
+	})
+	assert.PanicsWithValue(t, PanicError{Message: "Unexpected call to FormatterMock.Format"}, func() {
+		tester.Fatalf("Unexpected call to %s", "FormatterMock.Format")
+	})
+	assert.PanicsWithValue(t, PanicError{Message: "minimock: FailNow is called"}, tester.FailNow)
+}
+
+func TestController_FinishPanicTester(t *testing.T) {
+	c := NewController(PanicTester())
+	dm := &dummyMocker{}
+	c.RegisterMocker(&failingMocker{tester: PanicTester()})
+
+	assert.Panics(t, c.Finish)
+
+	//the lock of the controller is released by the panic
+	c.RegisterMocker(dm)
+	c.Finish()
+	assert.Equal(t, int32(0), dm.finishCounter, "finished controller checks the mockers again")
+}
@@ -0,0 +1,81 @@
+package minimock
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// ResultsQueue numbers the results of a mocked method queued by the ReturnOnce helper of the mocks,
+// the generated code keeps the typed results in its own slice at the indexes returned by Pop
+type ResultsQueue struct {
+	mutex             sync.Mutex
+	total             int32
+	next              int32
+	exhaustedReported bool
+	//pending is the length of the queue, so Pop doesn't take the lock if nothing is queued
+	pending int32
+}
+
+// Push adds the results to the end of the queue
+func (q *ResultsQueue) Push() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	atomic.AddInt32(&q.total, 1)
+	atomic.AddInt32(&q.pending, 1)
+}
+
+// Pop removes the first results from the queue and returns their index, ok is false if the queue is empty
+func (q *ResultsQueue) Pop() (i int, ok bool) {
+	if atomic.LoadInt32(&q.pending) == 0 {
+		return 0, false
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.next == q.total {
+		return 0, false
+	}
+
+	i = int(q.next)
+	q.next++
+	atomic.AddInt32(&q.pending, -1)
+	return i, true
+}
+
+// Exhausted returns the total number of the queued results if all of them are returned and reports true
+// only the first time, so the excess calls made concurrently fail the test once
+func (q *ResultsQueue) Exhausted() (total int, report bool) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.total == 0 || q.next < q.total {
+		return 0, false
+	}
+
+	report = !q.exhaustedReported
+	q.exhaustedReported = true
+	return int(q.total), report
+}
+
+// Len returns the number of the results that haven't been returned yet
+func (q *ResultsQueue) Len() int {
+	return int(atomic.LoadInt32(&q.pending))
+}
+
+// Total returns the number of the results queued since the last Reset
+func (q *ResultsQueue) Total() int {
+	return int(atomic.LoadInt32(&q.total))
+}
+
+// Reset removes all queued results
+func (q *ResultsQueue) Reset() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	atomic.StoreInt32(&q.total, 0)
+	q.next = 0
+	q.exhaustedReported = false
+	atomic.StoreInt32(&q.pending, 0)
+}
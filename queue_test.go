@@ -0,0 +1,54 @@
+package minimock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResultsQueue(t *testing.T) {
+	var q ResultsQueue
+
+	total, report := q.Exhausted()
+	assert.Equal(t, 0, total)
+	assert.False(t, report, "empty queue is exhausted")
+	_, ok := q.Pop()
+	assert.False(t, ok)
+
+	q.Push()
+	q.Push()
+	assert.Equal(t, 2, q.Len())
+	assert.Equal(t, 2, q.Total())
+
+	i, ok := q.Pop()
+	assert.True(t, ok)
+	assert.Equal(t, 0, i)
+
+	total, _ = q.Exhausted()
+	assert.Equal(t, 0, total, "queue with the results left is exhausted")
+
+	i, _ = q.Pop()
+	assert.Equal(t, 1, i)
+	assert.Equal(t, 0, q.Len())
+	assert.Equal(t, 2, q.Total())
+
+	total, report = q.Exhausted()
+	assert.Equal(t, 2, total)
+	assert.True(t, report)
+
+	_, report = q.Exhausted()
+	assert.False(t, report, "exhausted queue is reported twice")
+
+	q.Reset()
+	assert.Equal(t, 0, q.Len())
+	assert.Equal(t, 0, q.Total())
+	total, _ = q.Exhausted()
+	assert.Equal(t, 0, total)
+	_, ok = q.Pop()
+	assert.False(t, ok)
+
+	q.Push()
+	i, ok = q.Pop()
+	assert.True(t, ok)
+	assert.Equal(t, 0, i, "reset queue numbers the results from the beginning")
+}
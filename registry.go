@@ -0,0 +1,116 @@
+package minimock
+
+import (
+	"reflect"
+	"sync"
+)
+
+// cleaner is implemented by testing.T, testing.B and testing.F since Go 1.14
+type cleaner interface {
+	Cleanup(func())
+}
+
+var registry = struct {
+	sync.Mutex
+	mockers map[Tester][]Mocker
+}{mockers: map[Tester][]Mocker{}}
+
+var sequences = struct {
+	sync.Mutex
+	shared map[Tester]*Sequence
+}{shared: map[Tester]*Sequence{}}
+
+// Track enables the registry of the mocks created with the tester t, so all of them
+// can be checked by FinishAll(t) regardless of where they are created. The registry of t is
+// removed by FinishAll or by the Cleanup of t if it's supported by the tester
+func Track(t Tester) {
+	if !trackable(t) {
+		t.Fatalf("minimock.Track: %T can't be tracked since it's not comparable", t)
+		return
+	}
+
+	registry.Lock()
+	if _, ok := registry.mockers[t]; !ok {
+		registry.mockers[t] = []Mocker{}
+	}
+	registry.Unlock()
+
+	if c, ok := t.(cleaner); ok {
+		c.Cleanup(func() { untrack(t) })
+	}
+}
+
+// Register adds the mock to the registry of the tester t if it's enabled by Track,
+// it's called by the constructors of the generated mocks
+func Register(t Tester, m Mocker) {
+	if !trackable(t) {
+		return
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+
+	if mockers, ok := registry.mockers[t]; ok {
+		registry.mockers[t] = append(mockers, m)
+	}
+}
+
+// FinishAll calls MinimockFinish for all mocks created with the tester t after the call to Track(t)
+// and removes the registry of t
+func FinishAll(t Tester) {
+	for _, m := range untrack(t) {
+		m.MinimockFinish()
+	}
+}
+
+func untrack(t Tester) []Mocker {
+	if !trackable(t) {
+		return nil
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+
+	mockers := registry.mockers[t]
+	delete(registry.mockers, t)
+
+	return mockers
+}
+
+// trackable returns true if the tester can be used as a key of the registry
+func trackable(t Tester) bool {
+	return t != nil && reflect.TypeOf(t).Comparable()
+}
+
+// SequenceOf returns the sequence numbering the calls of the mocks created with the tester t,
+// it's called by the constructors of the generated mocks. The sequence of the Sequencer (i.e. Controller) is returned
+// as is, the mocks created with the same tester supporting Cleanup (i.e. *testing.T) share the sequence removed by the Cleanup,
+// so the order of their calls can be checked by InOrder. The mocks created with any other tester get their own sequences
+func SequenceOf(t Tester) *Sequence {
+	if sequencer, ok := t.(Sequencer); ok {
+		return sequencer.Sequence()
+	}
+
+	c, ok := t.(cleaner)
+	if !ok || !trackable(t) {
+		return &Sequence{}
+	}
+
+	sequences.Lock()
+	s, found := sequences.shared[t]
+	if !found {
+		s = &Sequence{}
+		sequences.shared[t] = s
+	}
+	sequences.Unlock()
+
+	if !found {
+		c.Cleanup(func() {
+			sequences.Lock()
+			delete(sequences.shared, t)
+			sequences.Unlock()
+		})
+	}
+
+	return s
+}
@@ -0,0 +1,110 @@
+package minimock
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type cleanupTester struct {
+	Tester
+	cleanups []func()
+}
+
+func (t *cleanupTester) Cleanup(f func()) { t.cleanups = append(t.cleanups, f) }
+
+func TestFinishAll(t *testing.T) {
+	first, second := &cleanupTester{}, &cleanupTester{}
+	firstMocker, secondMocker := &dummyMocker{}, &dummyMocker{}
+
+	Register(first, firstMocker) //the registry isn't enabled yet
+	Track(first)
+	Track(second)
+	Register(first, firstMocker)
+	Register(second, secondMocker)
+
+	FinishAll(first)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&firstMocker.finishCounter))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&secondMocker.finishCounter), "mock created with another tester is finished")
+
+	FinishAll(first)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&firstMocker.finishCounter), "registry isn't removed by FinishAll")
+
+	FinishAll(second)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&secondMocker.finishCounter))
+}
+
+func TestTrack_Cleanup(t *testing.T) {
+	tester := &cleanupTester{}
+	Track(tester)
+	Register(tester, &dummyMocker{})
+	assert.Len(t, tester.cleanups, 1)
+
+	tester.cleanups[0]()
+
+	registry.Lock()
+	_, ok := registry.mockers[tester]
+	registry.Unlock()
+	assert.False(t, ok, "registry isn't removed by Cleanup")
+}
+
+func TestRegister_Concurrently(t *testing.T) {
+	tester := &cleanupTester{}
+	Track(tester)
+
+	dm := &dummyMocker{}
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Register(tester, dm)
+		}()
+	}
+	wg.Wait()
+
+	FinishAll(tester)
+	assert.Equal(t, int32(10), atomic.LoadInt32(&dm.finishCounter))
+}
+
+type uncomparableTester struct {
+	Tester
+	fatals *int
+	_      []string
+}
+
+func (t uncomparableTester) Fatalf(format string, args ...interface{}) { *t.fatals++ }
+
+func TestTrack_Uncomparable(t *testing.T) {
+	tester := uncomparableTester{fatals: new(int)}
+	Track(tester)
+	assert.Equal(t, 1, *tester.fatals)
+
+	Register(tester, &dummyMocker{})
+	FinishAll(tester) //shouldn't panic
+}
+
+func TestSequenceOf(t *testing.T) {
+	tester := &cleanupTester{}
+	assert.True(t, SequenceOf(tester) == SequenceOf(tester), "sequence of the tester isn't shared")
+	assert.Len(t, tester.cleanups, 1)
+
+	other := &cleanupTester{}
+	assert.True(t, SequenceOf(tester) != SequenceOf(other), "sequence is shared by different testers")
+
+	for _, f := range tester.cleanups {
+		f()
+	}
+	sequences.Lock()
+	_, ok := sequences.shared[tester]
+	sequences.Unlock()
+	assert.False(t, ok, "sequence isn't removed by the Cleanup")
+
+	c := NewController(tester)
+	assert.True(t, c.Sequence() == SequenceOf(c))
+
+	//the testers that don't support Cleanup get their own sequences
+	assert.True(t, SequenceOf(&finishTester{}) != SequenceOf(&finishTester{}))
+}
@@ -0,0 +1,184 @@
+package minimock
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+)
+
+// Sequence numbers the calls of the mocks sharing it, so the order of
+// the calls made to the different mocks can be checked. All mocks created
+// with the same Controller share its sequence
+type Sequence struct {
+	last uint64
+}
+
+// Next returns the number of the next call in the sequence, nil sequence doesn't number the calls and returns 0
+func (s *Sequence) Next() uint64 {
+	if s == nil {
+		return 0
+	}
+
+	return atomic.AddUint64(&s.last, 1)
+}
+
+// Sequencer is implemented by the testers providing the sequence shared by the mocks created with them
+type Sequencer interface {
+	Sequence() *Sequence
+}
+
+// Calls is implemented by the method helpers of the mocks, i.e. formatterMock.FormatMock
+type Calls interface {
+	// MethodName returns the name of the mocked method, i.e. FormatterMock.Format
+	MethodName() string
+	// CallSequence returns the numbers of the method calls in the sequence in the order they were made
+	CallSequence() []uint64
+}
+
+// InOrder checks that all calls of each of the methods are made after all calls of the previous one,
+// the first violation of the order is reported with t.Error. It returns true if the order is respected
+func InOrder(t Tester, calls ...Calls) bool {
+	if err := CheckOrder(Ordered(calls...)); err != nil {
+		t.Error(err.Error())
+		return false
+	}
+
+	return true
+}
+
+// Ordered returns the calls of the methods or groups that have to be made in the given order,
+// i.e. all calls of each of them have to be made after all calls of the previous one
+func Ordered(calls ...Calls) Calls {
+	return ordered(calls)
+}
+
+// Unordered returns the calls of the methods or groups that can be made in any order,
+// the groups can be nested in each other: Ordered(a, Unordered(b, c), d)
+func Unordered(calls ...Calls) Calls {
+	return unordered(calls)
+}
+
+type ordered []Calls
+
+func (o ordered) MethodName() string     { return "Ordered(" + methodNames(o) + ")" }
+func (o ordered) CallSequence() []uint64 { return mergeSequences(o) }
+
+type unordered []Calls
+
+func (u unordered) MethodName() string     { return "Unordered(" + methodNames(u) + ")" }
+func (u unordered) CallSequence() []uint64 { return mergeSequences(u) }
+
+// CheckOrder returns an error describing the first violated constraint of the ordered and unordered
+// groups and the order the calls are made in, every method of the groups is expected to be called
+func CheckOrder(calls Calls) error {
+	if err := checkCalls(calls); err != nil {
+		return fmt.Errorf("%v, the calls are made in the order: %s", err, observedOrder(calls))
+	}
+
+	return nil
+}
+
+func checkCalls(calls Calls) error {
+	switch c := calls.(type) {
+	case ordered:
+		for _, child := range c {
+			if err := checkCalls(child); err != nil {
+				return err
+			}
+		}
+		return checkOrder(c)
+	case unordered:
+		for _, child := range c {
+			if err := checkCalls(child); err != nil {
+				return err
+			}
+		}
+	default:
+		if len(c.CallSequence()) == 0 {
+			return fmt.Errorf("Expected call to %s", c.MethodName())
+		}
+	}
+
+	return nil
+}
+
+func checkOrder(calls []Calls) error {
+	var previous Calls
+	var last uint64
+
+	for _, c := range calls {
+		sequence := c.CallSequence()
+		if len(sequence) == 0 { //empty groups don't affect the order
+			continue
+		}
+
+		if previous != nil && sequence[0] < last {
+			return fmt.Errorf("Expected %s to be called after %s, but %s call #%d is made before %s call #%d",
+				c.MethodName(), previous.MethodName(), c.MethodName(), sequence[0], previous.MethodName(), last)
+		}
+
+		previous, last = c, sequence[len(sequence)-1]
+	}
+
+	return nil
+}
+
+func methodNames(calls []Calls) string {
+	names := make([]string, len(calls))
+	for i, c := range calls {
+		names[i] = c.MethodName()
+	}
+
+	return strings.Join(names, ", ")
+}
+
+func mergeSequences(calls []Calls) []uint64 {
+	var result []uint64
+	for _, c := range calls {
+		result = append(result, c.CallSequence()...)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
+
+// observedOrder returns the calls of the methods of the groups sorted by their numbers in the sequence
+func observedOrder(calls Calls) string {
+	names := map[uint64]string{}
+	var walk func(c Calls)
+	walk = func(c Calls) {
+		switch c := c.(type) {
+		case ordered:
+			for _, child := range c {
+				walk(child)
+			}
+		case unordered:
+			for _, child := range c {
+				walk(child)
+			}
+		default:
+			for _, n := range c.CallSequence() {
+				names[n] = c.MethodName()
+			}
+		}
+	}
+	walk(calls)
+
+	numbers := make([]uint64, 0, len(names))
+	for n := range names {
+		numbers = append(numbers, n)
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+
+	order := make([]string, len(numbers))
+	for i, n := range numbers {
+		order[i] = fmt.Sprintf("%s #%d", names[n], n)
+	}
+
+	if len(order) == 0 {
+		return "no calls"
+	}
+
+	return strings.Join(order, ", ")
+}
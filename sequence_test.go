@@ -0,0 +1,80 @@
+package minimock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeCalls struct {
+	name     string
+	sequence []uint64
+}
+
+func (c fakeCalls) MethodName() string     { return c.name }
+func (c fakeCalls) CallSequence() []uint64 { return c.sequence }
+
+func TestSequence_Next(t *testing.T) {
+	s := &Sequence{}
+	assert.Equal(t, uint64(1), s.Next())
+	assert.Equal(t, uint64(2), s.Next())
+
+	var detached *Sequence
+	assert.Equal(t, uint64(0), detached.Next())
+}
+
+func TestController_Sequence(t *testing.T) {
+	c := NewController(t)
+	assert.True(t, c.Sequence() == c.Sequence())
+}
+
+func TestCheckOrder(t *testing.T) {
+	begin := fakeCalls{name: "Tx.Begin", sequence: []uint64{1}}
+	exec := fakeCalls{name: "Conn.Exec", sequence: []uint64{2, 4}}
+	commit := fakeCalls{name: "Tx.Commit", sequence: []uint64{3}}
+	rollback := fakeCalls{name: "Tx.Rollback"}
+
+	assert.NoError(t, CheckOrder(Ordered(begin, exec)))
+	assert.NoError(t, CheckOrder(Ordered(begin, commit)))
+	assert.EqualError(t, CheckOrder(Ordered(begin, exec, commit)), "Expected Tx.Commit to be called after Conn.Exec, but Tx.Commit call #3 is made before Conn.Exec call #4, "+
+		"the calls are made in the order: Tx.Begin #1, Conn.Exec #2, Tx.Commit #3, Conn.Exec #4")
+	assert.EqualError(t, CheckOrder(Ordered(begin, rollback)), "Expected call to Tx.Rollback, the calls are made in the order: Tx.Begin #1")
+}
+
+func TestCheckOrder_Unordered(t *testing.T) {
+	a := fakeCalls{name: "A", sequence: []uint64{1}}
+	b := fakeCalls{name: "B", sequence: []uint64{3}}
+	c := fakeCalls{name: "C", sequence: []uint64{2}}
+	d := fakeCalls{name: "D", sequence: []uint64{4}}
+	e := fakeCalls{name: "E"}
+
+	assert.NoError(t, CheckOrder(Ordered(a, Unordered(b, c), d)))
+	assert.NoError(t, CheckOrder(Unordered(d, Ordered(a, b))))
+	assert.EqualError(t, CheckOrder(Ordered(a, Unordered(b, d), c)), "Expected C to be called after Unordered(B, D), but C call #2 is made before Unordered(B, D) call #4, "+
+		"the calls are made in the order: A #1, C #2, B #3, D #4")
+	assert.EqualError(t, CheckOrder(Ordered(a, Unordered(b, e))), "Expected call to E, the calls are made in the order: A #1, B #3")
+}
+
+type finishTester struct {
+	Tester
+	errors []string
+	failed bool
+}
+
+func (t *finishTester) Error(args ...interface{}) { t.errors = append(t.errors, args[0].(string)) }
+func (t *finishTester) FailNow()                  { t.failed = true }
+
+func TestController_ExpectOrder(t *testing.T) {
+	tester := &finishTester{}
+	c := &Controller{Tester: tester}
+
+	c.ExpectOrder(fakeCalls{name: "A", sequence: []uint64{1}}, fakeCalls{name: "B", sequence: []uint64{2}})
+	c.Finish()
+	assert.False(t, tester.failed)
+
+	c = &Controller{Tester: tester}
+	c.ExpectOrder(fakeCalls{name: "B", sequence: []uint64{2}}, fakeCalls{name: "A", sequence: []uint64{1}})
+	c.Finish()
+	assert.True(t, tester.failed)
+	assert.Equal(t, []string{"Expected A to be called after B, but A call #1 is made before B call #2, the calls are made in the order: A #1, B #2"}, tester.errors)
+}
@@ -0,0 +1,57 @@
+package minimock
+
+import "sync"
+
+// SuiteController can be embedded into the testify suite to check the mocks created during each test method
+// by its TearDownTest, the failures are reported with the T() of the test method:
+//
+//	type ServiceSuite struct {
+//		suite.Suite
+//		minimock.SuiteController
+//	}
+//
+//	func (s *ServiceSuite) SetupTest()    { s.StartMocks(s.T()) }
+//	func (s *ServiceSuite) TearDownTest() { s.FinishMocks() }
+//
+//	func (s *ServiceSuite) TestFormat() {
+//		formatterMock := NewFormatterMock(s.Mocks()).FormatMock.Return("formatted")
+//		...
+//	}
+type SuiteController struct {
+	mutex      sync.Mutex
+	controller *Controller
+}
+
+// StartMocks creates the controller of the test method, the mocks of the previous test method
+// are checked if they aren't checked by FinishMocks yet
+func (s *SuiteController) StartMocks(t Tester) {
+	s.FinishMocks()
+
+	s.mutex.Lock()
+	s.controller = NewController(t)
+	s.mutex.Unlock()
+}
+
+// Mocks returns the controller of the current test method, the mocks created with it are checked by FinishMocks
+func (s *SuiteController) Mocks() *Controller {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.controller == nil {
+		panic("minimock.SuiteController.Mocks is called before StartMocks, it has to be called by SetupTest of the suite")
+	}
+
+	return s.controller
+}
+
+// FinishMocks checks the mocks created with the controller of the current test method and removes the controller
+func (s *SuiteController) FinishMocks() {
+	s.mutex.Lock()
+	controller := s.controller
+	s.controller = nil
+	s.mutex.Unlock()
+
+	if controller != nil {
+		controller.Finish()
+	}
+}
@@ -0,0 +1,29 @@
+package minimock
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuiteController(t *testing.T) {
+	s := &SuiteController{}
+	assert.Panics(t, func() { s.Mocks() })
+
+	first := &dummyMocker{}
+	s.StartMocks(t)
+	s.Mocks().RegisterMocker(first)
+	s.FinishMocks()
+	s.FinishMocks()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&first.finishCounter))
+
+	//the mocks of the previous test method are checked if TearDownTest isn't called
+	second := &dummyMocker{}
+	s.StartMocks(t)
+	controller := s.Mocks()
+	controller.RegisterMocker(second)
+	s.StartMocks(t)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&second.finishCounter))
+	assert.True(t, controller != s.Mocks(), "controller isn't reset between the test methods")
+}
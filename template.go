@@ -3,19 +3,34 @@ package minimock
 const (
 	// HeaderTemplate is used to generate package clause and go:generate instruction
 	HeaderTemplate = `
-		package {{$.Package.Name}}
+		{{if $.Options.HeaderVars.License}}{{$.Options.HeaderVars.License}}
 
-		// DO NOT EDIT!
-		// The code below was generated with http://github.com/gojuno/minimock ({{$.Options.HeaderVars.Version}})
+		{{end}}// Code generated by http://github.com/gojuno/minimock ({{$.Options.HeaderVars.Version}}). DO NOT EDIT.
+		{{- range $line := $.Options.HeaderVars.HeaderLines}}
+		// {{$line}}
+		{{- end}}
+
+		{{if $.Options.HeaderVars.BuildConstraint}}//go:build {{$.Options.HeaderVars.BuildConstraint}}
+
+		{{end}}package {{if $.Options.HeaderVars.PackageName}}{{$.Options.HeaderVars.PackageName}}{{else}}{{packageName $.Package.Name}}{{end}}
 
 		{{if $.Options.HeaderVars.GenerateInstruction}}
-		//go:generate minimock -i {{$.SourcePackage.PkgPath}}.{{$.Options.InterfaceName}} -o {{$.Options.OutputFile}}
+		//go:generate minimock -i {{$.Options.HeaderVars.SourceInterface}} -o ./{{base $.Options.OutputFile}}{{if $.Options.HeaderVars.MockName}} -t {{$.Options.HeaderVars.MockName}}{{end}}
+		{{- if $.Options.HeaderVars.PackageName}} -p {{$.Options.HeaderVars.PackageName}}{{end}}
+		{{- if $.Options.HeaderVars.CopyConstraints}} -copy-constraints{{end}}
+		{{- with $.Options.HeaderVars.TemplateVersion}}{{if gt . 1}} -template-version {{.}}{{end}}{{end}}
+		{{- if $.Options.HeaderVars.Tags}} -tags {{arg $.Options.HeaderVars.Tags}}{{end}}
+		{{- if $.Options.HeaderVars.BuildTags}} -build-tags {{arg $.Options.HeaderVars.BuildTags}}{{end}}
+		{{- if $.Options.HeaderVars.GOOS}} -goos {{$.Options.HeaderVars.GOOS}}{{end}}{{if $.Options.HeaderVars.GOARCH}} -goarch {{$.Options.HeaderVars.GOARCH}}{{end}}
+		{{- range $line := $.Options.HeaderVars.HeaderLines}} -header-line {{arg $line}}{{end}}
+		{{- if $.Options.HeaderVars.LicenseFile}} -license-file {{arg $.Options.HeaderVars.LicenseFile}}{{end}}
 		{{end}}
 
 		import (
-			{{range $import := $.Options.Imports}}{{- if not (in $import "\"time\"" "\"sync/atomic\"" "\"github.com/gojuno/minimock\"")}}
+			{{range $import := $.Options.Imports}}{{- if not (in $import "\"time\"" "\"sync/atomic\"" "\"github.com/gojuno/minimock\"" "\"C\"")}}
 				{{$import}}{{end}}{{end}}
 			{{$.Options.SourcePackageAlias}} "{{$.SourcePackage.PkgPath}}"
+			mm_sync "sync"
 			mm_atomic "sync/atomic"
 			mm_time "time"
 			"github.com/gojuno/minimock"
@@ -24,252 +39,756 @@ const (
 
 	// BodyTemplate is used to generate mock body
 	BodyTemplate = `
-		{{ $mock := (title (printf "%sMock" $.Interface.Name)) }}
-
-		// {{$mock}} implements {{$.Interface.Type}}
-		type {{$mock}} struct {
-			t minimock.Tester
-			{{ range $method := $.Interface.Methods }}
-				func{{$method.Name}} func{{ $method.Signature }}
-				after{{$method.Name}}Counter uint64
-				before{{$method.Name}}Counter uint64
-				{{$method.Name}}Mock m{{$mock}}{{$method.Name}}
+		{{ $methods := (methods $.Interface.Methods) }}{{ checkReserved $methods }}
+		{{ $members := (members $methods) }}
+		{{ $interfaceName := (or $.Vars.InterfaceName $.Interface.Name) }}
+		{{ $interfaceType := (or $.Vars.InterfaceType $.Interface.Type) }}
+		{{ $mock := (or $.Vars.MockName (title (printf "%sMock" $interfaceName))) }}
+		{{ $typeParams := (or $.Vars.TypeParams "") }}
+		{{ $typeArgs := (or $.Vars.TypeArgs "") }}
+		{{ $newMock := (printf "New%s" $mock) }}{{ if not (exported $mock) }}{{ $newMock = (printf "new%s" (title $mock)) }}{{ end }}
+		{{ $delegate := (printf "%s%s" $.Vars.InterfaceRef $typeArgs) }}{{ if $.Vars.ImportCycle }}{{ $delegate = "interface{" }}{{ range $method := $methods }}{{ $delegate = (printf "%s %s%s;" $delegate $method.Name $method.Signature) }}{{ end }}{{ $delegate = (printf "%s }" $delegate) }}{{ end }}
+		{{ $recordUnexpected := false }}{{ with $.Vars.TemplateVersion }}{{ if ge . 2 }}{{ $recordUnexpected = true }}{{ end }}{{ end }}
+
+		// {{$mock}} implements {{$interfaceType}}
+		{{- with $.Vars.InterfaceDoc}}
+		//
+		{{.}}{{end}}
+		type {{$mock}}{{$typeParams}} struct {
+			state minimock.MockState
+			mutex mm_sync.RWMutex
+			delegate {{$delegate}}
+			{{ range $method := $methods }}{{ $names := (index $members $method.Name) }}
+				{{with (doc $method.Name)}}{{.}}
+				{{end}}func{{$method.Name}} func{{ $method.Signature }}
+				{{$names.Mock}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}
 			{{ end }}
 		}
 
-		// New{{$mock}} returns a mock for {{$.Interface.Type}}
-		func New{{$mock}}(t minimock.Tester) *{{$mock}} {
-			m := &{{$mock}}{t: t}
-			if controller, ok := t.(minimock.MockController); ok {
-				controller.RegisterMocker(m)
-			}
-			{{ range $method := $.Interface.Methods }}m.{{$method.Name}}Mock = m{{$mock}}{{$method.Name}}{mock: m}
+		{{ if $.Vars.ImportCycle }}
+			// {{$mock}} can't be checked to implement {{$interfaceType}} here
+			// since the package of the interface imports the package of the mock
+		{{ else if $typeParams }}
+			// {{$mock}} is generic so it can't be checked to implement {{$interfaceType}} here,
+			// check it with the concrete type arguments instead of the type parameters:
+			// var _ {{$interfaceType}}{{$typeArgs}} = (*{{$mock}}{{$typeArgs}})(nil)
+		{{ else }}
+			var _ {{$.Vars.InterfaceRef}} = (*{{$mock}})(nil)
+		{{ end }}
+
+		// {{$newMock}} returns a mock for {{$interfaceType}}
+		func {{$newMock}}{{$typeParams}}(t minimock.Tester) *{{$mock}}{{$typeArgs}} {
+			m := &{{$mock}}{{$typeArgs}}{}
+			m.state.Init(m, t, "{{$mock}}", {{if $recordUnexpected}}minimock.GoroutineID(){{else}}0{{end}})
+			{{ range $method := $methods }}{{ $names := (index $members $method.Name) }}
+				m.{{$names.Mock}} = &m{{$mock}}{{$method.Name}}{{$typeArgs}}{mock: m}
+				m.{{$names.Mock}}.state.Init(&m.state, "{{$method.Name}}")
 			{{ end }}
 			return m
 		}
 
-		{{ range $method := $.Interface.Methods }}
-			type m{{$mock}}{{$method.Name}} struct {
-				mock              *{{$mock}}
-				defaultExpectation   *{{$mock}}{{$method.Name}}Expectation
-				expectations []*{{$mock}}{{$method.Name}}Expectation
+		{{ range $method := $methods }}{{ $names := (index $members $method.Name) }}
+			type m{{$mock}}{{$method.Name}}{{$typeParams}} struct {
+				mock               *{{$mock}}{{$typeArgs}}
+				state              minimock.MethodState
+				defaultExpectation *{{$mock}}{{$method.Name}}Expectation{{$typeArgs}}
+				expectations       []*{{$mock}}{{$method.Name}}Expectation{{$typeArgs}}
+				inspect{{$method.Name}} func({{$method.Params}})
+				{{- if $method.HasParams }}
+				compare minimock.Comparer
+				calls []{{$mock}}{{$method.Name}}Params{{$typeArgs}}
+				{{- end}}
+				called chan {{if $method.HasParams}}{{$mock}}{{$method.Name}}Params{{$typeArgs}}{{else}}struct{}{{end}}
+				{{- if $method.HasResults }}
+				queued []*{{$mock}}{{$method.Name}}Results{{$typeArgs}}
+				{{- end}}
+				{{- if (and $method.HasParams $method.HasResults) }}
+				//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+				//so the snapshot taken by expected can be read without the lock
+				whenResults []*{{$mock}}{{$method.Name}}Results{{$typeArgs}}
+				{{- end}}
 			}
 
-			// {{$mock}}{{$method.Name}}Expectation specifies expectation struct of the {{$.Interface.Name}}.{{$method.Name}}
-			type {{$mock}}{{$method.Name}}Expectation struct {
-				mock *{{$mock}}
-				{{ if $method.HasParams }}  params *{{$mock}}{{$method.Name}}Params  {{end}}
-				{{ if $method.HasResults }} results *{{$mock}}{{$method.Name}}Results {{end}}
+			// {{$mock}}{{$method.Name}}Expectation specifies expectation struct of the {{$interfaceName}}.{{$method.Name}}
+			type {{$mock}}{{$method.Name}}Expectation{{$typeParams}} struct {
+				mock *{{$mock}}{{$typeArgs}}
+				{{ if $method.HasParams }}  params *{{$mock}}{{$method.Name}}Params{{$typeArgs}}
+				matchers map[string]minimock.Matcher
+				partial bool {{end}}
+				{{ if $method.HasResults }} results *{{$mock}}{{$method.Name}}Results{{$typeArgs}} {{end}}
 				Counter uint64
 			}
 
 			{{if $method.HasParams }}
-				// {{$mock}}{{$method.Name}}Params contains parameters of the {{$.Interface.Name}}.{{$method.Name}}
-				type {{$mock}}{{$method.Name}}Params {{$method.ParamsStruct}}
+				// {{$mock}}{{$method.Name}}Params contains parameters of the {{$interfaceName}}.{{$method.Name}}
+				type {{$mock}}{{$method.Name}}Params{{$typeParams}} {{paramsStruct $method}}
 			{{end}}
 
 			{{if $method.HasResults }}
-				// {{$mock}}{{$method.Name}}Results contains results of the {{$.Interface.Name}}.{{$method.Name}}
-				type {{$mock}}{{$method.Name}}Results {{$method.ResultsStruct}}
+				// {{$mock}}{{$method.Name}}Results contains results of the {{$interfaceName}}.{{$method.Name}}
+				type {{$mock}}{{$method.Name}}Results{{$typeParams}} {{resultsStruct $method}}
 			{{end}}
 
-			// Expect sets up expected params for {{$.Interface.Name}}.{{$method.Name}}
-			func (m *m{{$mock}}{{$method.Name}}) Expect({{$method.Params}}) *m{{$mock}}{{$method.Name}} {
-				if m.mock.func{{$method.Name}} != nil {
-					m.mock.t.Fatalf("{{$mock}}.{{$method.Name}} mock is already set by Set")
-				}
-
-				if m.defaultExpectation == nil {
-					m.defaultExpectation = &{{$mock}}{{$method.Name}}Expectation{}
+			// Expect sets up expected params for {{$interfaceName}}.{{$method.Name}}
+			func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) Expect({{$method.Params}}) *m{{$mock}}{{$method.Name}}{{$typeArgs}} {
+				if _, mm_func := mm{{$method.Name}}.current(); mm_func != nil {
+					mm{{$method.Name}}.mock.state.T().Fatalf("{{$mock}}.{{$method.Name}} mock is already set by Set")
 				}
 
 				{{if $method.HasParams }}
-					m.defaultExpectation.params = &{{$mock}}{{$method.Name}}Params{ {{ $method.ParamsNames }} }
-					for _, e := range m.expectations {
-						if minimock.Equal(e.params, m.defaultExpectation.params) {
-							m.mock.t.Fatalf("Expectation set by When has same params: %#v", *m.defaultExpectation.params)
+					mm_params := &{{$mock}}{{$method.Name}}Params{{$typeArgs}}{ {{ $method.ParamsNames }} }
+					mm{{$method.Name}}.updateDefault(func(e *{{$mock}}{{$method.Name}}Expectation{{$typeArgs}}) {
+						if e.partial {
+							mm{{$method.Name}}.mock.state.T().Fatalf("{{$mock}}.{{$method.Name}} params are already set by the Expect*Param* and Match*Param* helpers")
+						}
+
+						e.params = mm_params
+					})
+
+					for _, e := range mm{{$method.Name}}.whenExpectations() {
+						if minimock.Equal(e.params, mm_params) {
+							mm{{$method.Name}}.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
 						}
 					}
+				{{else}}
+					mm{{$method.Name}}.updateDefault(func(*{{$mock}}{{$method.Name}}Expectation{{$typeArgs}}) {})
 				{{end}}
-				return m
+				return mm{{$method.Name}}
 			}
 
-			// Return sets up results that will be returned by {{$.Interface.Name}}.{{$method.Name}}
-			func (m *m{{$mock}}{{$method.Name}}) Return({{$method.Results}}) *{{$mock}} {
-				if m.mock.func{{$method.Name}} != nil {
-					m.mock.t.Fatalf("{{$mock}}.{{$method.Name}} mock is already set by Set")
+			// updateDefault replaces the default expectation of {{$interfaceName}}.{{$method.Name}} by its copy changed by the update function,
+			// so the calls made concurrently get either the previous or the updated expectation
+			func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) updateDefault(update func(e *{{$mock}}{{$method.Name}}Expectation{{$typeArgs}})) {
+				mm{{$method.Name}}.state.Mutex.Lock()
+				defer mm{{$method.Name}}.state.Mutex.Unlock()
+
+				e := &{{$mock}}{{$method.Name}}Expectation{{$typeArgs}}{mock: mm{{$method.Name}}.mock}
+				if previous := mm{{$method.Name}}.defaultExpectation; previous != nil {
+					{{- if $method.HasParams }}
+						if previous.params != nil {
+							params := *previous.params
+							e.params = &params
+						}
+						if previous.matchers != nil {
+							e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+							for name, m := range previous.matchers {
+								e.matchers[name] = m
+							}
+						}
+						e.partial = previous.partial
+					{{- end}}
+					{{- if $method.HasResults }}
+						e.results = previous.results
+					{{- end}}
+					e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+				}
+
+				update(e)
+				mm{{$method.Name}}.defaultExpectation = e
+			}
+
+			// current returns the default expectation and the function set up for {{$interfaceName}}.{{$method.Name}}
+			func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) current() (*{{$mock}}{{$method.Name}}Expectation{{$typeArgs}}, func{{$method.Signature}}) {
+				mm{{$method.Name}}.state.Mutex.RLock()
+				defer mm{{$method.Name}}.state.Mutex.RUnlock()
+
+				return mm{{$method.Name}}.defaultExpectation, mm{{$method.Name}}.mock.func{{$method.Name}}
+			}
+
+			{{if $method.HasParams }}
+				// partialParams updates the default expectation of {{$interfaceName}}.{{$method.Name}} by the Expect*Param* and Match*Param* helpers,
+				// only the params set by the helpers are checked unless all of them are set by Expect
+				func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) partialParams(update func(e *{{$mock}}{{$method.Name}}Expectation{{$typeArgs}})) {
+					if _, mm_func := mm{{$method.Name}}.current(); mm_func != nil {
+						mm{{$method.Name}}.mock.state.T().Fatalf("{{$mock}}.{{$method.Name}} mock is already set by Set")
+					}
+
+					mm{{$method.Name}}.updateDefault(func(e *{{$mock}}{{$method.Name}}Expectation{{$typeArgs}}) {
+						if e.params == nil {
+							e.params = &{{$mock}}{{$method.Name}}Params{{$typeArgs}}{}
+							e.partial = true
+							e.matchers = map[string]minimock.Matcher{
+								{{- range $param := (paramFields $method)}}
+									"{{$param.Name}}": minimock.Anything,
+								{{- end}}
+							}
+						}
+
+						if e.matchers == nil {
+							e.matchers = map[string]minimock.Matcher{}
+						}
+
+						update(e)
+					})
+				}
+			{{end}}
+
+			{{range $param := (paramFields $method)}}
+				// Expect{{$param.Name}}Param{{$param.Index}} sets up the expected value of the param #{{$param.Index}} of {{$interfaceName}}.{{$method.Name}},
+				// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+				func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) Expect{{$param.Name}}Param{{$param.Index}}({{$param.Name}} {{$param.Type}}) *m{{$mock}}{{$method.Name}}{{$typeArgs}} {
+					mm{{$method.Name}}.partialParams(func(e *{{$mock}}{{$method.Name}}Expectation{{$typeArgs}}) {
+						e.params.{{$param.Name}} = {{$param.Name}}
+						delete(e.matchers, "{{$param.Name}}")
+					})
+					return mm{{$method.Name}}
+				}
+
+				// Match{{$param.Name}}Param{{$param.Index}} sets up the predicate matching the param #{{$param.Index}} of {{$interfaceName}}.{{$method.Name}},
+				// it's used instead of the value of the param set by Expect
+				func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) Match{{$param.Name}}Param{{$param.Index}}(f func(got {{$param.Type}}) bool) *m{{$mock}}{{$method.Name}}{{$typeArgs}} {
+					mm_matcher := minimock.Predicate({{printf "predicate func(got %s) bool" $param.Type | printf "%q"}}, func(v interface{}) bool {
+						got, _ := v.({{$param.Type}})
+						return f(got)
+					})
+					mm{{$method.Name}}.partialParams(func(e *{{$mock}}{{$method.Name}}Expectation{{$typeArgs}}) {
+						e.matchers["{{$param.Name}}"] = mm_matcher
+					})
+					return mm{{$method.Name}}
+				}
+			{{end}}
+
+			// Return sets up results that will be returned by {{$interfaceName}}.{{$method.Name}}
+			func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) Return({{$method.Results}}) *{{$mock}}{{$typeArgs}} {
+				if _, mm_func := mm{{$method.Name}}.current(); mm_func != nil {
+					mm{{$method.Name}}.mock.state.T().Fatalf("{{$mock}}.{{$method.Name}} mock is already set by Set")
+				}
+
+				{{if $method.HasResults }}
+					mm_results := &{{$mock}}{{$method.Name}}Results{{$typeArgs}}{ {{ $method.ResultsNames }} }
+					mm{{$method.Name}}.updateDefault(func(e *{{$mock}}{{$method.Name}}Expectation{{$typeArgs}}) { e.results = mm_results })
+				{{else}}
+					mm{{$method.Name}}.updateDefault(func(*{{$mock}}{{$method.Name}}Expectation{{$typeArgs}}) {})
+				{{end}}
+				return mm{{$method.Name}}.mock
+			}
+
+			{{if $method.HasResults }}
+				// ReturnOnce queues results that will be returned by the next call of {{$interfaceName}}.{{$method.Name}},
+				// queued results are returned in the same order they were queued before the results set by Return or Set
+				func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) ReturnOnce({{$method.Results}}) *m{{$mock}}{{$method.Name}}{{$typeArgs}} {
+					mm{{$method.Name}}.state.Mutex.Lock()
+					mm{{$method.Name}}.queued = append(mm{{$method.Name}}.queued, &{{$mock}}{{$method.Name}}Results{{$typeArgs}}{ {{ $method.ResultsNames }} })
+					mm{{$method.Name}}.state.Mutex.Unlock()
+					mm{{$method.Name}}.state.PushResults()
+					return mm{{$method.Name}}
+				}
+
+				// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+				func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) popResults() *{{$mock}}{{$method.Name}}Results{{$typeArgs}} {
+					mm_i, mm_ok := mm{{$method.Name}}.state.PopResults()
+					if !mm_ok {
+						return nil
+					}
+
+					mm{{$method.Name}}.state.Mutex.RLock()
+					defer mm{{$method.Name}}.state.Mutex.RUnlock()
+
+					return mm{{$method.Name}}.queued[mm_i]
 				}
+			{{end}}
+
+			{{if $method.HasParams }}
+				// SetComparer sets up the function comparing the expected and the actual params of {{$interfaceName}}.{{$method.Name}} instead of minimock.Equal,
+				// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+				func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) SetComparer(compare minimock.Comparer) *m{{$mock}}{{$method.Name}}{{$typeArgs}} {
+					mm{{$method.Name}}.state.Mutex.Lock()
+					mm{{$method.Name}}.compare = compare
+					mm{{$method.Name}}.state.Mutex.Unlock()
+					return mm{{$method.Name}}
+				}
+			{{end}}
+
+			{{if $method.HasParams }}
+				// whenExpectations returns the expectations of {{$interfaceName}}.{{$method.Name}} set by When,
+				// the expectations can be set while the method is called concurrently
+				func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) whenExpectations() []*{{$mock}}{{$method.Name}}Expectation{{$typeArgs}} {
+					mm{{$method.Name}}.state.Mutex.RLock()
+					defer mm{{$method.Name}}.state.Mutex.RUnlock()
 
-				if m.defaultExpectation == nil {
-					m.defaultExpectation = &{{$mock}}{{$method.Name}}Expectation{mock: m.mock}
+					return mm{{$method.Name}}.expectations
 				}
-				{{if $method.HasResults }} m.defaultExpectation.results = &{{$mock}}{{$method.Name}}Results{ {{ $method.ResultsNames }} } {{end}}
-				return m.mock
+			{{end}}
+
+			// Inspect sets up the function called with the params of every {{$interfaceName}}.{{$method.Name}} call before the results are returned,
+			// it's called for the unexpected calls as well, the panic of the function fails the test
+			func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) Inspect(f func({{$method.Params}})) *m{{$mock}}{{$method.Name}}{{$typeArgs}} {
+				mm{{$method.Name}}.state.Mutex.Lock()
+				mm{{$method.Name}}.inspect{{$method.Name}} = f
+				mm{{$method.Name}}.state.Mutex.Unlock()
+				return mm{{$method.Name}}
+			}
+
+			// expected returns everything set up for the {{$interfaceName}}.{{$method.Name}} call under a single read lock: the default expectation,
+			// the functions set by Set and Inspect{{if $method.HasParams}}, the comparer set by SetComparer{{if $method.HasResults}}, the expectations set by When and their results{{end}}{{end}}
+			func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) expected() (*{{$mock}}{{$method.Name}}Expectation{{$typeArgs}}, func{{$method.Signature}}, func({{$method.Params}})
+				{{- if $method.HasParams}}, minimock.Comparer{{if $method.HasResults}}, []*{{$mock}}{{$method.Name}}Expectation{{$typeArgs}}, []*{{$mock}}{{$method.Name}}Results{{$typeArgs}}{{end}}{{end}}) {
+				mm{{$method.Name}}.state.Mutex.RLock()
+				defer mm{{$method.Name}}.state.Mutex.RUnlock()
+
+				return mm{{$method.Name}}.defaultExpectation, mm{{$method.Name}}.mock.func{{$method.Name}}, mm{{$method.Name}}.inspect{{$method.Name}}
+					{{- if $method.HasParams}}, mm{{$method.Name}}.compare{{if $method.HasResults}}, mm{{$method.Name}}.expectations, mm{{$method.Name}}.whenResults{{end}}{{end}}
 			}
 
-			//Set uses given function f to mock the {{$.Interface.Name}}.{{$method.Name}} method
-			func (m *m{{$mock}}{{$method.Name}}) Set(f func{{$method.Signature}}) *{{$mock}}{
-				if m.defaultExpectation != nil {
-					m.mock.t.Fatalf("Default expectation is already set for the {{$.Interface.Name}}.{{$method.Name}} method")
+			// setup returns the expectations and the function set up for {{$interfaceName}}.{{$method.Name}} to be checked when the mock is finished
+			func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) setup() (setup minimock.Setup) {
+				mm{{$method.Name}}.state.Mutex.RLock()
+				defer mm{{$method.Name}}.state.Mutex.RUnlock()
+
+				setup.Func = mm{{$method.Name}}.mock.func{{$method.Name}} != nil
+				if e := mm{{$method.Name}}.defaultExpectation; e != nil {
+					setup.Default = true
+					{{- if $method.HasParams }}
+						if e.params != nil {
+							setup.DefaultParams = *e.params
+						}
+					{{- end}}
 				}
 
-				if len(m.expectations) > 0 {
-					m.mock.t.Fatalf("Some expectations are already set for the {{$.Interface.Name}}.{{$method.Name}} method")
+				for _, e := range mm{{$method.Name}}.expectations {
+					when := minimock.WhenSetup{Counter: &e.Counter}
+					{{- if $method.HasParams }}
+						if e.params != nil {
+							when.Params = *e.params
+						}
+					{{- end}}
+					setup.When = append(setup.When, when)
 				}
 
-				m.mock.func{{$method.Name}}= f
-				return m.mock
+				return setup
+			}
+
+			// reset removes the expectations{{if $method.HasResults}} and the results queued by ReturnOnce{{end}} of {{$interfaceName}}.{{$method.Name}}
+			{{- if $method.HasParams}}, the params of the calls are removed from the history as well{{end}}
+			func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) reset() {
+				mm{{$method.Name}}.state.Mutex.Lock()
+				mm{{$method.Name}}.defaultExpectation = nil
+				mm{{$method.Name}}.expectations = nil
+				{{- if $method.HasResults }}
+					mm{{$method.Name}}.queued = nil
+				{{- end}}
+				{{- if (and $method.HasParams $method.HasResults) }}
+					mm{{$method.Name}}.whenResults = nil
+				{{- end}}
+				mm{{$method.Name}}.state.Mutex.Unlock()
+				{{- if $method.HasParams }}
+
+					mm{{$method.Name}}.state.History.Lock()
+					mm{{$method.Name}}.calls = nil
+					mm{{$method.Name}}.state.History.Unlock()
+				{{- end}}
+			}
+
+			// MethodName returns the name of the mocked method, it implements minimock.Calls
+			func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) MethodName() string {
+				return mm{{$method.Name}}.state.Name()
+			}
+
+			// CallSequence returns the numbers of the {{$interfaceName}}.{{$method.Name}} calls in the sequence shared by the mocks
+			// created with the same controller, it implements minimock.Calls
+			func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) CallSequence() []uint64 {
+				return mm{{$method.Name}}.state.CallSequence()
+			}
+
+			// WaitForCalls waits until {{$interfaceName}}.{{$method.Name}} is called at least n times and fails the test
+			// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+			func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) WaitForCalls(n uint64, timeout mm_time.Duration) {
+				mm{{$method.Name}}.state.WaitForCalls(n, timeout)
+			}
+
+			// Block makes the subsequent {{$interfaceName}}.{{$method.Name}} calls wait until the returned function is called,
+			// the calls left blocked are released by MinimockFinish. The release function can be called several times
+			func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) Block() (release func()) {
+				return mm{{$method.Name}}.state.Block()
+			}
+
+			// WaitUntilBlocked waits until at least n {{$interfaceName}}.{{$method.Name}} calls are blocked by Block and fails the test
+			// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+			func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+				mm{{$method.Name}}.state.WaitUntilBlocked(n, timeout)
+			}
+
+			// LimitConcurrency fails the test as soon as more than n {{$interfaceName}}.{{$method.Name}} calls are in flight at once
+			func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) LimitConcurrency(n int) *m{{$mock}}{{$method.Name}}{{$typeArgs}} {
+				mm{{$method.Name}}.state.SetLimit(n)
+				return mm{{$method.Name}}
+			}
+
+			// CaptureCalls creates the buffered channel receiving the {{if $method.HasParams}}params{{else}}signal{{end}} of each {{$interfaceName}}.{{$method.Name}} call returned by Called,
+			// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+			// The calls are recorded to the history regardless of the channel
+			func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) CaptureCalls(buffer int) *m{{$mock}}{{$method.Name}}{{$typeArgs}} {
+				mm{{$method.Name}}.state.History.Lock()
+				mm{{$method.Name}}.called = make(chan {{if $method.HasParams}}{{$mock}}{{$method.Name}}Params{{$typeArgs}}{{else}}struct{}{{end}}, buffer)
+				mm{{$method.Name}}.state.History.Unlock()
+				return mm{{$method.Name}}
+			}
+
+			// Called returns the channel set up by CaptureCalls receiving the {{if $method.HasParams}}params{{else}}signal{{end}} of each {{$interfaceName}}.{{$method.Name}} call
+			func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) Called() <-chan {{if $method.HasParams}}{{$mock}}{{$method.Name}}Params{{$typeArgs}}{{else}}struct{}{{end}} {
+				mm{{$method.Name}}.state.History.Lock()
+				defer mm{{$method.Name}}.state.History.Unlock()
+
+				if mm{{$method.Name}}.called == nil {
+					mm{{$method.Name}}.mock.state.T().Fatalf("Calls of {{$mock}}.{{$method.Name}} aren't captured, CaptureCalls has to be called before the calls")
+				}
+
+				return mm{{$method.Name}}.called
+			}
+
+			// DroppedCalls returns the number of the {{$interfaceName}}.{{$method.Name}} calls that haven't been sent to the channel returned by Called
+			// since its buffer is full
+			func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) DroppedCalls() uint64 {
+				return mm{{$method.Name}}.state.DroppedCalls()
+			}
+
+			// Times sets the exact number of the {{$interfaceName}}.{{$method.Name}} calls expected by the MinimockFinish and MinimockWait,
+			// Times(0) expects no calls even if the method is mocked
+			func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) Times(n uint64) *m{{$mock}}{{$method.Name}}{{$typeArgs}} {
+				mm{{$method.Name}}.state.SetTimes(n)
+				return mm{{$method.Name}}
+			}
+
+			// Optional excludes {{$interfaceName}}.{{$method.Name}} from the checks made by MinimockFinish and MinimockWait,
+			// so the test doesn't fail if the method isn't called, the calls are still counted
+			func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) Optional() *m{{$mock}}{{$method.Name}}{{$typeArgs}} {
+				mm{{$method.Name}}.state.SetOptional()
+				return mm{{$method.Name}}
+			}
+
+			// Set uses given function f to mock the {{$interfaceName}}.{{$method.Name}} method,
+			// the function is replaced under the lock, so it can be set up while the method is being called
+			func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) Set(f func{{$method.Signature}}) *{{$mock}}{{$typeArgs}}{
+				mm{{$method.Name}}.state.Mutex.Lock()
+				defer mm{{$method.Name}}.state.Mutex.Unlock()
+
+				if mm{{$method.Name}}.defaultExpectation != nil {
+					mm{{$method.Name}}.mock.state.T().Fatalf("Default expectation is already set for the {{$interfaceName}}.{{$method.Name}} method")
+				}
+
+				if len(mm{{$method.Name}}.expectations) > 0 {
+					mm{{$method.Name}}.mock.state.T().Fatalf("Some expectations are already set for the {{$interfaceName}}.{{$method.Name}} method")
+				}
+
+				mm{{$method.Name}}.mock.func{{$method.Name}} = f
+				return mm{{$method.Name}}.mock
 			}
 
 			{{if (and $method.HasParams $method.HasResults)}}
-				// When sets expectation for the {{$.Interface.Name}}.{{$method.Name}} which will trigger the result defined by the following
+				// When sets expectation for the {{$interfaceName}}.{{$method.Name}} which will trigger the result defined by the following
 				// Then helper
-				func (m *m{{$mock}}{{$method.Name}}) When({{$method.Params}}) *{{$mock}}{{$method.Name}}Expectation {
-					if m.mock.func{{$method.Name}} != nil {
-						m.mock.t.Fatalf("{{$mock}}.{{$method.Name}} mock is already set by Set")
+				func (mm{{$method.Name}} *m{{$mock}}{{$method.Name}}{{$typeArgs}}) When({{$method.Params}}) *{{$mock}}{{$method.Name}}Expectation{{$typeArgs}} {
+					if _, mm_func := mm{{$method.Name}}.current(); mm_func != nil {
+						mm{{$method.Name}}.mock.state.T().Fatalf("{{$mock}}.{{$method.Name}} mock is already set by Set")
 					}
 
-					expectation := &{{$mock}}{{$method.Name}}Expectation{
-						mock: m.mock,
-						params: &{{$mock}}{{$method.Name}}Params{ {{ $method.ParamsNames }} },
+					expectation := &{{$mock}}{{$method.Name}}Expectation{{$typeArgs}}{
+						mock: mm{{$method.Name}}.mock,
+						params: &{{$mock}}{{$method.Name}}Params{{$typeArgs}}{ {{ $method.ParamsNames }} },
 					}
-					m.expectations = append(m.expectations, expectation)
+					mm{{$method.Name}}.state.Mutex.Lock()
+					mm{{$method.Name}}.expectations = append(mm{{$method.Name}}.expectations, expectation)
+					mm{{$method.Name}}.whenResults = append(mm{{$method.Name}}.whenResults, nil)
+					mm{{$method.Name}}.state.Mutex.Unlock()
 					return expectation
 				}
 
-				// Then sets up {{$.Interface.Name}}.{{$method.Name}} return parameters for the expectation previously defined by the When method
-				func (e *{{$mock}}{{$method.Name}}Expectation) Then({{$method.Results}}) *{{$mock}} {
-					e.results = &{{$mock}}{{$method.Name}}Results{ {{ $method.ResultsNames }} }
-					return e.mock
+				// Then sets up {{$interfaceName}}.{{$method.Name}} return parameters for the expectation previously defined by the When method
+				func (mmExpectation *{{$mock}}{{$method.Name}}Expectation{{$typeArgs}}) Then({{$method.Results}}) *{{$mock}}{{$typeArgs}} {
+					mm_handle := mmExpectation.mock.{{$names.Mock}}
+					mm_handle.state.Mutex.Lock()
+					defer mm_handle.state.Mutex.Unlock()
+
+					mmExpectation.results = &{{$mock}}{{$method.Name}}Results{{$typeArgs}}{ {{ $method.ResultsNames }} }
+					mm_results := make([]*{{$mock}}{{$method.Name}}Results{{$typeArgs}}, len(mm_handle.whenResults))
+					for i, e := range mm_handle.expectations[:len(mm_results)] {
+						mm_results[i] = e.results
+					}
+					mm_handle.whenResults = mm_results
+					return mmExpectation.mock
 				}
 			{{end}}
 
-			// {{$method.Name}} implements {{$.Interface.Type}}
-			func (m *{{$mock}}) {{$method.Declaration}} {
-				mm_atomic.AddUint64(&m.before{{$method.Name}}Counter, 1)
-				defer mm_atomic.AddUint64(&m.after{{$method.Name}}Counter, 1)
+			// {{$method.Name}} implements {{$interfaceType}}
+			{{- with (doc $method.Name)}}
+			//
+			{{.}}{{end}}
+			func (mm{{$method.Name}} *{{$mock}}{{$typeArgs}}) {{$method.Declaration}} {
+				mm_method := mm{{$method.Name}}.{{$names.Mock}}
+				{{if $method.HasResults}}mm_call := {{end}}mm_method.state.Enter()
+				defer mm_method.state.Leave()
 
 				{{if $method.HasParams}}
-					for _, e := range m.{{$method.Name}}Mock.expectations {
-						if minimock.Equal(*e.params,  {{$mock}}{{$method.Name}}Params{ {{$method.ParamsNames}} }) {
-							mm_atomic.AddUint64(&e.Counter, 1)
-							{{$method.ReturnStruct "e.results" -}}
+					mm_params := {{$mock}}{{$method.Name}}Params{{$typeArgs}}{ {{$method.ParamsNames}} }
+				{{end}}
+
+				{{if $method.HasParams}}mm_comparer := {{end}}mm_method.state.Record()
+				{{- if $method.HasParams}}
+					mm_method.calls = append(mm_method.calls, mm_params)
+				{{- end}}
+				if mm_method.called != nil {
+					select {
+					case mm_method.called <- {{if $method.HasParams}}mm_params{{else}}struct{}{}{{end}}:
+					default:
+						mm_method.state.Drop()
+					}
+				}
+				mm_method.state.History.Unlock()
+
+				mm_method.state.Wait()
+
+				mm_expectation, mm_func{{$method.Name}}, mm_inspect{{$method.Name}}
+					{{- if $method.HasParams}}, mm_compare{{if $method.HasResults}}, mm_when, mm_whenResults{{end}}{{end}} := mm_method.expected()
+				if mm_inspect{{$method.Name}} != nil {
+					func() {
+						defer mm_method.state.RecoverInspect()
+						mm_inspect{{$method.Call}}
+					}()
+				}
+
+				{{if $method.HasParams}}
+					if mm_compare != nil {
+						mm_comparer = mm_compare
+					}
+					{{- if $method.HasResults }}
+
+						// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+						for i, mm_results := range mm_whenResults {
+							// cases set by When without Then are skipped until the results are set
+							if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+								mm_atomic.AddUint64(&e.Counter, 1)
+								{{returnResults $method "(*mm_results)" -}}
+							}
 						}
+					{{- end}}
+				{{end}}
+
+				{{if $method.HasResults }}
+					if mm_results := mm_method.popResults(); mm_results != nil {
+						{{- if $method.HasParams }}
+							if mm_expectation != nil && mm_expectation.params != nil {
+								mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+							}
+						{{ end }}
+						{{returnResults $method "(*mm_results)" -}}
+					}
+
+					// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+					if (mm_expectation == nil || mm_expectation.results == nil) && mm_func{{$method.Name}} == nil &&
+						mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, {{if $method.HasParams}}mm_params{{else}}nil{{end}}) {
+						return
 					}
 				{{end}}
 
-				if m.{{$method.Name}}Mock.defaultExpectation != nil {
-					mm_atomic.AddUint64(&m.{{$method.Name}}Mock.defaultExpectation.Counter, 1)
+				if mm_expectation != nil {
+					mm_atomic.AddUint64(&mm_expectation.Counter, 1)
 					{{- if $method.HasParams }}
-						want:= m.{{$method.Name}}Mock.defaultExpectation.params
-						got:= {{$mock}}{{$method.Name}}Params{ {{$method.ParamsNames}} }
-						if want != nil && !minimock.Equal(*want, got) {
-							m.t.Errorf("{{$mock}}.{{$method.Name}} got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+						if mm_expectation.params != nil {
+							mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
 						}
 					{{ end }}
 					{{if $method.HasResults }}
-						results := m.{{$method.Name}}Mock.defaultExpectation.results
-						if results == nil {
-							m.t.Fatal("No results are set for the {{$mock}}.{{$method.Name}}")
+						if mm_expectation.results == nil {
+							mm_method.state.NoResults()
+							{{- if $recordUnexpected }}
+								return
+							{{- end}}
 						}
-						{{$method.ReturnStruct "(*results)" -}}
+						{{returnResults $method "(*mm_expectation.results)" -}}
 					{{else}}
 						return
 					{{ end }}
 				}
-				if m.func{{$method.Name}} != nil {
-					{{$method.Pass "m.func"}}
+				if mm_func{{$method.Name}} != nil {
+					{{$method.Pass "mm_func"}}
 				}
-				m.t.Fatalf("Unexpected call to {{$mock}}.{{$method.Name}}.{{range $method.Params}} %v{{end}}", {{ $method.ParamsNames }} )
+				if mm_delegate := mm{{$method.Name}}.minimockDelegate(); mm_delegate != nil {
+					{{$method.Pass "mm_delegate."}}
+				}
+				if mm_method.state.Lenient() {
+					return
+				}
+				mm_method.state.UnexpectedCall({{if $method.HasParams}}mm_params, {{ $method.ParamsNames }}{{else}}nil{{end}})
 				{{if $method.HasResults}}return{{end}}
 			}
 
-			// {{$method.Name}}AfterCounter returns a count of finished {{$mock}}.{{$method.Name}} invocations
-			func (m *{{$mock}}) {{$method.Name}}AfterCounter() uint64 {
-				return mm_atomic.LoadUint64(&m.after{{$method.Name}}Counter)
+			// {{$names.AfterCounter}} returns a count of finished {{$mock}}.{{$method.Name}} invocations
+			func (mm{{$method.Name}} *{{$mock}}{{$typeArgs}}) {{$names.AfterCounter}}() uint64 {
+				return mm{{$method.Name}}.{{$names.Mock}}.state.AfterCalls()
 			}
 
-			// {{$method.Name}}BeforeCounter returns a count of {{$mock}}.{{$method.Name}} invocations
-			func (m *{{$mock}}) {{$method.Name}}BeforeCounter() uint64 {
-				return mm_atomic.LoadUint64(&m.before{{$method.Name}}Counter)
+			// {{$names.BeforeCounter}} returns a count of {{$mock}}.{{$method.Name}} invocations
+			func (mm{{$method.Name}} *{{$mock}}{{$typeArgs}}) {{$names.BeforeCounter}}() uint64 {
+				return mm{{$method.Name}}.{{$names.Mock}}.state.BeforeCalls()
 			}
 
-			// Minimock{{$method.Name}}Done returns true if the count of the {{$method.Name}} invocations corresponds
-			// the number of defined expectations
-			func (m *{{$mock}}) Minimock{{$method.Name}}Done() bool {
-				for _, e := range m.{{$method.Name}}Mock.expectations {
-					if mm_atomic.LoadUint64(&e.Counter) < 1 {
-						return false
-					}
-				}
+			{{if $method.HasParams}}
+				// {{$names.Calls}} returns the params of all {{$mock}}.{{$method.Name}} calls in the order they were made,
+				// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+				func (mm{{$method.Name}} *{{$mock}}{{$typeArgs}}) {{$names.Calls}}() []{{$mock}}{{$method.Name}}Params{{$typeArgs}} {
+					mm{{$method.Name}}.{{$names.Mock}}.state.History.Lock()
+					defer mm{{$method.Name}}.{{$names.Mock}}.state.History.Unlock()
 
-				// if default expectation was set then invocations count should be greater than zero
-				if m.{{$method.Name}}Mock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.after{{$method.Name}}Counter) < 1 {
-					return false
-				}
-				// if func was set then invocations count should be greater than zero
-				if m.func{{$method.Name}} != nil && mm_atomic.LoadUint64(&m.after{{$method.Name}}Counter) < 1  {
-					return false
+					calls := make([]{{$mock}}{{$method.Name}}Params{{$typeArgs}}, len(mm{{$method.Name}}.{{$names.Mock}}.calls))
+					copy(calls, mm{{$method.Name}}.{{$names.Mock}}.calls)
+					return calls
 				}
-				return true
-			}
 
-			// Minimock{{$method.Name}}Inspect logs each unmet expectation
-			func (m *{{$mock}}) Minimock{{$method.Name}}Inspect() {
-				for _, e := range m.{{$method.Name}}Mock.expectations {
-					if mm_atomic.LoadUint64(&e.Counter) < 1 {
-						{{- if $method.HasParams}}
-							m.t.Errorf("Expected call to {{$mock}}.{{$method.Name}} with params: %#v", *e.params)
-						{{else}}
-							m.t.Error("Expected call to {{$mock}}.{{$method.Name}}")
-						{{end -}}
+				// {{$names.LastParams}} returns the params of the latest {{$mock}}.{{$method.Name}} call and false if there were no calls
+				func (mm{{$method.Name}} *{{$mock}}{{$typeArgs}}) {{$names.LastParams}}() (params {{$mock}}{{$method.Name}}Params{{$typeArgs}}, ok bool) {
+					mm{{$method.Name}}.{{$names.Mock}}.state.History.Lock()
+					defer mm{{$method.Name}}.{{$names.Mock}}.state.History.Unlock()
+
+					if n := len(mm{{$method.Name}}.{{$names.Mock}}.calls); n > 0 {
+						return mm{{$method.Name}}.{{$names.Mock}}.calls[n-1], true
 					}
-				}
 
-				// if default expectation was set then invocations count should be greater than zero
-				if m.{{$method.Name}}Mock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.after{{$method.Name}}Counter) < 1 {
-					{{- if $method.HasParams}}
-						m.t.Errorf("Expected call to {{$mock}}.{{$method.Name}} with params: %#v", *m.{{$method.Name}}Mock.defaultExpectation.params)
-					{{else}}
-						m.t.Error("Expected call to {{$mock}}.{{$method.Name}}")
-					{{end -}}
-				}
-				// if func was set then invocations count should be greater than zero
-				if m.func{{$method.Name}} != nil && mm_atomic.LoadUint64(&m.after{{$method.Name}}Counter) < 1  {
-					m.t.Error("Expected call to {{$mock}}.{{$method.Name}}")
+					return params, false
 				}
+			{{end}}
+
+			// {{$names.CallTimes}} returns the times of all {{$mock}}.{{$method.Name}} calls in the order they were made,
+			// the times are taken from the clock set by MinimockSetClock
+			func (mm{{$method.Name}} *{{$mock}}{{$typeArgs}}) {{$names.CallTimes}}() []mm_time.Time {
+				return mm{{$method.Name}}.{{$names.Mock}}.state.CallTimes()
+			}
+
+			// {{$names.MaxInFlight}} returns the maximum number of the {{$mock}}.{{$method.Name}} calls that have been in flight at once
+			func (mm{{$method.Name}} *{{$mock}}{{$typeArgs}}) {{$names.MaxInFlight}}() int {
+				return mm{{$method.Name}}.{{$names.Mock}}.state.MaxInFlight()
+			}
+
+			// {{$names.UnexpectedCounter}} returns a count of {{$mock}}.{{$method.Name}} invocations made without an implementation
+			func (mm{{$method.Name}} *{{$mock}}{{$typeArgs}}) {{$names.UnexpectedCounter}}() uint64 {
+				return mm{{$method.Name}}.{{$names.Mock}}.state.UnexpectedCalls()
+			}
+
+			// {{$names.NotCalled}} returns true if {{$mock}}.{{$method.Name}} hasn't been called
+			func (mm{{$method.Name}} *{{$mock}}{{$typeArgs}}) {{$names.NotCalled}}() bool {
+				return mm{{$method.Name}}.{{$names.Mock}}.state.BeforeCalls() == 0
+			}
+
+			// {{$names.CallCount}} returns a count of {{$mock}}.{{$method.Name}} invocations, it's the same as {{$names.BeforeCounter}}
+			func (mm{{$method.Name}} *{{$mock}}{{$typeArgs}}) {{$names.CallCount}}() uint64 {
+				return mm{{$method.Name}}.{{$names.Mock}}.state.BeforeCalls()
+			}
+
+			// Minimock{{$method.Name}}Done returns true if the count of the {{$method.Name}} invocations corresponds
+			// the number of defined expectations
+			func (mm{{$method.Name}} *{{$mock}}{{$typeArgs}}) Minimock{{$method.Name}}Done() bool {
+				return mm{{$method.Name}}.{{$names.Mock}}.state.Done(mm{{$method.Name}}.{{$names.Mock}}.setup())
+			}
+
+			// Minimock{{$method.Name}}Inspect logs each unmet expectation
+			func (mm{{$method.Name}} *{{$mock}}{{$typeArgs}}) Minimock{{$method.Name}}Inspect() {
+				mm{{$method.Name}}.{{$names.Mock}}.state.Inspect(mm{{$method.Name}}.{{$names.Mock}}.setup())
 			}
 		{{end}}
 
+		// MinimockSetComparer sets up the function comparing the expected and the actual params of all {{$mock}} methods instead of minimock.Equal,
+		// the params matched by the matchers aren't compared
+		func (m *{{$mock}}{{$typeArgs}}) MinimockSetComparer(compare minimock.Comparer) *{{$mock}}{{$typeArgs}} {
+			m.state.SetComparer(compare)
+			return m
+		}
+
+		// MinimockSetSequence sets up the sequence numbering the {{$mock}} calls, the mocks sharing the sequence
+		// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+		// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+		func (m *{{$mock}}{{$typeArgs}}) MinimockSetSequence(sequence *minimock.Sequence) *{{$mock}}{{$typeArgs}} {
+			m.state.SetSequence(sequence)
+			return m
+		}
+
+		// MinimockSetClock sets up the function returning the current time for the history of {{$mock}} calls instead of time.Now
+		func (m *{{$mock}}{{$typeArgs}}) MinimockSetClock(clock func() mm_time.Time) *{{$mock}}{{$typeArgs}} {
+			m.state.SetClock(clock)
+			return m
+		}
+
+		// MinimockSetAutoFinish enables or disables the check of {{$mock}} made by the Cleanup of the tester passed to {{$newMock}},
+		// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+		func (m *{{$mock}}{{$typeArgs}}) MinimockSetAutoFinish(enabled bool) *{{$mock}}{{$typeArgs}} {
+			m.state.SetAutoFinish(enabled)
+			return m
+		}
+
+		// MinimockSetDelegate sets up the implementation the calls of the {{$mock}} methods are forwarded to when the methods
+		// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+		// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+		func (m *{{$mock}}{{$typeArgs}}) MinimockSetDelegate(impl {{$delegate}}) *{{$mock}}{{$typeArgs}} {
+			m.mutex.Lock()
+			m.delegate = impl
+			m.mutex.Unlock()
+			return m
+		}
+
+		func (m *{{$mock}}{{$typeArgs}}) minimockDelegate() {{$delegate}} {
+			m.mutex.RLock()
+			defer m.mutex.RUnlock()
+
+			return m.delegate
+		}
+
+		// MinimockSetLenient enables or disables the lenient mode of {{$mock}}: the calls of the methods that have neither
+		// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+		// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+		func (m *{{$mock}}{{$typeArgs}}) MinimockSetLenient(enabled bool) *{{$mock}}{{$typeArgs}} {
+			m.state.SetLenient(enabled)
+			return m
+		}
+
+		// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+		// the methods that haven't been called this way are omitted
+		func (m *{{$mock}}{{$typeArgs}}) MinimockLenientCalls() map[string]uint64 {
+			return m.state.LenientCalls()
+		}
+
+		// MinimockAssertNotCalled fails the test without stopping it if any of the {{$mock}} methods with the given names has been called,
+		// it doesn't depend on the expectations and the functions set up for the methods
+		func (m *{{$mock}}{{$typeArgs}}) MinimockAssertNotCalled(methodNames ...string) {
+			m.state.AssertNotCalled(methodNames...)
+		}
+
+		// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+		// queued by ReturnOnce of all {{$mock}} methods, the functions set by Set and Inspect are kept.
+		// It fails the test if any of the methods is being called
+		func (m *{{$mock}}{{$typeArgs}}) MinimockReset() {
+			m.state.Reset(
+				{{- range $method := $methods }}{{ $names := (index $members $method.Name) }}
+					m.{{$names.Mock}}.reset,
+				{{- end}}
+			)
+		}
+
+		// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+		func (m *{{$mock}}{{$typeArgs}}) MinimockResetAll() {
+			m.MinimockReset()
+			{{- range $method := $methods }}{{ $names := (index $members $method.Name) }}
+				m.{{$names.Mock}}.state.Mutex.Lock()
+				m.func{{$method.Name}} = nil
+				m.{{$names.Mock}}.inspect{{$method.Name}} = nil
+				m.{{$names.Mock}}.state.Mutex.Unlock()
+			{{- end}}
+		}
+
 		// MinimockFinish checks that all mocked methods have been called the expected number of times
-		func (m *{{$mock}}) MinimockFinish() {
-			if !m.minimockDone() {
-				{{- range $method := $.Interface.Methods }}
-					m.Minimock{{$method.Name}}Inspect()
-				{{ end -}}
-				m.t.FailNow()
-			}
+		func (m *{{$mock}}{{$typeArgs}}) MinimockFinish() {
+			m.state.Finish(m.minimockDone
+				{{- range $method := $methods }},
+					m.Minimock{{$method.Name}}Inspect
+				{{- end}},
+			)
 		}
 
 		// MinimockWait waits for all mocked methods to be called the expected number of times
-		func (m *{{$mock}}) MinimockWait(timeout mm_time.Duration) {
-			timeoutCh := mm_time.After(timeout)
-			for {
-				if m.minimockDone() {
-					return
-				}
-				select {
-				case <-timeoutCh:
-					m.MinimockFinish()
-					return
-				case <-mm_time.After(10 * mm_time.Millisecond):
-				}
-			}
+		func (m *{{$mock}}{{$typeArgs}}) MinimockWait(timeout mm_time.Duration) {
+			m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
 		}
 
-		func (m *{{$mock}}) minimockDone() bool {
+		func (m *{{$mock}}{{$typeArgs}}) minimockDone() bool {
 			done := true
-			return done {{ range $method := $.Interface.Methods }}&&
+			return done {{ range $method := $methods }}&&
 			m.Minimock{{$method.Name}}Done(){{end -}}
 		}
 	`
@@ -0,0 +1,26 @@
+package tests
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllocatorMock_UnsafePointer(t *testing.T) {
+	buf := make([]byte, 8)
+	p := unsafe.Pointer(&buf[0])
+
+	allocatorMock := NewAllocatorMock(t).
+		AllocMock.Expect(8).Return(p).
+		FreeMock.Expect(p, 8).Return()
+	defer allocatorMock.MinimockFinish()
+
+	var allocator Allocator = allocatorMock
+
+	assert.Equal(t, p, allocator.Alloc(8))
+	allocator.Free(p, 8)
+
+	assert.EqualValues(t, 1, allocatorMock.AllocAfterCounter())
+	assert.EqualValues(t, 1, allocatorMock.FreeAfterCounter())
+}
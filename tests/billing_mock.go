@@ -0,0 +1,670 @@
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+package tests
+
+//go:generate minimock -i github.com/gojuno/minimock/tests/dotimport.Billing -o ./billing_mock.go
+
+import (
+	mm_sync "sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock"
+	"github.com/gojuno/minimock/tests/billing/types"
+	mm_dotimport "github.com/gojuno/minimock/tests/dotimport"
+)
+
+// BillingMock implements dotimport.Billing
+//
+// Billing interface refers to the dot imported types
+type BillingMock struct {
+	state    minimock.MockState
+	mutex    mm_sync.RWMutex
+	delegate mm_dotimport.Billing
+
+	funcInvoice func(id int) (ip1 *types.Invoice, err error)
+	InvoiceMock *mBillingMockInvoice
+}
+
+var _ mm_dotimport.Billing = (*BillingMock)(nil)
+
+// NewBillingMock returns a mock for dotimport.Billing
+func NewBillingMock(t minimock.Tester) *BillingMock {
+	m := &BillingMock{}
+	m.state.Init(m, t, "BillingMock", 0)
+
+	m.InvoiceMock = &mBillingMockInvoice{mock: m}
+	m.InvoiceMock.state.Init(&m.state, "Invoice")
+
+	return m
+}
+
+type mBillingMockInvoice struct {
+	mock               *BillingMock
+	state              minimock.MethodState
+	defaultExpectation *BillingMockInvoiceExpectation
+	expectations       []*BillingMockInvoiceExpectation
+	inspectInvoice     func(id int)
+	compare            minimock.Comparer
+	calls              []BillingMockInvoiceParams
+	called             chan BillingMockInvoiceParams
+	queued             []*BillingMockInvoiceResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*BillingMockInvoiceResults
+}
+
+// BillingMockInvoiceExpectation specifies expectation struct of the Billing.Invoice
+type BillingMockInvoiceExpectation struct {
+	mock     *BillingMock
+	params   *BillingMockInvoiceParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *BillingMockInvoiceResults
+	Counter  uint64
+}
+
+// BillingMockInvoiceParams contains parameters of the Billing.Invoice
+type BillingMockInvoiceParams struct {
+	Id int
+}
+
+// BillingMockInvoiceResults contains results of the Billing.Invoice
+type BillingMockInvoiceResults struct {
+	R0 *types.Invoice
+	R1 error
+}
+
+// Expect sets up expected params for Billing.Invoice
+func (mmInvoice *mBillingMockInvoice) Expect(id int) *mBillingMockInvoice {
+	if _, mm_func := mmInvoice.current(); mm_func != nil {
+		mmInvoice.mock.state.T().Fatalf("BillingMock.Invoice mock is already set by Set")
+	}
+
+	mm_params := &BillingMockInvoiceParams{id}
+	mmInvoice.updateDefault(func(e *BillingMockInvoiceExpectation) {
+		if e.partial {
+			mmInvoice.mock.state.T().Fatalf("BillingMock.Invoice params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmInvoice.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmInvoice.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmInvoice
+}
+
+// updateDefault replaces the default expectation of Billing.Invoice by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmInvoice *mBillingMockInvoice) updateDefault(update func(e *BillingMockInvoiceExpectation)) {
+	mmInvoice.state.Mutex.Lock()
+	defer mmInvoice.state.Mutex.Unlock()
+
+	e := &BillingMockInvoiceExpectation{mock: mmInvoice.mock}
+	if previous := mmInvoice.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmInvoice.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Billing.Invoice
+func (mmInvoice *mBillingMockInvoice) current() (*BillingMockInvoiceExpectation, func(id int) (ip1 *types.Invoice, err error)) {
+	mmInvoice.state.Mutex.RLock()
+	defer mmInvoice.state.Mutex.RUnlock()
+
+	return mmInvoice.defaultExpectation, mmInvoice.mock.funcInvoice
+}
+
+// partialParams updates the default expectation of Billing.Invoice by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmInvoice *mBillingMockInvoice) partialParams(update func(e *BillingMockInvoiceExpectation)) {
+	if _, mm_func := mmInvoice.current(); mm_func != nil {
+		mmInvoice.mock.state.T().Fatalf("BillingMock.Invoice mock is already set by Set")
+	}
+
+	mmInvoice.updateDefault(func(e *BillingMockInvoiceExpectation) {
+		if e.params == nil {
+			e.params = &BillingMockInvoiceParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"Id": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectIdParam1 sets up the expected value of the param #1 of Billing.Invoice,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmInvoice *mBillingMockInvoice) ExpectIdParam1(Id int) *mBillingMockInvoice {
+	mmInvoice.partialParams(func(e *BillingMockInvoiceExpectation) {
+		e.params.Id = Id
+		delete(e.matchers, "Id")
+	})
+	return mmInvoice
+}
+
+// MatchIdParam1 sets up the predicate matching the param #1 of Billing.Invoice,
+// it's used instead of the value of the param set by Expect
+func (mmInvoice *mBillingMockInvoice) MatchIdParam1(f func(got int) bool) *mBillingMockInvoice {
+	mm_matcher := minimock.Predicate("predicate func(got int) bool", func(v interface{}) bool {
+		got, _ := v.(int)
+		return f(got)
+	})
+	mmInvoice.partialParams(func(e *BillingMockInvoiceExpectation) {
+		e.matchers["Id"] = mm_matcher
+	})
+	return mmInvoice
+}
+
+// Return sets up results that will be returned by Billing.Invoice
+func (mmInvoice *mBillingMockInvoice) Return(ip1 *types.Invoice, err error) *BillingMock {
+	if _, mm_func := mmInvoice.current(); mm_func != nil {
+		mmInvoice.mock.state.T().Fatalf("BillingMock.Invoice mock is already set by Set")
+	}
+
+	mm_results := &BillingMockInvoiceResults{ip1, err}
+	mmInvoice.updateDefault(func(e *BillingMockInvoiceExpectation) { e.results = mm_results })
+
+	return mmInvoice.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Billing.Invoice,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmInvoice *mBillingMockInvoice) ReturnOnce(ip1 *types.Invoice, err error) *mBillingMockInvoice {
+	mmInvoice.state.Mutex.Lock()
+	mmInvoice.queued = append(mmInvoice.queued, &BillingMockInvoiceResults{ip1, err})
+	mmInvoice.state.Mutex.Unlock()
+	mmInvoice.state.PushResults()
+	return mmInvoice
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmInvoice *mBillingMockInvoice) popResults() *BillingMockInvoiceResults {
+	mm_i, mm_ok := mmInvoice.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmInvoice.state.Mutex.RLock()
+	defer mmInvoice.state.Mutex.RUnlock()
+
+	return mmInvoice.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of Billing.Invoice instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmInvoice *mBillingMockInvoice) SetComparer(compare minimock.Comparer) *mBillingMockInvoice {
+	mmInvoice.state.Mutex.Lock()
+	mmInvoice.compare = compare
+	mmInvoice.state.Mutex.Unlock()
+	return mmInvoice
+}
+
+// whenExpectations returns the expectations of Billing.Invoice set by When,
+// the expectations can be set while the method is called concurrently
+func (mmInvoice *mBillingMockInvoice) whenExpectations() []*BillingMockInvoiceExpectation {
+	mmInvoice.state.Mutex.RLock()
+	defer mmInvoice.state.Mutex.RUnlock()
+
+	return mmInvoice.expectations
+}
+
+// Inspect sets up the function called with the params of every Billing.Invoice call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmInvoice *mBillingMockInvoice) Inspect(f func(id int)) *mBillingMockInvoice {
+	mmInvoice.state.Mutex.Lock()
+	mmInvoice.inspectInvoice = f
+	mmInvoice.state.Mutex.Unlock()
+	return mmInvoice
+}
+
+// expected returns everything set up for the Billing.Invoice call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmInvoice *mBillingMockInvoice) expected() (*BillingMockInvoiceExpectation, func(id int) (ip1 *types.Invoice, err error), func(id int), minimock.Comparer, []*BillingMockInvoiceExpectation, []*BillingMockInvoiceResults) {
+	mmInvoice.state.Mutex.RLock()
+	defer mmInvoice.state.Mutex.RUnlock()
+
+	return mmInvoice.defaultExpectation, mmInvoice.mock.funcInvoice, mmInvoice.inspectInvoice, mmInvoice.compare, mmInvoice.expectations, mmInvoice.whenResults
+}
+
+// setup returns the expectations and the function set up for Billing.Invoice to be checked when the mock is finished
+func (mmInvoice *mBillingMockInvoice) setup() (setup minimock.Setup) {
+	mmInvoice.state.Mutex.RLock()
+	defer mmInvoice.state.Mutex.RUnlock()
+
+	setup.Func = mmInvoice.mock.funcInvoice != nil
+	if e := mmInvoice.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmInvoice.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Billing.Invoice, the params of the calls are removed from the history as well
+func (mmInvoice *mBillingMockInvoice) reset() {
+	mmInvoice.state.Mutex.Lock()
+	mmInvoice.defaultExpectation = nil
+	mmInvoice.expectations = nil
+	mmInvoice.queued = nil
+	mmInvoice.whenResults = nil
+	mmInvoice.state.Mutex.Unlock()
+
+	mmInvoice.state.History.Lock()
+	mmInvoice.calls = nil
+	mmInvoice.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmInvoice *mBillingMockInvoice) MethodName() string {
+	return mmInvoice.state.Name()
+}
+
+// CallSequence returns the numbers of the Billing.Invoice calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmInvoice *mBillingMockInvoice) CallSequence() []uint64 {
+	return mmInvoice.state.CallSequence()
+}
+
+// WaitForCalls waits until Billing.Invoice is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmInvoice *mBillingMockInvoice) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmInvoice.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Billing.Invoice calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmInvoice *mBillingMockInvoice) Block() (release func()) {
+	return mmInvoice.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Billing.Invoice calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmInvoice *mBillingMockInvoice) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmInvoice.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Billing.Invoice calls are in flight at once
+func (mmInvoice *mBillingMockInvoice) LimitConcurrency(n int) *mBillingMockInvoice {
+	mmInvoice.state.SetLimit(n)
+	return mmInvoice
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Billing.Invoice call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmInvoice *mBillingMockInvoice) CaptureCalls(buffer int) *mBillingMockInvoice {
+	mmInvoice.state.History.Lock()
+	mmInvoice.called = make(chan BillingMockInvoiceParams, buffer)
+	mmInvoice.state.History.Unlock()
+	return mmInvoice
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Billing.Invoice call
+func (mmInvoice *mBillingMockInvoice) Called() <-chan BillingMockInvoiceParams {
+	mmInvoice.state.History.Lock()
+	defer mmInvoice.state.History.Unlock()
+
+	if mmInvoice.called == nil {
+		mmInvoice.mock.state.T().Fatalf("Calls of BillingMock.Invoice aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmInvoice.called
+}
+
+// DroppedCalls returns the number of the Billing.Invoice calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmInvoice *mBillingMockInvoice) DroppedCalls() uint64 {
+	return mmInvoice.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Billing.Invoice calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmInvoice *mBillingMockInvoice) Times(n uint64) *mBillingMockInvoice {
+	mmInvoice.state.SetTimes(n)
+	return mmInvoice
+}
+
+// Optional excludes Billing.Invoice from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmInvoice *mBillingMockInvoice) Optional() *mBillingMockInvoice {
+	mmInvoice.state.SetOptional()
+	return mmInvoice
+}
+
+// Set uses given function f to mock the Billing.Invoice method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmInvoice *mBillingMockInvoice) Set(f func(id int) (ip1 *types.Invoice, err error)) *BillingMock {
+	mmInvoice.state.Mutex.Lock()
+	defer mmInvoice.state.Mutex.Unlock()
+
+	if mmInvoice.defaultExpectation != nil {
+		mmInvoice.mock.state.T().Fatalf("Default expectation is already set for the Billing.Invoice method")
+	}
+
+	if len(mmInvoice.expectations) > 0 {
+		mmInvoice.mock.state.T().Fatalf("Some expectations are already set for the Billing.Invoice method")
+	}
+
+	mmInvoice.mock.funcInvoice = f
+	return mmInvoice.mock
+}
+
+// When sets expectation for the Billing.Invoice which will trigger the result defined by the following
+// Then helper
+func (mmInvoice *mBillingMockInvoice) When(id int) *BillingMockInvoiceExpectation {
+	if _, mm_func := mmInvoice.current(); mm_func != nil {
+		mmInvoice.mock.state.T().Fatalf("BillingMock.Invoice mock is already set by Set")
+	}
+
+	expectation := &BillingMockInvoiceExpectation{
+		mock:   mmInvoice.mock,
+		params: &BillingMockInvoiceParams{id},
+	}
+	mmInvoice.state.Mutex.Lock()
+	mmInvoice.expectations = append(mmInvoice.expectations, expectation)
+	mmInvoice.whenResults = append(mmInvoice.whenResults, nil)
+	mmInvoice.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up Billing.Invoice return parameters for the expectation previously defined by the When method
+func (mmExpectation *BillingMockInvoiceExpectation) Then(ip1 *types.Invoice, err error) *BillingMock {
+	mm_handle := mmExpectation.mock.InvoiceMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &BillingMockInvoiceResults{ip1, err}
+	mm_results := make([]*BillingMockInvoiceResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Invoice implements dotimport.Billing
+func (mmInvoice *BillingMock) Invoice(id int) (ip1 *types.Invoice, err error) {
+	mm_method := mmInvoice.InvoiceMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := BillingMockInvoiceParams{id}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcInvoice, mm_inspectInvoice, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectInvoice != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectInvoice(id)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).R0, (*mm_results).R1
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).R0, (*mm_results).R1
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcInvoice == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0, (*mm_expectation.results).R1
+	}
+	if mm_funcInvoice != nil {
+		return mm_funcInvoice(id)
+	}
+	if mm_delegate := mmInvoice.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Invoice(id)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, id)
+	return
+}
+
+// InvoiceAfterCounter returns a count of finished BillingMock.Invoice invocations
+func (mmInvoice *BillingMock) InvoiceAfterCounter() uint64 {
+	return mmInvoice.InvoiceMock.state.AfterCalls()
+}
+
+// InvoiceBeforeCounter returns a count of BillingMock.Invoice invocations
+func (mmInvoice *BillingMock) InvoiceBeforeCounter() uint64 {
+	return mmInvoice.InvoiceMock.state.BeforeCalls()
+}
+
+// InvoiceCalls returns the params of all BillingMock.Invoice calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmInvoice *BillingMock) InvoiceCalls() []BillingMockInvoiceParams {
+	mmInvoice.InvoiceMock.state.History.Lock()
+	defer mmInvoice.InvoiceMock.state.History.Unlock()
+
+	calls := make([]BillingMockInvoiceParams, len(mmInvoice.InvoiceMock.calls))
+	copy(calls, mmInvoice.InvoiceMock.calls)
+	return calls
+}
+
+// InvoiceLastParams returns the params of the latest BillingMock.Invoice call and false if there were no calls
+func (mmInvoice *BillingMock) InvoiceLastParams() (params BillingMockInvoiceParams, ok bool) {
+	mmInvoice.InvoiceMock.state.History.Lock()
+	defer mmInvoice.InvoiceMock.state.History.Unlock()
+
+	if n := len(mmInvoice.InvoiceMock.calls); n > 0 {
+		return mmInvoice.InvoiceMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// InvoiceCallTimes returns the times of all BillingMock.Invoice calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmInvoice *BillingMock) InvoiceCallTimes() []mm_time.Time {
+	return mmInvoice.InvoiceMock.state.CallTimes()
+}
+
+// InvoiceMaxInFlight returns the maximum number of the BillingMock.Invoice calls that have been in flight at once
+func (mmInvoice *BillingMock) InvoiceMaxInFlight() int {
+	return mmInvoice.InvoiceMock.state.MaxInFlight()
+}
+
+// InvoiceUnexpectedCounter returns a count of BillingMock.Invoice invocations made without an implementation
+func (mmInvoice *BillingMock) InvoiceUnexpectedCounter() uint64 {
+	return mmInvoice.InvoiceMock.state.UnexpectedCalls()
+}
+
+// InvoiceNotCalled returns true if BillingMock.Invoice hasn't been called
+func (mmInvoice *BillingMock) InvoiceNotCalled() bool {
+	return mmInvoice.InvoiceMock.state.BeforeCalls() == 0
+}
+
+// InvoiceCallCount returns a count of BillingMock.Invoice invocations, it's the same as InvoiceBeforeCounter
+func (mmInvoice *BillingMock) InvoiceCallCount() uint64 {
+	return mmInvoice.InvoiceMock.state.BeforeCalls()
+}
+
+// MinimockInvoiceDone returns true if the count of the Invoice invocations corresponds
+// the number of defined expectations
+func (mmInvoice *BillingMock) MinimockInvoiceDone() bool {
+	return mmInvoice.InvoiceMock.state.Done(mmInvoice.InvoiceMock.setup())
+}
+
+// MinimockInvoiceInspect logs each unmet expectation
+func (mmInvoice *BillingMock) MinimockInvoiceInspect() {
+	mmInvoice.InvoiceMock.state.Inspect(mmInvoice.InvoiceMock.setup())
+}
+
+// MinimockSetComparer sets up the function comparing the expected and the actual params of all BillingMock methods instead of minimock.Equal,
+// the params matched by the matchers aren't compared
+func (m *BillingMock) MinimockSetComparer(compare minimock.Comparer) *BillingMock {
+	m.state.SetComparer(compare)
+	return m
+}
+
+// MinimockSetSequence sets up the sequence numbering the BillingMock calls, the mocks sharing the sequence
+// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+func (m *BillingMock) MinimockSetSequence(sequence *minimock.Sequence) *BillingMock {
+	m.state.SetSequence(sequence)
+	return m
+}
+
+// MinimockSetClock sets up the function returning the current time for the history of BillingMock calls instead of time.Now
+func (m *BillingMock) MinimockSetClock(clock func() mm_time.Time) *BillingMock {
+	m.state.SetClock(clock)
+	return m
+}
+
+// MinimockSetAutoFinish enables or disables the check of BillingMock made by the Cleanup of the tester passed to NewBillingMock,
+// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+func (m *BillingMock) MinimockSetAutoFinish(enabled bool) *BillingMock {
+	m.state.SetAutoFinish(enabled)
+	return m
+}
+
+// MinimockSetDelegate sets up the implementation the calls of the BillingMock methods are forwarded to when the methods
+// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+func (m *BillingMock) MinimockSetDelegate(impl mm_dotimport.Billing) *BillingMock {
+	m.mutex.Lock()
+	m.delegate = impl
+	m.mutex.Unlock()
+	return m
+}
+
+func (m *BillingMock) minimockDelegate() mm_dotimport.Billing {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.delegate
+}
+
+// MinimockSetLenient enables or disables the lenient mode of BillingMock: the calls of the methods that have neither
+// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+func (m *BillingMock) MinimockSetLenient(enabled bool) *BillingMock {
+	m.state.SetLenient(enabled)
+	return m
+}
+
+// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (m *BillingMock) MinimockLenientCalls() map[string]uint64 {
+	return m.state.LenientCalls()
+}
+
+// MinimockAssertNotCalled fails the test without stopping it if any of the BillingMock methods with the given names has been called,
+// it doesn't depend on the expectations and the functions set up for the methods
+func (m *BillingMock) MinimockAssertNotCalled(methodNames ...string) {
+	m.state.AssertNotCalled(methodNames...)
+}
+
+// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+// queued by ReturnOnce of all BillingMock methods, the functions set by Set and Inspect are kept.
+// It fails the test if any of the methods is being called
+func (m *BillingMock) MinimockReset() {
+	m.state.Reset(
+		m.InvoiceMock.reset,
+	)
+}
+
+// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+func (m *BillingMock) MinimockResetAll() {
+	m.MinimockReset()
+	m.InvoiceMock.state.Mutex.Lock()
+	m.funcInvoice = nil
+	m.InvoiceMock.inspectInvoice = nil
+	m.InvoiceMock.state.Mutex.Unlock()
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *BillingMock) MinimockFinish() {
+	m.state.Finish(m.minimockDone,
+		m.MinimockInvoiceInspect,
+	)
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *BillingMock) MinimockWait(timeout mm_time.Duration) {
+	m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
+}
+
+func (m *BillingMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockInvoiceDone()
+}
@@ -0,0 +1,20 @@
+package tests
+
+import (
+	"testing"
+
+	billingtypes "github.com/gojuno/minimock/tests/billing/types"
+	"github.com/gojuno/minimock/tests/dotimport"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBillingMock_DotImportedTypes(t *testing.T) {
+	billingMock := NewBillingMock(t).InvoiceMock.Expect(1).Return(&billingtypes.Invoice{Amount: 10}, nil)
+	defer billingMock.MinimockFinish()
+
+	var billing dotimport.Billing = billingMock
+
+	invoice, err := billing.Invoice(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, invoice.Amount)
+}
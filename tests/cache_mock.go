@@ -0,0 +1,1367 @@
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+package tests
+
+//go:generate minimock -i github.com/gojuno/minimock/tests.Cache -o ./cache_mock.go
+
+import (
+	mm_sync "sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock"
+)
+
+// CacheMock implements Cache
+//
+// Cache interface is used to test mocks of the interfaces which methods have the same names as the mock members
+type CacheMock struct {
+	state    minimock.MockState
+	mutex    mm_sync.RWMutex
+	delegate Cache
+
+	funcGet         func(key string) (s1 string)
+	MinimockGetMock *mCacheMockGet
+
+	funcGetAfterCounter func() (u1 uint64)
+	GetAfterCounterMock *mCacheMockGetAfterCounter
+
+	funcGetMock func() (s1 string)
+	GetMockMock *mCacheMockGetMock
+}
+
+var _ Cache = (*CacheMock)(nil)
+
+// NewCacheMock returns a mock for Cache
+func NewCacheMock(t minimock.Tester) *CacheMock {
+	m := &CacheMock{}
+	m.state.Init(m, t, "CacheMock", 0)
+
+	m.MinimockGetMock = &mCacheMockGet{mock: m}
+	m.MinimockGetMock.state.Init(&m.state, "Get")
+
+	m.GetAfterCounterMock = &mCacheMockGetAfterCounter{mock: m}
+	m.GetAfterCounterMock.state.Init(&m.state, "GetAfterCounter")
+
+	m.GetMockMock = &mCacheMockGetMock{mock: m}
+	m.GetMockMock.state.Init(&m.state, "GetMock")
+
+	return m
+}
+
+type mCacheMockGet struct {
+	mock               *CacheMock
+	state              minimock.MethodState
+	defaultExpectation *CacheMockGetExpectation
+	expectations       []*CacheMockGetExpectation
+	inspectGet         func(key string)
+	compare            minimock.Comparer
+	calls              []CacheMockGetParams
+	called             chan CacheMockGetParams
+	queued             []*CacheMockGetResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*CacheMockGetResults
+}
+
+// CacheMockGetExpectation specifies expectation struct of the Cache.Get
+type CacheMockGetExpectation struct {
+	mock     *CacheMock
+	params   *CacheMockGetParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *CacheMockGetResults
+	Counter  uint64
+}
+
+// CacheMockGetParams contains parameters of the Cache.Get
+type CacheMockGetParams struct {
+	Key string
+}
+
+// CacheMockGetResults contains results of the Cache.Get
+type CacheMockGetResults struct {
+	R0 string
+}
+
+// Expect sets up expected params for Cache.Get
+func (mmGet *mCacheMockGet) Expect(key string) *mCacheMockGet {
+	if _, mm_func := mmGet.current(); mm_func != nil {
+		mmGet.mock.state.T().Fatalf("CacheMock.Get mock is already set by Set")
+	}
+
+	mm_params := &CacheMockGetParams{key}
+	mmGet.updateDefault(func(e *CacheMockGetExpectation) {
+		if e.partial {
+			mmGet.mock.state.T().Fatalf("CacheMock.Get params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmGet.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmGet.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmGet
+}
+
+// updateDefault replaces the default expectation of Cache.Get by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmGet *mCacheMockGet) updateDefault(update func(e *CacheMockGetExpectation)) {
+	mmGet.state.Mutex.Lock()
+	defer mmGet.state.Mutex.Unlock()
+
+	e := &CacheMockGetExpectation{mock: mmGet.mock}
+	if previous := mmGet.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmGet.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Cache.Get
+func (mmGet *mCacheMockGet) current() (*CacheMockGetExpectation, func(key string) (s1 string)) {
+	mmGet.state.Mutex.RLock()
+	defer mmGet.state.Mutex.RUnlock()
+
+	return mmGet.defaultExpectation, mmGet.mock.funcGet
+}
+
+// partialParams updates the default expectation of Cache.Get by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmGet *mCacheMockGet) partialParams(update func(e *CacheMockGetExpectation)) {
+	if _, mm_func := mmGet.current(); mm_func != nil {
+		mmGet.mock.state.T().Fatalf("CacheMock.Get mock is already set by Set")
+	}
+
+	mmGet.updateDefault(func(e *CacheMockGetExpectation) {
+		if e.params == nil {
+			e.params = &CacheMockGetParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"Key": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectKeyParam1 sets up the expected value of the param #1 of Cache.Get,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmGet *mCacheMockGet) ExpectKeyParam1(Key string) *mCacheMockGet {
+	mmGet.partialParams(func(e *CacheMockGetExpectation) {
+		e.params.Key = Key
+		delete(e.matchers, "Key")
+	})
+	return mmGet
+}
+
+// MatchKeyParam1 sets up the predicate matching the param #1 of Cache.Get,
+// it's used instead of the value of the param set by Expect
+func (mmGet *mCacheMockGet) MatchKeyParam1(f func(got string) bool) *mCacheMockGet {
+	mm_matcher := minimock.Predicate("predicate func(got string) bool", func(v interface{}) bool {
+		got, _ := v.(string)
+		return f(got)
+	})
+	mmGet.partialParams(func(e *CacheMockGetExpectation) {
+		e.matchers["Key"] = mm_matcher
+	})
+	return mmGet
+}
+
+// Return sets up results that will be returned by Cache.Get
+func (mmGet *mCacheMockGet) Return(s1 string) *CacheMock {
+	if _, mm_func := mmGet.current(); mm_func != nil {
+		mmGet.mock.state.T().Fatalf("CacheMock.Get mock is already set by Set")
+	}
+
+	mm_results := &CacheMockGetResults{s1}
+	mmGet.updateDefault(func(e *CacheMockGetExpectation) { e.results = mm_results })
+
+	return mmGet.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Cache.Get,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmGet *mCacheMockGet) ReturnOnce(s1 string) *mCacheMockGet {
+	mmGet.state.Mutex.Lock()
+	mmGet.queued = append(mmGet.queued, &CacheMockGetResults{s1})
+	mmGet.state.Mutex.Unlock()
+	mmGet.state.PushResults()
+	return mmGet
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmGet *mCacheMockGet) popResults() *CacheMockGetResults {
+	mm_i, mm_ok := mmGet.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmGet.state.Mutex.RLock()
+	defer mmGet.state.Mutex.RUnlock()
+
+	return mmGet.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of Cache.Get instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmGet *mCacheMockGet) SetComparer(compare minimock.Comparer) *mCacheMockGet {
+	mmGet.state.Mutex.Lock()
+	mmGet.compare = compare
+	mmGet.state.Mutex.Unlock()
+	return mmGet
+}
+
+// whenExpectations returns the expectations of Cache.Get set by When,
+// the expectations can be set while the method is called concurrently
+func (mmGet *mCacheMockGet) whenExpectations() []*CacheMockGetExpectation {
+	mmGet.state.Mutex.RLock()
+	defer mmGet.state.Mutex.RUnlock()
+
+	return mmGet.expectations
+}
+
+// Inspect sets up the function called with the params of every Cache.Get call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmGet *mCacheMockGet) Inspect(f func(key string)) *mCacheMockGet {
+	mmGet.state.Mutex.Lock()
+	mmGet.inspectGet = f
+	mmGet.state.Mutex.Unlock()
+	return mmGet
+}
+
+// expected returns everything set up for the Cache.Get call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmGet *mCacheMockGet) expected() (*CacheMockGetExpectation, func(key string) (s1 string), func(key string), minimock.Comparer, []*CacheMockGetExpectation, []*CacheMockGetResults) {
+	mmGet.state.Mutex.RLock()
+	defer mmGet.state.Mutex.RUnlock()
+
+	return mmGet.defaultExpectation, mmGet.mock.funcGet, mmGet.inspectGet, mmGet.compare, mmGet.expectations, mmGet.whenResults
+}
+
+// setup returns the expectations and the function set up for Cache.Get to be checked when the mock is finished
+func (mmGet *mCacheMockGet) setup() (setup minimock.Setup) {
+	mmGet.state.Mutex.RLock()
+	defer mmGet.state.Mutex.RUnlock()
+
+	setup.Func = mmGet.mock.funcGet != nil
+	if e := mmGet.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmGet.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Cache.Get, the params of the calls are removed from the history as well
+func (mmGet *mCacheMockGet) reset() {
+	mmGet.state.Mutex.Lock()
+	mmGet.defaultExpectation = nil
+	mmGet.expectations = nil
+	mmGet.queued = nil
+	mmGet.whenResults = nil
+	mmGet.state.Mutex.Unlock()
+
+	mmGet.state.History.Lock()
+	mmGet.calls = nil
+	mmGet.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmGet *mCacheMockGet) MethodName() string {
+	return mmGet.state.Name()
+}
+
+// CallSequence returns the numbers of the Cache.Get calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmGet *mCacheMockGet) CallSequence() []uint64 {
+	return mmGet.state.CallSequence()
+}
+
+// WaitForCalls waits until Cache.Get is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmGet *mCacheMockGet) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmGet.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Cache.Get calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmGet *mCacheMockGet) Block() (release func()) {
+	return mmGet.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Cache.Get calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmGet *mCacheMockGet) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmGet.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Cache.Get calls are in flight at once
+func (mmGet *mCacheMockGet) LimitConcurrency(n int) *mCacheMockGet {
+	mmGet.state.SetLimit(n)
+	return mmGet
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Cache.Get call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmGet *mCacheMockGet) CaptureCalls(buffer int) *mCacheMockGet {
+	mmGet.state.History.Lock()
+	mmGet.called = make(chan CacheMockGetParams, buffer)
+	mmGet.state.History.Unlock()
+	return mmGet
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Cache.Get call
+func (mmGet *mCacheMockGet) Called() <-chan CacheMockGetParams {
+	mmGet.state.History.Lock()
+	defer mmGet.state.History.Unlock()
+
+	if mmGet.called == nil {
+		mmGet.mock.state.T().Fatalf("Calls of CacheMock.Get aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmGet.called
+}
+
+// DroppedCalls returns the number of the Cache.Get calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmGet *mCacheMockGet) DroppedCalls() uint64 {
+	return mmGet.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Cache.Get calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmGet *mCacheMockGet) Times(n uint64) *mCacheMockGet {
+	mmGet.state.SetTimes(n)
+	return mmGet
+}
+
+// Optional excludes Cache.Get from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmGet *mCacheMockGet) Optional() *mCacheMockGet {
+	mmGet.state.SetOptional()
+	return mmGet
+}
+
+// Set uses given function f to mock the Cache.Get method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmGet *mCacheMockGet) Set(f func(key string) (s1 string)) *CacheMock {
+	mmGet.state.Mutex.Lock()
+	defer mmGet.state.Mutex.Unlock()
+
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.state.T().Fatalf("Default expectation is already set for the Cache.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.state.T().Fatalf("Some expectations are already set for the Cache.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	return mmGet.mock
+}
+
+// When sets expectation for the Cache.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mCacheMockGet) When(key string) *CacheMockGetExpectation {
+	if _, mm_func := mmGet.current(); mm_func != nil {
+		mmGet.mock.state.T().Fatalf("CacheMock.Get mock is already set by Set")
+	}
+
+	expectation := &CacheMockGetExpectation{
+		mock:   mmGet.mock,
+		params: &CacheMockGetParams{key},
+	}
+	mmGet.state.Mutex.Lock()
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	mmGet.whenResults = append(mmGet.whenResults, nil)
+	mmGet.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up Cache.Get return parameters for the expectation previously defined by the When method
+func (mmExpectation *CacheMockGetExpectation) Then(s1 string) *CacheMock {
+	mm_handle := mmExpectation.mock.MinimockGetMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &CacheMockGetResults{s1}
+	mm_results := make([]*CacheMockGetResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Get implements Cache
+func (mmGet *CacheMock) Get(key string) (s1 string) {
+	mm_method := mmGet.MinimockGetMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := CacheMockGetParams{key}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcGet, mm_inspectGet, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectGet != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectGet(key)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).R0
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcGet == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcGet != nil {
+		return mm_funcGet(key)
+	}
+	if mm_delegate := mmGet.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Get(key)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, key)
+	return
+}
+
+// MinimockGetAfterCounter returns a count of finished CacheMock.Get invocations
+func (mmGet *CacheMock) MinimockGetAfterCounter() uint64 {
+	return mmGet.MinimockGetMock.state.AfterCalls()
+}
+
+// GetBeforeCounter returns a count of CacheMock.Get invocations
+func (mmGet *CacheMock) GetBeforeCounter() uint64 {
+	return mmGet.MinimockGetMock.state.BeforeCalls()
+}
+
+// GetCalls returns the params of all CacheMock.Get calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmGet *CacheMock) GetCalls() []CacheMockGetParams {
+	mmGet.MinimockGetMock.state.History.Lock()
+	defer mmGet.MinimockGetMock.state.History.Unlock()
+
+	calls := make([]CacheMockGetParams, len(mmGet.MinimockGetMock.calls))
+	copy(calls, mmGet.MinimockGetMock.calls)
+	return calls
+}
+
+// GetLastParams returns the params of the latest CacheMock.Get call and false if there were no calls
+func (mmGet *CacheMock) GetLastParams() (params CacheMockGetParams, ok bool) {
+	mmGet.MinimockGetMock.state.History.Lock()
+	defer mmGet.MinimockGetMock.state.History.Unlock()
+
+	if n := len(mmGet.MinimockGetMock.calls); n > 0 {
+		return mmGet.MinimockGetMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// GetCallTimes returns the times of all CacheMock.Get calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmGet *CacheMock) GetCallTimes() []mm_time.Time {
+	return mmGet.MinimockGetMock.state.CallTimes()
+}
+
+// GetMaxInFlight returns the maximum number of the CacheMock.Get calls that have been in flight at once
+func (mmGet *CacheMock) GetMaxInFlight() int {
+	return mmGet.MinimockGetMock.state.MaxInFlight()
+}
+
+// GetUnexpectedCounter returns a count of CacheMock.Get invocations made without an implementation
+func (mmGet *CacheMock) GetUnexpectedCounter() uint64 {
+	return mmGet.MinimockGetMock.state.UnexpectedCalls()
+}
+
+// GetNotCalled returns true if CacheMock.Get hasn't been called
+func (mmGet *CacheMock) GetNotCalled() bool {
+	return mmGet.MinimockGetMock.state.BeforeCalls() == 0
+}
+
+// GetCallCount returns a count of CacheMock.Get invocations, it's the same as GetBeforeCounter
+func (mmGet *CacheMock) GetCallCount() uint64 {
+	return mmGet.MinimockGetMock.state.BeforeCalls()
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (mmGet *CacheMock) MinimockGetDone() bool {
+	return mmGet.MinimockGetMock.state.Done(mmGet.MinimockGetMock.setup())
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (mmGet *CacheMock) MinimockGetInspect() {
+	mmGet.MinimockGetMock.state.Inspect(mmGet.MinimockGetMock.setup())
+}
+
+type mCacheMockGetAfterCounter struct {
+	mock                   *CacheMock
+	state                  minimock.MethodState
+	defaultExpectation     *CacheMockGetAfterCounterExpectation
+	expectations           []*CacheMockGetAfterCounterExpectation
+	inspectGetAfterCounter func()
+	called                 chan struct{}
+	queued                 []*CacheMockGetAfterCounterResults
+}
+
+// CacheMockGetAfterCounterExpectation specifies expectation struct of the Cache.GetAfterCounter
+type CacheMockGetAfterCounterExpectation struct {
+	mock *CacheMock
+
+	results *CacheMockGetAfterCounterResults
+	Counter uint64
+}
+
+// CacheMockGetAfterCounterResults contains results of the Cache.GetAfterCounter
+type CacheMockGetAfterCounterResults struct {
+	R0 uint64
+}
+
+// Expect sets up expected params for Cache.GetAfterCounter
+func (mmGetAfterCounter *mCacheMockGetAfterCounter) Expect() *mCacheMockGetAfterCounter {
+	if _, mm_func := mmGetAfterCounter.current(); mm_func != nil {
+		mmGetAfterCounter.mock.state.T().Fatalf("CacheMock.GetAfterCounter mock is already set by Set")
+	}
+
+	mmGetAfterCounter.updateDefault(func(*CacheMockGetAfterCounterExpectation) {})
+
+	return mmGetAfterCounter
+}
+
+// updateDefault replaces the default expectation of Cache.GetAfterCounter by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmGetAfterCounter *mCacheMockGetAfterCounter) updateDefault(update func(e *CacheMockGetAfterCounterExpectation)) {
+	mmGetAfterCounter.state.Mutex.Lock()
+	defer mmGetAfterCounter.state.Mutex.Unlock()
+
+	e := &CacheMockGetAfterCounterExpectation{mock: mmGetAfterCounter.mock}
+	if previous := mmGetAfterCounter.defaultExpectation; previous != nil {
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmGetAfterCounter.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Cache.GetAfterCounter
+func (mmGetAfterCounter *mCacheMockGetAfterCounter) current() (*CacheMockGetAfterCounterExpectation, func() (u1 uint64)) {
+	mmGetAfterCounter.state.Mutex.RLock()
+	defer mmGetAfterCounter.state.Mutex.RUnlock()
+
+	return mmGetAfterCounter.defaultExpectation, mmGetAfterCounter.mock.funcGetAfterCounter
+}
+
+// Return sets up results that will be returned by Cache.GetAfterCounter
+func (mmGetAfterCounter *mCacheMockGetAfterCounter) Return(u1 uint64) *CacheMock {
+	if _, mm_func := mmGetAfterCounter.current(); mm_func != nil {
+		mmGetAfterCounter.mock.state.T().Fatalf("CacheMock.GetAfterCounter mock is already set by Set")
+	}
+
+	mm_results := &CacheMockGetAfterCounterResults{u1}
+	mmGetAfterCounter.updateDefault(func(e *CacheMockGetAfterCounterExpectation) { e.results = mm_results })
+
+	return mmGetAfterCounter.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Cache.GetAfterCounter,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmGetAfterCounter *mCacheMockGetAfterCounter) ReturnOnce(u1 uint64) *mCacheMockGetAfterCounter {
+	mmGetAfterCounter.state.Mutex.Lock()
+	mmGetAfterCounter.queued = append(mmGetAfterCounter.queued, &CacheMockGetAfterCounterResults{u1})
+	mmGetAfterCounter.state.Mutex.Unlock()
+	mmGetAfterCounter.state.PushResults()
+	return mmGetAfterCounter
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmGetAfterCounter *mCacheMockGetAfterCounter) popResults() *CacheMockGetAfterCounterResults {
+	mm_i, mm_ok := mmGetAfterCounter.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmGetAfterCounter.state.Mutex.RLock()
+	defer mmGetAfterCounter.state.Mutex.RUnlock()
+
+	return mmGetAfterCounter.queued[mm_i]
+}
+
+// Inspect sets up the function called with the params of every Cache.GetAfterCounter call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmGetAfterCounter *mCacheMockGetAfterCounter) Inspect(f func()) *mCacheMockGetAfterCounter {
+	mmGetAfterCounter.state.Mutex.Lock()
+	mmGetAfterCounter.inspectGetAfterCounter = f
+	mmGetAfterCounter.state.Mutex.Unlock()
+	return mmGetAfterCounter
+}
+
+// expected returns everything set up for the Cache.GetAfterCounter call under a single read lock: the default expectation,
+// the functions set by Set and Inspect
+func (mmGetAfterCounter *mCacheMockGetAfterCounter) expected() (*CacheMockGetAfterCounterExpectation, func() (u1 uint64), func()) {
+	mmGetAfterCounter.state.Mutex.RLock()
+	defer mmGetAfterCounter.state.Mutex.RUnlock()
+
+	return mmGetAfterCounter.defaultExpectation, mmGetAfterCounter.mock.funcGetAfterCounter, mmGetAfterCounter.inspectGetAfterCounter
+}
+
+// setup returns the expectations and the function set up for Cache.GetAfterCounter to be checked when the mock is finished
+func (mmGetAfterCounter *mCacheMockGetAfterCounter) setup() (setup minimock.Setup) {
+	mmGetAfterCounter.state.Mutex.RLock()
+	defer mmGetAfterCounter.state.Mutex.RUnlock()
+
+	setup.Func = mmGetAfterCounter.mock.funcGetAfterCounter != nil
+	if e := mmGetAfterCounter.defaultExpectation; e != nil {
+		setup.Default = true
+	}
+
+	for _, e := range mmGetAfterCounter.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Cache.GetAfterCounter
+func (mmGetAfterCounter *mCacheMockGetAfterCounter) reset() {
+	mmGetAfterCounter.state.Mutex.Lock()
+	mmGetAfterCounter.defaultExpectation = nil
+	mmGetAfterCounter.expectations = nil
+	mmGetAfterCounter.queued = nil
+	mmGetAfterCounter.state.Mutex.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmGetAfterCounter *mCacheMockGetAfterCounter) MethodName() string {
+	return mmGetAfterCounter.state.Name()
+}
+
+// CallSequence returns the numbers of the Cache.GetAfterCounter calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmGetAfterCounter *mCacheMockGetAfterCounter) CallSequence() []uint64 {
+	return mmGetAfterCounter.state.CallSequence()
+}
+
+// WaitForCalls waits until Cache.GetAfterCounter is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmGetAfterCounter *mCacheMockGetAfterCounter) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmGetAfterCounter.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Cache.GetAfterCounter calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmGetAfterCounter *mCacheMockGetAfterCounter) Block() (release func()) {
+	return mmGetAfterCounter.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Cache.GetAfterCounter calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmGetAfterCounter *mCacheMockGetAfterCounter) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmGetAfterCounter.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Cache.GetAfterCounter calls are in flight at once
+func (mmGetAfterCounter *mCacheMockGetAfterCounter) LimitConcurrency(n int) *mCacheMockGetAfterCounter {
+	mmGetAfterCounter.state.SetLimit(n)
+	return mmGetAfterCounter
+}
+
+// CaptureCalls creates the buffered channel receiving the signal of each Cache.GetAfterCounter call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmGetAfterCounter *mCacheMockGetAfterCounter) CaptureCalls(buffer int) *mCacheMockGetAfterCounter {
+	mmGetAfterCounter.state.History.Lock()
+	mmGetAfterCounter.called = make(chan struct{}, buffer)
+	mmGetAfterCounter.state.History.Unlock()
+	return mmGetAfterCounter
+}
+
+// Called returns the channel set up by CaptureCalls receiving the signal of each Cache.GetAfterCounter call
+func (mmGetAfterCounter *mCacheMockGetAfterCounter) Called() <-chan struct{} {
+	mmGetAfterCounter.state.History.Lock()
+	defer mmGetAfterCounter.state.History.Unlock()
+
+	if mmGetAfterCounter.called == nil {
+		mmGetAfterCounter.mock.state.T().Fatalf("Calls of CacheMock.GetAfterCounter aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmGetAfterCounter.called
+}
+
+// DroppedCalls returns the number of the Cache.GetAfterCounter calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmGetAfterCounter *mCacheMockGetAfterCounter) DroppedCalls() uint64 {
+	return mmGetAfterCounter.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Cache.GetAfterCounter calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmGetAfterCounter *mCacheMockGetAfterCounter) Times(n uint64) *mCacheMockGetAfterCounter {
+	mmGetAfterCounter.state.SetTimes(n)
+	return mmGetAfterCounter
+}
+
+// Optional excludes Cache.GetAfterCounter from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmGetAfterCounter *mCacheMockGetAfterCounter) Optional() *mCacheMockGetAfterCounter {
+	mmGetAfterCounter.state.SetOptional()
+	return mmGetAfterCounter
+}
+
+// Set uses given function f to mock the Cache.GetAfterCounter method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmGetAfterCounter *mCacheMockGetAfterCounter) Set(f func() (u1 uint64)) *CacheMock {
+	mmGetAfterCounter.state.Mutex.Lock()
+	defer mmGetAfterCounter.state.Mutex.Unlock()
+
+	if mmGetAfterCounter.defaultExpectation != nil {
+		mmGetAfterCounter.mock.state.T().Fatalf("Default expectation is already set for the Cache.GetAfterCounter method")
+	}
+
+	if len(mmGetAfterCounter.expectations) > 0 {
+		mmGetAfterCounter.mock.state.T().Fatalf("Some expectations are already set for the Cache.GetAfterCounter method")
+	}
+
+	mmGetAfterCounter.mock.funcGetAfterCounter = f
+	return mmGetAfterCounter.mock
+}
+
+// GetAfterCounter implements Cache
+func (mmGetAfterCounter *CacheMock) GetAfterCounter() (u1 uint64) {
+	mm_method := mmGetAfterCounter.GetAfterCounterMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_method.state.Record()
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- struct{}{}:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcGetAfterCounter, mm_inspectGetAfterCounter := mm_method.expected()
+	if mm_inspectGetAfterCounter != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectGetAfterCounter()
+		}()
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcGetAfterCounter == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, nil) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcGetAfterCounter != nil {
+		return mm_funcGetAfterCounter()
+	}
+	if mm_delegate := mmGetAfterCounter.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.GetAfterCounter()
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(nil)
+	return
+}
+
+// GetAfterCounterAfterCounter returns a count of finished CacheMock.GetAfterCounter invocations
+func (mmGetAfterCounter *CacheMock) GetAfterCounterAfterCounter() uint64 {
+	return mmGetAfterCounter.GetAfterCounterMock.state.AfterCalls()
+}
+
+// GetAfterCounterBeforeCounter returns a count of CacheMock.GetAfterCounter invocations
+func (mmGetAfterCounter *CacheMock) GetAfterCounterBeforeCounter() uint64 {
+	return mmGetAfterCounter.GetAfterCounterMock.state.BeforeCalls()
+}
+
+// GetAfterCounterCallTimes returns the times of all CacheMock.GetAfterCounter calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmGetAfterCounter *CacheMock) GetAfterCounterCallTimes() []mm_time.Time {
+	return mmGetAfterCounter.GetAfterCounterMock.state.CallTimes()
+}
+
+// GetAfterCounterMaxInFlight returns the maximum number of the CacheMock.GetAfterCounter calls that have been in flight at once
+func (mmGetAfterCounter *CacheMock) GetAfterCounterMaxInFlight() int {
+	return mmGetAfterCounter.GetAfterCounterMock.state.MaxInFlight()
+}
+
+// GetAfterCounterUnexpectedCounter returns a count of CacheMock.GetAfterCounter invocations made without an implementation
+func (mmGetAfterCounter *CacheMock) GetAfterCounterUnexpectedCounter() uint64 {
+	return mmGetAfterCounter.GetAfterCounterMock.state.UnexpectedCalls()
+}
+
+// GetAfterCounterNotCalled returns true if CacheMock.GetAfterCounter hasn't been called
+func (mmGetAfterCounter *CacheMock) GetAfterCounterNotCalled() bool {
+	return mmGetAfterCounter.GetAfterCounterMock.state.BeforeCalls() == 0
+}
+
+// GetAfterCounterCallCount returns a count of CacheMock.GetAfterCounter invocations, it's the same as GetAfterCounterBeforeCounter
+func (mmGetAfterCounter *CacheMock) GetAfterCounterCallCount() uint64 {
+	return mmGetAfterCounter.GetAfterCounterMock.state.BeforeCalls()
+}
+
+// MinimockGetAfterCounterDone returns true if the count of the GetAfterCounter invocations corresponds
+// the number of defined expectations
+func (mmGetAfterCounter *CacheMock) MinimockGetAfterCounterDone() bool {
+	return mmGetAfterCounter.GetAfterCounterMock.state.Done(mmGetAfterCounter.GetAfterCounterMock.setup())
+}
+
+// MinimockGetAfterCounterInspect logs each unmet expectation
+func (mmGetAfterCounter *CacheMock) MinimockGetAfterCounterInspect() {
+	mmGetAfterCounter.GetAfterCounterMock.state.Inspect(mmGetAfterCounter.GetAfterCounterMock.setup())
+}
+
+type mCacheMockGetMock struct {
+	mock               *CacheMock
+	state              minimock.MethodState
+	defaultExpectation *CacheMockGetMockExpectation
+	expectations       []*CacheMockGetMockExpectation
+	inspectGetMock     func()
+	called             chan struct{}
+	queued             []*CacheMockGetMockResults
+}
+
+// CacheMockGetMockExpectation specifies expectation struct of the Cache.GetMock
+type CacheMockGetMockExpectation struct {
+	mock *CacheMock
+
+	results *CacheMockGetMockResults
+	Counter uint64
+}
+
+// CacheMockGetMockResults contains results of the Cache.GetMock
+type CacheMockGetMockResults struct {
+	R0 string
+}
+
+// Expect sets up expected params for Cache.GetMock
+func (mmGetMock *mCacheMockGetMock) Expect() *mCacheMockGetMock {
+	if _, mm_func := mmGetMock.current(); mm_func != nil {
+		mmGetMock.mock.state.T().Fatalf("CacheMock.GetMock mock is already set by Set")
+	}
+
+	mmGetMock.updateDefault(func(*CacheMockGetMockExpectation) {})
+
+	return mmGetMock
+}
+
+// updateDefault replaces the default expectation of Cache.GetMock by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmGetMock *mCacheMockGetMock) updateDefault(update func(e *CacheMockGetMockExpectation)) {
+	mmGetMock.state.Mutex.Lock()
+	defer mmGetMock.state.Mutex.Unlock()
+
+	e := &CacheMockGetMockExpectation{mock: mmGetMock.mock}
+	if previous := mmGetMock.defaultExpectation; previous != nil {
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmGetMock.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Cache.GetMock
+func (mmGetMock *mCacheMockGetMock) current() (*CacheMockGetMockExpectation, func() (s1 string)) {
+	mmGetMock.state.Mutex.RLock()
+	defer mmGetMock.state.Mutex.RUnlock()
+
+	return mmGetMock.defaultExpectation, mmGetMock.mock.funcGetMock
+}
+
+// Return sets up results that will be returned by Cache.GetMock
+func (mmGetMock *mCacheMockGetMock) Return(s1 string) *CacheMock {
+	if _, mm_func := mmGetMock.current(); mm_func != nil {
+		mmGetMock.mock.state.T().Fatalf("CacheMock.GetMock mock is already set by Set")
+	}
+
+	mm_results := &CacheMockGetMockResults{s1}
+	mmGetMock.updateDefault(func(e *CacheMockGetMockExpectation) { e.results = mm_results })
+
+	return mmGetMock.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Cache.GetMock,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmGetMock *mCacheMockGetMock) ReturnOnce(s1 string) *mCacheMockGetMock {
+	mmGetMock.state.Mutex.Lock()
+	mmGetMock.queued = append(mmGetMock.queued, &CacheMockGetMockResults{s1})
+	mmGetMock.state.Mutex.Unlock()
+	mmGetMock.state.PushResults()
+	return mmGetMock
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmGetMock *mCacheMockGetMock) popResults() *CacheMockGetMockResults {
+	mm_i, mm_ok := mmGetMock.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmGetMock.state.Mutex.RLock()
+	defer mmGetMock.state.Mutex.RUnlock()
+
+	return mmGetMock.queued[mm_i]
+}
+
+// Inspect sets up the function called with the params of every Cache.GetMock call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmGetMock *mCacheMockGetMock) Inspect(f func()) *mCacheMockGetMock {
+	mmGetMock.state.Mutex.Lock()
+	mmGetMock.inspectGetMock = f
+	mmGetMock.state.Mutex.Unlock()
+	return mmGetMock
+}
+
+// expected returns everything set up for the Cache.GetMock call under a single read lock: the default expectation,
+// the functions set by Set and Inspect
+func (mmGetMock *mCacheMockGetMock) expected() (*CacheMockGetMockExpectation, func() (s1 string), func()) {
+	mmGetMock.state.Mutex.RLock()
+	defer mmGetMock.state.Mutex.RUnlock()
+
+	return mmGetMock.defaultExpectation, mmGetMock.mock.funcGetMock, mmGetMock.inspectGetMock
+}
+
+// setup returns the expectations and the function set up for Cache.GetMock to be checked when the mock is finished
+func (mmGetMock *mCacheMockGetMock) setup() (setup minimock.Setup) {
+	mmGetMock.state.Mutex.RLock()
+	defer mmGetMock.state.Mutex.RUnlock()
+
+	setup.Func = mmGetMock.mock.funcGetMock != nil
+	if e := mmGetMock.defaultExpectation; e != nil {
+		setup.Default = true
+	}
+
+	for _, e := range mmGetMock.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Cache.GetMock
+func (mmGetMock *mCacheMockGetMock) reset() {
+	mmGetMock.state.Mutex.Lock()
+	mmGetMock.defaultExpectation = nil
+	mmGetMock.expectations = nil
+	mmGetMock.queued = nil
+	mmGetMock.state.Mutex.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmGetMock *mCacheMockGetMock) MethodName() string {
+	return mmGetMock.state.Name()
+}
+
+// CallSequence returns the numbers of the Cache.GetMock calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmGetMock *mCacheMockGetMock) CallSequence() []uint64 {
+	return mmGetMock.state.CallSequence()
+}
+
+// WaitForCalls waits until Cache.GetMock is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmGetMock *mCacheMockGetMock) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmGetMock.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Cache.GetMock calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmGetMock *mCacheMockGetMock) Block() (release func()) {
+	return mmGetMock.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Cache.GetMock calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmGetMock *mCacheMockGetMock) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmGetMock.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Cache.GetMock calls are in flight at once
+func (mmGetMock *mCacheMockGetMock) LimitConcurrency(n int) *mCacheMockGetMock {
+	mmGetMock.state.SetLimit(n)
+	return mmGetMock
+}
+
+// CaptureCalls creates the buffered channel receiving the signal of each Cache.GetMock call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmGetMock *mCacheMockGetMock) CaptureCalls(buffer int) *mCacheMockGetMock {
+	mmGetMock.state.History.Lock()
+	mmGetMock.called = make(chan struct{}, buffer)
+	mmGetMock.state.History.Unlock()
+	return mmGetMock
+}
+
+// Called returns the channel set up by CaptureCalls receiving the signal of each Cache.GetMock call
+func (mmGetMock *mCacheMockGetMock) Called() <-chan struct{} {
+	mmGetMock.state.History.Lock()
+	defer mmGetMock.state.History.Unlock()
+
+	if mmGetMock.called == nil {
+		mmGetMock.mock.state.T().Fatalf("Calls of CacheMock.GetMock aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmGetMock.called
+}
+
+// DroppedCalls returns the number of the Cache.GetMock calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmGetMock *mCacheMockGetMock) DroppedCalls() uint64 {
+	return mmGetMock.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Cache.GetMock calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmGetMock *mCacheMockGetMock) Times(n uint64) *mCacheMockGetMock {
+	mmGetMock.state.SetTimes(n)
+	return mmGetMock
+}
+
+// Optional excludes Cache.GetMock from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmGetMock *mCacheMockGetMock) Optional() *mCacheMockGetMock {
+	mmGetMock.state.SetOptional()
+	return mmGetMock
+}
+
+// Set uses given function f to mock the Cache.GetMock method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmGetMock *mCacheMockGetMock) Set(f func() (s1 string)) *CacheMock {
+	mmGetMock.state.Mutex.Lock()
+	defer mmGetMock.state.Mutex.Unlock()
+
+	if mmGetMock.defaultExpectation != nil {
+		mmGetMock.mock.state.T().Fatalf("Default expectation is already set for the Cache.GetMock method")
+	}
+
+	if len(mmGetMock.expectations) > 0 {
+		mmGetMock.mock.state.T().Fatalf("Some expectations are already set for the Cache.GetMock method")
+	}
+
+	mmGetMock.mock.funcGetMock = f
+	return mmGetMock.mock
+}
+
+// GetMock implements Cache
+func (mmGetMock *CacheMock) GetMock() (s1 string) {
+	mm_method := mmGetMock.GetMockMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_method.state.Record()
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- struct{}{}:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcGetMock, mm_inspectGetMock := mm_method.expected()
+	if mm_inspectGetMock != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectGetMock()
+		}()
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcGetMock == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, nil) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcGetMock != nil {
+		return mm_funcGetMock()
+	}
+	if mm_delegate := mmGetMock.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.GetMock()
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(nil)
+	return
+}
+
+// GetMockAfterCounter returns a count of finished CacheMock.GetMock invocations
+func (mmGetMock *CacheMock) GetMockAfterCounter() uint64 {
+	return mmGetMock.GetMockMock.state.AfterCalls()
+}
+
+// GetMockBeforeCounter returns a count of CacheMock.GetMock invocations
+func (mmGetMock *CacheMock) GetMockBeforeCounter() uint64 {
+	return mmGetMock.GetMockMock.state.BeforeCalls()
+}
+
+// GetMockCallTimes returns the times of all CacheMock.GetMock calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmGetMock *CacheMock) GetMockCallTimes() []mm_time.Time {
+	return mmGetMock.GetMockMock.state.CallTimes()
+}
+
+// GetMockMaxInFlight returns the maximum number of the CacheMock.GetMock calls that have been in flight at once
+func (mmGetMock *CacheMock) GetMockMaxInFlight() int {
+	return mmGetMock.GetMockMock.state.MaxInFlight()
+}
+
+// GetMockUnexpectedCounter returns a count of CacheMock.GetMock invocations made without an implementation
+func (mmGetMock *CacheMock) GetMockUnexpectedCounter() uint64 {
+	return mmGetMock.GetMockMock.state.UnexpectedCalls()
+}
+
+// GetMockNotCalled returns true if CacheMock.GetMock hasn't been called
+func (mmGetMock *CacheMock) GetMockNotCalled() bool {
+	return mmGetMock.GetMockMock.state.BeforeCalls() == 0
+}
+
+// GetMockCallCount returns a count of CacheMock.GetMock invocations, it's the same as GetMockBeforeCounter
+func (mmGetMock *CacheMock) GetMockCallCount() uint64 {
+	return mmGetMock.GetMockMock.state.BeforeCalls()
+}
+
+// MinimockGetMockDone returns true if the count of the GetMock invocations corresponds
+// the number of defined expectations
+func (mmGetMock *CacheMock) MinimockGetMockDone() bool {
+	return mmGetMock.GetMockMock.state.Done(mmGetMock.GetMockMock.setup())
+}
+
+// MinimockGetMockInspect logs each unmet expectation
+func (mmGetMock *CacheMock) MinimockGetMockInspect() {
+	mmGetMock.GetMockMock.state.Inspect(mmGetMock.GetMockMock.setup())
+}
+
+// MinimockSetComparer sets up the function comparing the expected and the actual params of all CacheMock methods instead of minimock.Equal,
+// the params matched by the matchers aren't compared
+func (m *CacheMock) MinimockSetComparer(compare minimock.Comparer) *CacheMock {
+	m.state.SetComparer(compare)
+	return m
+}
+
+// MinimockSetSequence sets up the sequence numbering the CacheMock calls, the mocks sharing the sequence
+// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+func (m *CacheMock) MinimockSetSequence(sequence *minimock.Sequence) *CacheMock {
+	m.state.SetSequence(sequence)
+	return m
+}
+
+// MinimockSetClock sets up the function returning the current time for the history of CacheMock calls instead of time.Now
+func (m *CacheMock) MinimockSetClock(clock func() mm_time.Time) *CacheMock {
+	m.state.SetClock(clock)
+	return m
+}
+
+// MinimockSetAutoFinish enables or disables the check of CacheMock made by the Cleanup of the tester passed to NewCacheMock,
+// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+func (m *CacheMock) MinimockSetAutoFinish(enabled bool) *CacheMock {
+	m.state.SetAutoFinish(enabled)
+	return m
+}
+
+// MinimockSetDelegate sets up the implementation the calls of the CacheMock methods are forwarded to when the methods
+// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+func (m *CacheMock) MinimockSetDelegate(impl Cache) *CacheMock {
+	m.mutex.Lock()
+	m.delegate = impl
+	m.mutex.Unlock()
+	return m
+}
+
+func (m *CacheMock) minimockDelegate() Cache {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.delegate
+}
+
+// MinimockSetLenient enables or disables the lenient mode of CacheMock: the calls of the methods that have neither
+// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+func (m *CacheMock) MinimockSetLenient(enabled bool) *CacheMock {
+	m.state.SetLenient(enabled)
+	return m
+}
+
+// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (m *CacheMock) MinimockLenientCalls() map[string]uint64 {
+	return m.state.LenientCalls()
+}
+
+// MinimockAssertNotCalled fails the test without stopping it if any of the CacheMock methods with the given names has been called,
+// it doesn't depend on the expectations and the functions set up for the methods
+func (m *CacheMock) MinimockAssertNotCalled(methodNames ...string) {
+	m.state.AssertNotCalled(methodNames...)
+}
+
+// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+// queued by ReturnOnce of all CacheMock methods, the functions set by Set and Inspect are kept.
+// It fails the test if any of the methods is being called
+func (m *CacheMock) MinimockReset() {
+	m.state.Reset(
+		m.MinimockGetMock.reset,
+		m.GetAfterCounterMock.reset,
+		m.GetMockMock.reset,
+	)
+}
+
+// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+func (m *CacheMock) MinimockResetAll() {
+	m.MinimockReset()
+	m.MinimockGetMock.state.Mutex.Lock()
+	m.funcGet = nil
+	m.MinimockGetMock.inspectGet = nil
+	m.MinimockGetMock.state.Mutex.Unlock()
+	m.GetAfterCounterMock.state.Mutex.Lock()
+	m.funcGetAfterCounter = nil
+	m.GetAfterCounterMock.inspectGetAfterCounter = nil
+	m.GetAfterCounterMock.state.Mutex.Unlock()
+	m.GetMockMock.state.Mutex.Lock()
+	m.funcGetMock = nil
+	m.GetMockMock.inspectGetMock = nil
+	m.GetMockMock.state.Mutex.Unlock()
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CacheMock) MinimockFinish() {
+	m.state.Finish(m.minimockDone,
+		m.MinimockGetInspect,
+		m.MinimockGetAfterCounterInspect,
+		m.MinimockGetMockInspect,
+	)
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CacheMock) MinimockWait(timeout mm_time.Duration) {
+	m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
+}
+
+func (m *CacheMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetDone() &&
+		m.MinimockGetAfterCounterDone() &&
+		m.MinimockGetMockDone()
+}
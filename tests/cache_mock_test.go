@@ -0,0 +1,32 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheMock_MethodsNamedAsMockMembers(t *testing.T) {
+	cacheMock := NewCacheMock(t).
+		MinimockGetMock.Expect("key").Return("value").
+		GetMockMock.Return("mock").
+		GetAfterCounterMock.Return(1)
+	defer cacheMock.MinimockFinish()
+
+	var cache Cache = cacheMock
+
+	assert.Equal(t, "value", cache.Get("key"))
+	assert.Equal(t, "mock", cache.GetMock())
+	assert.Equal(t, uint64(1), cache.GetAfterCounter())
+	assert.Equal(t, uint64(1), cacheMock.MinimockGetAfterCounter())
+}
+
+func TestCacheMock_Calls(t *testing.T) {
+	cacheMock := NewCacheMock(t).MinimockGetMock.Return("value")
+	defer cacheMock.MinimockFinish()
+
+	cacheMock.Get("key")
+
+	assert.Equal(t, []CacheMockGetParams{{Key: "key"}}, cacheMock.GetCalls())
+	assert.Equal(t, uint64(1), cacheMock.GetCallCount())
+}
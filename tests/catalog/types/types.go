@@ -0,0 +1,7 @@
+// Package types is used to test mocks of the interfaces referring to several packages with the same name
+package types
+
+// Item is a catalog item
+type Item struct {
+	Price int
+}
@@ -0,0 +1,696 @@
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+package tests
+
+//go:generate minimock -i github.com/gojuno/minimock/tests.Checkout -o ./checkout_mock.go
+
+import (
+	mm_sync "sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock"
+	billingtypes "github.com/gojuno/minimock/tests/billing/types"
+	catalogtypes "github.com/gojuno/minimock/tests/catalog/types"
+	"github.com/gojuno/minimock/tests/shipping/types"
+)
+
+// CheckoutMock implements Checkout
+//
+// Checkout interface is used to test mocks of the interfaces referring to several packages with the same name
+type CheckoutMock struct {
+	state    minimock.MockState
+	mutex    mm_sync.RWMutex
+	delegate Checkout
+
+	funcPay func(invoice billingtypes.Invoice, items []catalogtypes.Item) (p1 types.Parcel, err error)
+	PayMock *mCheckoutMockPay
+}
+
+var _ Checkout = (*CheckoutMock)(nil)
+
+// NewCheckoutMock returns a mock for Checkout
+func NewCheckoutMock(t minimock.Tester) *CheckoutMock {
+	m := &CheckoutMock{}
+	m.state.Init(m, t, "CheckoutMock", 0)
+
+	m.PayMock = &mCheckoutMockPay{mock: m}
+	m.PayMock.state.Init(&m.state, "Pay")
+
+	return m
+}
+
+type mCheckoutMockPay struct {
+	mock               *CheckoutMock
+	state              minimock.MethodState
+	defaultExpectation *CheckoutMockPayExpectation
+	expectations       []*CheckoutMockPayExpectation
+	inspectPay         func(invoice billingtypes.Invoice, items []catalogtypes.Item)
+	compare            minimock.Comparer
+	calls              []CheckoutMockPayParams
+	called             chan CheckoutMockPayParams
+	queued             []*CheckoutMockPayResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*CheckoutMockPayResults
+}
+
+// CheckoutMockPayExpectation specifies expectation struct of the Checkout.Pay
+type CheckoutMockPayExpectation struct {
+	mock     *CheckoutMock
+	params   *CheckoutMockPayParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *CheckoutMockPayResults
+	Counter  uint64
+}
+
+// CheckoutMockPayParams contains parameters of the Checkout.Pay
+type CheckoutMockPayParams struct {
+	Invoice billingtypes.Invoice
+	Items   []catalogtypes.Item
+}
+
+// CheckoutMockPayResults contains results of the Checkout.Pay
+type CheckoutMockPayResults struct {
+	R0 types.Parcel
+	R1 error
+}
+
+// Expect sets up expected params for Checkout.Pay
+func (mmPay *mCheckoutMockPay) Expect(invoice billingtypes.Invoice, items []catalogtypes.Item) *mCheckoutMockPay {
+	if _, mm_func := mmPay.current(); mm_func != nil {
+		mmPay.mock.state.T().Fatalf("CheckoutMock.Pay mock is already set by Set")
+	}
+
+	mm_params := &CheckoutMockPayParams{invoice, items}
+	mmPay.updateDefault(func(e *CheckoutMockPayExpectation) {
+		if e.partial {
+			mmPay.mock.state.T().Fatalf("CheckoutMock.Pay params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmPay.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmPay.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmPay
+}
+
+// updateDefault replaces the default expectation of Checkout.Pay by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmPay *mCheckoutMockPay) updateDefault(update func(e *CheckoutMockPayExpectation)) {
+	mmPay.state.Mutex.Lock()
+	defer mmPay.state.Mutex.Unlock()
+
+	e := &CheckoutMockPayExpectation{mock: mmPay.mock}
+	if previous := mmPay.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmPay.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Checkout.Pay
+func (mmPay *mCheckoutMockPay) current() (*CheckoutMockPayExpectation, func(invoice billingtypes.Invoice, items []catalogtypes.Item) (p1 types.Parcel, err error)) {
+	mmPay.state.Mutex.RLock()
+	defer mmPay.state.Mutex.RUnlock()
+
+	return mmPay.defaultExpectation, mmPay.mock.funcPay
+}
+
+// partialParams updates the default expectation of Checkout.Pay by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmPay *mCheckoutMockPay) partialParams(update func(e *CheckoutMockPayExpectation)) {
+	if _, mm_func := mmPay.current(); mm_func != nil {
+		mmPay.mock.state.T().Fatalf("CheckoutMock.Pay mock is already set by Set")
+	}
+
+	mmPay.updateDefault(func(e *CheckoutMockPayExpectation) {
+		if e.params == nil {
+			e.params = &CheckoutMockPayParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"Invoice": minimock.Anything,
+				"Items":   minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectInvoiceParam1 sets up the expected value of the param #1 of Checkout.Pay,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmPay *mCheckoutMockPay) ExpectInvoiceParam1(Invoice billingtypes.Invoice) *mCheckoutMockPay {
+	mmPay.partialParams(func(e *CheckoutMockPayExpectation) {
+		e.params.Invoice = Invoice
+		delete(e.matchers, "Invoice")
+	})
+	return mmPay
+}
+
+// MatchInvoiceParam1 sets up the predicate matching the param #1 of Checkout.Pay,
+// it's used instead of the value of the param set by Expect
+func (mmPay *mCheckoutMockPay) MatchInvoiceParam1(f func(got billingtypes.Invoice) bool) *mCheckoutMockPay {
+	mm_matcher := minimock.Predicate("predicate func(got billingtypes.Invoice) bool", func(v interface{}) bool {
+		got, _ := v.(billingtypes.Invoice)
+		return f(got)
+	})
+	mmPay.partialParams(func(e *CheckoutMockPayExpectation) {
+		e.matchers["Invoice"] = mm_matcher
+	})
+	return mmPay
+}
+
+// ExpectItemsParam2 sets up the expected value of the param #2 of Checkout.Pay,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmPay *mCheckoutMockPay) ExpectItemsParam2(Items []catalogtypes.Item) *mCheckoutMockPay {
+	mmPay.partialParams(func(e *CheckoutMockPayExpectation) {
+		e.params.Items = Items
+		delete(e.matchers, "Items")
+	})
+	return mmPay
+}
+
+// MatchItemsParam2 sets up the predicate matching the param #2 of Checkout.Pay,
+// it's used instead of the value of the param set by Expect
+func (mmPay *mCheckoutMockPay) MatchItemsParam2(f func(got []catalogtypes.Item) bool) *mCheckoutMockPay {
+	mm_matcher := minimock.Predicate("predicate func(got []catalogtypes.Item) bool", func(v interface{}) bool {
+		got, _ := v.([]catalogtypes.Item)
+		return f(got)
+	})
+	mmPay.partialParams(func(e *CheckoutMockPayExpectation) {
+		e.matchers["Items"] = mm_matcher
+	})
+	return mmPay
+}
+
+// Return sets up results that will be returned by Checkout.Pay
+func (mmPay *mCheckoutMockPay) Return(p1 types.Parcel, err error) *CheckoutMock {
+	if _, mm_func := mmPay.current(); mm_func != nil {
+		mmPay.mock.state.T().Fatalf("CheckoutMock.Pay mock is already set by Set")
+	}
+
+	mm_results := &CheckoutMockPayResults{p1, err}
+	mmPay.updateDefault(func(e *CheckoutMockPayExpectation) { e.results = mm_results })
+
+	return mmPay.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Checkout.Pay,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmPay *mCheckoutMockPay) ReturnOnce(p1 types.Parcel, err error) *mCheckoutMockPay {
+	mmPay.state.Mutex.Lock()
+	mmPay.queued = append(mmPay.queued, &CheckoutMockPayResults{p1, err})
+	mmPay.state.Mutex.Unlock()
+	mmPay.state.PushResults()
+	return mmPay
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmPay *mCheckoutMockPay) popResults() *CheckoutMockPayResults {
+	mm_i, mm_ok := mmPay.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmPay.state.Mutex.RLock()
+	defer mmPay.state.Mutex.RUnlock()
+
+	return mmPay.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of Checkout.Pay instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmPay *mCheckoutMockPay) SetComparer(compare minimock.Comparer) *mCheckoutMockPay {
+	mmPay.state.Mutex.Lock()
+	mmPay.compare = compare
+	mmPay.state.Mutex.Unlock()
+	return mmPay
+}
+
+// whenExpectations returns the expectations of Checkout.Pay set by When,
+// the expectations can be set while the method is called concurrently
+func (mmPay *mCheckoutMockPay) whenExpectations() []*CheckoutMockPayExpectation {
+	mmPay.state.Mutex.RLock()
+	defer mmPay.state.Mutex.RUnlock()
+
+	return mmPay.expectations
+}
+
+// Inspect sets up the function called with the params of every Checkout.Pay call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmPay *mCheckoutMockPay) Inspect(f func(invoice billingtypes.Invoice, items []catalogtypes.Item)) *mCheckoutMockPay {
+	mmPay.state.Mutex.Lock()
+	mmPay.inspectPay = f
+	mmPay.state.Mutex.Unlock()
+	return mmPay
+}
+
+// expected returns everything set up for the Checkout.Pay call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmPay *mCheckoutMockPay) expected() (*CheckoutMockPayExpectation, func(invoice billingtypes.Invoice, items []catalogtypes.Item) (p1 types.Parcel, err error), func(invoice billingtypes.Invoice, items []catalogtypes.Item), minimock.Comparer, []*CheckoutMockPayExpectation, []*CheckoutMockPayResults) {
+	mmPay.state.Mutex.RLock()
+	defer mmPay.state.Mutex.RUnlock()
+
+	return mmPay.defaultExpectation, mmPay.mock.funcPay, mmPay.inspectPay, mmPay.compare, mmPay.expectations, mmPay.whenResults
+}
+
+// setup returns the expectations and the function set up for Checkout.Pay to be checked when the mock is finished
+func (mmPay *mCheckoutMockPay) setup() (setup minimock.Setup) {
+	mmPay.state.Mutex.RLock()
+	defer mmPay.state.Mutex.RUnlock()
+
+	setup.Func = mmPay.mock.funcPay != nil
+	if e := mmPay.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmPay.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Checkout.Pay, the params of the calls are removed from the history as well
+func (mmPay *mCheckoutMockPay) reset() {
+	mmPay.state.Mutex.Lock()
+	mmPay.defaultExpectation = nil
+	mmPay.expectations = nil
+	mmPay.queued = nil
+	mmPay.whenResults = nil
+	mmPay.state.Mutex.Unlock()
+
+	mmPay.state.History.Lock()
+	mmPay.calls = nil
+	mmPay.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmPay *mCheckoutMockPay) MethodName() string {
+	return mmPay.state.Name()
+}
+
+// CallSequence returns the numbers of the Checkout.Pay calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmPay *mCheckoutMockPay) CallSequence() []uint64 {
+	return mmPay.state.CallSequence()
+}
+
+// WaitForCalls waits until Checkout.Pay is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmPay *mCheckoutMockPay) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmPay.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Checkout.Pay calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmPay *mCheckoutMockPay) Block() (release func()) {
+	return mmPay.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Checkout.Pay calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmPay *mCheckoutMockPay) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmPay.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Checkout.Pay calls are in flight at once
+func (mmPay *mCheckoutMockPay) LimitConcurrency(n int) *mCheckoutMockPay {
+	mmPay.state.SetLimit(n)
+	return mmPay
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Checkout.Pay call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmPay *mCheckoutMockPay) CaptureCalls(buffer int) *mCheckoutMockPay {
+	mmPay.state.History.Lock()
+	mmPay.called = make(chan CheckoutMockPayParams, buffer)
+	mmPay.state.History.Unlock()
+	return mmPay
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Checkout.Pay call
+func (mmPay *mCheckoutMockPay) Called() <-chan CheckoutMockPayParams {
+	mmPay.state.History.Lock()
+	defer mmPay.state.History.Unlock()
+
+	if mmPay.called == nil {
+		mmPay.mock.state.T().Fatalf("Calls of CheckoutMock.Pay aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmPay.called
+}
+
+// DroppedCalls returns the number of the Checkout.Pay calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmPay *mCheckoutMockPay) DroppedCalls() uint64 {
+	return mmPay.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Checkout.Pay calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmPay *mCheckoutMockPay) Times(n uint64) *mCheckoutMockPay {
+	mmPay.state.SetTimes(n)
+	return mmPay
+}
+
+// Optional excludes Checkout.Pay from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmPay *mCheckoutMockPay) Optional() *mCheckoutMockPay {
+	mmPay.state.SetOptional()
+	return mmPay
+}
+
+// Set uses given function f to mock the Checkout.Pay method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmPay *mCheckoutMockPay) Set(f func(invoice billingtypes.Invoice, items []catalogtypes.Item) (p1 types.Parcel, err error)) *CheckoutMock {
+	mmPay.state.Mutex.Lock()
+	defer mmPay.state.Mutex.Unlock()
+
+	if mmPay.defaultExpectation != nil {
+		mmPay.mock.state.T().Fatalf("Default expectation is already set for the Checkout.Pay method")
+	}
+
+	if len(mmPay.expectations) > 0 {
+		mmPay.mock.state.T().Fatalf("Some expectations are already set for the Checkout.Pay method")
+	}
+
+	mmPay.mock.funcPay = f
+	return mmPay.mock
+}
+
+// When sets expectation for the Checkout.Pay which will trigger the result defined by the following
+// Then helper
+func (mmPay *mCheckoutMockPay) When(invoice billingtypes.Invoice, items []catalogtypes.Item) *CheckoutMockPayExpectation {
+	if _, mm_func := mmPay.current(); mm_func != nil {
+		mmPay.mock.state.T().Fatalf("CheckoutMock.Pay mock is already set by Set")
+	}
+
+	expectation := &CheckoutMockPayExpectation{
+		mock:   mmPay.mock,
+		params: &CheckoutMockPayParams{invoice, items},
+	}
+	mmPay.state.Mutex.Lock()
+	mmPay.expectations = append(mmPay.expectations, expectation)
+	mmPay.whenResults = append(mmPay.whenResults, nil)
+	mmPay.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up Checkout.Pay return parameters for the expectation previously defined by the When method
+func (mmExpectation *CheckoutMockPayExpectation) Then(p1 types.Parcel, err error) *CheckoutMock {
+	mm_handle := mmExpectation.mock.PayMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &CheckoutMockPayResults{p1, err}
+	mm_results := make([]*CheckoutMockPayResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Pay implements Checkout
+func (mmPay *CheckoutMock) Pay(invoice billingtypes.Invoice, items []catalogtypes.Item) (p1 types.Parcel, err error) {
+	mm_method := mmPay.PayMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := CheckoutMockPayParams{invoice, items}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcPay, mm_inspectPay, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectPay != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectPay(invoice, items)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).R0, (*mm_results).R1
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).R0, (*mm_results).R1
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcPay == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0, (*mm_expectation.results).R1
+	}
+	if mm_funcPay != nil {
+		return mm_funcPay(invoice, items)
+	}
+	if mm_delegate := mmPay.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Pay(invoice, items)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, invoice, items)
+	return
+}
+
+// PayAfterCounter returns a count of finished CheckoutMock.Pay invocations
+func (mmPay *CheckoutMock) PayAfterCounter() uint64 {
+	return mmPay.PayMock.state.AfterCalls()
+}
+
+// PayBeforeCounter returns a count of CheckoutMock.Pay invocations
+func (mmPay *CheckoutMock) PayBeforeCounter() uint64 {
+	return mmPay.PayMock.state.BeforeCalls()
+}
+
+// PayCalls returns the params of all CheckoutMock.Pay calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmPay *CheckoutMock) PayCalls() []CheckoutMockPayParams {
+	mmPay.PayMock.state.History.Lock()
+	defer mmPay.PayMock.state.History.Unlock()
+
+	calls := make([]CheckoutMockPayParams, len(mmPay.PayMock.calls))
+	copy(calls, mmPay.PayMock.calls)
+	return calls
+}
+
+// PayLastParams returns the params of the latest CheckoutMock.Pay call and false if there were no calls
+func (mmPay *CheckoutMock) PayLastParams() (params CheckoutMockPayParams, ok bool) {
+	mmPay.PayMock.state.History.Lock()
+	defer mmPay.PayMock.state.History.Unlock()
+
+	if n := len(mmPay.PayMock.calls); n > 0 {
+		return mmPay.PayMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// PayCallTimes returns the times of all CheckoutMock.Pay calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmPay *CheckoutMock) PayCallTimes() []mm_time.Time {
+	return mmPay.PayMock.state.CallTimes()
+}
+
+// PayMaxInFlight returns the maximum number of the CheckoutMock.Pay calls that have been in flight at once
+func (mmPay *CheckoutMock) PayMaxInFlight() int {
+	return mmPay.PayMock.state.MaxInFlight()
+}
+
+// PayUnexpectedCounter returns a count of CheckoutMock.Pay invocations made without an implementation
+func (mmPay *CheckoutMock) PayUnexpectedCounter() uint64 {
+	return mmPay.PayMock.state.UnexpectedCalls()
+}
+
+// PayNotCalled returns true if CheckoutMock.Pay hasn't been called
+func (mmPay *CheckoutMock) PayNotCalled() bool {
+	return mmPay.PayMock.state.BeforeCalls() == 0
+}
+
+// PayCallCount returns a count of CheckoutMock.Pay invocations, it's the same as PayBeforeCounter
+func (mmPay *CheckoutMock) PayCallCount() uint64 {
+	return mmPay.PayMock.state.BeforeCalls()
+}
+
+// MinimockPayDone returns true if the count of the Pay invocations corresponds
+// the number of defined expectations
+func (mmPay *CheckoutMock) MinimockPayDone() bool {
+	return mmPay.PayMock.state.Done(mmPay.PayMock.setup())
+}
+
+// MinimockPayInspect logs each unmet expectation
+func (mmPay *CheckoutMock) MinimockPayInspect() {
+	mmPay.PayMock.state.Inspect(mmPay.PayMock.setup())
+}
+
+// MinimockSetComparer sets up the function comparing the expected and the actual params of all CheckoutMock methods instead of minimock.Equal,
+// the params matched by the matchers aren't compared
+func (m *CheckoutMock) MinimockSetComparer(compare minimock.Comparer) *CheckoutMock {
+	m.state.SetComparer(compare)
+	return m
+}
+
+// MinimockSetSequence sets up the sequence numbering the CheckoutMock calls, the mocks sharing the sequence
+// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+func (m *CheckoutMock) MinimockSetSequence(sequence *minimock.Sequence) *CheckoutMock {
+	m.state.SetSequence(sequence)
+	return m
+}
+
+// MinimockSetClock sets up the function returning the current time for the history of CheckoutMock calls instead of time.Now
+func (m *CheckoutMock) MinimockSetClock(clock func() mm_time.Time) *CheckoutMock {
+	m.state.SetClock(clock)
+	return m
+}
+
+// MinimockSetAutoFinish enables or disables the check of CheckoutMock made by the Cleanup of the tester passed to NewCheckoutMock,
+// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+func (m *CheckoutMock) MinimockSetAutoFinish(enabled bool) *CheckoutMock {
+	m.state.SetAutoFinish(enabled)
+	return m
+}
+
+// MinimockSetDelegate sets up the implementation the calls of the CheckoutMock methods are forwarded to when the methods
+// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+func (m *CheckoutMock) MinimockSetDelegate(impl Checkout) *CheckoutMock {
+	m.mutex.Lock()
+	m.delegate = impl
+	m.mutex.Unlock()
+	return m
+}
+
+func (m *CheckoutMock) minimockDelegate() Checkout {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.delegate
+}
+
+// MinimockSetLenient enables or disables the lenient mode of CheckoutMock: the calls of the methods that have neither
+// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+func (m *CheckoutMock) MinimockSetLenient(enabled bool) *CheckoutMock {
+	m.state.SetLenient(enabled)
+	return m
+}
+
+// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (m *CheckoutMock) MinimockLenientCalls() map[string]uint64 {
+	return m.state.LenientCalls()
+}
+
+// MinimockAssertNotCalled fails the test without stopping it if any of the CheckoutMock methods with the given names has been called,
+// it doesn't depend on the expectations and the functions set up for the methods
+func (m *CheckoutMock) MinimockAssertNotCalled(methodNames ...string) {
+	m.state.AssertNotCalled(methodNames...)
+}
+
+// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+// queued by ReturnOnce of all CheckoutMock methods, the functions set by Set and Inspect are kept.
+// It fails the test if any of the methods is being called
+func (m *CheckoutMock) MinimockReset() {
+	m.state.Reset(
+		m.PayMock.reset,
+	)
+}
+
+// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+func (m *CheckoutMock) MinimockResetAll() {
+	m.MinimockReset()
+	m.PayMock.state.Mutex.Lock()
+	m.funcPay = nil
+	m.PayMock.inspectPay = nil
+	m.PayMock.state.Mutex.Unlock()
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CheckoutMock) MinimockFinish() {
+	m.state.Finish(m.minimockDone,
+		m.MinimockPayInspect,
+	)
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CheckoutMock) MinimockWait(timeout mm_time.Duration) {
+	m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
+}
+
+func (m *CheckoutMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockPayDone()
+}
@@ -0,0 +1,23 @@
+package tests
+
+import (
+	"testing"
+
+	billingtypes "github.com/gojuno/minimock/tests/billing/types"
+	catalogtypes "github.com/gojuno/minimock/tests/catalog/types"
+	"github.com/gojuno/minimock/tests/shipping/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckoutMock_PackagesWithTheSameName(t *testing.T) {
+	invoice, items := billingtypes.Invoice{Amount: 10}, []catalogtypes.Item{{Price: 10}}
+
+	checkoutMock := NewCheckoutMock(t).PayMock.Expect(invoice, items).Return(types.Parcel{Items: 1}, nil)
+	defer checkoutMock.MinimockFinish()
+
+	var checkout Checkout = checkoutMock
+
+	parcel, err := checkout.Pay(invoice, items)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, parcel.Items)
+}
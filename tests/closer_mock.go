@@ -0,0 +1,476 @@
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+package tests
+
+//go:generate minimock -i github.com/gojuno/minimock/tests.Closer -o ./closer_mock.go
+
+import (
+	mm_sync "sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock"
+)
+
+// CloserMock implements Closer
+//
+// Closer alias is used to test mocks of the aliases to the interfaces from other packages
+type CloserMock struct {
+	state    minimock.MockState
+	mutex    mm_sync.RWMutex
+	delegate Closer
+
+	funcClose func() (err error)
+	CloseMock *mCloserMockClose
+}
+
+var _ Closer = (*CloserMock)(nil)
+
+// NewCloserMock returns a mock for Closer
+func NewCloserMock(t minimock.Tester) *CloserMock {
+	m := &CloserMock{}
+	m.state.Init(m, t, "CloserMock", 0)
+
+	m.CloseMock = &mCloserMockClose{mock: m}
+	m.CloseMock.state.Init(&m.state, "Close")
+
+	return m
+}
+
+type mCloserMockClose struct {
+	mock               *CloserMock
+	state              minimock.MethodState
+	defaultExpectation *CloserMockCloseExpectation
+	expectations       []*CloserMockCloseExpectation
+	inspectClose       func()
+	called             chan struct{}
+	queued             []*CloserMockCloseResults
+}
+
+// CloserMockCloseExpectation specifies expectation struct of the Closer.Close
+type CloserMockCloseExpectation struct {
+	mock *CloserMock
+
+	results *CloserMockCloseResults
+	Counter uint64
+}
+
+// CloserMockCloseResults contains results of the Closer.Close
+type CloserMockCloseResults struct {
+	R0 error
+}
+
+// Expect sets up expected params for Closer.Close
+func (mmClose *mCloserMockClose) Expect() *mCloserMockClose {
+	if _, mm_func := mmClose.current(); mm_func != nil {
+		mmClose.mock.state.T().Fatalf("CloserMock.Close mock is already set by Set")
+	}
+
+	mmClose.updateDefault(func(*CloserMockCloseExpectation) {})
+
+	return mmClose
+}
+
+// updateDefault replaces the default expectation of Closer.Close by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmClose *mCloserMockClose) updateDefault(update func(e *CloserMockCloseExpectation)) {
+	mmClose.state.Mutex.Lock()
+	defer mmClose.state.Mutex.Unlock()
+
+	e := &CloserMockCloseExpectation{mock: mmClose.mock}
+	if previous := mmClose.defaultExpectation; previous != nil {
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmClose.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Closer.Close
+func (mmClose *mCloserMockClose) current() (*CloserMockCloseExpectation, func() (err error)) {
+	mmClose.state.Mutex.RLock()
+	defer mmClose.state.Mutex.RUnlock()
+
+	return mmClose.defaultExpectation, mmClose.mock.funcClose
+}
+
+// Return sets up results that will be returned by Closer.Close
+func (mmClose *mCloserMockClose) Return(err error) *CloserMock {
+	if _, mm_func := mmClose.current(); mm_func != nil {
+		mmClose.mock.state.T().Fatalf("CloserMock.Close mock is already set by Set")
+	}
+
+	mm_results := &CloserMockCloseResults{err}
+	mmClose.updateDefault(func(e *CloserMockCloseExpectation) { e.results = mm_results })
+
+	return mmClose.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Closer.Close,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmClose *mCloserMockClose) ReturnOnce(err error) *mCloserMockClose {
+	mmClose.state.Mutex.Lock()
+	mmClose.queued = append(mmClose.queued, &CloserMockCloseResults{err})
+	mmClose.state.Mutex.Unlock()
+	mmClose.state.PushResults()
+	return mmClose
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmClose *mCloserMockClose) popResults() *CloserMockCloseResults {
+	mm_i, mm_ok := mmClose.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmClose.state.Mutex.RLock()
+	defer mmClose.state.Mutex.RUnlock()
+
+	return mmClose.queued[mm_i]
+}
+
+// Inspect sets up the function called with the params of every Closer.Close call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmClose *mCloserMockClose) Inspect(f func()) *mCloserMockClose {
+	mmClose.state.Mutex.Lock()
+	mmClose.inspectClose = f
+	mmClose.state.Mutex.Unlock()
+	return mmClose
+}
+
+// expected returns everything set up for the Closer.Close call under a single read lock: the default expectation,
+// the functions set by Set and Inspect
+func (mmClose *mCloserMockClose) expected() (*CloserMockCloseExpectation, func() (err error), func()) {
+	mmClose.state.Mutex.RLock()
+	defer mmClose.state.Mutex.RUnlock()
+
+	return mmClose.defaultExpectation, mmClose.mock.funcClose, mmClose.inspectClose
+}
+
+// setup returns the expectations and the function set up for Closer.Close to be checked when the mock is finished
+func (mmClose *mCloserMockClose) setup() (setup minimock.Setup) {
+	mmClose.state.Mutex.RLock()
+	defer mmClose.state.Mutex.RUnlock()
+
+	setup.Func = mmClose.mock.funcClose != nil
+	if e := mmClose.defaultExpectation; e != nil {
+		setup.Default = true
+	}
+
+	for _, e := range mmClose.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Closer.Close
+func (mmClose *mCloserMockClose) reset() {
+	mmClose.state.Mutex.Lock()
+	mmClose.defaultExpectation = nil
+	mmClose.expectations = nil
+	mmClose.queued = nil
+	mmClose.state.Mutex.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmClose *mCloserMockClose) MethodName() string {
+	return mmClose.state.Name()
+}
+
+// CallSequence returns the numbers of the Closer.Close calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmClose *mCloserMockClose) CallSequence() []uint64 {
+	return mmClose.state.CallSequence()
+}
+
+// WaitForCalls waits until Closer.Close is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmClose *mCloserMockClose) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmClose.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Closer.Close calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmClose *mCloserMockClose) Block() (release func()) {
+	return mmClose.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Closer.Close calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmClose *mCloserMockClose) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmClose.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Closer.Close calls are in flight at once
+func (mmClose *mCloserMockClose) LimitConcurrency(n int) *mCloserMockClose {
+	mmClose.state.SetLimit(n)
+	return mmClose
+}
+
+// CaptureCalls creates the buffered channel receiving the signal of each Closer.Close call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmClose *mCloserMockClose) CaptureCalls(buffer int) *mCloserMockClose {
+	mmClose.state.History.Lock()
+	mmClose.called = make(chan struct{}, buffer)
+	mmClose.state.History.Unlock()
+	return mmClose
+}
+
+// Called returns the channel set up by CaptureCalls receiving the signal of each Closer.Close call
+func (mmClose *mCloserMockClose) Called() <-chan struct{} {
+	mmClose.state.History.Lock()
+	defer mmClose.state.History.Unlock()
+
+	if mmClose.called == nil {
+		mmClose.mock.state.T().Fatalf("Calls of CloserMock.Close aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmClose.called
+}
+
+// DroppedCalls returns the number of the Closer.Close calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmClose *mCloserMockClose) DroppedCalls() uint64 {
+	return mmClose.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Closer.Close calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmClose *mCloserMockClose) Times(n uint64) *mCloserMockClose {
+	mmClose.state.SetTimes(n)
+	return mmClose
+}
+
+// Optional excludes Closer.Close from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmClose *mCloserMockClose) Optional() *mCloserMockClose {
+	mmClose.state.SetOptional()
+	return mmClose
+}
+
+// Set uses given function f to mock the Closer.Close method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmClose *mCloserMockClose) Set(f func() (err error)) *CloserMock {
+	mmClose.state.Mutex.Lock()
+	defer mmClose.state.Mutex.Unlock()
+
+	if mmClose.defaultExpectation != nil {
+		mmClose.mock.state.T().Fatalf("Default expectation is already set for the Closer.Close method")
+	}
+
+	if len(mmClose.expectations) > 0 {
+		mmClose.mock.state.T().Fatalf("Some expectations are already set for the Closer.Close method")
+	}
+
+	mmClose.mock.funcClose = f
+	return mmClose.mock
+}
+
+// Close implements Closer
+func (mmClose *CloserMock) Close() (err error) {
+	mm_method := mmClose.CloseMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_method.state.Record()
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- struct{}{}:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcClose, mm_inspectClose := mm_method.expected()
+	if mm_inspectClose != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectClose()
+		}()
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcClose == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, nil) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcClose != nil {
+		return mm_funcClose()
+	}
+	if mm_delegate := mmClose.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Close()
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(nil)
+	return
+}
+
+// CloseAfterCounter returns a count of finished CloserMock.Close invocations
+func (mmClose *CloserMock) CloseAfterCounter() uint64 {
+	return mmClose.CloseMock.state.AfterCalls()
+}
+
+// CloseBeforeCounter returns a count of CloserMock.Close invocations
+func (mmClose *CloserMock) CloseBeforeCounter() uint64 {
+	return mmClose.CloseMock.state.BeforeCalls()
+}
+
+// CloseCallTimes returns the times of all CloserMock.Close calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmClose *CloserMock) CloseCallTimes() []mm_time.Time {
+	return mmClose.CloseMock.state.CallTimes()
+}
+
+// CloseMaxInFlight returns the maximum number of the CloserMock.Close calls that have been in flight at once
+func (mmClose *CloserMock) CloseMaxInFlight() int {
+	return mmClose.CloseMock.state.MaxInFlight()
+}
+
+// CloseUnexpectedCounter returns a count of CloserMock.Close invocations made without an implementation
+func (mmClose *CloserMock) CloseUnexpectedCounter() uint64 {
+	return mmClose.CloseMock.state.UnexpectedCalls()
+}
+
+// CloseNotCalled returns true if CloserMock.Close hasn't been called
+func (mmClose *CloserMock) CloseNotCalled() bool {
+	return mmClose.CloseMock.state.BeforeCalls() == 0
+}
+
+// CloseCallCount returns a count of CloserMock.Close invocations, it's the same as CloseBeforeCounter
+func (mmClose *CloserMock) CloseCallCount() uint64 {
+	return mmClose.CloseMock.state.BeforeCalls()
+}
+
+// MinimockCloseDone returns true if the count of the Close invocations corresponds
+// the number of defined expectations
+func (mmClose *CloserMock) MinimockCloseDone() bool {
+	return mmClose.CloseMock.state.Done(mmClose.CloseMock.setup())
+}
+
+// MinimockCloseInspect logs each unmet expectation
+func (mmClose *CloserMock) MinimockCloseInspect() {
+	mmClose.CloseMock.state.Inspect(mmClose.CloseMock.setup())
+}
+
+// MinimockSetComparer sets up the function comparing the expected and the actual params of all CloserMock methods instead of minimock.Equal,
+// the params matched by the matchers aren't compared
+func (m *CloserMock) MinimockSetComparer(compare minimock.Comparer) *CloserMock {
+	m.state.SetComparer(compare)
+	return m
+}
+
+// MinimockSetSequence sets up the sequence numbering the CloserMock calls, the mocks sharing the sequence
+// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+func (m *CloserMock) MinimockSetSequence(sequence *minimock.Sequence) *CloserMock {
+	m.state.SetSequence(sequence)
+	return m
+}
+
+// MinimockSetClock sets up the function returning the current time for the history of CloserMock calls instead of time.Now
+func (m *CloserMock) MinimockSetClock(clock func() mm_time.Time) *CloserMock {
+	m.state.SetClock(clock)
+	return m
+}
+
+// MinimockSetAutoFinish enables or disables the check of CloserMock made by the Cleanup of the tester passed to NewCloserMock,
+// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+func (m *CloserMock) MinimockSetAutoFinish(enabled bool) *CloserMock {
+	m.state.SetAutoFinish(enabled)
+	return m
+}
+
+// MinimockSetDelegate sets up the implementation the calls of the CloserMock methods are forwarded to when the methods
+// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+func (m *CloserMock) MinimockSetDelegate(impl Closer) *CloserMock {
+	m.mutex.Lock()
+	m.delegate = impl
+	m.mutex.Unlock()
+	return m
+}
+
+func (m *CloserMock) minimockDelegate() Closer {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.delegate
+}
+
+// MinimockSetLenient enables or disables the lenient mode of CloserMock: the calls of the methods that have neither
+// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+func (m *CloserMock) MinimockSetLenient(enabled bool) *CloserMock {
+	m.state.SetLenient(enabled)
+	return m
+}
+
+// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (m *CloserMock) MinimockLenientCalls() map[string]uint64 {
+	return m.state.LenientCalls()
+}
+
+// MinimockAssertNotCalled fails the test without stopping it if any of the CloserMock methods with the given names has been called,
+// it doesn't depend on the expectations and the functions set up for the methods
+func (m *CloserMock) MinimockAssertNotCalled(methodNames ...string) {
+	m.state.AssertNotCalled(methodNames...)
+}
+
+// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+// queued by ReturnOnce of all CloserMock methods, the functions set by Set and Inspect are kept.
+// It fails the test if any of the methods is being called
+func (m *CloserMock) MinimockReset() {
+	m.state.Reset(
+		m.CloseMock.reset,
+	)
+}
+
+// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+func (m *CloserMock) MinimockResetAll() {
+	m.MinimockReset()
+	m.CloseMock.state.Mutex.Lock()
+	m.funcClose = nil
+	m.CloseMock.inspectClose = nil
+	m.CloseMock.state.Mutex.Unlock()
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CloserMock) MinimockFinish() {
+	m.state.Finish(m.minimockDone,
+		m.MinimockCloseInspect,
+	)
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CloserMock) MinimockWait(timeout mm_time.Duration) {
+	m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
+}
+
+func (m *CloserMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCloseDone()
+}
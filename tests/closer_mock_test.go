@@ -0,0 +1,17 @@
+package tests
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloserMock_Alias(t *testing.T) {
+	closerMock := NewCloserMock(t).CloseMock.Return(errors.New("closed"))
+	defer closerMock.MinimockFinish()
+
+	var closer io.Closer = closerMock
+	assert.EqualError(t, closer.Close(), "closed")
+}
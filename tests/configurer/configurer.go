@@ -0,0 +1,9 @@
+// Package configurer is used to test mocks generated into the package which types are used by the source interface
+package configurer
+
+import "github.com/gojuno/minimock/tests"
+
+// Configurer interface refers to the types of the tests package where its mock is generated into
+type Configurer interface {
+	Configure(opts tests.Options) (tests.Options, error)
+}
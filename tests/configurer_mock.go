@@ -0,0 +1,675 @@
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+package tests
+
+//go:generate minimock -i github.com/gojuno/minimock/tests/configurer.Configurer -o ./configurer_mock.go
+
+import (
+	mm_sync "sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock"
+)
+
+// ConfigurerMock implements configurer.Configurer
+//
+// Configurer interface refers to the types of the tests package where its mock is generated into
+type ConfigurerMock struct {
+	state    minimock.MockState
+	mutex    mm_sync.RWMutex
+	delegate interface {
+		Configure(opts Options) (o1 Options, err error)
+	}
+
+	funcConfigure func(opts Options) (o1 Options, err error)
+	ConfigureMock *mConfigurerMockConfigure
+}
+
+// ConfigurerMock can't be checked to implement configurer.Configurer here
+// since the package of the interface imports the package of the mock
+
+// NewConfigurerMock returns a mock for configurer.Configurer
+func NewConfigurerMock(t minimock.Tester) *ConfigurerMock {
+	m := &ConfigurerMock{}
+	m.state.Init(m, t, "ConfigurerMock", 0)
+
+	m.ConfigureMock = &mConfigurerMockConfigure{mock: m}
+	m.ConfigureMock.state.Init(&m.state, "Configure")
+
+	return m
+}
+
+type mConfigurerMockConfigure struct {
+	mock               *ConfigurerMock
+	state              minimock.MethodState
+	defaultExpectation *ConfigurerMockConfigureExpectation
+	expectations       []*ConfigurerMockConfigureExpectation
+	inspectConfigure   func(opts Options)
+	compare            minimock.Comparer
+	calls              []ConfigurerMockConfigureParams
+	called             chan ConfigurerMockConfigureParams
+	queued             []*ConfigurerMockConfigureResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*ConfigurerMockConfigureResults
+}
+
+// ConfigurerMockConfigureExpectation specifies expectation struct of the Configurer.Configure
+type ConfigurerMockConfigureExpectation struct {
+	mock     *ConfigurerMock
+	params   *ConfigurerMockConfigureParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *ConfigurerMockConfigureResults
+	Counter  uint64
+}
+
+// ConfigurerMockConfigureParams contains parameters of the Configurer.Configure
+type ConfigurerMockConfigureParams struct {
+	Opts Options
+}
+
+// ConfigurerMockConfigureResults contains results of the Configurer.Configure
+type ConfigurerMockConfigureResults struct {
+	R0 Options
+	R1 error
+}
+
+// Expect sets up expected params for Configurer.Configure
+func (mmConfigure *mConfigurerMockConfigure) Expect(opts Options) *mConfigurerMockConfigure {
+	if _, mm_func := mmConfigure.current(); mm_func != nil {
+		mmConfigure.mock.state.T().Fatalf("ConfigurerMock.Configure mock is already set by Set")
+	}
+
+	mm_params := &ConfigurerMockConfigureParams{opts}
+	mmConfigure.updateDefault(func(e *ConfigurerMockConfigureExpectation) {
+		if e.partial {
+			mmConfigure.mock.state.T().Fatalf("ConfigurerMock.Configure params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmConfigure.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmConfigure.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmConfigure
+}
+
+// updateDefault replaces the default expectation of Configurer.Configure by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmConfigure *mConfigurerMockConfigure) updateDefault(update func(e *ConfigurerMockConfigureExpectation)) {
+	mmConfigure.state.Mutex.Lock()
+	defer mmConfigure.state.Mutex.Unlock()
+
+	e := &ConfigurerMockConfigureExpectation{mock: mmConfigure.mock}
+	if previous := mmConfigure.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmConfigure.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Configurer.Configure
+func (mmConfigure *mConfigurerMockConfigure) current() (*ConfigurerMockConfigureExpectation, func(opts Options) (o1 Options, err error)) {
+	mmConfigure.state.Mutex.RLock()
+	defer mmConfigure.state.Mutex.RUnlock()
+
+	return mmConfigure.defaultExpectation, mmConfigure.mock.funcConfigure
+}
+
+// partialParams updates the default expectation of Configurer.Configure by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmConfigure *mConfigurerMockConfigure) partialParams(update func(e *ConfigurerMockConfigureExpectation)) {
+	if _, mm_func := mmConfigure.current(); mm_func != nil {
+		mmConfigure.mock.state.T().Fatalf("ConfigurerMock.Configure mock is already set by Set")
+	}
+
+	mmConfigure.updateDefault(func(e *ConfigurerMockConfigureExpectation) {
+		if e.params == nil {
+			e.params = &ConfigurerMockConfigureParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"Opts": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectOptsParam1 sets up the expected value of the param #1 of Configurer.Configure,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmConfigure *mConfigurerMockConfigure) ExpectOptsParam1(Opts Options) *mConfigurerMockConfigure {
+	mmConfigure.partialParams(func(e *ConfigurerMockConfigureExpectation) {
+		e.params.Opts = Opts
+		delete(e.matchers, "Opts")
+	})
+	return mmConfigure
+}
+
+// MatchOptsParam1 sets up the predicate matching the param #1 of Configurer.Configure,
+// it's used instead of the value of the param set by Expect
+func (mmConfigure *mConfigurerMockConfigure) MatchOptsParam1(f func(got Options) bool) *mConfigurerMockConfigure {
+	mm_matcher := minimock.Predicate("predicate func(got tests.Options) bool", func(v interface{}) bool {
+		got, _ := v.(Options)
+		return f(got)
+	})
+	mmConfigure.partialParams(func(e *ConfigurerMockConfigureExpectation) {
+		e.matchers["Opts"] = mm_matcher
+	})
+	return mmConfigure
+}
+
+// Return sets up results that will be returned by Configurer.Configure
+func (mmConfigure *mConfigurerMockConfigure) Return(o1 Options, err error) *ConfigurerMock {
+	if _, mm_func := mmConfigure.current(); mm_func != nil {
+		mmConfigure.mock.state.T().Fatalf("ConfigurerMock.Configure mock is already set by Set")
+	}
+
+	mm_results := &ConfigurerMockConfigureResults{o1, err}
+	mmConfigure.updateDefault(func(e *ConfigurerMockConfigureExpectation) { e.results = mm_results })
+
+	return mmConfigure.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Configurer.Configure,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmConfigure *mConfigurerMockConfigure) ReturnOnce(o1 Options, err error) *mConfigurerMockConfigure {
+	mmConfigure.state.Mutex.Lock()
+	mmConfigure.queued = append(mmConfigure.queued, &ConfigurerMockConfigureResults{o1, err})
+	mmConfigure.state.Mutex.Unlock()
+	mmConfigure.state.PushResults()
+	return mmConfigure
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmConfigure *mConfigurerMockConfigure) popResults() *ConfigurerMockConfigureResults {
+	mm_i, mm_ok := mmConfigure.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmConfigure.state.Mutex.RLock()
+	defer mmConfigure.state.Mutex.RUnlock()
+
+	return mmConfigure.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of Configurer.Configure instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmConfigure *mConfigurerMockConfigure) SetComparer(compare minimock.Comparer) *mConfigurerMockConfigure {
+	mmConfigure.state.Mutex.Lock()
+	mmConfigure.compare = compare
+	mmConfigure.state.Mutex.Unlock()
+	return mmConfigure
+}
+
+// whenExpectations returns the expectations of Configurer.Configure set by When,
+// the expectations can be set while the method is called concurrently
+func (mmConfigure *mConfigurerMockConfigure) whenExpectations() []*ConfigurerMockConfigureExpectation {
+	mmConfigure.state.Mutex.RLock()
+	defer mmConfigure.state.Mutex.RUnlock()
+
+	return mmConfigure.expectations
+}
+
+// Inspect sets up the function called with the params of every Configurer.Configure call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmConfigure *mConfigurerMockConfigure) Inspect(f func(opts Options)) *mConfigurerMockConfigure {
+	mmConfigure.state.Mutex.Lock()
+	mmConfigure.inspectConfigure = f
+	mmConfigure.state.Mutex.Unlock()
+	return mmConfigure
+}
+
+// expected returns everything set up for the Configurer.Configure call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmConfigure *mConfigurerMockConfigure) expected() (*ConfigurerMockConfigureExpectation, func(opts Options) (o1 Options, err error), func(opts Options), minimock.Comparer, []*ConfigurerMockConfigureExpectation, []*ConfigurerMockConfigureResults) {
+	mmConfigure.state.Mutex.RLock()
+	defer mmConfigure.state.Mutex.RUnlock()
+
+	return mmConfigure.defaultExpectation, mmConfigure.mock.funcConfigure, mmConfigure.inspectConfigure, mmConfigure.compare, mmConfigure.expectations, mmConfigure.whenResults
+}
+
+// setup returns the expectations and the function set up for Configurer.Configure to be checked when the mock is finished
+func (mmConfigure *mConfigurerMockConfigure) setup() (setup minimock.Setup) {
+	mmConfigure.state.Mutex.RLock()
+	defer mmConfigure.state.Mutex.RUnlock()
+
+	setup.Func = mmConfigure.mock.funcConfigure != nil
+	if e := mmConfigure.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmConfigure.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Configurer.Configure, the params of the calls are removed from the history as well
+func (mmConfigure *mConfigurerMockConfigure) reset() {
+	mmConfigure.state.Mutex.Lock()
+	mmConfigure.defaultExpectation = nil
+	mmConfigure.expectations = nil
+	mmConfigure.queued = nil
+	mmConfigure.whenResults = nil
+	mmConfigure.state.Mutex.Unlock()
+
+	mmConfigure.state.History.Lock()
+	mmConfigure.calls = nil
+	mmConfigure.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmConfigure *mConfigurerMockConfigure) MethodName() string {
+	return mmConfigure.state.Name()
+}
+
+// CallSequence returns the numbers of the Configurer.Configure calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmConfigure *mConfigurerMockConfigure) CallSequence() []uint64 {
+	return mmConfigure.state.CallSequence()
+}
+
+// WaitForCalls waits until Configurer.Configure is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmConfigure *mConfigurerMockConfigure) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmConfigure.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Configurer.Configure calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmConfigure *mConfigurerMockConfigure) Block() (release func()) {
+	return mmConfigure.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Configurer.Configure calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmConfigure *mConfigurerMockConfigure) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmConfigure.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Configurer.Configure calls are in flight at once
+func (mmConfigure *mConfigurerMockConfigure) LimitConcurrency(n int) *mConfigurerMockConfigure {
+	mmConfigure.state.SetLimit(n)
+	return mmConfigure
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Configurer.Configure call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmConfigure *mConfigurerMockConfigure) CaptureCalls(buffer int) *mConfigurerMockConfigure {
+	mmConfigure.state.History.Lock()
+	mmConfigure.called = make(chan ConfigurerMockConfigureParams, buffer)
+	mmConfigure.state.History.Unlock()
+	return mmConfigure
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Configurer.Configure call
+func (mmConfigure *mConfigurerMockConfigure) Called() <-chan ConfigurerMockConfigureParams {
+	mmConfigure.state.History.Lock()
+	defer mmConfigure.state.History.Unlock()
+
+	if mmConfigure.called == nil {
+		mmConfigure.mock.state.T().Fatalf("Calls of ConfigurerMock.Configure aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmConfigure.called
+}
+
+// DroppedCalls returns the number of the Configurer.Configure calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmConfigure *mConfigurerMockConfigure) DroppedCalls() uint64 {
+	return mmConfigure.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Configurer.Configure calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmConfigure *mConfigurerMockConfigure) Times(n uint64) *mConfigurerMockConfigure {
+	mmConfigure.state.SetTimes(n)
+	return mmConfigure
+}
+
+// Optional excludes Configurer.Configure from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmConfigure *mConfigurerMockConfigure) Optional() *mConfigurerMockConfigure {
+	mmConfigure.state.SetOptional()
+	return mmConfigure
+}
+
+// Set uses given function f to mock the Configurer.Configure method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmConfigure *mConfigurerMockConfigure) Set(f func(opts Options) (o1 Options, err error)) *ConfigurerMock {
+	mmConfigure.state.Mutex.Lock()
+	defer mmConfigure.state.Mutex.Unlock()
+
+	if mmConfigure.defaultExpectation != nil {
+		mmConfigure.mock.state.T().Fatalf("Default expectation is already set for the Configurer.Configure method")
+	}
+
+	if len(mmConfigure.expectations) > 0 {
+		mmConfigure.mock.state.T().Fatalf("Some expectations are already set for the Configurer.Configure method")
+	}
+
+	mmConfigure.mock.funcConfigure = f
+	return mmConfigure.mock
+}
+
+// When sets expectation for the Configurer.Configure which will trigger the result defined by the following
+// Then helper
+func (mmConfigure *mConfigurerMockConfigure) When(opts Options) *ConfigurerMockConfigureExpectation {
+	if _, mm_func := mmConfigure.current(); mm_func != nil {
+		mmConfigure.mock.state.T().Fatalf("ConfigurerMock.Configure mock is already set by Set")
+	}
+
+	expectation := &ConfigurerMockConfigureExpectation{
+		mock:   mmConfigure.mock,
+		params: &ConfigurerMockConfigureParams{opts},
+	}
+	mmConfigure.state.Mutex.Lock()
+	mmConfigure.expectations = append(mmConfigure.expectations, expectation)
+	mmConfigure.whenResults = append(mmConfigure.whenResults, nil)
+	mmConfigure.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up Configurer.Configure return parameters for the expectation previously defined by the When method
+func (mmExpectation *ConfigurerMockConfigureExpectation) Then(o1 Options, err error) *ConfigurerMock {
+	mm_handle := mmExpectation.mock.ConfigureMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &ConfigurerMockConfigureResults{o1, err}
+	mm_results := make([]*ConfigurerMockConfigureResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Configure implements configurer.Configurer
+func (mmConfigure *ConfigurerMock) Configure(opts Options) (o1 Options, err error) {
+	mm_method := mmConfigure.ConfigureMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := ConfigurerMockConfigureParams{opts}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcConfigure, mm_inspectConfigure, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectConfigure != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectConfigure(opts)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).R0, (*mm_results).R1
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).R0, (*mm_results).R1
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcConfigure == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0, (*mm_expectation.results).R1
+	}
+	if mm_funcConfigure != nil {
+		return mm_funcConfigure(opts)
+	}
+	if mm_delegate := mmConfigure.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Configure(opts)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, opts)
+	return
+}
+
+// ConfigureAfterCounter returns a count of finished ConfigurerMock.Configure invocations
+func (mmConfigure *ConfigurerMock) ConfigureAfterCounter() uint64 {
+	return mmConfigure.ConfigureMock.state.AfterCalls()
+}
+
+// ConfigureBeforeCounter returns a count of ConfigurerMock.Configure invocations
+func (mmConfigure *ConfigurerMock) ConfigureBeforeCounter() uint64 {
+	return mmConfigure.ConfigureMock.state.BeforeCalls()
+}
+
+// ConfigureCalls returns the params of all ConfigurerMock.Configure calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmConfigure *ConfigurerMock) ConfigureCalls() []ConfigurerMockConfigureParams {
+	mmConfigure.ConfigureMock.state.History.Lock()
+	defer mmConfigure.ConfigureMock.state.History.Unlock()
+
+	calls := make([]ConfigurerMockConfigureParams, len(mmConfigure.ConfigureMock.calls))
+	copy(calls, mmConfigure.ConfigureMock.calls)
+	return calls
+}
+
+// ConfigureLastParams returns the params of the latest ConfigurerMock.Configure call and false if there were no calls
+func (mmConfigure *ConfigurerMock) ConfigureLastParams() (params ConfigurerMockConfigureParams, ok bool) {
+	mmConfigure.ConfigureMock.state.History.Lock()
+	defer mmConfigure.ConfigureMock.state.History.Unlock()
+
+	if n := len(mmConfigure.ConfigureMock.calls); n > 0 {
+		return mmConfigure.ConfigureMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// ConfigureCallTimes returns the times of all ConfigurerMock.Configure calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmConfigure *ConfigurerMock) ConfigureCallTimes() []mm_time.Time {
+	return mmConfigure.ConfigureMock.state.CallTimes()
+}
+
+// ConfigureMaxInFlight returns the maximum number of the ConfigurerMock.Configure calls that have been in flight at once
+func (mmConfigure *ConfigurerMock) ConfigureMaxInFlight() int {
+	return mmConfigure.ConfigureMock.state.MaxInFlight()
+}
+
+// ConfigureUnexpectedCounter returns a count of ConfigurerMock.Configure invocations made without an implementation
+func (mmConfigure *ConfigurerMock) ConfigureUnexpectedCounter() uint64 {
+	return mmConfigure.ConfigureMock.state.UnexpectedCalls()
+}
+
+// ConfigureNotCalled returns true if ConfigurerMock.Configure hasn't been called
+func (mmConfigure *ConfigurerMock) ConfigureNotCalled() bool {
+	return mmConfigure.ConfigureMock.state.BeforeCalls() == 0
+}
+
+// ConfigureCallCount returns a count of ConfigurerMock.Configure invocations, it's the same as ConfigureBeforeCounter
+func (mmConfigure *ConfigurerMock) ConfigureCallCount() uint64 {
+	return mmConfigure.ConfigureMock.state.BeforeCalls()
+}
+
+// MinimockConfigureDone returns true if the count of the Configure invocations corresponds
+// the number of defined expectations
+func (mmConfigure *ConfigurerMock) MinimockConfigureDone() bool {
+	return mmConfigure.ConfigureMock.state.Done(mmConfigure.ConfigureMock.setup())
+}
+
+// MinimockConfigureInspect logs each unmet expectation
+func (mmConfigure *ConfigurerMock) MinimockConfigureInspect() {
+	mmConfigure.ConfigureMock.state.Inspect(mmConfigure.ConfigureMock.setup())
+}
+
+// MinimockSetComparer sets up the function comparing the expected and the actual params of all ConfigurerMock methods instead of minimock.Equal,
+// the params matched by the matchers aren't compared
+func (m *ConfigurerMock) MinimockSetComparer(compare minimock.Comparer) *ConfigurerMock {
+	m.state.SetComparer(compare)
+	return m
+}
+
+// MinimockSetSequence sets up the sequence numbering the ConfigurerMock calls, the mocks sharing the sequence
+// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+func (m *ConfigurerMock) MinimockSetSequence(sequence *minimock.Sequence) *ConfigurerMock {
+	m.state.SetSequence(sequence)
+	return m
+}
+
+// MinimockSetClock sets up the function returning the current time for the history of ConfigurerMock calls instead of time.Now
+func (m *ConfigurerMock) MinimockSetClock(clock func() mm_time.Time) *ConfigurerMock {
+	m.state.SetClock(clock)
+	return m
+}
+
+// MinimockSetAutoFinish enables or disables the check of ConfigurerMock made by the Cleanup of the tester passed to NewConfigurerMock,
+// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+func (m *ConfigurerMock) MinimockSetAutoFinish(enabled bool) *ConfigurerMock {
+	m.state.SetAutoFinish(enabled)
+	return m
+}
+
+// MinimockSetDelegate sets up the implementation the calls of the ConfigurerMock methods are forwarded to when the methods
+// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+func (m *ConfigurerMock) MinimockSetDelegate(impl interface {
+	Configure(opts Options) (o1 Options, err error)
+}) *ConfigurerMock {
+	m.mutex.Lock()
+	m.delegate = impl
+	m.mutex.Unlock()
+	return m
+}
+
+func (m *ConfigurerMock) minimockDelegate() interface {
+	Configure(opts Options) (o1 Options, err error)
+} {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.delegate
+}
+
+// MinimockSetLenient enables or disables the lenient mode of ConfigurerMock: the calls of the methods that have neither
+// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+func (m *ConfigurerMock) MinimockSetLenient(enabled bool) *ConfigurerMock {
+	m.state.SetLenient(enabled)
+	return m
+}
+
+// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (m *ConfigurerMock) MinimockLenientCalls() map[string]uint64 {
+	return m.state.LenientCalls()
+}
+
+// MinimockAssertNotCalled fails the test without stopping it if any of the ConfigurerMock methods with the given names has been called,
+// it doesn't depend on the expectations and the functions set up for the methods
+func (m *ConfigurerMock) MinimockAssertNotCalled(methodNames ...string) {
+	m.state.AssertNotCalled(methodNames...)
+}
+
+// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+// queued by ReturnOnce of all ConfigurerMock methods, the functions set by Set and Inspect are kept.
+// It fails the test if any of the methods is being called
+func (m *ConfigurerMock) MinimockReset() {
+	m.state.Reset(
+		m.ConfigureMock.reset,
+	)
+}
+
+// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+func (m *ConfigurerMock) MinimockResetAll() {
+	m.MinimockReset()
+	m.ConfigureMock.state.Mutex.Lock()
+	m.funcConfigure = nil
+	m.ConfigureMock.inspectConfigure = nil
+	m.ConfigureMock.state.Mutex.Unlock()
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ConfigurerMock) MinimockFinish() {
+	m.state.Finish(m.minimockDone,
+		m.MinimockConfigureInspect,
+	)
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ConfigurerMock) MinimockWait(timeout mm_time.Duration) {
+	m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
+}
+
+func (m *ConfigurerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockConfigureDone()
+}
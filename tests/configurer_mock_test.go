@@ -0,0 +1,22 @@
+package tests_test
+
+import (
+	"testing"
+
+	"github.com/gojuno/minimock/tests"
+	"github.com/gojuno/minimock/tests/configurer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigurerMock_DestinationPackageTypes(t *testing.T) {
+	configurerMock := tests.NewConfigurerMock(t).ConfigureMock.
+		Expect(tests.Options{}).
+		Return(tests.Options{Verbose: true}, nil)
+	defer configurerMock.MinimockFinish()
+
+	var c configurer.Configurer = configurerMock
+
+	opts, err := c.Configure(tests.Options{})
+	assert.NoError(t, err)
+	assert.True(t, opts.Verbose)
+}
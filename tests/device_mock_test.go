@@ -0,0 +1,22 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/gojuno/minimock/tests/native"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeviceMock_CgoPackage(t *testing.T) {
+	deviceMock := NewDeviceMock(t).
+		ReadMock.Expect([]byte{}).Return(0, nil).
+		StatusMock.Return(native.Status(1))
+	defer deviceMock.MinimockFinish()
+
+	var device native.Device = deviceMock
+
+	n, err := device.Read([]byte{})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+	assert.Equal(t, native.Status(1), device.Status())
+}
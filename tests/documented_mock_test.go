@@ -0,0 +1,41 @@
+package tests
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocumentedMock_Docs(t *testing.T) {
+	f, err := parser.ParseFile(token.NewFileSet(), "documented_mock.go", nil, parser.ParseComments)
+	require.NoError(t, err)
+
+	docs := map[string]string{}
+	for _, d := range f.Decls {
+		switch d := d.(type) {
+		case *ast.GenDecl:
+			if ts, ok := d.Specs[0].(*ast.TypeSpec); ok {
+				docs[ts.Name.Name] = d.Doc.Text()
+			}
+		case *ast.FuncDecl:
+			if d.Recv != nil {
+				docs[d.Name.Name] = d.Doc.Text()
+			}
+		}
+	}
+
+	assert.Equal(t, "DocumentedMock implements Documented\n\nDocumented interface is used to test copying of the documentation comments into the mock\n", docs["DocumentedMock"])
+	assert.Equal(t, "Get implements Documented\n\nGet returns the value stored by the key,\ncomments with */ are copied as is since they can't terminate the line comment\n", docs["Get"])
+	assert.Equal(t, "Set implements Documented\n\nSet stores the value by the key\n", docs["Set"])
+
+	//directives are not copied, so they don't affect the generated code
+	for _, c := range f.Comments {
+		for _, line := range c.List {
+			assert.NotContains(t, line.Text, "nolint")
+		}
+	}
+}
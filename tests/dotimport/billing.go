@@ -0,0 +1,9 @@
+// Package dotimport is used to test mocks of the interfaces declared in files with dot imports
+package dotimport
+
+import . "github.com/gojuno/minimock/tests/billing/types"
+
+// Billing interface refers to the dot imported types
+type Billing interface {
+	Invoice(id int) (*Invoice, error)
+}
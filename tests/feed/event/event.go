@@ -0,0 +1,12 @@
+// Package event is used to test mocks of the interfaces which embed interfaces from other packages with channel types
+package event
+
+// Source interface is embedded into the feed.Feed interface
+type Source interface {
+	Events() chan Event
+}
+
+// Event is an element type of the Source channel
+type Event struct {
+	Name string
+}
@@ -0,0 +1,24 @@
+// Package feed is used to test mocks of the interfaces which methods use channels, maps and slices of the package types
+package feed
+
+import "github.com/gojuno/minimock/tests/feed/event"
+
+// Feed interface refers to the types of this package from the channel, map, slice and array types,
+// its mock is generated into another package to check that the structure of these types is preserved
+type Feed interface {
+	Updates() <-chan Update
+	Publish(ch chan<- Update) error
+	Pipe(ch chan Update) chan<- []*Update
+	Streams() chan<- <-chan Update
+	Index() map[Key][]*Update
+	Groups(m map[Key]map[string][2]*Update) []map[Key]chan Update
+	event.Source //to check that the channel types of the interfaces embedded from other packages are preserved
+}
+
+// Update is an element type of the Feed channels
+type Update struct {
+	Key Key
+}
+
+// Key is a key type of the Feed maps
+type Key string
@@ -0,0 +1,3152 @@
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+package tests
+
+//go:generate minimock -i github.com/gojuno/minimock/tests/feed.Feed -o ./feed_mock.go
+
+import (
+	mm_sync "sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock"
+	mm_feed "github.com/gojuno/minimock/tests/feed"
+	"github.com/gojuno/minimock/tests/feed/event"
+)
+
+// FeedMock implements feed.Feed
+//
+// Feed interface refers to the types of this package from the channel, map, slice and array types,
+// its mock is generated into another package to check that the structure of these types is preserved
+type FeedMock struct {
+	state    minimock.MockState
+	mutex    mm_sync.RWMutex
+	delegate mm_feed.Feed
+
+	funcEvents func() (ch1 chan event.Event)
+	EventsMock *mFeedMockEvents
+
+	funcGroups func(m map[mm_feed.Key]map[string][2]*mm_feed.Update) (ma1 []map[mm_feed.Key]chan mm_feed.Update)
+	GroupsMock *mFeedMockGroups
+
+	funcIndex func() (m1 map[mm_feed.Key][]*mm_feed.Update)
+	IndexMock *mFeedMockIndex
+
+	funcPipe func(ch chan mm_feed.Update) (ch1 chan<- []*mm_feed.Update)
+	PipeMock *mFeedMockPipe
+
+	funcPublish func(ch chan<- mm_feed.Update) (err error)
+	PublishMock *mFeedMockPublish
+
+	funcStreams func() (ch1 chan<- <-chan mm_feed.Update)
+	StreamsMock *mFeedMockStreams
+
+	funcUpdates func() (ch1 <-chan mm_feed.Update)
+	UpdatesMock *mFeedMockUpdates
+}
+
+var _ mm_feed.Feed = (*FeedMock)(nil)
+
+// NewFeedMock returns a mock for feed.Feed
+func NewFeedMock(t minimock.Tester) *FeedMock {
+	m := &FeedMock{}
+	m.state.Init(m, t, "FeedMock", 0)
+
+	m.EventsMock = &mFeedMockEvents{mock: m}
+	m.EventsMock.state.Init(&m.state, "Events")
+
+	m.GroupsMock = &mFeedMockGroups{mock: m}
+	m.GroupsMock.state.Init(&m.state, "Groups")
+
+	m.IndexMock = &mFeedMockIndex{mock: m}
+	m.IndexMock.state.Init(&m.state, "Index")
+
+	m.PipeMock = &mFeedMockPipe{mock: m}
+	m.PipeMock.state.Init(&m.state, "Pipe")
+
+	m.PublishMock = &mFeedMockPublish{mock: m}
+	m.PublishMock.state.Init(&m.state, "Publish")
+
+	m.StreamsMock = &mFeedMockStreams{mock: m}
+	m.StreamsMock.state.Init(&m.state, "Streams")
+
+	m.UpdatesMock = &mFeedMockUpdates{mock: m}
+	m.UpdatesMock.state.Init(&m.state, "Updates")
+
+	return m
+}
+
+type mFeedMockEvents struct {
+	mock               *FeedMock
+	state              minimock.MethodState
+	defaultExpectation *FeedMockEventsExpectation
+	expectations       []*FeedMockEventsExpectation
+	inspectEvents      func()
+	called             chan struct{}
+	queued             []*FeedMockEventsResults
+}
+
+// FeedMockEventsExpectation specifies expectation struct of the Feed.Events
+type FeedMockEventsExpectation struct {
+	mock *FeedMock
+
+	results *FeedMockEventsResults
+	Counter uint64
+}
+
+// FeedMockEventsResults contains results of the Feed.Events
+type FeedMockEventsResults struct {
+	R0 chan event.Event
+}
+
+// Expect sets up expected params for Feed.Events
+func (mmEvents *mFeedMockEvents) Expect() *mFeedMockEvents {
+	if _, mm_func := mmEvents.current(); mm_func != nil {
+		mmEvents.mock.state.T().Fatalf("FeedMock.Events mock is already set by Set")
+	}
+
+	mmEvents.updateDefault(func(*FeedMockEventsExpectation) {})
+
+	return mmEvents
+}
+
+// updateDefault replaces the default expectation of Feed.Events by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmEvents *mFeedMockEvents) updateDefault(update func(e *FeedMockEventsExpectation)) {
+	mmEvents.state.Mutex.Lock()
+	defer mmEvents.state.Mutex.Unlock()
+
+	e := &FeedMockEventsExpectation{mock: mmEvents.mock}
+	if previous := mmEvents.defaultExpectation; previous != nil {
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmEvents.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Feed.Events
+func (mmEvents *mFeedMockEvents) current() (*FeedMockEventsExpectation, func() (ch1 chan event.Event)) {
+	mmEvents.state.Mutex.RLock()
+	defer mmEvents.state.Mutex.RUnlock()
+
+	return mmEvents.defaultExpectation, mmEvents.mock.funcEvents
+}
+
+// Return sets up results that will be returned by Feed.Events
+func (mmEvents *mFeedMockEvents) Return(ch1 chan event.Event) *FeedMock {
+	if _, mm_func := mmEvents.current(); mm_func != nil {
+		mmEvents.mock.state.T().Fatalf("FeedMock.Events mock is already set by Set")
+	}
+
+	mm_results := &FeedMockEventsResults{ch1}
+	mmEvents.updateDefault(func(e *FeedMockEventsExpectation) { e.results = mm_results })
+
+	return mmEvents.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Feed.Events,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmEvents *mFeedMockEvents) ReturnOnce(ch1 chan event.Event) *mFeedMockEvents {
+	mmEvents.state.Mutex.Lock()
+	mmEvents.queued = append(mmEvents.queued, &FeedMockEventsResults{ch1})
+	mmEvents.state.Mutex.Unlock()
+	mmEvents.state.PushResults()
+	return mmEvents
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmEvents *mFeedMockEvents) popResults() *FeedMockEventsResults {
+	mm_i, mm_ok := mmEvents.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmEvents.state.Mutex.RLock()
+	defer mmEvents.state.Mutex.RUnlock()
+
+	return mmEvents.queued[mm_i]
+}
+
+// Inspect sets up the function called with the params of every Feed.Events call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmEvents *mFeedMockEvents) Inspect(f func()) *mFeedMockEvents {
+	mmEvents.state.Mutex.Lock()
+	mmEvents.inspectEvents = f
+	mmEvents.state.Mutex.Unlock()
+	return mmEvents
+}
+
+// expected returns everything set up for the Feed.Events call under a single read lock: the default expectation,
+// the functions set by Set and Inspect
+func (mmEvents *mFeedMockEvents) expected() (*FeedMockEventsExpectation, func() (ch1 chan event.Event), func()) {
+	mmEvents.state.Mutex.RLock()
+	defer mmEvents.state.Mutex.RUnlock()
+
+	return mmEvents.defaultExpectation, mmEvents.mock.funcEvents, mmEvents.inspectEvents
+}
+
+// setup returns the expectations and the function set up for Feed.Events to be checked when the mock is finished
+func (mmEvents *mFeedMockEvents) setup() (setup minimock.Setup) {
+	mmEvents.state.Mutex.RLock()
+	defer mmEvents.state.Mutex.RUnlock()
+
+	setup.Func = mmEvents.mock.funcEvents != nil
+	if e := mmEvents.defaultExpectation; e != nil {
+		setup.Default = true
+	}
+
+	for _, e := range mmEvents.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Feed.Events
+func (mmEvents *mFeedMockEvents) reset() {
+	mmEvents.state.Mutex.Lock()
+	mmEvents.defaultExpectation = nil
+	mmEvents.expectations = nil
+	mmEvents.queued = nil
+	mmEvents.state.Mutex.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmEvents *mFeedMockEvents) MethodName() string {
+	return mmEvents.state.Name()
+}
+
+// CallSequence returns the numbers of the Feed.Events calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmEvents *mFeedMockEvents) CallSequence() []uint64 {
+	return mmEvents.state.CallSequence()
+}
+
+// WaitForCalls waits until Feed.Events is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmEvents *mFeedMockEvents) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmEvents.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Feed.Events calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmEvents *mFeedMockEvents) Block() (release func()) {
+	return mmEvents.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Feed.Events calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmEvents *mFeedMockEvents) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmEvents.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Feed.Events calls are in flight at once
+func (mmEvents *mFeedMockEvents) LimitConcurrency(n int) *mFeedMockEvents {
+	mmEvents.state.SetLimit(n)
+	return mmEvents
+}
+
+// CaptureCalls creates the buffered channel receiving the signal of each Feed.Events call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmEvents *mFeedMockEvents) CaptureCalls(buffer int) *mFeedMockEvents {
+	mmEvents.state.History.Lock()
+	mmEvents.called = make(chan struct{}, buffer)
+	mmEvents.state.History.Unlock()
+	return mmEvents
+}
+
+// Called returns the channel set up by CaptureCalls receiving the signal of each Feed.Events call
+func (mmEvents *mFeedMockEvents) Called() <-chan struct{} {
+	mmEvents.state.History.Lock()
+	defer mmEvents.state.History.Unlock()
+
+	if mmEvents.called == nil {
+		mmEvents.mock.state.T().Fatalf("Calls of FeedMock.Events aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmEvents.called
+}
+
+// DroppedCalls returns the number of the Feed.Events calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmEvents *mFeedMockEvents) DroppedCalls() uint64 {
+	return mmEvents.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Feed.Events calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmEvents *mFeedMockEvents) Times(n uint64) *mFeedMockEvents {
+	mmEvents.state.SetTimes(n)
+	return mmEvents
+}
+
+// Optional excludes Feed.Events from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmEvents *mFeedMockEvents) Optional() *mFeedMockEvents {
+	mmEvents.state.SetOptional()
+	return mmEvents
+}
+
+// Set uses given function f to mock the Feed.Events method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmEvents *mFeedMockEvents) Set(f func() (ch1 chan event.Event)) *FeedMock {
+	mmEvents.state.Mutex.Lock()
+	defer mmEvents.state.Mutex.Unlock()
+
+	if mmEvents.defaultExpectation != nil {
+		mmEvents.mock.state.T().Fatalf("Default expectation is already set for the Feed.Events method")
+	}
+
+	if len(mmEvents.expectations) > 0 {
+		mmEvents.mock.state.T().Fatalf("Some expectations are already set for the Feed.Events method")
+	}
+
+	mmEvents.mock.funcEvents = f
+	return mmEvents.mock
+}
+
+// Events implements feed.Feed
+func (mmEvents *FeedMock) Events() (ch1 chan event.Event) {
+	mm_method := mmEvents.EventsMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_method.state.Record()
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- struct{}{}:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcEvents, mm_inspectEvents := mm_method.expected()
+	if mm_inspectEvents != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectEvents()
+		}()
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcEvents == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, nil) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcEvents != nil {
+		return mm_funcEvents()
+	}
+	if mm_delegate := mmEvents.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Events()
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(nil)
+	return
+}
+
+// EventsAfterCounter returns a count of finished FeedMock.Events invocations
+func (mmEvents *FeedMock) EventsAfterCounter() uint64 {
+	return mmEvents.EventsMock.state.AfterCalls()
+}
+
+// EventsBeforeCounter returns a count of FeedMock.Events invocations
+func (mmEvents *FeedMock) EventsBeforeCounter() uint64 {
+	return mmEvents.EventsMock.state.BeforeCalls()
+}
+
+// EventsCallTimes returns the times of all FeedMock.Events calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmEvents *FeedMock) EventsCallTimes() []mm_time.Time {
+	return mmEvents.EventsMock.state.CallTimes()
+}
+
+// EventsMaxInFlight returns the maximum number of the FeedMock.Events calls that have been in flight at once
+func (mmEvents *FeedMock) EventsMaxInFlight() int {
+	return mmEvents.EventsMock.state.MaxInFlight()
+}
+
+// EventsUnexpectedCounter returns a count of FeedMock.Events invocations made without an implementation
+func (mmEvents *FeedMock) EventsUnexpectedCounter() uint64 {
+	return mmEvents.EventsMock.state.UnexpectedCalls()
+}
+
+// EventsNotCalled returns true if FeedMock.Events hasn't been called
+func (mmEvents *FeedMock) EventsNotCalled() bool {
+	return mmEvents.EventsMock.state.BeforeCalls() == 0
+}
+
+// EventsCallCount returns a count of FeedMock.Events invocations, it's the same as EventsBeforeCounter
+func (mmEvents *FeedMock) EventsCallCount() uint64 {
+	return mmEvents.EventsMock.state.BeforeCalls()
+}
+
+// MinimockEventsDone returns true if the count of the Events invocations corresponds
+// the number of defined expectations
+func (mmEvents *FeedMock) MinimockEventsDone() bool {
+	return mmEvents.EventsMock.state.Done(mmEvents.EventsMock.setup())
+}
+
+// MinimockEventsInspect logs each unmet expectation
+func (mmEvents *FeedMock) MinimockEventsInspect() {
+	mmEvents.EventsMock.state.Inspect(mmEvents.EventsMock.setup())
+}
+
+type mFeedMockGroups struct {
+	mock               *FeedMock
+	state              minimock.MethodState
+	defaultExpectation *FeedMockGroupsExpectation
+	expectations       []*FeedMockGroupsExpectation
+	inspectGroups      func(m map[mm_feed.Key]map[string][2]*mm_feed.Update)
+	compare            minimock.Comparer
+	calls              []FeedMockGroupsParams
+	called             chan FeedMockGroupsParams
+	queued             []*FeedMockGroupsResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*FeedMockGroupsResults
+}
+
+// FeedMockGroupsExpectation specifies expectation struct of the Feed.Groups
+type FeedMockGroupsExpectation struct {
+	mock     *FeedMock
+	params   *FeedMockGroupsParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *FeedMockGroupsResults
+	Counter  uint64
+}
+
+// FeedMockGroupsParams contains parameters of the Feed.Groups
+type FeedMockGroupsParams struct {
+	M map[mm_feed.Key]map[string][2]*mm_feed.Update
+}
+
+// FeedMockGroupsResults contains results of the Feed.Groups
+type FeedMockGroupsResults struct {
+	R0 []map[mm_feed.Key]chan mm_feed.Update
+}
+
+// Expect sets up expected params for Feed.Groups
+func (mmGroups *mFeedMockGroups) Expect(m map[mm_feed.Key]map[string][2]*mm_feed.Update) *mFeedMockGroups {
+	if _, mm_func := mmGroups.current(); mm_func != nil {
+		mmGroups.mock.state.T().Fatalf("FeedMock.Groups mock is already set by Set")
+	}
+
+	mm_params := &FeedMockGroupsParams{m}
+	mmGroups.updateDefault(func(e *FeedMockGroupsExpectation) {
+		if e.partial {
+			mmGroups.mock.state.T().Fatalf("FeedMock.Groups params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmGroups.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmGroups.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmGroups
+}
+
+// updateDefault replaces the default expectation of Feed.Groups by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmGroups *mFeedMockGroups) updateDefault(update func(e *FeedMockGroupsExpectation)) {
+	mmGroups.state.Mutex.Lock()
+	defer mmGroups.state.Mutex.Unlock()
+
+	e := &FeedMockGroupsExpectation{mock: mmGroups.mock}
+	if previous := mmGroups.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmGroups.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Feed.Groups
+func (mmGroups *mFeedMockGroups) current() (*FeedMockGroupsExpectation, func(m map[mm_feed.Key]map[string][2]*mm_feed.Update) (ma1 []map[mm_feed.Key]chan mm_feed.Update)) {
+	mmGroups.state.Mutex.RLock()
+	defer mmGroups.state.Mutex.RUnlock()
+
+	return mmGroups.defaultExpectation, mmGroups.mock.funcGroups
+}
+
+// partialParams updates the default expectation of Feed.Groups by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmGroups *mFeedMockGroups) partialParams(update func(e *FeedMockGroupsExpectation)) {
+	if _, mm_func := mmGroups.current(); mm_func != nil {
+		mmGroups.mock.state.T().Fatalf("FeedMock.Groups mock is already set by Set")
+	}
+
+	mmGroups.updateDefault(func(e *FeedMockGroupsExpectation) {
+		if e.params == nil {
+			e.params = &FeedMockGroupsParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"M": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectMParam1 sets up the expected value of the param #1 of Feed.Groups,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmGroups *mFeedMockGroups) ExpectMParam1(M map[mm_feed.Key]map[string][2]*mm_feed.Update) *mFeedMockGroups {
+	mmGroups.partialParams(func(e *FeedMockGroupsExpectation) {
+		e.params.M = M
+		delete(e.matchers, "M")
+	})
+	return mmGroups
+}
+
+// MatchMParam1 sets up the predicate matching the param #1 of Feed.Groups,
+// it's used instead of the value of the param set by Expect
+func (mmGroups *mFeedMockGroups) MatchMParam1(f func(got map[mm_feed.Key]map[string][2]*mm_feed.Update) bool) *mFeedMockGroups {
+	mm_matcher := minimock.Predicate("predicate func(got map[mm_feed.Key]map[string][2]*mm_feed.Update) bool", func(v interface{}) bool {
+		got, _ := v.(map[mm_feed.Key]map[string][2]*mm_feed.Update)
+		return f(got)
+	})
+	mmGroups.partialParams(func(e *FeedMockGroupsExpectation) {
+		e.matchers["M"] = mm_matcher
+	})
+	return mmGroups
+}
+
+// Return sets up results that will be returned by Feed.Groups
+func (mmGroups *mFeedMockGroups) Return(ma1 []map[mm_feed.Key]chan mm_feed.Update) *FeedMock {
+	if _, mm_func := mmGroups.current(); mm_func != nil {
+		mmGroups.mock.state.T().Fatalf("FeedMock.Groups mock is already set by Set")
+	}
+
+	mm_results := &FeedMockGroupsResults{ma1}
+	mmGroups.updateDefault(func(e *FeedMockGroupsExpectation) { e.results = mm_results })
+
+	return mmGroups.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Feed.Groups,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmGroups *mFeedMockGroups) ReturnOnce(ma1 []map[mm_feed.Key]chan mm_feed.Update) *mFeedMockGroups {
+	mmGroups.state.Mutex.Lock()
+	mmGroups.queued = append(mmGroups.queued, &FeedMockGroupsResults{ma1})
+	mmGroups.state.Mutex.Unlock()
+	mmGroups.state.PushResults()
+	return mmGroups
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmGroups *mFeedMockGroups) popResults() *FeedMockGroupsResults {
+	mm_i, mm_ok := mmGroups.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmGroups.state.Mutex.RLock()
+	defer mmGroups.state.Mutex.RUnlock()
+
+	return mmGroups.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of Feed.Groups instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmGroups *mFeedMockGroups) SetComparer(compare minimock.Comparer) *mFeedMockGroups {
+	mmGroups.state.Mutex.Lock()
+	mmGroups.compare = compare
+	mmGroups.state.Mutex.Unlock()
+	return mmGroups
+}
+
+// whenExpectations returns the expectations of Feed.Groups set by When,
+// the expectations can be set while the method is called concurrently
+func (mmGroups *mFeedMockGroups) whenExpectations() []*FeedMockGroupsExpectation {
+	mmGroups.state.Mutex.RLock()
+	defer mmGroups.state.Mutex.RUnlock()
+
+	return mmGroups.expectations
+}
+
+// Inspect sets up the function called with the params of every Feed.Groups call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmGroups *mFeedMockGroups) Inspect(f func(m map[mm_feed.Key]map[string][2]*mm_feed.Update)) *mFeedMockGroups {
+	mmGroups.state.Mutex.Lock()
+	mmGroups.inspectGroups = f
+	mmGroups.state.Mutex.Unlock()
+	return mmGroups
+}
+
+// expected returns everything set up for the Feed.Groups call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmGroups *mFeedMockGroups) expected() (*FeedMockGroupsExpectation, func(m map[mm_feed.Key]map[string][2]*mm_feed.Update) (ma1 []map[mm_feed.Key]chan mm_feed.Update), func(m map[mm_feed.Key]map[string][2]*mm_feed.Update), minimock.Comparer, []*FeedMockGroupsExpectation, []*FeedMockGroupsResults) {
+	mmGroups.state.Mutex.RLock()
+	defer mmGroups.state.Mutex.RUnlock()
+
+	return mmGroups.defaultExpectation, mmGroups.mock.funcGroups, mmGroups.inspectGroups, mmGroups.compare, mmGroups.expectations, mmGroups.whenResults
+}
+
+// setup returns the expectations and the function set up for Feed.Groups to be checked when the mock is finished
+func (mmGroups *mFeedMockGroups) setup() (setup minimock.Setup) {
+	mmGroups.state.Mutex.RLock()
+	defer mmGroups.state.Mutex.RUnlock()
+
+	setup.Func = mmGroups.mock.funcGroups != nil
+	if e := mmGroups.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmGroups.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Feed.Groups, the params of the calls are removed from the history as well
+func (mmGroups *mFeedMockGroups) reset() {
+	mmGroups.state.Mutex.Lock()
+	mmGroups.defaultExpectation = nil
+	mmGroups.expectations = nil
+	mmGroups.queued = nil
+	mmGroups.whenResults = nil
+	mmGroups.state.Mutex.Unlock()
+
+	mmGroups.state.History.Lock()
+	mmGroups.calls = nil
+	mmGroups.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmGroups *mFeedMockGroups) MethodName() string {
+	return mmGroups.state.Name()
+}
+
+// CallSequence returns the numbers of the Feed.Groups calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmGroups *mFeedMockGroups) CallSequence() []uint64 {
+	return mmGroups.state.CallSequence()
+}
+
+// WaitForCalls waits until Feed.Groups is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmGroups *mFeedMockGroups) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmGroups.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Feed.Groups calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmGroups *mFeedMockGroups) Block() (release func()) {
+	return mmGroups.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Feed.Groups calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmGroups *mFeedMockGroups) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmGroups.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Feed.Groups calls are in flight at once
+func (mmGroups *mFeedMockGroups) LimitConcurrency(n int) *mFeedMockGroups {
+	mmGroups.state.SetLimit(n)
+	return mmGroups
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Feed.Groups call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmGroups *mFeedMockGroups) CaptureCalls(buffer int) *mFeedMockGroups {
+	mmGroups.state.History.Lock()
+	mmGroups.called = make(chan FeedMockGroupsParams, buffer)
+	mmGroups.state.History.Unlock()
+	return mmGroups
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Feed.Groups call
+func (mmGroups *mFeedMockGroups) Called() <-chan FeedMockGroupsParams {
+	mmGroups.state.History.Lock()
+	defer mmGroups.state.History.Unlock()
+
+	if mmGroups.called == nil {
+		mmGroups.mock.state.T().Fatalf("Calls of FeedMock.Groups aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmGroups.called
+}
+
+// DroppedCalls returns the number of the Feed.Groups calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmGroups *mFeedMockGroups) DroppedCalls() uint64 {
+	return mmGroups.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Feed.Groups calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmGroups *mFeedMockGroups) Times(n uint64) *mFeedMockGroups {
+	mmGroups.state.SetTimes(n)
+	return mmGroups
+}
+
+// Optional excludes Feed.Groups from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmGroups *mFeedMockGroups) Optional() *mFeedMockGroups {
+	mmGroups.state.SetOptional()
+	return mmGroups
+}
+
+// Set uses given function f to mock the Feed.Groups method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmGroups *mFeedMockGroups) Set(f func(m map[mm_feed.Key]map[string][2]*mm_feed.Update) (ma1 []map[mm_feed.Key]chan mm_feed.Update)) *FeedMock {
+	mmGroups.state.Mutex.Lock()
+	defer mmGroups.state.Mutex.Unlock()
+
+	if mmGroups.defaultExpectation != nil {
+		mmGroups.mock.state.T().Fatalf("Default expectation is already set for the Feed.Groups method")
+	}
+
+	if len(mmGroups.expectations) > 0 {
+		mmGroups.mock.state.T().Fatalf("Some expectations are already set for the Feed.Groups method")
+	}
+
+	mmGroups.mock.funcGroups = f
+	return mmGroups.mock
+}
+
+// When sets expectation for the Feed.Groups which will trigger the result defined by the following
+// Then helper
+func (mmGroups *mFeedMockGroups) When(m map[mm_feed.Key]map[string][2]*mm_feed.Update) *FeedMockGroupsExpectation {
+	if _, mm_func := mmGroups.current(); mm_func != nil {
+		mmGroups.mock.state.T().Fatalf("FeedMock.Groups mock is already set by Set")
+	}
+
+	expectation := &FeedMockGroupsExpectation{
+		mock:   mmGroups.mock,
+		params: &FeedMockGroupsParams{m},
+	}
+	mmGroups.state.Mutex.Lock()
+	mmGroups.expectations = append(mmGroups.expectations, expectation)
+	mmGroups.whenResults = append(mmGroups.whenResults, nil)
+	mmGroups.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up Feed.Groups return parameters for the expectation previously defined by the When method
+func (mmExpectation *FeedMockGroupsExpectation) Then(ma1 []map[mm_feed.Key]chan mm_feed.Update) *FeedMock {
+	mm_handle := mmExpectation.mock.GroupsMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &FeedMockGroupsResults{ma1}
+	mm_results := make([]*FeedMockGroupsResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Groups implements feed.Feed
+func (mmGroups *FeedMock) Groups(m map[mm_feed.Key]map[string][2]*mm_feed.Update) (ma1 []map[mm_feed.Key]chan mm_feed.Update) {
+	mm_method := mmGroups.GroupsMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := FeedMockGroupsParams{m}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcGroups, mm_inspectGroups, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectGroups != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectGroups(m)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).R0
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcGroups == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcGroups != nil {
+		return mm_funcGroups(m)
+	}
+	if mm_delegate := mmGroups.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Groups(m)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, m)
+	return
+}
+
+// GroupsAfterCounter returns a count of finished FeedMock.Groups invocations
+func (mmGroups *FeedMock) GroupsAfterCounter() uint64 {
+	return mmGroups.GroupsMock.state.AfterCalls()
+}
+
+// GroupsBeforeCounter returns a count of FeedMock.Groups invocations
+func (mmGroups *FeedMock) GroupsBeforeCounter() uint64 {
+	return mmGroups.GroupsMock.state.BeforeCalls()
+}
+
+// GroupsCalls returns the params of all FeedMock.Groups calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmGroups *FeedMock) GroupsCalls() []FeedMockGroupsParams {
+	mmGroups.GroupsMock.state.History.Lock()
+	defer mmGroups.GroupsMock.state.History.Unlock()
+
+	calls := make([]FeedMockGroupsParams, len(mmGroups.GroupsMock.calls))
+	copy(calls, mmGroups.GroupsMock.calls)
+	return calls
+}
+
+// GroupsLastParams returns the params of the latest FeedMock.Groups call and false if there were no calls
+func (mmGroups *FeedMock) GroupsLastParams() (params FeedMockGroupsParams, ok bool) {
+	mmGroups.GroupsMock.state.History.Lock()
+	defer mmGroups.GroupsMock.state.History.Unlock()
+
+	if n := len(mmGroups.GroupsMock.calls); n > 0 {
+		return mmGroups.GroupsMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// GroupsCallTimes returns the times of all FeedMock.Groups calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmGroups *FeedMock) GroupsCallTimes() []mm_time.Time {
+	return mmGroups.GroupsMock.state.CallTimes()
+}
+
+// GroupsMaxInFlight returns the maximum number of the FeedMock.Groups calls that have been in flight at once
+func (mmGroups *FeedMock) GroupsMaxInFlight() int {
+	return mmGroups.GroupsMock.state.MaxInFlight()
+}
+
+// GroupsUnexpectedCounter returns a count of FeedMock.Groups invocations made without an implementation
+func (mmGroups *FeedMock) GroupsUnexpectedCounter() uint64 {
+	return mmGroups.GroupsMock.state.UnexpectedCalls()
+}
+
+// GroupsNotCalled returns true if FeedMock.Groups hasn't been called
+func (mmGroups *FeedMock) GroupsNotCalled() bool {
+	return mmGroups.GroupsMock.state.BeforeCalls() == 0
+}
+
+// GroupsCallCount returns a count of FeedMock.Groups invocations, it's the same as GroupsBeforeCounter
+func (mmGroups *FeedMock) GroupsCallCount() uint64 {
+	return mmGroups.GroupsMock.state.BeforeCalls()
+}
+
+// MinimockGroupsDone returns true if the count of the Groups invocations corresponds
+// the number of defined expectations
+func (mmGroups *FeedMock) MinimockGroupsDone() bool {
+	return mmGroups.GroupsMock.state.Done(mmGroups.GroupsMock.setup())
+}
+
+// MinimockGroupsInspect logs each unmet expectation
+func (mmGroups *FeedMock) MinimockGroupsInspect() {
+	mmGroups.GroupsMock.state.Inspect(mmGroups.GroupsMock.setup())
+}
+
+type mFeedMockIndex struct {
+	mock               *FeedMock
+	state              minimock.MethodState
+	defaultExpectation *FeedMockIndexExpectation
+	expectations       []*FeedMockIndexExpectation
+	inspectIndex       func()
+	called             chan struct{}
+	queued             []*FeedMockIndexResults
+}
+
+// FeedMockIndexExpectation specifies expectation struct of the Feed.Index
+type FeedMockIndexExpectation struct {
+	mock *FeedMock
+
+	results *FeedMockIndexResults
+	Counter uint64
+}
+
+// FeedMockIndexResults contains results of the Feed.Index
+type FeedMockIndexResults struct {
+	R0 map[mm_feed.Key][]*mm_feed.Update
+}
+
+// Expect sets up expected params for Feed.Index
+func (mmIndex *mFeedMockIndex) Expect() *mFeedMockIndex {
+	if _, mm_func := mmIndex.current(); mm_func != nil {
+		mmIndex.mock.state.T().Fatalf("FeedMock.Index mock is already set by Set")
+	}
+
+	mmIndex.updateDefault(func(*FeedMockIndexExpectation) {})
+
+	return mmIndex
+}
+
+// updateDefault replaces the default expectation of Feed.Index by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmIndex *mFeedMockIndex) updateDefault(update func(e *FeedMockIndexExpectation)) {
+	mmIndex.state.Mutex.Lock()
+	defer mmIndex.state.Mutex.Unlock()
+
+	e := &FeedMockIndexExpectation{mock: mmIndex.mock}
+	if previous := mmIndex.defaultExpectation; previous != nil {
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmIndex.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Feed.Index
+func (mmIndex *mFeedMockIndex) current() (*FeedMockIndexExpectation, func() (m1 map[mm_feed.Key][]*mm_feed.Update)) {
+	mmIndex.state.Mutex.RLock()
+	defer mmIndex.state.Mutex.RUnlock()
+
+	return mmIndex.defaultExpectation, mmIndex.mock.funcIndex
+}
+
+// Return sets up results that will be returned by Feed.Index
+func (mmIndex *mFeedMockIndex) Return(m1 map[mm_feed.Key][]*mm_feed.Update) *FeedMock {
+	if _, mm_func := mmIndex.current(); mm_func != nil {
+		mmIndex.mock.state.T().Fatalf("FeedMock.Index mock is already set by Set")
+	}
+
+	mm_results := &FeedMockIndexResults{m1}
+	mmIndex.updateDefault(func(e *FeedMockIndexExpectation) { e.results = mm_results })
+
+	return mmIndex.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Feed.Index,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmIndex *mFeedMockIndex) ReturnOnce(m1 map[mm_feed.Key][]*mm_feed.Update) *mFeedMockIndex {
+	mmIndex.state.Mutex.Lock()
+	mmIndex.queued = append(mmIndex.queued, &FeedMockIndexResults{m1})
+	mmIndex.state.Mutex.Unlock()
+	mmIndex.state.PushResults()
+	return mmIndex
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmIndex *mFeedMockIndex) popResults() *FeedMockIndexResults {
+	mm_i, mm_ok := mmIndex.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmIndex.state.Mutex.RLock()
+	defer mmIndex.state.Mutex.RUnlock()
+
+	return mmIndex.queued[mm_i]
+}
+
+// Inspect sets up the function called with the params of every Feed.Index call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmIndex *mFeedMockIndex) Inspect(f func()) *mFeedMockIndex {
+	mmIndex.state.Mutex.Lock()
+	mmIndex.inspectIndex = f
+	mmIndex.state.Mutex.Unlock()
+	return mmIndex
+}
+
+// expected returns everything set up for the Feed.Index call under a single read lock: the default expectation,
+// the functions set by Set and Inspect
+func (mmIndex *mFeedMockIndex) expected() (*FeedMockIndexExpectation, func() (m1 map[mm_feed.Key][]*mm_feed.Update), func()) {
+	mmIndex.state.Mutex.RLock()
+	defer mmIndex.state.Mutex.RUnlock()
+
+	return mmIndex.defaultExpectation, mmIndex.mock.funcIndex, mmIndex.inspectIndex
+}
+
+// setup returns the expectations and the function set up for Feed.Index to be checked when the mock is finished
+func (mmIndex *mFeedMockIndex) setup() (setup minimock.Setup) {
+	mmIndex.state.Mutex.RLock()
+	defer mmIndex.state.Mutex.RUnlock()
+
+	setup.Func = mmIndex.mock.funcIndex != nil
+	if e := mmIndex.defaultExpectation; e != nil {
+		setup.Default = true
+	}
+
+	for _, e := range mmIndex.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Feed.Index
+func (mmIndex *mFeedMockIndex) reset() {
+	mmIndex.state.Mutex.Lock()
+	mmIndex.defaultExpectation = nil
+	mmIndex.expectations = nil
+	mmIndex.queued = nil
+	mmIndex.state.Mutex.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmIndex *mFeedMockIndex) MethodName() string {
+	return mmIndex.state.Name()
+}
+
+// CallSequence returns the numbers of the Feed.Index calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmIndex *mFeedMockIndex) CallSequence() []uint64 {
+	return mmIndex.state.CallSequence()
+}
+
+// WaitForCalls waits until Feed.Index is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmIndex *mFeedMockIndex) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmIndex.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Feed.Index calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmIndex *mFeedMockIndex) Block() (release func()) {
+	return mmIndex.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Feed.Index calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmIndex *mFeedMockIndex) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmIndex.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Feed.Index calls are in flight at once
+func (mmIndex *mFeedMockIndex) LimitConcurrency(n int) *mFeedMockIndex {
+	mmIndex.state.SetLimit(n)
+	return mmIndex
+}
+
+// CaptureCalls creates the buffered channel receiving the signal of each Feed.Index call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmIndex *mFeedMockIndex) CaptureCalls(buffer int) *mFeedMockIndex {
+	mmIndex.state.History.Lock()
+	mmIndex.called = make(chan struct{}, buffer)
+	mmIndex.state.History.Unlock()
+	return mmIndex
+}
+
+// Called returns the channel set up by CaptureCalls receiving the signal of each Feed.Index call
+func (mmIndex *mFeedMockIndex) Called() <-chan struct{} {
+	mmIndex.state.History.Lock()
+	defer mmIndex.state.History.Unlock()
+
+	if mmIndex.called == nil {
+		mmIndex.mock.state.T().Fatalf("Calls of FeedMock.Index aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmIndex.called
+}
+
+// DroppedCalls returns the number of the Feed.Index calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmIndex *mFeedMockIndex) DroppedCalls() uint64 {
+	return mmIndex.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Feed.Index calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmIndex *mFeedMockIndex) Times(n uint64) *mFeedMockIndex {
+	mmIndex.state.SetTimes(n)
+	return mmIndex
+}
+
+// Optional excludes Feed.Index from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmIndex *mFeedMockIndex) Optional() *mFeedMockIndex {
+	mmIndex.state.SetOptional()
+	return mmIndex
+}
+
+// Set uses given function f to mock the Feed.Index method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmIndex *mFeedMockIndex) Set(f func() (m1 map[mm_feed.Key][]*mm_feed.Update)) *FeedMock {
+	mmIndex.state.Mutex.Lock()
+	defer mmIndex.state.Mutex.Unlock()
+
+	if mmIndex.defaultExpectation != nil {
+		mmIndex.mock.state.T().Fatalf("Default expectation is already set for the Feed.Index method")
+	}
+
+	if len(mmIndex.expectations) > 0 {
+		mmIndex.mock.state.T().Fatalf("Some expectations are already set for the Feed.Index method")
+	}
+
+	mmIndex.mock.funcIndex = f
+	return mmIndex.mock
+}
+
+// Index implements feed.Feed
+func (mmIndex *FeedMock) Index() (m1 map[mm_feed.Key][]*mm_feed.Update) {
+	mm_method := mmIndex.IndexMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_method.state.Record()
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- struct{}{}:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcIndex, mm_inspectIndex := mm_method.expected()
+	if mm_inspectIndex != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectIndex()
+		}()
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcIndex == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, nil) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcIndex != nil {
+		return mm_funcIndex()
+	}
+	if mm_delegate := mmIndex.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Index()
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(nil)
+	return
+}
+
+// IndexAfterCounter returns a count of finished FeedMock.Index invocations
+func (mmIndex *FeedMock) IndexAfterCounter() uint64 {
+	return mmIndex.IndexMock.state.AfterCalls()
+}
+
+// IndexBeforeCounter returns a count of FeedMock.Index invocations
+func (mmIndex *FeedMock) IndexBeforeCounter() uint64 {
+	return mmIndex.IndexMock.state.BeforeCalls()
+}
+
+// IndexCallTimes returns the times of all FeedMock.Index calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmIndex *FeedMock) IndexCallTimes() []mm_time.Time {
+	return mmIndex.IndexMock.state.CallTimes()
+}
+
+// IndexMaxInFlight returns the maximum number of the FeedMock.Index calls that have been in flight at once
+func (mmIndex *FeedMock) IndexMaxInFlight() int {
+	return mmIndex.IndexMock.state.MaxInFlight()
+}
+
+// IndexUnexpectedCounter returns a count of FeedMock.Index invocations made without an implementation
+func (mmIndex *FeedMock) IndexUnexpectedCounter() uint64 {
+	return mmIndex.IndexMock.state.UnexpectedCalls()
+}
+
+// IndexNotCalled returns true if FeedMock.Index hasn't been called
+func (mmIndex *FeedMock) IndexNotCalled() bool {
+	return mmIndex.IndexMock.state.BeforeCalls() == 0
+}
+
+// IndexCallCount returns a count of FeedMock.Index invocations, it's the same as IndexBeforeCounter
+func (mmIndex *FeedMock) IndexCallCount() uint64 {
+	return mmIndex.IndexMock.state.BeforeCalls()
+}
+
+// MinimockIndexDone returns true if the count of the Index invocations corresponds
+// the number of defined expectations
+func (mmIndex *FeedMock) MinimockIndexDone() bool {
+	return mmIndex.IndexMock.state.Done(mmIndex.IndexMock.setup())
+}
+
+// MinimockIndexInspect logs each unmet expectation
+func (mmIndex *FeedMock) MinimockIndexInspect() {
+	mmIndex.IndexMock.state.Inspect(mmIndex.IndexMock.setup())
+}
+
+type mFeedMockPipe struct {
+	mock               *FeedMock
+	state              minimock.MethodState
+	defaultExpectation *FeedMockPipeExpectation
+	expectations       []*FeedMockPipeExpectation
+	inspectPipe        func(ch chan mm_feed.Update)
+	compare            minimock.Comparer
+	calls              []FeedMockPipeParams
+	called             chan FeedMockPipeParams
+	queued             []*FeedMockPipeResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*FeedMockPipeResults
+}
+
+// FeedMockPipeExpectation specifies expectation struct of the Feed.Pipe
+type FeedMockPipeExpectation struct {
+	mock     *FeedMock
+	params   *FeedMockPipeParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *FeedMockPipeResults
+	Counter  uint64
+}
+
+// FeedMockPipeParams contains parameters of the Feed.Pipe
+type FeedMockPipeParams struct {
+	Ch chan mm_feed.Update
+}
+
+// FeedMockPipeResults contains results of the Feed.Pipe
+type FeedMockPipeResults struct {
+	R0 chan<- []*mm_feed.Update
+}
+
+// Expect sets up expected params for Feed.Pipe
+func (mmPipe *mFeedMockPipe) Expect(ch chan mm_feed.Update) *mFeedMockPipe {
+	if _, mm_func := mmPipe.current(); mm_func != nil {
+		mmPipe.mock.state.T().Fatalf("FeedMock.Pipe mock is already set by Set")
+	}
+
+	mm_params := &FeedMockPipeParams{ch}
+	mmPipe.updateDefault(func(e *FeedMockPipeExpectation) {
+		if e.partial {
+			mmPipe.mock.state.T().Fatalf("FeedMock.Pipe params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmPipe.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmPipe.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmPipe
+}
+
+// updateDefault replaces the default expectation of Feed.Pipe by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmPipe *mFeedMockPipe) updateDefault(update func(e *FeedMockPipeExpectation)) {
+	mmPipe.state.Mutex.Lock()
+	defer mmPipe.state.Mutex.Unlock()
+
+	e := &FeedMockPipeExpectation{mock: mmPipe.mock}
+	if previous := mmPipe.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmPipe.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Feed.Pipe
+func (mmPipe *mFeedMockPipe) current() (*FeedMockPipeExpectation, func(ch chan mm_feed.Update) (ch1 chan<- []*mm_feed.Update)) {
+	mmPipe.state.Mutex.RLock()
+	defer mmPipe.state.Mutex.RUnlock()
+
+	return mmPipe.defaultExpectation, mmPipe.mock.funcPipe
+}
+
+// partialParams updates the default expectation of Feed.Pipe by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmPipe *mFeedMockPipe) partialParams(update func(e *FeedMockPipeExpectation)) {
+	if _, mm_func := mmPipe.current(); mm_func != nil {
+		mmPipe.mock.state.T().Fatalf("FeedMock.Pipe mock is already set by Set")
+	}
+
+	mmPipe.updateDefault(func(e *FeedMockPipeExpectation) {
+		if e.params == nil {
+			e.params = &FeedMockPipeParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"Ch": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectChParam1 sets up the expected value of the param #1 of Feed.Pipe,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmPipe *mFeedMockPipe) ExpectChParam1(Ch chan mm_feed.Update) *mFeedMockPipe {
+	mmPipe.partialParams(func(e *FeedMockPipeExpectation) {
+		e.params.Ch = Ch
+		delete(e.matchers, "Ch")
+	})
+	return mmPipe
+}
+
+// MatchChParam1 sets up the predicate matching the param #1 of Feed.Pipe,
+// it's used instead of the value of the param set by Expect
+func (mmPipe *mFeedMockPipe) MatchChParam1(f func(got chan mm_feed.Update) bool) *mFeedMockPipe {
+	mm_matcher := minimock.Predicate("predicate func(got chan mm_feed.Update) bool", func(v interface{}) bool {
+		got, _ := v.(chan mm_feed.Update)
+		return f(got)
+	})
+	mmPipe.partialParams(func(e *FeedMockPipeExpectation) {
+		e.matchers["Ch"] = mm_matcher
+	})
+	return mmPipe
+}
+
+// Return sets up results that will be returned by Feed.Pipe
+func (mmPipe *mFeedMockPipe) Return(ch1 chan<- []*mm_feed.Update) *FeedMock {
+	if _, mm_func := mmPipe.current(); mm_func != nil {
+		mmPipe.mock.state.T().Fatalf("FeedMock.Pipe mock is already set by Set")
+	}
+
+	mm_results := &FeedMockPipeResults{ch1}
+	mmPipe.updateDefault(func(e *FeedMockPipeExpectation) { e.results = mm_results })
+
+	return mmPipe.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Feed.Pipe,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmPipe *mFeedMockPipe) ReturnOnce(ch1 chan<- []*mm_feed.Update) *mFeedMockPipe {
+	mmPipe.state.Mutex.Lock()
+	mmPipe.queued = append(mmPipe.queued, &FeedMockPipeResults{ch1})
+	mmPipe.state.Mutex.Unlock()
+	mmPipe.state.PushResults()
+	return mmPipe
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmPipe *mFeedMockPipe) popResults() *FeedMockPipeResults {
+	mm_i, mm_ok := mmPipe.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmPipe.state.Mutex.RLock()
+	defer mmPipe.state.Mutex.RUnlock()
+
+	return mmPipe.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of Feed.Pipe instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmPipe *mFeedMockPipe) SetComparer(compare minimock.Comparer) *mFeedMockPipe {
+	mmPipe.state.Mutex.Lock()
+	mmPipe.compare = compare
+	mmPipe.state.Mutex.Unlock()
+	return mmPipe
+}
+
+// whenExpectations returns the expectations of Feed.Pipe set by When,
+// the expectations can be set while the method is called concurrently
+func (mmPipe *mFeedMockPipe) whenExpectations() []*FeedMockPipeExpectation {
+	mmPipe.state.Mutex.RLock()
+	defer mmPipe.state.Mutex.RUnlock()
+
+	return mmPipe.expectations
+}
+
+// Inspect sets up the function called with the params of every Feed.Pipe call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmPipe *mFeedMockPipe) Inspect(f func(ch chan mm_feed.Update)) *mFeedMockPipe {
+	mmPipe.state.Mutex.Lock()
+	mmPipe.inspectPipe = f
+	mmPipe.state.Mutex.Unlock()
+	return mmPipe
+}
+
+// expected returns everything set up for the Feed.Pipe call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmPipe *mFeedMockPipe) expected() (*FeedMockPipeExpectation, func(ch chan mm_feed.Update) (ch1 chan<- []*mm_feed.Update), func(ch chan mm_feed.Update), minimock.Comparer, []*FeedMockPipeExpectation, []*FeedMockPipeResults) {
+	mmPipe.state.Mutex.RLock()
+	defer mmPipe.state.Mutex.RUnlock()
+
+	return mmPipe.defaultExpectation, mmPipe.mock.funcPipe, mmPipe.inspectPipe, mmPipe.compare, mmPipe.expectations, mmPipe.whenResults
+}
+
+// setup returns the expectations and the function set up for Feed.Pipe to be checked when the mock is finished
+func (mmPipe *mFeedMockPipe) setup() (setup minimock.Setup) {
+	mmPipe.state.Mutex.RLock()
+	defer mmPipe.state.Mutex.RUnlock()
+
+	setup.Func = mmPipe.mock.funcPipe != nil
+	if e := mmPipe.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmPipe.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Feed.Pipe, the params of the calls are removed from the history as well
+func (mmPipe *mFeedMockPipe) reset() {
+	mmPipe.state.Mutex.Lock()
+	mmPipe.defaultExpectation = nil
+	mmPipe.expectations = nil
+	mmPipe.queued = nil
+	mmPipe.whenResults = nil
+	mmPipe.state.Mutex.Unlock()
+
+	mmPipe.state.History.Lock()
+	mmPipe.calls = nil
+	mmPipe.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmPipe *mFeedMockPipe) MethodName() string {
+	return mmPipe.state.Name()
+}
+
+// CallSequence returns the numbers of the Feed.Pipe calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmPipe *mFeedMockPipe) CallSequence() []uint64 {
+	return mmPipe.state.CallSequence()
+}
+
+// WaitForCalls waits until Feed.Pipe is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmPipe *mFeedMockPipe) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmPipe.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Feed.Pipe calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmPipe *mFeedMockPipe) Block() (release func()) {
+	return mmPipe.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Feed.Pipe calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmPipe *mFeedMockPipe) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmPipe.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Feed.Pipe calls are in flight at once
+func (mmPipe *mFeedMockPipe) LimitConcurrency(n int) *mFeedMockPipe {
+	mmPipe.state.SetLimit(n)
+	return mmPipe
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Feed.Pipe call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmPipe *mFeedMockPipe) CaptureCalls(buffer int) *mFeedMockPipe {
+	mmPipe.state.History.Lock()
+	mmPipe.called = make(chan FeedMockPipeParams, buffer)
+	mmPipe.state.History.Unlock()
+	return mmPipe
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Feed.Pipe call
+func (mmPipe *mFeedMockPipe) Called() <-chan FeedMockPipeParams {
+	mmPipe.state.History.Lock()
+	defer mmPipe.state.History.Unlock()
+
+	if mmPipe.called == nil {
+		mmPipe.mock.state.T().Fatalf("Calls of FeedMock.Pipe aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmPipe.called
+}
+
+// DroppedCalls returns the number of the Feed.Pipe calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmPipe *mFeedMockPipe) DroppedCalls() uint64 {
+	return mmPipe.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Feed.Pipe calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmPipe *mFeedMockPipe) Times(n uint64) *mFeedMockPipe {
+	mmPipe.state.SetTimes(n)
+	return mmPipe
+}
+
+// Optional excludes Feed.Pipe from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmPipe *mFeedMockPipe) Optional() *mFeedMockPipe {
+	mmPipe.state.SetOptional()
+	return mmPipe
+}
+
+// Set uses given function f to mock the Feed.Pipe method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmPipe *mFeedMockPipe) Set(f func(ch chan mm_feed.Update) (ch1 chan<- []*mm_feed.Update)) *FeedMock {
+	mmPipe.state.Mutex.Lock()
+	defer mmPipe.state.Mutex.Unlock()
+
+	if mmPipe.defaultExpectation != nil {
+		mmPipe.mock.state.T().Fatalf("Default expectation is already set for the Feed.Pipe method")
+	}
+
+	if len(mmPipe.expectations) > 0 {
+		mmPipe.mock.state.T().Fatalf("Some expectations are already set for the Feed.Pipe method")
+	}
+
+	mmPipe.mock.funcPipe = f
+	return mmPipe.mock
+}
+
+// When sets expectation for the Feed.Pipe which will trigger the result defined by the following
+// Then helper
+func (mmPipe *mFeedMockPipe) When(ch chan mm_feed.Update) *FeedMockPipeExpectation {
+	if _, mm_func := mmPipe.current(); mm_func != nil {
+		mmPipe.mock.state.T().Fatalf("FeedMock.Pipe mock is already set by Set")
+	}
+
+	expectation := &FeedMockPipeExpectation{
+		mock:   mmPipe.mock,
+		params: &FeedMockPipeParams{ch},
+	}
+	mmPipe.state.Mutex.Lock()
+	mmPipe.expectations = append(mmPipe.expectations, expectation)
+	mmPipe.whenResults = append(mmPipe.whenResults, nil)
+	mmPipe.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up Feed.Pipe return parameters for the expectation previously defined by the When method
+func (mmExpectation *FeedMockPipeExpectation) Then(ch1 chan<- []*mm_feed.Update) *FeedMock {
+	mm_handle := mmExpectation.mock.PipeMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &FeedMockPipeResults{ch1}
+	mm_results := make([]*FeedMockPipeResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Pipe implements feed.Feed
+func (mmPipe *FeedMock) Pipe(ch chan mm_feed.Update) (ch1 chan<- []*mm_feed.Update) {
+	mm_method := mmPipe.PipeMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := FeedMockPipeParams{ch}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcPipe, mm_inspectPipe, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectPipe != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectPipe(ch)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).R0
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcPipe == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcPipe != nil {
+		return mm_funcPipe(ch)
+	}
+	if mm_delegate := mmPipe.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Pipe(ch)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, ch)
+	return
+}
+
+// PipeAfterCounter returns a count of finished FeedMock.Pipe invocations
+func (mmPipe *FeedMock) PipeAfterCounter() uint64 {
+	return mmPipe.PipeMock.state.AfterCalls()
+}
+
+// PipeBeforeCounter returns a count of FeedMock.Pipe invocations
+func (mmPipe *FeedMock) PipeBeforeCounter() uint64 {
+	return mmPipe.PipeMock.state.BeforeCalls()
+}
+
+// PipeCalls returns the params of all FeedMock.Pipe calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmPipe *FeedMock) PipeCalls() []FeedMockPipeParams {
+	mmPipe.PipeMock.state.History.Lock()
+	defer mmPipe.PipeMock.state.History.Unlock()
+
+	calls := make([]FeedMockPipeParams, len(mmPipe.PipeMock.calls))
+	copy(calls, mmPipe.PipeMock.calls)
+	return calls
+}
+
+// PipeLastParams returns the params of the latest FeedMock.Pipe call and false if there were no calls
+func (mmPipe *FeedMock) PipeLastParams() (params FeedMockPipeParams, ok bool) {
+	mmPipe.PipeMock.state.History.Lock()
+	defer mmPipe.PipeMock.state.History.Unlock()
+
+	if n := len(mmPipe.PipeMock.calls); n > 0 {
+		return mmPipe.PipeMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// PipeCallTimes returns the times of all FeedMock.Pipe calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmPipe *FeedMock) PipeCallTimes() []mm_time.Time {
+	return mmPipe.PipeMock.state.CallTimes()
+}
+
+// PipeMaxInFlight returns the maximum number of the FeedMock.Pipe calls that have been in flight at once
+func (mmPipe *FeedMock) PipeMaxInFlight() int {
+	return mmPipe.PipeMock.state.MaxInFlight()
+}
+
+// PipeUnexpectedCounter returns a count of FeedMock.Pipe invocations made without an implementation
+func (mmPipe *FeedMock) PipeUnexpectedCounter() uint64 {
+	return mmPipe.PipeMock.state.UnexpectedCalls()
+}
+
+// PipeNotCalled returns true if FeedMock.Pipe hasn't been called
+func (mmPipe *FeedMock) PipeNotCalled() bool {
+	return mmPipe.PipeMock.state.BeforeCalls() == 0
+}
+
+// PipeCallCount returns a count of FeedMock.Pipe invocations, it's the same as PipeBeforeCounter
+func (mmPipe *FeedMock) PipeCallCount() uint64 {
+	return mmPipe.PipeMock.state.BeforeCalls()
+}
+
+// MinimockPipeDone returns true if the count of the Pipe invocations corresponds
+// the number of defined expectations
+func (mmPipe *FeedMock) MinimockPipeDone() bool {
+	return mmPipe.PipeMock.state.Done(mmPipe.PipeMock.setup())
+}
+
+// MinimockPipeInspect logs each unmet expectation
+func (mmPipe *FeedMock) MinimockPipeInspect() {
+	mmPipe.PipeMock.state.Inspect(mmPipe.PipeMock.setup())
+}
+
+type mFeedMockPublish struct {
+	mock               *FeedMock
+	state              minimock.MethodState
+	defaultExpectation *FeedMockPublishExpectation
+	expectations       []*FeedMockPublishExpectation
+	inspectPublish     func(ch chan<- mm_feed.Update)
+	compare            minimock.Comparer
+	calls              []FeedMockPublishParams
+	called             chan FeedMockPublishParams
+	queued             []*FeedMockPublishResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*FeedMockPublishResults
+}
+
+// FeedMockPublishExpectation specifies expectation struct of the Feed.Publish
+type FeedMockPublishExpectation struct {
+	mock     *FeedMock
+	params   *FeedMockPublishParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *FeedMockPublishResults
+	Counter  uint64
+}
+
+// FeedMockPublishParams contains parameters of the Feed.Publish
+type FeedMockPublishParams struct {
+	Ch chan<- mm_feed.Update
+}
+
+// FeedMockPublishResults contains results of the Feed.Publish
+type FeedMockPublishResults struct {
+	R0 error
+}
+
+// Expect sets up expected params for Feed.Publish
+func (mmPublish *mFeedMockPublish) Expect(ch chan<- mm_feed.Update) *mFeedMockPublish {
+	if _, mm_func := mmPublish.current(); mm_func != nil {
+		mmPublish.mock.state.T().Fatalf("FeedMock.Publish mock is already set by Set")
+	}
+
+	mm_params := &FeedMockPublishParams{ch}
+	mmPublish.updateDefault(func(e *FeedMockPublishExpectation) {
+		if e.partial {
+			mmPublish.mock.state.T().Fatalf("FeedMock.Publish params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmPublish.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmPublish.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmPublish
+}
+
+// updateDefault replaces the default expectation of Feed.Publish by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmPublish *mFeedMockPublish) updateDefault(update func(e *FeedMockPublishExpectation)) {
+	mmPublish.state.Mutex.Lock()
+	defer mmPublish.state.Mutex.Unlock()
+
+	e := &FeedMockPublishExpectation{mock: mmPublish.mock}
+	if previous := mmPublish.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmPublish.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Feed.Publish
+func (mmPublish *mFeedMockPublish) current() (*FeedMockPublishExpectation, func(ch chan<- mm_feed.Update) (err error)) {
+	mmPublish.state.Mutex.RLock()
+	defer mmPublish.state.Mutex.RUnlock()
+
+	return mmPublish.defaultExpectation, mmPublish.mock.funcPublish
+}
+
+// partialParams updates the default expectation of Feed.Publish by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmPublish *mFeedMockPublish) partialParams(update func(e *FeedMockPublishExpectation)) {
+	if _, mm_func := mmPublish.current(); mm_func != nil {
+		mmPublish.mock.state.T().Fatalf("FeedMock.Publish mock is already set by Set")
+	}
+
+	mmPublish.updateDefault(func(e *FeedMockPublishExpectation) {
+		if e.params == nil {
+			e.params = &FeedMockPublishParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"Ch": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectChParam1 sets up the expected value of the param #1 of Feed.Publish,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmPublish *mFeedMockPublish) ExpectChParam1(Ch chan<- mm_feed.Update) *mFeedMockPublish {
+	mmPublish.partialParams(func(e *FeedMockPublishExpectation) {
+		e.params.Ch = Ch
+		delete(e.matchers, "Ch")
+	})
+	return mmPublish
+}
+
+// MatchChParam1 sets up the predicate matching the param #1 of Feed.Publish,
+// it's used instead of the value of the param set by Expect
+func (mmPublish *mFeedMockPublish) MatchChParam1(f func(got chan<- mm_feed.Update) bool) *mFeedMockPublish {
+	mm_matcher := minimock.Predicate("predicate func(got chan<- mm_feed.Update) bool", func(v interface{}) bool {
+		got, _ := v.(chan<- mm_feed.Update)
+		return f(got)
+	})
+	mmPublish.partialParams(func(e *FeedMockPublishExpectation) {
+		e.matchers["Ch"] = mm_matcher
+	})
+	return mmPublish
+}
+
+// Return sets up results that will be returned by Feed.Publish
+func (mmPublish *mFeedMockPublish) Return(err error) *FeedMock {
+	if _, mm_func := mmPublish.current(); mm_func != nil {
+		mmPublish.mock.state.T().Fatalf("FeedMock.Publish mock is already set by Set")
+	}
+
+	mm_results := &FeedMockPublishResults{err}
+	mmPublish.updateDefault(func(e *FeedMockPublishExpectation) { e.results = mm_results })
+
+	return mmPublish.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Feed.Publish,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmPublish *mFeedMockPublish) ReturnOnce(err error) *mFeedMockPublish {
+	mmPublish.state.Mutex.Lock()
+	mmPublish.queued = append(mmPublish.queued, &FeedMockPublishResults{err})
+	mmPublish.state.Mutex.Unlock()
+	mmPublish.state.PushResults()
+	return mmPublish
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmPublish *mFeedMockPublish) popResults() *FeedMockPublishResults {
+	mm_i, mm_ok := mmPublish.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmPublish.state.Mutex.RLock()
+	defer mmPublish.state.Mutex.RUnlock()
+
+	return mmPublish.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of Feed.Publish instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmPublish *mFeedMockPublish) SetComparer(compare minimock.Comparer) *mFeedMockPublish {
+	mmPublish.state.Mutex.Lock()
+	mmPublish.compare = compare
+	mmPublish.state.Mutex.Unlock()
+	return mmPublish
+}
+
+// whenExpectations returns the expectations of Feed.Publish set by When,
+// the expectations can be set while the method is called concurrently
+func (mmPublish *mFeedMockPublish) whenExpectations() []*FeedMockPublishExpectation {
+	mmPublish.state.Mutex.RLock()
+	defer mmPublish.state.Mutex.RUnlock()
+
+	return mmPublish.expectations
+}
+
+// Inspect sets up the function called with the params of every Feed.Publish call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmPublish *mFeedMockPublish) Inspect(f func(ch chan<- mm_feed.Update)) *mFeedMockPublish {
+	mmPublish.state.Mutex.Lock()
+	mmPublish.inspectPublish = f
+	mmPublish.state.Mutex.Unlock()
+	return mmPublish
+}
+
+// expected returns everything set up for the Feed.Publish call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmPublish *mFeedMockPublish) expected() (*FeedMockPublishExpectation, func(ch chan<- mm_feed.Update) (err error), func(ch chan<- mm_feed.Update), minimock.Comparer, []*FeedMockPublishExpectation, []*FeedMockPublishResults) {
+	mmPublish.state.Mutex.RLock()
+	defer mmPublish.state.Mutex.RUnlock()
+
+	return mmPublish.defaultExpectation, mmPublish.mock.funcPublish, mmPublish.inspectPublish, mmPublish.compare, mmPublish.expectations, mmPublish.whenResults
+}
+
+// setup returns the expectations and the function set up for Feed.Publish to be checked when the mock is finished
+func (mmPublish *mFeedMockPublish) setup() (setup minimock.Setup) {
+	mmPublish.state.Mutex.RLock()
+	defer mmPublish.state.Mutex.RUnlock()
+
+	setup.Func = mmPublish.mock.funcPublish != nil
+	if e := mmPublish.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmPublish.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Feed.Publish, the params of the calls are removed from the history as well
+func (mmPublish *mFeedMockPublish) reset() {
+	mmPublish.state.Mutex.Lock()
+	mmPublish.defaultExpectation = nil
+	mmPublish.expectations = nil
+	mmPublish.queued = nil
+	mmPublish.whenResults = nil
+	mmPublish.state.Mutex.Unlock()
+
+	mmPublish.state.History.Lock()
+	mmPublish.calls = nil
+	mmPublish.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmPublish *mFeedMockPublish) MethodName() string {
+	return mmPublish.state.Name()
+}
+
+// CallSequence returns the numbers of the Feed.Publish calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmPublish *mFeedMockPublish) CallSequence() []uint64 {
+	return mmPublish.state.CallSequence()
+}
+
+// WaitForCalls waits until Feed.Publish is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmPublish *mFeedMockPublish) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmPublish.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Feed.Publish calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmPublish *mFeedMockPublish) Block() (release func()) {
+	return mmPublish.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Feed.Publish calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmPublish *mFeedMockPublish) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmPublish.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Feed.Publish calls are in flight at once
+func (mmPublish *mFeedMockPublish) LimitConcurrency(n int) *mFeedMockPublish {
+	mmPublish.state.SetLimit(n)
+	return mmPublish
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Feed.Publish call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmPublish *mFeedMockPublish) CaptureCalls(buffer int) *mFeedMockPublish {
+	mmPublish.state.History.Lock()
+	mmPublish.called = make(chan FeedMockPublishParams, buffer)
+	mmPublish.state.History.Unlock()
+	return mmPublish
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Feed.Publish call
+func (mmPublish *mFeedMockPublish) Called() <-chan FeedMockPublishParams {
+	mmPublish.state.History.Lock()
+	defer mmPublish.state.History.Unlock()
+
+	if mmPublish.called == nil {
+		mmPublish.mock.state.T().Fatalf("Calls of FeedMock.Publish aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmPublish.called
+}
+
+// DroppedCalls returns the number of the Feed.Publish calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmPublish *mFeedMockPublish) DroppedCalls() uint64 {
+	return mmPublish.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Feed.Publish calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmPublish *mFeedMockPublish) Times(n uint64) *mFeedMockPublish {
+	mmPublish.state.SetTimes(n)
+	return mmPublish
+}
+
+// Optional excludes Feed.Publish from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmPublish *mFeedMockPublish) Optional() *mFeedMockPublish {
+	mmPublish.state.SetOptional()
+	return mmPublish
+}
+
+// Set uses given function f to mock the Feed.Publish method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmPublish *mFeedMockPublish) Set(f func(ch chan<- mm_feed.Update) (err error)) *FeedMock {
+	mmPublish.state.Mutex.Lock()
+	defer mmPublish.state.Mutex.Unlock()
+
+	if mmPublish.defaultExpectation != nil {
+		mmPublish.mock.state.T().Fatalf("Default expectation is already set for the Feed.Publish method")
+	}
+
+	if len(mmPublish.expectations) > 0 {
+		mmPublish.mock.state.T().Fatalf("Some expectations are already set for the Feed.Publish method")
+	}
+
+	mmPublish.mock.funcPublish = f
+	return mmPublish.mock
+}
+
+// When sets expectation for the Feed.Publish which will trigger the result defined by the following
+// Then helper
+func (mmPublish *mFeedMockPublish) When(ch chan<- mm_feed.Update) *FeedMockPublishExpectation {
+	if _, mm_func := mmPublish.current(); mm_func != nil {
+		mmPublish.mock.state.T().Fatalf("FeedMock.Publish mock is already set by Set")
+	}
+
+	expectation := &FeedMockPublishExpectation{
+		mock:   mmPublish.mock,
+		params: &FeedMockPublishParams{ch},
+	}
+	mmPublish.state.Mutex.Lock()
+	mmPublish.expectations = append(mmPublish.expectations, expectation)
+	mmPublish.whenResults = append(mmPublish.whenResults, nil)
+	mmPublish.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up Feed.Publish return parameters for the expectation previously defined by the When method
+func (mmExpectation *FeedMockPublishExpectation) Then(err error) *FeedMock {
+	mm_handle := mmExpectation.mock.PublishMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &FeedMockPublishResults{err}
+	mm_results := make([]*FeedMockPublishResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Publish implements feed.Feed
+func (mmPublish *FeedMock) Publish(ch chan<- mm_feed.Update) (err error) {
+	mm_method := mmPublish.PublishMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := FeedMockPublishParams{ch}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcPublish, mm_inspectPublish, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectPublish != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectPublish(ch)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).R0
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcPublish == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcPublish != nil {
+		return mm_funcPublish(ch)
+	}
+	if mm_delegate := mmPublish.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Publish(ch)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, ch)
+	return
+}
+
+// PublishAfterCounter returns a count of finished FeedMock.Publish invocations
+func (mmPublish *FeedMock) PublishAfterCounter() uint64 {
+	return mmPublish.PublishMock.state.AfterCalls()
+}
+
+// PublishBeforeCounter returns a count of FeedMock.Publish invocations
+func (mmPublish *FeedMock) PublishBeforeCounter() uint64 {
+	return mmPublish.PublishMock.state.BeforeCalls()
+}
+
+// PublishCalls returns the params of all FeedMock.Publish calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmPublish *FeedMock) PublishCalls() []FeedMockPublishParams {
+	mmPublish.PublishMock.state.History.Lock()
+	defer mmPublish.PublishMock.state.History.Unlock()
+
+	calls := make([]FeedMockPublishParams, len(mmPublish.PublishMock.calls))
+	copy(calls, mmPublish.PublishMock.calls)
+	return calls
+}
+
+// PublishLastParams returns the params of the latest FeedMock.Publish call and false if there were no calls
+func (mmPublish *FeedMock) PublishLastParams() (params FeedMockPublishParams, ok bool) {
+	mmPublish.PublishMock.state.History.Lock()
+	defer mmPublish.PublishMock.state.History.Unlock()
+
+	if n := len(mmPublish.PublishMock.calls); n > 0 {
+		return mmPublish.PublishMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// PublishCallTimes returns the times of all FeedMock.Publish calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmPublish *FeedMock) PublishCallTimes() []mm_time.Time {
+	return mmPublish.PublishMock.state.CallTimes()
+}
+
+// PublishMaxInFlight returns the maximum number of the FeedMock.Publish calls that have been in flight at once
+func (mmPublish *FeedMock) PublishMaxInFlight() int {
+	return mmPublish.PublishMock.state.MaxInFlight()
+}
+
+// PublishUnexpectedCounter returns a count of FeedMock.Publish invocations made without an implementation
+func (mmPublish *FeedMock) PublishUnexpectedCounter() uint64 {
+	return mmPublish.PublishMock.state.UnexpectedCalls()
+}
+
+// PublishNotCalled returns true if FeedMock.Publish hasn't been called
+func (mmPublish *FeedMock) PublishNotCalled() bool {
+	return mmPublish.PublishMock.state.BeforeCalls() == 0
+}
+
+// PublishCallCount returns a count of FeedMock.Publish invocations, it's the same as PublishBeforeCounter
+func (mmPublish *FeedMock) PublishCallCount() uint64 {
+	return mmPublish.PublishMock.state.BeforeCalls()
+}
+
+// MinimockPublishDone returns true if the count of the Publish invocations corresponds
+// the number of defined expectations
+func (mmPublish *FeedMock) MinimockPublishDone() bool {
+	return mmPublish.PublishMock.state.Done(mmPublish.PublishMock.setup())
+}
+
+// MinimockPublishInspect logs each unmet expectation
+func (mmPublish *FeedMock) MinimockPublishInspect() {
+	mmPublish.PublishMock.state.Inspect(mmPublish.PublishMock.setup())
+}
+
+type mFeedMockStreams struct {
+	mock               *FeedMock
+	state              minimock.MethodState
+	defaultExpectation *FeedMockStreamsExpectation
+	expectations       []*FeedMockStreamsExpectation
+	inspectStreams     func()
+	called             chan struct{}
+	queued             []*FeedMockStreamsResults
+}
+
+// FeedMockStreamsExpectation specifies expectation struct of the Feed.Streams
+type FeedMockStreamsExpectation struct {
+	mock *FeedMock
+
+	results *FeedMockStreamsResults
+	Counter uint64
+}
+
+// FeedMockStreamsResults contains results of the Feed.Streams
+type FeedMockStreamsResults struct {
+	R0 chan<- <-chan mm_feed.Update
+}
+
+// Expect sets up expected params for Feed.Streams
+func (mmStreams *mFeedMockStreams) Expect() *mFeedMockStreams {
+	if _, mm_func := mmStreams.current(); mm_func != nil {
+		mmStreams.mock.state.T().Fatalf("FeedMock.Streams mock is already set by Set")
+	}
+
+	mmStreams.updateDefault(func(*FeedMockStreamsExpectation) {})
+
+	return mmStreams
+}
+
+// updateDefault replaces the default expectation of Feed.Streams by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmStreams *mFeedMockStreams) updateDefault(update func(e *FeedMockStreamsExpectation)) {
+	mmStreams.state.Mutex.Lock()
+	defer mmStreams.state.Mutex.Unlock()
+
+	e := &FeedMockStreamsExpectation{mock: mmStreams.mock}
+	if previous := mmStreams.defaultExpectation; previous != nil {
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmStreams.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Feed.Streams
+func (mmStreams *mFeedMockStreams) current() (*FeedMockStreamsExpectation, func() (ch1 chan<- <-chan mm_feed.Update)) {
+	mmStreams.state.Mutex.RLock()
+	defer mmStreams.state.Mutex.RUnlock()
+
+	return mmStreams.defaultExpectation, mmStreams.mock.funcStreams
+}
+
+// Return sets up results that will be returned by Feed.Streams
+func (mmStreams *mFeedMockStreams) Return(ch1 chan<- <-chan mm_feed.Update) *FeedMock {
+	if _, mm_func := mmStreams.current(); mm_func != nil {
+		mmStreams.mock.state.T().Fatalf("FeedMock.Streams mock is already set by Set")
+	}
+
+	mm_results := &FeedMockStreamsResults{ch1}
+	mmStreams.updateDefault(func(e *FeedMockStreamsExpectation) { e.results = mm_results })
+
+	return mmStreams.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Feed.Streams,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmStreams *mFeedMockStreams) ReturnOnce(ch1 chan<- <-chan mm_feed.Update) *mFeedMockStreams {
+	mmStreams.state.Mutex.Lock()
+	mmStreams.queued = append(mmStreams.queued, &FeedMockStreamsResults{ch1})
+	mmStreams.state.Mutex.Unlock()
+	mmStreams.state.PushResults()
+	return mmStreams
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmStreams *mFeedMockStreams) popResults() *FeedMockStreamsResults {
+	mm_i, mm_ok := mmStreams.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmStreams.state.Mutex.RLock()
+	defer mmStreams.state.Mutex.RUnlock()
+
+	return mmStreams.queued[mm_i]
+}
+
+// Inspect sets up the function called with the params of every Feed.Streams call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmStreams *mFeedMockStreams) Inspect(f func()) *mFeedMockStreams {
+	mmStreams.state.Mutex.Lock()
+	mmStreams.inspectStreams = f
+	mmStreams.state.Mutex.Unlock()
+	return mmStreams
+}
+
+// expected returns everything set up for the Feed.Streams call under a single read lock: the default expectation,
+// the functions set by Set and Inspect
+func (mmStreams *mFeedMockStreams) expected() (*FeedMockStreamsExpectation, func() (ch1 chan<- <-chan mm_feed.Update), func()) {
+	mmStreams.state.Mutex.RLock()
+	defer mmStreams.state.Mutex.RUnlock()
+
+	return mmStreams.defaultExpectation, mmStreams.mock.funcStreams, mmStreams.inspectStreams
+}
+
+// setup returns the expectations and the function set up for Feed.Streams to be checked when the mock is finished
+func (mmStreams *mFeedMockStreams) setup() (setup minimock.Setup) {
+	mmStreams.state.Mutex.RLock()
+	defer mmStreams.state.Mutex.RUnlock()
+
+	setup.Func = mmStreams.mock.funcStreams != nil
+	if e := mmStreams.defaultExpectation; e != nil {
+		setup.Default = true
+	}
+
+	for _, e := range mmStreams.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Feed.Streams
+func (mmStreams *mFeedMockStreams) reset() {
+	mmStreams.state.Mutex.Lock()
+	mmStreams.defaultExpectation = nil
+	mmStreams.expectations = nil
+	mmStreams.queued = nil
+	mmStreams.state.Mutex.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmStreams *mFeedMockStreams) MethodName() string {
+	return mmStreams.state.Name()
+}
+
+// CallSequence returns the numbers of the Feed.Streams calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmStreams *mFeedMockStreams) CallSequence() []uint64 {
+	return mmStreams.state.CallSequence()
+}
+
+// WaitForCalls waits until Feed.Streams is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmStreams *mFeedMockStreams) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmStreams.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Feed.Streams calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmStreams *mFeedMockStreams) Block() (release func()) {
+	return mmStreams.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Feed.Streams calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmStreams *mFeedMockStreams) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmStreams.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Feed.Streams calls are in flight at once
+func (mmStreams *mFeedMockStreams) LimitConcurrency(n int) *mFeedMockStreams {
+	mmStreams.state.SetLimit(n)
+	return mmStreams
+}
+
+// CaptureCalls creates the buffered channel receiving the signal of each Feed.Streams call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmStreams *mFeedMockStreams) CaptureCalls(buffer int) *mFeedMockStreams {
+	mmStreams.state.History.Lock()
+	mmStreams.called = make(chan struct{}, buffer)
+	mmStreams.state.History.Unlock()
+	return mmStreams
+}
+
+// Called returns the channel set up by CaptureCalls receiving the signal of each Feed.Streams call
+func (mmStreams *mFeedMockStreams) Called() <-chan struct{} {
+	mmStreams.state.History.Lock()
+	defer mmStreams.state.History.Unlock()
+
+	if mmStreams.called == nil {
+		mmStreams.mock.state.T().Fatalf("Calls of FeedMock.Streams aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmStreams.called
+}
+
+// DroppedCalls returns the number of the Feed.Streams calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmStreams *mFeedMockStreams) DroppedCalls() uint64 {
+	return mmStreams.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Feed.Streams calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmStreams *mFeedMockStreams) Times(n uint64) *mFeedMockStreams {
+	mmStreams.state.SetTimes(n)
+	return mmStreams
+}
+
+// Optional excludes Feed.Streams from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmStreams *mFeedMockStreams) Optional() *mFeedMockStreams {
+	mmStreams.state.SetOptional()
+	return mmStreams
+}
+
+// Set uses given function f to mock the Feed.Streams method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmStreams *mFeedMockStreams) Set(f func() (ch1 chan<- <-chan mm_feed.Update)) *FeedMock {
+	mmStreams.state.Mutex.Lock()
+	defer mmStreams.state.Mutex.Unlock()
+
+	if mmStreams.defaultExpectation != nil {
+		mmStreams.mock.state.T().Fatalf("Default expectation is already set for the Feed.Streams method")
+	}
+
+	if len(mmStreams.expectations) > 0 {
+		mmStreams.mock.state.T().Fatalf("Some expectations are already set for the Feed.Streams method")
+	}
+
+	mmStreams.mock.funcStreams = f
+	return mmStreams.mock
+}
+
+// Streams implements feed.Feed
+func (mmStreams *FeedMock) Streams() (ch1 chan<- <-chan mm_feed.Update) {
+	mm_method := mmStreams.StreamsMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_method.state.Record()
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- struct{}{}:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcStreams, mm_inspectStreams := mm_method.expected()
+	if mm_inspectStreams != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectStreams()
+		}()
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcStreams == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, nil) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcStreams != nil {
+		return mm_funcStreams()
+	}
+	if mm_delegate := mmStreams.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Streams()
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(nil)
+	return
+}
+
+// StreamsAfterCounter returns a count of finished FeedMock.Streams invocations
+func (mmStreams *FeedMock) StreamsAfterCounter() uint64 {
+	return mmStreams.StreamsMock.state.AfterCalls()
+}
+
+// StreamsBeforeCounter returns a count of FeedMock.Streams invocations
+func (mmStreams *FeedMock) StreamsBeforeCounter() uint64 {
+	return mmStreams.StreamsMock.state.BeforeCalls()
+}
+
+// StreamsCallTimes returns the times of all FeedMock.Streams calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmStreams *FeedMock) StreamsCallTimes() []mm_time.Time {
+	return mmStreams.StreamsMock.state.CallTimes()
+}
+
+// StreamsMaxInFlight returns the maximum number of the FeedMock.Streams calls that have been in flight at once
+func (mmStreams *FeedMock) StreamsMaxInFlight() int {
+	return mmStreams.StreamsMock.state.MaxInFlight()
+}
+
+// StreamsUnexpectedCounter returns a count of FeedMock.Streams invocations made without an implementation
+func (mmStreams *FeedMock) StreamsUnexpectedCounter() uint64 {
+	return mmStreams.StreamsMock.state.UnexpectedCalls()
+}
+
+// StreamsNotCalled returns true if FeedMock.Streams hasn't been called
+func (mmStreams *FeedMock) StreamsNotCalled() bool {
+	return mmStreams.StreamsMock.state.BeforeCalls() == 0
+}
+
+// StreamsCallCount returns a count of FeedMock.Streams invocations, it's the same as StreamsBeforeCounter
+func (mmStreams *FeedMock) StreamsCallCount() uint64 {
+	return mmStreams.StreamsMock.state.BeforeCalls()
+}
+
+// MinimockStreamsDone returns true if the count of the Streams invocations corresponds
+// the number of defined expectations
+func (mmStreams *FeedMock) MinimockStreamsDone() bool {
+	return mmStreams.StreamsMock.state.Done(mmStreams.StreamsMock.setup())
+}
+
+// MinimockStreamsInspect logs each unmet expectation
+func (mmStreams *FeedMock) MinimockStreamsInspect() {
+	mmStreams.StreamsMock.state.Inspect(mmStreams.StreamsMock.setup())
+}
+
+type mFeedMockUpdates struct {
+	mock               *FeedMock
+	state              minimock.MethodState
+	defaultExpectation *FeedMockUpdatesExpectation
+	expectations       []*FeedMockUpdatesExpectation
+	inspectUpdates     func()
+	called             chan struct{}
+	queued             []*FeedMockUpdatesResults
+}
+
+// FeedMockUpdatesExpectation specifies expectation struct of the Feed.Updates
+type FeedMockUpdatesExpectation struct {
+	mock *FeedMock
+
+	results *FeedMockUpdatesResults
+	Counter uint64
+}
+
+// FeedMockUpdatesResults contains results of the Feed.Updates
+type FeedMockUpdatesResults struct {
+	R0 <-chan mm_feed.Update
+}
+
+// Expect sets up expected params for Feed.Updates
+func (mmUpdates *mFeedMockUpdates) Expect() *mFeedMockUpdates {
+	if _, mm_func := mmUpdates.current(); mm_func != nil {
+		mmUpdates.mock.state.T().Fatalf("FeedMock.Updates mock is already set by Set")
+	}
+
+	mmUpdates.updateDefault(func(*FeedMockUpdatesExpectation) {})
+
+	return mmUpdates
+}
+
+// updateDefault replaces the default expectation of Feed.Updates by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmUpdates *mFeedMockUpdates) updateDefault(update func(e *FeedMockUpdatesExpectation)) {
+	mmUpdates.state.Mutex.Lock()
+	defer mmUpdates.state.Mutex.Unlock()
+
+	e := &FeedMockUpdatesExpectation{mock: mmUpdates.mock}
+	if previous := mmUpdates.defaultExpectation; previous != nil {
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmUpdates.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Feed.Updates
+func (mmUpdates *mFeedMockUpdates) current() (*FeedMockUpdatesExpectation, func() (ch1 <-chan mm_feed.Update)) {
+	mmUpdates.state.Mutex.RLock()
+	defer mmUpdates.state.Mutex.RUnlock()
+
+	return mmUpdates.defaultExpectation, mmUpdates.mock.funcUpdates
+}
+
+// Return sets up results that will be returned by Feed.Updates
+func (mmUpdates *mFeedMockUpdates) Return(ch1 <-chan mm_feed.Update) *FeedMock {
+	if _, mm_func := mmUpdates.current(); mm_func != nil {
+		mmUpdates.mock.state.T().Fatalf("FeedMock.Updates mock is already set by Set")
+	}
+
+	mm_results := &FeedMockUpdatesResults{ch1}
+	mmUpdates.updateDefault(func(e *FeedMockUpdatesExpectation) { e.results = mm_results })
+
+	return mmUpdates.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Feed.Updates,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmUpdates *mFeedMockUpdates) ReturnOnce(ch1 <-chan mm_feed.Update) *mFeedMockUpdates {
+	mmUpdates.state.Mutex.Lock()
+	mmUpdates.queued = append(mmUpdates.queued, &FeedMockUpdatesResults{ch1})
+	mmUpdates.state.Mutex.Unlock()
+	mmUpdates.state.PushResults()
+	return mmUpdates
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmUpdates *mFeedMockUpdates) popResults() *FeedMockUpdatesResults {
+	mm_i, mm_ok := mmUpdates.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmUpdates.state.Mutex.RLock()
+	defer mmUpdates.state.Mutex.RUnlock()
+
+	return mmUpdates.queued[mm_i]
+}
+
+// Inspect sets up the function called with the params of every Feed.Updates call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmUpdates *mFeedMockUpdates) Inspect(f func()) *mFeedMockUpdates {
+	mmUpdates.state.Mutex.Lock()
+	mmUpdates.inspectUpdates = f
+	mmUpdates.state.Mutex.Unlock()
+	return mmUpdates
+}
+
+// expected returns everything set up for the Feed.Updates call under a single read lock: the default expectation,
+// the functions set by Set and Inspect
+func (mmUpdates *mFeedMockUpdates) expected() (*FeedMockUpdatesExpectation, func() (ch1 <-chan mm_feed.Update), func()) {
+	mmUpdates.state.Mutex.RLock()
+	defer mmUpdates.state.Mutex.RUnlock()
+
+	return mmUpdates.defaultExpectation, mmUpdates.mock.funcUpdates, mmUpdates.inspectUpdates
+}
+
+// setup returns the expectations and the function set up for Feed.Updates to be checked when the mock is finished
+func (mmUpdates *mFeedMockUpdates) setup() (setup minimock.Setup) {
+	mmUpdates.state.Mutex.RLock()
+	defer mmUpdates.state.Mutex.RUnlock()
+
+	setup.Func = mmUpdates.mock.funcUpdates != nil
+	if e := mmUpdates.defaultExpectation; e != nil {
+		setup.Default = true
+	}
+
+	for _, e := range mmUpdates.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Feed.Updates
+func (mmUpdates *mFeedMockUpdates) reset() {
+	mmUpdates.state.Mutex.Lock()
+	mmUpdates.defaultExpectation = nil
+	mmUpdates.expectations = nil
+	mmUpdates.queued = nil
+	mmUpdates.state.Mutex.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmUpdates *mFeedMockUpdates) MethodName() string {
+	return mmUpdates.state.Name()
+}
+
+// CallSequence returns the numbers of the Feed.Updates calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmUpdates *mFeedMockUpdates) CallSequence() []uint64 {
+	return mmUpdates.state.CallSequence()
+}
+
+// WaitForCalls waits until Feed.Updates is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmUpdates *mFeedMockUpdates) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmUpdates.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Feed.Updates calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmUpdates *mFeedMockUpdates) Block() (release func()) {
+	return mmUpdates.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Feed.Updates calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmUpdates *mFeedMockUpdates) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmUpdates.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Feed.Updates calls are in flight at once
+func (mmUpdates *mFeedMockUpdates) LimitConcurrency(n int) *mFeedMockUpdates {
+	mmUpdates.state.SetLimit(n)
+	return mmUpdates
+}
+
+// CaptureCalls creates the buffered channel receiving the signal of each Feed.Updates call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmUpdates *mFeedMockUpdates) CaptureCalls(buffer int) *mFeedMockUpdates {
+	mmUpdates.state.History.Lock()
+	mmUpdates.called = make(chan struct{}, buffer)
+	mmUpdates.state.History.Unlock()
+	return mmUpdates
+}
+
+// Called returns the channel set up by CaptureCalls receiving the signal of each Feed.Updates call
+func (mmUpdates *mFeedMockUpdates) Called() <-chan struct{} {
+	mmUpdates.state.History.Lock()
+	defer mmUpdates.state.History.Unlock()
+
+	if mmUpdates.called == nil {
+		mmUpdates.mock.state.T().Fatalf("Calls of FeedMock.Updates aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmUpdates.called
+}
+
+// DroppedCalls returns the number of the Feed.Updates calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmUpdates *mFeedMockUpdates) DroppedCalls() uint64 {
+	return mmUpdates.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Feed.Updates calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmUpdates *mFeedMockUpdates) Times(n uint64) *mFeedMockUpdates {
+	mmUpdates.state.SetTimes(n)
+	return mmUpdates
+}
+
+// Optional excludes Feed.Updates from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmUpdates *mFeedMockUpdates) Optional() *mFeedMockUpdates {
+	mmUpdates.state.SetOptional()
+	return mmUpdates
+}
+
+// Set uses given function f to mock the Feed.Updates method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmUpdates *mFeedMockUpdates) Set(f func() (ch1 <-chan mm_feed.Update)) *FeedMock {
+	mmUpdates.state.Mutex.Lock()
+	defer mmUpdates.state.Mutex.Unlock()
+
+	if mmUpdates.defaultExpectation != nil {
+		mmUpdates.mock.state.T().Fatalf("Default expectation is already set for the Feed.Updates method")
+	}
+
+	if len(mmUpdates.expectations) > 0 {
+		mmUpdates.mock.state.T().Fatalf("Some expectations are already set for the Feed.Updates method")
+	}
+
+	mmUpdates.mock.funcUpdates = f
+	return mmUpdates.mock
+}
+
+// Updates implements feed.Feed
+func (mmUpdates *FeedMock) Updates() (ch1 <-chan mm_feed.Update) {
+	mm_method := mmUpdates.UpdatesMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_method.state.Record()
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- struct{}{}:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcUpdates, mm_inspectUpdates := mm_method.expected()
+	if mm_inspectUpdates != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectUpdates()
+		}()
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcUpdates == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, nil) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcUpdates != nil {
+		return mm_funcUpdates()
+	}
+	if mm_delegate := mmUpdates.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Updates()
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(nil)
+	return
+}
+
+// UpdatesAfterCounter returns a count of finished FeedMock.Updates invocations
+func (mmUpdates *FeedMock) UpdatesAfterCounter() uint64 {
+	return mmUpdates.UpdatesMock.state.AfterCalls()
+}
+
+// UpdatesBeforeCounter returns a count of FeedMock.Updates invocations
+func (mmUpdates *FeedMock) UpdatesBeforeCounter() uint64 {
+	return mmUpdates.UpdatesMock.state.BeforeCalls()
+}
+
+// UpdatesCallTimes returns the times of all FeedMock.Updates calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmUpdates *FeedMock) UpdatesCallTimes() []mm_time.Time {
+	return mmUpdates.UpdatesMock.state.CallTimes()
+}
+
+// UpdatesMaxInFlight returns the maximum number of the FeedMock.Updates calls that have been in flight at once
+func (mmUpdates *FeedMock) UpdatesMaxInFlight() int {
+	return mmUpdates.UpdatesMock.state.MaxInFlight()
+}
+
+// UpdatesUnexpectedCounter returns a count of FeedMock.Updates invocations made without an implementation
+func (mmUpdates *FeedMock) UpdatesUnexpectedCounter() uint64 {
+	return mmUpdates.UpdatesMock.state.UnexpectedCalls()
+}
+
+// UpdatesNotCalled returns true if FeedMock.Updates hasn't been called
+func (mmUpdates *FeedMock) UpdatesNotCalled() bool {
+	return mmUpdates.UpdatesMock.state.BeforeCalls() == 0
+}
+
+// UpdatesCallCount returns a count of FeedMock.Updates invocations, it's the same as UpdatesBeforeCounter
+func (mmUpdates *FeedMock) UpdatesCallCount() uint64 {
+	return mmUpdates.UpdatesMock.state.BeforeCalls()
+}
+
+// MinimockUpdatesDone returns true if the count of the Updates invocations corresponds
+// the number of defined expectations
+func (mmUpdates *FeedMock) MinimockUpdatesDone() bool {
+	return mmUpdates.UpdatesMock.state.Done(mmUpdates.UpdatesMock.setup())
+}
+
+// MinimockUpdatesInspect logs each unmet expectation
+func (mmUpdates *FeedMock) MinimockUpdatesInspect() {
+	mmUpdates.UpdatesMock.state.Inspect(mmUpdates.UpdatesMock.setup())
+}
+
+// MinimockSetComparer sets up the function comparing the expected and the actual params of all FeedMock methods instead of minimock.Equal,
+// the params matched by the matchers aren't compared
+func (m *FeedMock) MinimockSetComparer(compare minimock.Comparer) *FeedMock {
+	m.state.SetComparer(compare)
+	return m
+}
+
+// MinimockSetSequence sets up the sequence numbering the FeedMock calls, the mocks sharing the sequence
+// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+func (m *FeedMock) MinimockSetSequence(sequence *minimock.Sequence) *FeedMock {
+	m.state.SetSequence(sequence)
+	return m
+}
+
+// MinimockSetClock sets up the function returning the current time for the history of FeedMock calls instead of time.Now
+func (m *FeedMock) MinimockSetClock(clock func() mm_time.Time) *FeedMock {
+	m.state.SetClock(clock)
+	return m
+}
+
+// MinimockSetAutoFinish enables or disables the check of FeedMock made by the Cleanup of the tester passed to NewFeedMock,
+// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+func (m *FeedMock) MinimockSetAutoFinish(enabled bool) *FeedMock {
+	m.state.SetAutoFinish(enabled)
+	return m
+}
+
+// MinimockSetDelegate sets up the implementation the calls of the FeedMock methods are forwarded to when the methods
+// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+func (m *FeedMock) MinimockSetDelegate(impl mm_feed.Feed) *FeedMock {
+	m.mutex.Lock()
+	m.delegate = impl
+	m.mutex.Unlock()
+	return m
+}
+
+func (m *FeedMock) minimockDelegate() mm_feed.Feed {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.delegate
+}
+
+// MinimockSetLenient enables or disables the lenient mode of FeedMock: the calls of the methods that have neither
+// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+func (m *FeedMock) MinimockSetLenient(enabled bool) *FeedMock {
+	m.state.SetLenient(enabled)
+	return m
+}
+
+// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (m *FeedMock) MinimockLenientCalls() map[string]uint64 {
+	return m.state.LenientCalls()
+}
+
+// MinimockAssertNotCalled fails the test without stopping it if any of the FeedMock methods with the given names has been called,
+// it doesn't depend on the expectations and the functions set up for the methods
+func (m *FeedMock) MinimockAssertNotCalled(methodNames ...string) {
+	m.state.AssertNotCalled(methodNames...)
+}
+
+// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+// queued by ReturnOnce of all FeedMock methods, the functions set by Set and Inspect are kept.
+// It fails the test if any of the methods is being called
+func (m *FeedMock) MinimockReset() {
+	m.state.Reset(
+		m.EventsMock.reset,
+		m.GroupsMock.reset,
+		m.IndexMock.reset,
+		m.PipeMock.reset,
+		m.PublishMock.reset,
+		m.StreamsMock.reset,
+		m.UpdatesMock.reset,
+	)
+}
+
+// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+func (m *FeedMock) MinimockResetAll() {
+	m.MinimockReset()
+	m.EventsMock.state.Mutex.Lock()
+	m.funcEvents = nil
+	m.EventsMock.inspectEvents = nil
+	m.EventsMock.state.Mutex.Unlock()
+	m.GroupsMock.state.Mutex.Lock()
+	m.funcGroups = nil
+	m.GroupsMock.inspectGroups = nil
+	m.GroupsMock.state.Mutex.Unlock()
+	m.IndexMock.state.Mutex.Lock()
+	m.funcIndex = nil
+	m.IndexMock.inspectIndex = nil
+	m.IndexMock.state.Mutex.Unlock()
+	m.PipeMock.state.Mutex.Lock()
+	m.funcPipe = nil
+	m.PipeMock.inspectPipe = nil
+	m.PipeMock.state.Mutex.Unlock()
+	m.PublishMock.state.Mutex.Lock()
+	m.funcPublish = nil
+	m.PublishMock.inspectPublish = nil
+	m.PublishMock.state.Mutex.Unlock()
+	m.StreamsMock.state.Mutex.Lock()
+	m.funcStreams = nil
+	m.StreamsMock.inspectStreams = nil
+	m.StreamsMock.state.Mutex.Unlock()
+	m.UpdatesMock.state.Mutex.Lock()
+	m.funcUpdates = nil
+	m.UpdatesMock.inspectUpdates = nil
+	m.UpdatesMock.state.Mutex.Unlock()
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *FeedMock) MinimockFinish() {
+	m.state.Finish(m.minimockDone,
+		m.MinimockEventsInspect,
+		m.MinimockGroupsInspect,
+		m.MinimockIndexInspect,
+		m.MinimockPipeInspect,
+		m.MinimockPublishInspect,
+		m.MinimockStreamsInspect,
+		m.MinimockUpdatesInspect,
+	)
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *FeedMock) MinimockWait(timeout mm_time.Duration) {
+	m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
+}
+
+func (m *FeedMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockEventsDone() &&
+		m.MinimockGroupsDone() &&
+		m.MinimockIndexDone() &&
+		m.MinimockPipeDone() &&
+		m.MinimockPublishDone() &&
+		m.MinimockStreamsDone() &&
+		m.MinimockUpdatesDone()
+}
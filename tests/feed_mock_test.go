@@ -0,0 +1,43 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/gojuno/minimock/tests/feed"
+	"github.com/gojuno/minimock/tests/feed/event"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeedMock_ChannelDirections(t *testing.T) {
+	updates := make(chan feed.Update, 1)
+	events := make(chan event.Event, 1)
+
+	feedMock := NewFeedMock(t).
+		UpdatesMock.Return(updates).
+		PublishMock.Expect(updates).Return(nil).
+		EventsMock.Return(events)
+	defer feedMock.MinimockFinish()
+
+	var f feed.Feed = feedMock
+
+	assert.NoError(t, f.Publish(updates))
+
+	updates <- feed.Update{Key: "a"}
+	assert.Equal(t, feed.Update{Key: "a"}, <-f.Updates())
+
+	events <- event.Event{Name: "b"}
+	assert.Equal(t, event.Event{Name: "b"}, <-f.Events())
+}
+
+func TestFeedMock_CompositeTypes(t *testing.T) {
+	index := map[feed.Key][]*feed.Update{"a": {{Key: "a"}}}
+	groups := map[feed.Key]map[string][2]*feed.Update{"a": {"first": {{Key: "a"}}}}
+
+	feedMock := NewFeedMock(t).
+		IndexMock.Return(index).
+		GroupsMock.Expect(groups).Return([]map[feed.Key]chan feed.Update{{}})
+	defer feedMock.MinimockFinish()
+
+	assert.Equal(t, index, feedMock.Index())
+	assert.Len(t, feedMock.Groups(groups), 1)
+}
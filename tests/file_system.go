@@ -0,0 +1,11 @@
+//go:build go1.16
+// +build go1.16
+
+package tests
+
+import "io/fs"
+
+//FileSystem interface is used to test mocks with the build constraints copied from the source file
+type FileSystem interface {
+	Open(name string) (fs.File, error)
+}
@@ -0,0 +1,21 @@
+//go:build go1.16
+// +build go1.16
+
+package tests
+
+import (
+	"io/fs"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileSystemMock_CopiedConstraints(t *testing.T) {
+	fileSystemMock := NewFileSystemMock(t).OpenMock.Expect("a.txt").Return(nil, fs.ErrNotExist)
+	defer fileSystemMock.MinimockFinish()
+
+	var fileSystem FileSystem = fileSystemMock
+
+	_, err := fileSystem.Open("a.txt")
+	assert.Equal(t, fs.ErrNotExist, err)
+}
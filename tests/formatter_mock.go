@@ -1,11 +1,11 @@
-package tests
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
 
-// DO NOT EDIT!
-// The code below was generated with http://github.com/gojuno/minimock (dev)
+package tests
 
-//go:generate minimock -i github.com/gojuno/minimock/tests.Formatter -o ./tests/formatter_mock.go
+//go:generate minimock -i github.com/gojuno/minimock/tests.Formatter -o ./formatter_mock.go
 
 import (
+	mm_sync "sync"
 	mm_atomic "sync/atomic"
 	mm_time "time"
 
@@ -13,222 +13,676 @@ import (
 )
 
 // FormatterMock implements Formatter
+//
+// Formatter interface is used to test code generated by minimock
 type FormatterMock struct {
-	t minimock.Tester
+	state    minimock.MockState
+	mutex    mm_sync.RWMutex
+	delegate Formatter
 
-	funcFormat          func(s1 string, p1 ...interface{}) (s2 string)
-	afterFormatCounter  uint64
-	beforeFormatCounter uint64
-	FormatMock          mFormatterMockFormat
+	funcFormat func(s1 string, p1 ...interface{}) (s2 string)
+	FormatMock *mFormatterMockFormat
 }
 
+var _ Formatter = (*FormatterMock)(nil)
+
 // NewFormatterMock returns a mock for Formatter
 func NewFormatterMock(t minimock.Tester) *FormatterMock {
-	m := &FormatterMock{t: t}
-	if controller, ok := t.(minimock.MockController); ok {
-		controller.RegisterMocker(m)
-	}
-	m.FormatMock = mFormatterMockFormat{mock: m}
+	m := &FormatterMock{}
+	m.state.Init(m, t, "FormatterMock", 0)
+
+	m.FormatMock = &mFormatterMockFormat{mock: m}
+	m.FormatMock.state.Init(&m.state, "Format")
 
 	return m
 }
 
 type mFormatterMockFormat struct {
 	mock               *FormatterMock
+	state              minimock.MethodState
 	defaultExpectation *FormatterMockFormatExpectation
 	expectations       []*FormatterMockFormatExpectation
+	inspectFormat      func(s1 string, p1 ...interface{})
+	compare            minimock.Comparer
+	calls              []FormatterMockFormatParams
+	called             chan FormatterMockFormatParams
+	queued             []*FormatterMockFormatResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*FormatterMockFormatResults
 }
 
 // FormatterMockFormatExpectation specifies expectation struct of the Formatter.Format
 type FormatterMockFormatExpectation struct {
-	mock    *FormatterMock
-	params  *FormatterMockFormatParams
-	results *FormatterMockFormatResults
-	Counter uint64
+	mock     *FormatterMock
+	params   *FormatterMockFormatParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *FormatterMockFormatResults
+	Counter  uint64
 }
 
 // FormatterMockFormatParams contains parameters of the Formatter.Format
 type FormatterMockFormatParams struct {
-	s1 string
-	p1 []interface{}
+	P0 string
+	P1 []interface{}
 }
 
 // FormatterMockFormatResults contains results of the Formatter.Format
 type FormatterMockFormatResults struct {
-	s2 string
+	R0 string
 }
 
 // Expect sets up expected params for Formatter.Format
-func (m *mFormatterMockFormat) Expect(s1 string, p1 ...interface{}) *mFormatterMockFormat {
-	if m.mock.funcFormat != nil {
-		m.mock.t.Fatalf("FormatterMock.Format mock is already set by Set")
+func (mmFormat *mFormatterMockFormat) Expect(s1 string, p1 ...interface{}) *mFormatterMockFormat {
+	if _, mm_func := mmFormat.current(); mm_func != nil {
+		mmFormat.mock.state.T().Fatalf("FormatterMock.Format mock is already set by Set")
 	}
 
-	if m.defaultExpectation == nil {
-		m.defaultExpectation = &FormatterMockFormatExpectation{}
+	mm_params := &FormatterMockFormatParams{s1, p1}
+	mmFormat.updateDefault(func(e *FormatterMockFormatExpectation) {
+		if e.partial {
+			mmFormat.mock.state.T().Fatalf("FormatterMock.Format params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmFormat.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmFormat.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
 	}
 
-	m.defaultExpectation.params = &FormatterMockFormatParams{s1, p1}
-	for _, e := range m.expectations {
-		if minimock.Equal(e.params, m.defaultExpectation.params) {
-			m.mock.t.Fatalf("Expectation set by When has same params: %#v", *m.defaultExpectation.params)
+	return mmFormat
+}
+
+// updateDefault replaces the default expectation of Formatter.Format by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmFormat *mFormatterMockFormat) updateDefault(update func(e *FormatterMockFormatExpectation)) {
+	mmFormat.state.Mutex.Lock()
+	defer mmFormat.state.Mutex.Unlock()
+
+	e := &FormatterMockFormatExpectation{mock: mmFormat.mock}
+	if previous := mmFormat.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
 		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
 	}
 
-	return m
+	update(e)
+	mmFormat.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Formatter.Format
+func (mmFormat *mFormatterMockFormat) current() (*FormatterMockFormatExpectation, func(s1 string, p1 ...interface{}) (s2 string)) {
+	mmFormat.state.Mutex.RLock()
+	defer mmFormat.state.Mutex.RUnlock()
+
+	return mmFormat.defaultExpectation, mmFormat.mock.funcFormat
+}
+
+// partialParams updates the default expectation of Formatter.Format by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmFormat *mFormatterMockFormat) partialParams(update func(e *FormatterMockFormatExpectation)) {
+	if _, mm_func := mmFormat.current(); mm_func != nil {
+		mmFormat.mock.state.T().Fatalf("FormatterMock.Format mock is already set by Set")
+	}
+
+	mmFormat.updateDefault(func(e *FormatterMockFormatExpectation) {
+		if e.params == nil {
+			e.params = &FormatterMockFormatParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"P0": minimock.Anything,
+				"P1": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectP0Param1 sets up the expected value of the param #1 of Formatter.Format,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmFormat *mFormatterMockFormat) ExpectP0Param1(P0 string) *mFormatterMockFormat {
+	mmFormat.partialParams(func(e *FormatterMockFormatExpectation) {
+		e.params.P0 = P0
+		delete(e.matchers, "P0")
+	})
+	return mmFormat
+}
+
+// MatchP0Param1 sets up the predicate matching the param #1 of Formatter.Format,
+// it's used instead of the value of the param set by Expect
+func (mmFormat *mFormatterMockFormat) MatchP0Param1(f func(got string) bool) *mFormatterMockFormat {
+	mm_matcher := minimock.Predicate("predicate func(got string) bool", func(v interface{}) bool {
+		got, _ := v.(string)
+		return f(got)
+	})
+	mmFormat.partialParams(func(e *FormatterMockFormatExpectation) {
+		e.matchers["P0"] = mm_matcher
+	})
+	return mmFormat
+}
+
+// ExpectP1Param2 sets up the expected value of the param #2 of Formatter.Format,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmFormat *mFormatterMockFormat) ExpectP1Param2(P1 []interface{}) *mFormatterMockFormat {
+	mmFormat.partialParams(func(e *FormatterMockFormatExpectation) {
+		e.params.P1 = P1
+		delete(e.matchers, "P1")
+	})
+	return mmFormat
+}
+
+// MatchP1Param2 sets up the predicate matching the param #2 of Formatter.Format,
+// it's used instead of the value of the param set by Expect
+func (mmFormat *mFormatterMockFormat) MatchP1Param2(f func(got []interface{}) bool) *mFormatterMockFormat {
+	mm_matcher := minimock.Predicate("predicate func(got []interface{}) bool", func(v interface{}) bool {
+		got, _ := v.([]interface{})
+		return f(got)
+	})
+	mmFormat.partialParams(func(e *FormatterMockFormatExpectation) {
+		e.matchers["P1"] = mm_matcher
+	})
+	return mmFormat
 }
 
 // Return sets up results that will be returned by Formatter.Format
-func (m *mFormatterMockFormat) Return(s2 string) *FormatterMock {
-	if m.mock.funcFormat != nil {
-		m.mock.t.Fatalf("FormatterMock.Format mock is already set by Set")
+func (mmFormat *mFormatterMockFormat) Return(s2 string) *FormatterMock {
+	if _, mm_func := mmFormat.current(); mm_func != nil {
+		mmFormat.mock.state.T().Fatalf("FormatterMock.Format mock is already set by Set")
 	}
 
-	if m.defaultExpectation == nil {
-		m.defaultExpectation = &FormatterMockFormatExpectation{mock: m.mock}
+	mm_results := &FormatterMockFormatResults{s2}
+	mmFormat.updateDefault(func(e *FormatterMockFormatExpectation) { e.results = mm_results })
+
+	return mmFormat.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Formatter.Format,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmFormat *mFormatterMockFormat) ReturnOnce(s2 string) *mFormatterMockFormat {
+	mmFormat.state.Mutex.Lock()
+	mmFormat.queued = append(mmFormat.queued, &FormatterMockFormatResults{s2})
+	mmFormat.state.Mutex.Unlock()
+	mmFormat.state.PushResults()
+	return mmFormat
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmFormat *mFormatterMockFormat) popResults() *FormatterMockFormatResults {
+	mm_i, mm_ok := mmFormat.state.PopResults()
+	if !mm_ok {
+		return nil
 	}
-	m.defaultExpectation.results = &FormatterMockFormatResults{s2}
-	return m.mock
+
+	mmFormat.state.Mutex.RLock()
+	defer mmFormat.state.Mutex.RUnlock()
+
+	return mmFormat.queued[mm_i]
 }
 
-//Set uses given function f to mock the Formatter.Format method
-func (m *mFormatterMockFormat) Set(f func(s1 string, p1 ...interface{}) (s2 string)) *FormatterMock {
-	if m.defaultExpectation != nil {
-		m.mock.t.Fatalf("Default expectation is already set for the Formatter.Format method")
+// SetComparer sets up the function comparing the expected and the actual params of Formatter.Format instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmFormat *mFormatterMockFormat) SetComparer(compare minimock.Comparer) *mFormatterMockFormat {
+	mmFormat.state.Mutex.Lock()
+	mmFormat.compare = compare
+	mmFormat.state.Mutex.Unlock()
+	return mmFormat
+}
+
+// whenExpectations returns the expectations of Formatter.Format set by When,
+// the expectations can be set while the method is called concurrently
+func (mmFormat *mFormatterMockFormat) whenExpectations() []*FormatterMockFormatExpectation {
+	mmFormat.state.Mutex.RLock()
+	defer mmFormat.state.Mutex.RUnlock()
+
+	return mmFormat.expectations
+}
+
+// Inspect sets up the function called with the params of every Formatter.Format call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmFormat *mFormatterMockFormat) Inspect(f func(s1 string, p1 ...interface{})) *mFormatterMockFormat {
+	mmFormat.state.Mutex.Lock()
+	mmFormat.inspectFormat = f
+	mmFormat.state.Mutex.Unlock()
+	return mmFormat
+}
+
+// expected returns everything set up for the Formatter.Format call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmFormat *mFormatterMockFormat) expected() (*FormatterMockFormatExpectation, func(s1 string, p1 ...interface{}) (s2 string), func(s1 string, p1 ...interface{}), minimock.Comparer, []*FormatterMockFormatExpectation, []*FormatterMockFormatResults) {
+	mmFormat.state.Mutex.RLock()
+	defer mmFormat.state.Mutex.RUnlock()
+
+	return mmFormat.defaultExpectation, mmFormat.mock.funcFormat, mmFormat.inspectFormat, mmFormat.compare, mmFormat.expectations, mmFormat.whenResults
+}
+
+// setup returns the expectations and the function set up for Formatter.Format to be checked when the mock is finished
+func (mmFormat *mFormatterMockFormat) setup() (setup minimock.Setup) {
+	mmFormat.state.Mutex.RLock()
+	defer mmFormat.state.Mutex.RUnlock()
+
+	setup.Func = mmFormat.mock.funcFormat != nil
+	if e := mmFormat.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
 	}
 
-	if len(m.expectations) > 0 {
-		m.mock.t.Fatalf("Some expectations are already set for the Formatter.Format method")
+	for _, e := range mmFormat.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
 	}
 
-	m.mock.funcFormat = f
-	return m.mock
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Formatter.Format, the params of the calls are removed from the history as well
+func (mmFormat *mFormatterMockFormat) reset() {
+	mmFormat.state.Mutex.Lock()
+	mmFormat.defaultExpectation = nil
+	mmFormat.expectations = nil
+	mmFormat.queued = nil
+	mmFormat.whenResults = nil
+	mmFormat.state.Mutex.Unlock()
+
+	mmFormat.state.History.Lock()
+	mmFormat.calls = nil
+	mmFormat.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmFormat *mFormatterMockFormat) MethodName() string {
+	return mmFormat.state.Name()
+}
+
+// CallSequence returns the numbers of the Formatter.Format calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmFormat *mFormatterMockFormat) CallSequence() []uint64 {
+	return mmFormat.state.CallSequence()
+}
+
+// WaitForCalls waits until Formatter.Format is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmFormat *mFormatterMockFormat) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmFormat.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Formatter.Format calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmFormat *mFormatterMockFormat) Block() (release func()) {
+	return mmFormat.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Formatter.Format calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmFormat *mFormatterMockFormat) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmFormat.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Formatter.Format calls are in flight at once
+func (mmFormat *mFormatterMockFormat) LimitConcurrency(n int) *mFormatterMockFormat {
+	mmFormat.state.SetLimit(n)
+	return mmFormat
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Formatter.Format call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmFormat *mFormatterMockFormat) CaptureCalls(buffer int) *mFormatterMockFormat {
+	mmFormat.state.History.Lock()
+	mmFormat.called = make(chan FormatterMockFormatParams, buffer)
+	mmFormat.state.History.Unlock()
+	return mmFormat
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Formatter.Format call
+func (mmFormat *mFormatterMockFormat) Called() <-chan FormatterMockFormatParams {
+	mmFormat.state.History.Lock()
+	defer mmFormat.state.History.Unlock()
+
+	if mmFormat.called == nil {
+		mmFormat.mock.state.T().Fatalf("Calls of FormatterMock.Format aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmFormat.called
+}
+
+// DroppedCalls returns the number of the Formatter.Format calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmFormat *mFormatterMockFormat) DroppedCalls() uint64 {
+	return mmFormat.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Formatter.Format calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmFormat *mFormatterMockFormat) Times(n uint64) *mFormatterMockFormat {
+	mmFormat.state.SetTimes(n)
+	return mmFormat
+}
+
+// Optional excludes Formatter.Format from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmFormat *mFormatterMockFormat) Optional() *mFormatterMockFormat {
+	mmFormat.state.SetOptional()
+	return mmFormat
+}
+
+// Set uses given function f to mock the Formatter.Format method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmFormat *mFormatterMockFormat) Set(f func(s1 string, p1 ...interface{}) (s2 string)) *FormatterMock {
+	mmFormat.state.Mutex.Lock()
+	defer mmFormat.state.Mutex.Unlock()
+
+	if mmFormat.defaultExpectation != nil {
+		mmFormat.mock.state.T().Fatalf("Default expectation is already set for the Formatter.Format method")
+	}
+
+	if len(mmFormat.expectations) > 0 {
+		mmFormat.mock.state.T().Fatalf("Some expectations are already set for the Formatter.Format method")
+	}
+
+	mmFormat.mock.funcFormat = f
+	return mmFormat.mock
 }
 
 // When sets expectation for the Formatter.Format which will trigger the result defined by the following
 // Then helper
-func (m *mFormatterMockFormat) When(s1 string, p1 ...interface{}) *FormatterMockFormatExpectation {
-	if m.mock.funcFormat != nil {
-		m.mock.t.Fatalf("FormatterMock.Format mock is already set by Set")
+func (mmFormat *mFormatterMockFormat) When(s1 string, p1 ...interface{}) *FormatterMockFormatExpectation {
+	if _, mm_func := mmFormat.current(); mm_func != nil {
+		mmFormat.mock.state.T().Fatalf("FormatterMock.Format mock is already set by Set")
 	}
 
 	expectation := &FormatterMockFormatExpectation{
-		mock:   m.mock,
+		mock:   mmFormat.mock,
 		params: &FormatterMockFormatParams{s1, p1},
 	}
-	m.expectations = append(m.expectations, expectation)
+	mmFormat.state.Mutex.Lock()
+	mmFormat.expectations = append(mmFormat.expectations, expectation)
+	mmFormat.whenResults = append(mmFormat.whenResults, nil)
+	mmFormat.state.Mutex.Unlock()
 	return expectation
 }
 
 // Then sets up Formatter.Format return parameters for the expectation previously defined by the When method
-func (e *FormatterMockFormatExpectation) Then(s2 string) *FormatterMock {
-	e.results = &FormatterMockFormatResults{s2}
-	return e.mock
+func (mmExpectation *FormatterMockFormatExpectation) Then(s2 string) *FormatterMock {
+	mm_handle := mmExpectation.mock.FormatMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &FormatterMockFormatResults{s2}
+	mm_results := make([]*FormatterMockFormatResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
 }
 
 // Format implements Formatter
-func (m *FormatterMock) Format(s1 string, p1 ...interface{}) (s2 string) {
-	mm_atomic.AddUint64(&m.beforeFormatCounter, 1)
-	defer mm_atomic.AddUint64(&m.afterFormatCounter, 1)
+func (mmFormat *FormatterMock) Format(s1 string, p1 ...interface{}) (s2 string) {
+	mm_method := mmFormat.FormatMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := FormatterMockFormatParams{s1, p1}
 
-	for _, e := range m.FormatMock.expectations {
-		if minimock.Equal(*e.params, FormatterMockFormatParams{s1, p1}) {
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcFormat, mm_inspectFormat, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectFormat != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectFormat(s1, p1...)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.s2
+			return (*mm_results).R0
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
 		}
+
+		return (*mm_results).R0
 	}
 
-	if m.FormatMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&m.FormatMock.defaultExpectation.Counter, 1)
-		want := m.FormatMock.defaultExpectation.params
-		got := FormatterMockFormatParams{s1, p1}
-		if want != nil && !minimock.Equal(*want, got) {
-			m.t.Errorf("FormatterMock.Format got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcFormat == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
 		}
 
-		results := m.FormatMock.defaultExpectation.results
-		if results == nil {
-			m.t.Fatal("No results are set for the FormatterMock.Format")
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
 		}
-		return (*results).s2
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcFormat != nil {
+		return mm_funcFormat(s1, p1...)
 	}
-	if m.funcFormat != nil {
-		return m.funcFormat(s1, p1...)
+	if mm_delegate := mmFormat.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Format(s1, p1...)
 	}
-	m.t.Fatalf("Unexpected call to FormatterMock.Format. %v %v", s1, p1)
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, s1, p1)
 	return
 }
 
 // FormatAfterCounter returns a count of finished FormatterMock.Format invocations
-func (m *FormatterMock) FormatAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&m.afterFormatCounter)
+func (mmFormat *FormatterMock) FormatAfterCounter() uint64 {
+	return mmFormat.FormatMock.state.AfterCalls()
 }
 
 // FormatBeforeCounter returns a count of FormatterMock.Format invocations
-func (m *FormatterMock) FormatBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&m.beforeFormatCounter)
+func (mmFormat *FormatterMock) FormatBeforeCounter() uint64 {
+	return mmFormat.FormatMock.state.BeforeCalls()
 }
 
-// MinimockFormatDone returns true if the count of the Format invocations corresponds
-// the number of defined expectations
-func (m *FormatterMock) MinimockFormatDone() bool {
-	for _, e := range m.FormatMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			return false
-		}
-	}
+// FormatCalls returns the params of all FormatterMock.Format calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmFormat *FormatterMock) FormatCalls() []FormatterMockFormatParams {
+	mmFormat.FormatMock.state.History.Lock()
+	defer mmFormat.FormatMock.state.History.Unlock()
 
-	// if default expectation was set then invocations count should be greater than zero
-	if m.FormatMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterFormatCounter) < 1 {
-		return false
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcFormat != nil && mm_atomic.LoadUint64(&m.afterFormatCounter) < 1 {
-		return false
+	calls := make([]FormatterMockFormatParams, len(mmFormat.FormatMock.calls))
+	copy(calls, mmFormat.FormatMock.calls)
+	return calls
+}
+
+// FormatLastParams returns the params of the latest FormatterMock.Format call and false if there were no calls
+func (mmFormat *FormatterMock) FormatLastParams() (params FormatterMockFormatParams, ok bool) {
+	mmFormat.FormatMock.state.History.Lock()
+	defer mmFormat.FormatMock.state.History.Unlock()
+
+	if n := len(mmFormat.FormatMock.calls); n > 0 {
+		return mmFormat.FormatMock.calls[n-1], true
 	}
-	return true
+
+	return params, false
+}
+
+// FormatCallTimes returns the times of all FormatterMock.Format calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmFormat *FormatterMock) FormatCallTimes() []mm_time.Time {
+	return mmFormat.FormatMock.state.CallTimes()
+}
+
+// FormatMaxInFlight returns the maximum number of the FormatterMock.Format calls that have been in flight at once
+func (mmFormat *FormatterMock) FormatMaxInFlight() int {
+	return mmFormat.FormatMock.state.MaxInFlight()
+}
+
+// FormatUnexpectedCounter returns a count of FormatterMock.Format invocations made without an implementation
+func (mmFormat *FormatterMock) FormatUnexpectedCounter() uint64 {
+	return mmFormat.FormatMock.state.UnexpectedCalls()
+}
+
+// FormatNotCalled returns true if FormatterMock.Format hasn't been called
+func (mmFormat *FormatterMock) FormatNotCalled() bool {
+	return mmFormat.FormatMock.state.BeforeCalls() == 0
+}
+
+// FormatCallCount returns a count of FormatterMock.Format invocations, it's the same as FormatBeforeCounter
+func (mmFormat *FormatterMock) FormatCallCount() uint64 {
+	return mmFormat.FormatMock.state.BeforeCalls()
+}
+
+// MinimockFormatDone returns true if the count of the Format invocations corresponds
+// the number of defined expectations
+func (mmFormat *FormatterMock) MinimockFormatDone() bool {
+	return mmFormat.FormatMock.state.Done(mmFormat.FormatMock.setup())
 }
 
 // MinimockFormatInspect logs each unmet expectation
-func (m *FormatterMock) MinimockFormatInspect() {
-	for _, e := range m.FormatMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to FormatterMock.Format with params: %#v", *e.params)
-		}
-	}
+func (mmFormat *FormatterMock) MinimockFormatInspect() {
+	mmFormat.FormatMock.state.Inspect(mmFormat.FormatMock.setup())
+}
 
-	// if default expectation was set then invocations count should be greater than zero
-	if m.FormatMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterFormatCounter) < 1 {
-		m.t.Errorf("Expected call to FormatterMock.Format with params: %#v", *m.FormatMock.defaultExpectation.params)
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcFormat != nil && mm_atomic.LoadUint64(&m.afterFormatCounter) < 1 {
-		m.t.Error("Expected call to FormatterMock.Format")
-	}
+// MinimockSetComparer sets up the function comparing the expected and the actual params of all FormatterMock methods instead of minimock.Equal,
+// the params matched by the matchers aren't compared
+func (m *FormatterMock) MinimockSetComparer(compare minimock.Comparer) *FormatterMock {
+	m.state.SetComparer(compare)
+	return m
+}
+
+// MinimockSetSequence sets up the sequence numbering the FormatterMock calls, the mocks sharing the sequence
+// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+func (m *FormatterMock) MinimockSetSequence(sequence *minimock.Sequence) *FormatterMock {
+	m.state.SetSequence(sequence)
+	return m
+}
+
+// MinimockSetClock sets up the function returning the current time for the history of FormatterMock calls instead of time.Now
+func (m *FormatterMock) MinimockSetClock(clock func() mm_time.Time) *FormatterMock {
+	m.state.SetClock(clock)
+	return m
+}
+
+// MinimockSetAutoFinish enables or disables the check of FormatterMock made by the Cleanup of the tester passed to NewFormatterMock,
+// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+func (m *FormatterMock) MinimockSetAutoFinish(enabled bool) *FormatterMock {
+	m.state.SetAutoFinish(enabled)
+	return m
+}
+
+// MinimockSetDelegate sets up the implementation the calls of the FormatterMock methods are forwarded to when the methods
+// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+func (m *FormatterMock) MinimockSetDelegate(impl Formatter) *FormatterMock {
+	m.mutex.Lock()
+	m.delegate = impl
+	m.mutex.Unlock()
+	return m
+}
+
+func (m *FormatterMock) minimockDelegate() Formatter {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.delegate
+}
+
+// MinimockSetLenient enables or disables the lenient mode of FormatterMock: the calls of the methods that have neither
+// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+func (m *FormatterMock) MinimockSetLenient(enabled bool) *FormatterMock {
+	m.state.SetLenient(enabled)
+	return m
+}
+
+// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (m *FormatterMock) MinimockLenientCalls() map[string]uint64 {
+	return m.state.LenientCalls()
+}
+
+// MinimockAssertNotCalled fails the test without stopping it if any of the FormatterMock methods with the given names has been called,
+// it doesn't depend on the expectations and the functions set up for the methods
+func (m *FormatterMock) MinimockAssertNotCalled(methodNames ...string) {
+	m.state.AssertNotCalled(methodNames...)
+}
+
+// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+// queued by ReturnOnce of all FormatterMock methods, the functions set by Set and Inspect are kept.
+// It fails the test if any of the methods is being called
+func (m *FormatterMock) MinimockReset() {
+	m.state.Reset(
+		m.FormatMock.reset,
+	)
+}
+
+// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+func (m *FormatterMock) MinimockResetAll() {
+	m.MinimockReset()
+	m.FormatMock.state.Mutex.Lock()
+	m.funcFormat = nil
+	m.FormatMock.inspectFormat = nil
+	m.FormatMock.state.Mutex.Unlock()
 }
 
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *FormatterMock) MinimockFinish() {
-	if !m.minimockDone() {
-		m.MinimockFormatInspect()
-		m.t.FailNow()
-	}
+	m.state.Finish(m.minimockDone,
+		m.MinimockFormatInspect,
+	)
 }
 
 // MinimockWait waits for all mocked methods to be called the expected number of times
 func (m *FormatterMock) MinimockWait(timeout mm_time.Duration) {
-	timeoutCh := mm_time.After(timeout)
-	for {
-		if m.minimockDone() {
-			return
-		}
-		select {
-		case <-timeoutCh:
-			m.MinimockFinish()
-			return
-		case <-mm_time.After(10 * mm_time.Millisecond):
-		}
-	}
+	m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
 }
 
 func (m *FormatterMock) minimockDone() bool {
@@ -1,7 +1,13 @@
 package tests
 
 import (
+	"fmt"
+	"path/filepath"
 	"reflect"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -79,10 +85,11 @@ func TestFormatterMock_ExpectDifferentArguments(t *testing.T) {
 		defer tester.MinimockFinish()
 
 		tester.ErrorfMock.Set(func(s string, args ...interface{}) {
-			assert.Equal(t, "FormatterMock.Format got unexpected parameters, want: %#v, got: %#v%s\n", s)
-			require.Len(t, args, 3)
-			assert.Equal(t, FormatterMockFormatParams{s1: "expected"}, args[0])
-			assert.Equal(t, FormatterMockFormatParams{s1: "actual"}, args[1])
+			assert.Equal(t, "FormatterMock.Format got unexpected parameters, want: %#v, got: %#v%s%s\n", s)
+			require.Len(t, args, 4)
+			assert.Equal(t, FormatterMockFormatParams{P0: "expected"}, args[0])
+			assert.Equal(t, FormatterMockFormatParams{P0: "actual"}, args[1])
+			assert.Equal(t, "\n\nMismatched params:\n  P0: want: \"expected\", got: \"actual\"\n", args[2])
 		})
 
 		tester.FatalMock.Expect("No results are set for the FormatterMock.Format").Return()
@@ -109,7 +116,7 @@ func TestFormatterMock_ExpectAfterWhen(t *testing.T) {
 	tester := NewTesterMock(t)
 	defer tester.MinimockFinish()
 
-	tester.FatalfMock.Expect("Expectation set by When has same params: %#v", FormatterMockFormatParams{s1: "Should not work", p1: nil}).Return()
+	tester.FatalfMock.Expect("Expectation set by When has same params: %#v", FormatterMockFormatParams{P0: "Should not work", P1: nil}).Return()
 
 	formatterMock := NewFormatterMock(tester)
 	formatterMock.FormatMock.When("Should not work").Then("")
@@ -139,6 +146,149 @@ func TestFormatterMock_ReturnAfterSet(t *testing.T) {
 	formatterMock.FormatMock.Return("Should not work")
 }
 
+func TestFormatterMock_ReturnOnce(t *testing.T) {
+	formatterMock := NewFormatterMock(t)
+	defer formatterMock.MinimockFinish()
+
+	formatterMock.FormatMock.ReturnOnce("first").ReturnOnce("second").Return("default")
+
+	assert.Equal(t, "first", formatterMock.Format(""))
+	assert.Equal(t, "second", formatterMock.Format(""))
+	assert.Equal(t, "default", formatterMock.Format(""))
+	assert.Equal(t, "default", formatterMock.Format(""))
+}
+
+func TestFormatterMock_ReturnOnceBeforeSet(t *testing.T) {
+	formatterMock := NewFormatterMock(t)
+	defer formatterMock.MinimockFinish()
+
+	formatterMock.FormatMock.ReturnOnce("queued")
+	formatterMock.FormatMock.Set(func(string, ...interface{}) string { return "set" })
+
+	assert.Equal(t, "queued", formatterMock.Format(""))
+	assert.Equal(t, "set", formatterMock.Format(""))
+}
+
+func TestFormatterMock_ReturnOnceWithExpect(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	tester.ErrorfMock.Set(func(s string, args ...interface{}) {
+		assert.Equal(t, "FormatterMock.Format got unexpected parameters, want: %#v, got: %#v%s%s\n", s)
+		require.Len(t, args, 4)
+		assert.Equal(t, FormatterMockFormatParams{P0: "expected"}, args[0])
+		assert.Equal(t, FormatterMockFormatParams{P0: "actual"}, args[1])
+		assert.Equal(t, "\n\nMismatched params:\n  P0: want: \"expected\", got: \"actual\"\n", args[2])
+	})
+
+	formatterMock := NewFormatterMock(tester)
+	formatterMock.FormatMock.Expect("expected").ReturnOnce("queued")
+
+	assert.Equal(t, "queued", formatterMock.Format("actual"))
+}
+
+func TestFormatterMock_ReturnOnceConcurrent(t *testing.T) {
+	formatterMock := NewFormatterMock(t)
+	defer formatterMock.MinimockFinish()
+
+	for i := 0; i < 10; i++ {
+		formatterMock.FormatMock.ReturnOnce("queued")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, "queued", formatterMock.Format(""))
+		}()
+	}
+	wg.Wait()
+}
+
+func TestFormatterMock_WhenWithMatchers(t *testing.T) {
+	formatterMock := NewFormatterMock(t).
+		FormatMock.When("%d", minimock.Anything).Then("number").
+		FormatMock.Return("default")
+	defer formatterMock.MinimockFinish()
+
+	assert.Equal(t, "number", formatterMock.Format("%d", 1))
+	assert.Equal(t, "default", formatterMock.Format("%s", "1"))
+}
+
+func TestFormatterMock_WhenWithoutThen(t *testing.T) {
+	formatterMock := NewFormatterMock(t).FormatMock.Return("default")
+
+	//the case isn't used until the results are set by Then
+	expectation := formatterMock.FormatMock.When("a")
+	assert.Equal(t, "default", formatterMock.Format("a"))
+
+	expectation.Then("a")
+	assert.Equal(t, "a", formatterMock.Format("a"))
+
+	formatterMock.MinimockFinish()
+}
+
+func TestFormatterMock_WhenConcurrently(t *testing.T) {
+	formatterMock := NewFormatterMock(t).FormatMock.Return("default")
+	defer formatterMock.MinimockFinish()
+
+	//When cases are set up while the method is being called
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			formatterMock.FormatMock.When(fmt.Sprint(i)).Then(fmt.Sprint(i))
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			assert.Contains(t, []string{"default", fmt.Sprint(i)}, formatterMock.Format(fmt.Sprint(i)))
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, fmt.Sprint(i), formatterMock.Format(fmt.Sprint(i)))
+	}
+}
+
+func TestFormatterMock_ReturnOnceExceeded(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	tester.FatalfMock.Expect("Unexpected call #%d to FormatterMock.Format, only %d results are queued by ReturnOnce, params: %#v",
+		uint64(2), 1, FormatterMockFormatParams{P0: "second"}).Return()
+
+	formatterMock := NewFormatterMock(tester)
+	formatterMock.FormatMock.ReturnOnce("first")
+
+	assert.Equal(t, "first", formatterMock.Format("first"))
+	assert.Equal(t, "", formatterMock.Format("second"))
+}
+
+func TestFormatterMock_ReturnOnceExceededConcurrently(t *testing.T) {
+	var reported uint64
+
+	tester := NewTesterMock(t)
+	tester.FatalfMock.Set(func(string, ...interface{}) { atomic.AddUint64(&reported, 1) })
+
+	formatterMock := NewFormatterMock(tester)
+	formatterMock.FormatMock.ReturnOnce("first")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			formatterMock.Format("")
+		}()
+	}
+	wg.Wait()
+
+	assert.EqualValues(t, 1, atomic.LoadUint64(&reported))
+}
+
 func TestFormatterMock_Set(t *testing.T) {
 	tester := NewTesterMock(t)
 
@@ -198,12 +348,12 @@ func TestFormatterMockFormat_WhenAfterSet(t *testing.T) {
 }
 
 func TestFormatterMock_MinimockFormatDone(t *testing.T) {
-	formatterMock := NewFormatterMock(t)
+	formatterMock := NewFormatterMock(t).MinimockSetAutoFinish(false)
 
 	formatterMock.FormatMock.expectations = []*FormatterMockFormatExpectation{{}}
 	assert.False(t, formatterMock.MinimockFormatDone())
 
-	formatterMock = NewFormatterMock(t)
+	formatterMock = NewFormatterMock(t).MinimockSetAutoFinish(false)
 	formatterMock.FormatMock.defaultExpectation = &FormatterMockFormatExpectation{}
 	assert.False(t, formatterMock.MinimockFormatDone())
 }
@@ -237,6 +387,60 @@ func TestFormatterMock_MinimockFinish_WithNoMetExpectations(t *testing.T) {
 	formatterMock.MinimockFinish()
 }
 
+func TestFormatterMock_MinimockFinish_WithQueuedResults(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	tester.ErrorfMock.Expect("Expected %d more calls to FormatterMock.Format to return the results queued by ReturnOnce", 1).Return()
+	tester.FailNowMock.Expect().Return()
+
+	formatterMock := NewFormatterMock(tester)
+	formatterMock.FormatMock.ReturnOnce("first").ReturnOnce("second")
+
+	assert.Equal(t, "first", formatterMock.Format(""))
+	assert.False(t, formatterMock.MinimockFormatDone())
+
+	formatterMock.MinimockFinish()
+}
+
+func TestFormatterMock_Times(t *testing.T) {
+	formatterMock := NewFormatterMock(t)
+	defer formatterMock.MinimockFinish()
+
+	formatterMock.FormatMock.Expect("a").Times(2).Return("b")
+	assert.False(t, formatterMock.MinimockFormatDone())
+
+	formatterMock.Format("a")
+	assert.False(t, formatterMock.MinimockFormatDone())
+
+	formatterMock.Format("a")
+	assert.True(t, formatterMock.MinimockFormatDone())
+}
+
+func TestFormatterMock_TimesZero(t *testing.T) {
+	formatterMock := NewFormatterMock(t)
+	defer formatterMock.MinimockFinish()
+
+	formatterMock.FormatMock.Times(0).Set(func(string, ...interface{}) string { return "" })
+	assert.True(t, formatterMock.MinimockFormatDone())
+}
+
+func TestFormatterMock_MinimockFinish_WithUnexpectedNumberOfCalls(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	tester.ErrorfMock.Expect("Expected %d calls to FormatterMock.Format, but got %d", uint64(1), uint64(2)).Return()
+	tester.FailNowMock.Expect().Return()
+
+	formatterMock := NewFormatterMock(tester)
+	formatterMock.FormatMock.Times(1).Return("")
+
+	formatterMock.Format("")
+	formatterMock.Format("")
+
+	formatterMock.MinimockFinish()
+}
+
 func TestFormatterMock_MinimockWait(t *testing.T) {
 	tester := NewTesterMock(t)
 	defer tester.MinimockFinish()
@@ -269,3 +473,660 @@ func TestFormatterMock_RegistersMocker(t *testing.T) {
 	NewFormatterMock(mockController)
 	assert.Equal(t, 1, mockController.registerCounter)
 }
+
+func TestFormatterMock_Inspect(t *testing.T) {
+	var formats []string
+	formatterMock := NewFormatterMock(t).FormatMock.Return("formatted")
+	formatterMock.FormatMock.Inspect(func(format string, args ...interface{}) {
+		formats = append(formats, fmt.Sprintf(format, args...))
+	})
+	defer formatterMock.MinimockFinish()
+
+	assert.Equal(t, "formatted", formatterMock.Format("%s %d", "a", 1))
+	assert.Equal(t, "formatted", formatterMock.Format("b"))
+	assert.Equal(t, []string{"a 1", "b"}, formats)
+}
+
+func TestFormatterMock_InspectUnexpectedCall(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	tester.FatalfMock.Expect("Unexpected call to FormatterMock.Format. %v %v", "unexpected", []interface{}(nil)).Return()
+
+	var inspected bool
+	formatterMock := NewFormatterMock(tester)
+	formatterMock.FormatMock.Inspect(func(string, ...interface{}) { inspected = true })
+
+	formatterMock.Format("unexpected")
+	assert.True(t, inspected)
+}
+
+func TestFormatterMock_InspectPanics(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	tester.ErrorfMock.Expect("FormatterMock.Format inspector panicked: %v", "boom").Return()
+
+	formatterMock := NewFormatterMock(tester).
+		FormatMock.Inspect(func(string, ...interface{}) { panic("boom") }).
+		Return("formatted")
+
+	assert.Equal(t, "formatted", formatterMock.Format("a"))
+}
+
+func TestFormatterMock_Calls(t *testing.T) {
+	formatterMock := NewFormatterMock(t).FormatMock.Return("formatted")
+	defer formatterMock.MinimockFinish()
+
+	assert.Empty(t, formatterMock.FormatCalls())
+
+	formatterMock.Format("first")
+	formatterMock.Format("%d", 2)
+	formatterMock.Format("third")
+
+	calls := formatterMock.FormatCalls()
+	require.Len(t, calls, 3)
+	assert.Equal(t, FormatterMockFormatParams{P0: "%d", P1: []interface{}{2}}, calls[1])
+	assert.Equal(t, uint64(3), formatterMock.FormatCallCount())
+
+	//the returned slice is a copy of the history
+	calls[0].P0 = "changed"
+	assert.Equal(t, "first", formatterMock.FormatCalls()[0].P0)
+}
+
+func TestFormatterMock_CallsConcurrently(t *testing.T) {
+	formatterMock := NewFormatterMock(t).FormatMock.Return("formatted")
+	defer formatterMock.MinimockFinish()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			formatterMock.Format("")
+			formatterMock.FormatCalls()
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, formatterMock.FormatCalls(), 10)
+}
+
+func TestFormatterMock_LastParams(t *testing.T) {
+	formatterMock := NewFormatterMock(t).FormatMock.Return("formatted")
+	defer formatterMock.MinimockFinish()
+
+	_, ok := formatterMock.FormatLastParams()
+	assert.False(t, ok)
+
+	formatterMock.Format("first")
+	formatterMock.Format("second", 2)
+
+	params, ok := formatterMock.FormatLastParams()
+	assert.True(t, ok)
+	assert.Equal(t, FormatterMockFormatParams{P0: "second", P1: []interface{}{2}}, params)
+}
+
+func TestFormatterMock_MinimockReset(t *testing.T) {
+	formatterMock := NewFormatterMock(t)
+	defer formatterMock.MinimockFinish()
+
+	for _, format := range []string{"first", "second"} {
+		formatterMock.FormatMock.Expect(format).ReturnOnce("queued").Return(format)
+
+		assert.Equal(t, "queued", formatterMock.Format(format))
+		assert.Equal(t, format, formatterMock.Format(format))
+		assert.Equal(t, uint64(2), formatterMock.FormatAfterCounter())
+		assert.Len(t, formatterMock.FormatCalls(), 2)
+
+		formatterMock.MinimockReset()
+		assert.Equal(t, uint64(0), formatterMock.FormatAfterCounter())
+		assert.Empty(t, formatterMock.FormatCalls())
+		assert.Empty(t, formatterMock.FormatCallTimes())
+	}
+}
+
+func TestFormatterMock_MinimockResetKeepsFunc(t *testing.T) {
+	formatterMock := NewFormatterMock(t).FormatMock.Set(func(string, ...interface{}) string { return "set" })
+
+	formatterMock.Format("")
+	formatterMock.MinimockReset()
+	assert.Equal(t, "set", formatterMock.Format(""))
+
+	formatterMock.MinimockResetAll()
+	formatterMock.FormatMock.Return("returned")
+	assert.Equal(t, "returned", formatterMock.Format(""))
+
+	formatterMock.MinimockFinish()
+}
+
+func TestFormatterMock_MinimockResetWhileCalled(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	tester.FatalfMock.Expect("FormatterMock.MinimockReset is called while FormatterMock.Format is being called").Return()
+
+	called, release := make(chan struct{}), make(chan struct{})
+	formatterMock := NewFormatterMock(tester).FormatMock.Set(func(string, ...interface{}) string {
+		close(called)
+		<-release
+		return ""
+	})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		formatterMock.Format("")
+	}()
+
+	<-called
+	formatterMock.MinimockReset()
+	close(release)
+	<-done
+}
+
+func TestFormatterMock_FinishAll(t *testing.T) {
+	minimock.Track(t)
+	defer minimock.FinishAll(t)
+
+	//the mock is checked by FinishAll even though it's created by the helper
+	newFormatter := func() Formatter { return NewFormatterMock(t).FormatMock.Return("formatted") }
+	assert.Equal(t, "formatted", newFormatter().Format(""))
+}
+
+type cleanupTester struct {
+	*TesterMock
+	cleanups []func()
+}
+
+func (t *cleanupTester) Cleanup(f func()) { t.cleanups = append(t.cleanups, f) }
+
+func (t *cleanupTester) cleanup() {
+	for _, f := range t.cleanups {
+		f()
+	}
+}
+
+func TestFormatterMock_AutoFinish(t *testing.T) {
+	tester := &cleanupTester{TesterMock: NewTesterMock(t)}
+	defer tester.MinimockFinish()
+
+	tester.ErrorMock.Expect("Expected call to FormatterMock.Format").Return()
+	tester.FailNowMock.Expect().Return()
+
+	NewFormatterMock(tester).FormatMock.Return("")
+	tester.cleanup()
+}
+
+func TestFormatterMock_AutoFinishAfterFinish(t *testing.T) {
+	tester := &cleanupTester{TesterMock: NewTesterMock(t)}
+	defer tester.MinimockFinish()
+
+	tester.ErrorMock.Expect("Expected call to FormatterMock.Format").Return()
+	tester.FailNowMock.Expect().Return()
+
+	//the failure isn't reported twice
+	formatterMock := NewFormatterMock(tester).FormatMock.Return("")
+	formatterMock.MinimockFinish()
+	tester.cleanup()
+	assert.Equal(t, uint64(1), tester.ErrorAfterCounter())
+}
+
+func TestFormatterMock_NoAutoFinish(t *testing.T) {
+	tester := &cleanupTester{TesterMock: NewTesterMock(t)}
+	defer tester.MinimockFinish()
+
+	NewFormatterMock(tester).MinimockSetAutoFinish(false).FormatMock.Return("")
+	tester.cleanup()
+}
+
+func TestFormatterMock_FailHandlerTester(t *testing.T) {
+	var messages []string
+	fail := func(message string, callerSkip ...int) {
+		messages = append(messages, message)
+		panic(message) //ginkgo.Fail stops the spec by panicking
+	}
+
+	formatterMock := NewFormatterMock(minimock.FailHandlerTester(fail)).FormatMock.Expect("a").Return("b")
+	assert.Panics(t, func() { formatterMock.Format("c") })
+	require.Len(t, messages, 1)
+	assert.Contains(t, messages[0], "FormatterMock.Format got unexpected parameters")
+
+	assert.Panics(t, func() { NewFormatterMock(minimock.FailHandlerTester(fail)).Format("a") })
+	require.Len(t, messages, 2)
+	assert.Contains(t, messages[1], "Unexpected call to FormatterMock.Format")
+}
+
+func TestFormatterMock_PanicTester(t *testing.T) {
+	formatterMock := NewFormatterMock(minimock.PanicTester()).FormatMock.LimitConcurrency(1).Expect("a").Return("b")
+	assert.Equal(t, "b", formatterMock.Format("a"))
+
+	assert.Contains(t, panicMessage(func() { formatterMock.Format("c") }), "FormatterMock.Format got unexpected parameters")
+
+	//the counters are consistent after the panic, so the mock keeps working
+	assert.Equal(t, uint64(2), formatterMock.FormatAfterCounter())
+	assert.Equal(t, "b", formatterMock.Format("a"))
+	assert.Equal(t, 1, formatterMock.FormatMaxInFlight())
+
+	unexpected := NewFormatterMock(minimock.PanicTester())
+	assert.Equal(t, "Unexpected call to FormatterMock.Format. a []", panicMessage(func() { unexpected.Format("a") }))
+	assert.Contains(t, panicMessage(unexpected.MinimockFinish), "FormatterMock.Format was called 1 times without an implementation")
+}
+
+// panicMessage returns the message of the minimock.PanicError the function panics with
+func panicMessage(f func()) (message string) {
+	defer func() {
+		if e, ok := recover().(minimock.PanicError); ok {
+			message = e.Message
+		}
+	}()
+
+	f()
+	return ""
+}
+
+type sprintfFormatter struct{}
+
+func (sprintfFormatter) Format(format string, args ...interface{}) string {
+	return fmt.Sprintf(format, args...)
+}
+
+func TestFormatterMock_Delegate(t *testing.T) {
+	formatterMock := NewFormatterMock(t).MinimockSetDelegate(sprintfFormatter{})
+
+	//variadic params are passed to the delegate one by one
+	assert.Equal(t, "1 and 2", formatterMock.Format("%d and %d", 1, 2))
+	assert.Equal(t, []FormatterMockFormatParams{{"%d and %d", []interface{}{1, 2}}}, formatterMock.FormatCalls())
+
+	//the mocked method isn't forwarded to the delegate
+	formatterMock.FormatMock.Return("mocked")
+	assert.Equal(t, "mocked", formatterMock.Format("%d", 1))
+}
+
+// locationHandler returns the fail handler recording the location of the failure
+// the way ginkgo.Fail does, the failure stops the spec by panicking
+func locationHandler(locations *[]string) minimock.FailHandler {
+	return func(message string, callerSkip ...int) {
+		_, file, line, _ := runtime.Caller(callerSkip[0] + 1)
+		*locations = append(*locations, fmt.Sprintf("%s:%d", filepath.Base(file), line))
+		panic(message)
+	}
+}
+
+// callLocation returns the location of the next line
+func callLocation() string {
+	_, file, line, _ := runtime.Caller(1)
+	return fmt.Sprintf("%s:%d", filepath.Base(file), line+1)
+}
+
+func TestFormatterMock_FailHandlerTesterLocation(t *testing.T) {
+	var locations []string
+	mc := minimock.NewController(minimock.FailHandlerTester(locationHandler(&locations)))
+
+	formatterMock := NewFormatterMock(mc)
+	var unexpected string
+	assert.Panics(t, func() {
+		unexpected = callLocation()
+		formatterMock.Format("a")
+	})
+
+	formatterMock.FormatMock.Expect("a").Return("b")
+	var mismatch string
+	assert.Panics(t, func() {
+		mismatch = callLocation()
+		formatterMock.Format("c")
+	})
+
+	//the failures are reported at the calls to the mock rather than inside of the controller or the mock
+	assert.Equal(t, []string{unexpected, mismatch}, locations)
+}
+
+// Example_failHandlerTester shows an unexpected call reported as a failure of a Ginkgo spec,
+// the fail handler prints the location of the failure the way ginkgo.Fail does
+func Example_failHandlerTester() {
+	fail := func(message string, callerSkip ...int) {
+		_, file, _, _ := runtime.Caller(callerSkip[0] + 1)
+		fmt.Printf("%s: %s\n", filepath.Base(file), message)
+		panic(message)
+	}
+
+	mc := minimock.NewController(minimock.FailHandlerTester(fail))
+	formatterMock := NewFormatterMock(mc)
+
+	defer func() { recover() }() //ginkgo recovers from the panic of Fail and marks the spec as failed
+	formatterMock.Format("hello %s", "world")
+
+	// Output:
+	// formatter_mock_test.go: Unexpected call to FormatterMock.Format. hello %s [world]
+}
+
+func TestFormatterMock_WaitForCalls(t *testing.T) {
+	formatterMock := NewFormatterMock(t).FormatMock.Return("")
+
+	go func() {
+		for i := 0; i < 3; i++ {
+			formatterMock.Format("", i)
+		}
+	}()
+
+	formatterMock.FormatMock.WaitForCalls(3, time.Second)
+	assert.Len(t, formatterMock.FormatCalls(), 3)
+}
+
+func TestFormatterMock_WaitForCallsTimeout(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	tester.FatalfMock.Expect("Expected %d calls to FormatterMock.Format within %v, but got %d", uint64(2), time.Duration(0), uint64(1)).Return()
+
+	formatterMock := NewFormatterMock(tester).FormatMock.Return("")
+	formatterMock.Format("")
+
+	formatterMock.FormatMock.WaitForCalls(1, 0)
+	formatterMock.FormatMock.WaitForCalls(2, 0)
+}
+
+func TestFormatterMock_CaptureCalls(t *testing.T) {
+	formatterMock := NewFormatterMock(t).FormatMock.CaptureCalls(1).Return("")
+
+	go formatterMock.Format("a", 1)
+	assert.Equal(t, FormatterMockFormatParams{"a", []interface{}{1}}, <-formatterMock.FormatMock.Called())
+
+	//the call doesn't block when the buffer is full
+	formatterMock.Format("b")
+	formatterMock.Format("c")
+	assert.Equal(t, uint64(1), formatterMock.FormatMock.DroppedCalls())
+	assert.Equal(t, FormatterMockFormatParams{"b", nil}, <-formatterMock.FormatMock.Called())
+	assert.Len(t, formatterMock.FormatCalls(), 3)
+}
+
+func TestFormatterMock_CalledWithoutCapture(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	tester.FatalfMock.Expect("Calls of FormatterMock.Format aren't captured, CaptureCalls has to be called before the calls").Return()
+
+	assert.Nil(t, NewFormatterMock(tester).FormatMock.Called())
+}
+
+func TestFormatterMock_Block(t *testing.T) {
+	formatterMock := NewFormatterMock(t).FormatMock.Return("formatted")
+	release := formatterMock.FormatMock.Block()
+
+	results := make(chan string, 2)
+	for i := 0; i < 2; i++ {
+		go func() { results <- formatterMock.Format("") }()
+	}
+
+	formatterMock.FormatMock.WaitUntilBlocked(2, time.Second)
+	assert.Equal(t, uint64(0), formatterMock.FormatAfterCounter())
+
+	release()
+	release()
+	assert.Equal(t, "formatted", <-results)
+	assert.Equal(t, "formatted", <-results)
+	assert.Equal(t, "formatted", formatterMock.Format(""), "call is blocked after the release")
+}
+
+func TestFormatterMock_BlockReleasedByFinish(t *testing.T) {
+	formatterMock := NewFormatterMock(t).FormatMock.Return("formatted")
+	formatterMock.Format("")
+
+	formatterMock.FormatMock.Block()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		formatterMock.Format("")
+	}()
+
+	formatterMock.FormatMock.WaitUntilBlocked(1, time.Second)
+	formatterMock.MinimockFinish()
+	<-done
+}
+
+func TestFormatterMock_WaitUntilBlockedTimeout(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	tester.FatalfMock.Expect("Expected %d blocked calls to FormatterMock.Format within %v, but got %d", uint64(1), time.Duration(0), uint64(0)).Return()
+
+	NewFormatterMock(tester).FormatMock.WaitUntilBlocked(1, 0)
+}
+
+func TestFormatterMock_MaxInFlight(t *testing.T) {
+	formatterMock := NewFormatterMock(t).FormatMock.Return("")
+	release := formatterMock.FormatMock.Block()
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			formatterMock.Format("")
+		}()
+	}
+
+	formatterMock.FormatMock.WaitUntilBlocked(3, time.Second)
+	release()
+	wg.Wait()
+
+	formatterMock.Format("")
+	assert.Equal(t, 3, formatterMock.FormatMaxInFlight())
+
+	formatterMock.MinimockReset()
+	assert.Equal(t, 0, formatterMock.FormatMaxInFlight())
+}
+
+func TestFormatterMock_LimitConcurrency(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	tester.ErrorfMock.Expect("Expected at most %d concurrent calls to FormatterMock.Format, but %d goroutines are calling it", int64(1), int64(2)).Return()
+
+	formatterMock := NewFormatterMock(tester).FormatMock.LimitConcurrency(1).Return("")
+	release := formatterMock.FormatMock.Block()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		formatterMock.Format("")
+	}()
+
+	formatterMock.FormatMock.WaitUntilBlocked(1, time.Second)
+	release()
+	<-done
+	formatterMock.Format("") //the limit isn't exceeded by the sequential calls
+
+	release = formatterMock.FormatMock.Block()
+	go formatterMock.Format("")
+	formatterMock.FormatMock.WaitUntilBlocked(1, time.Second)
+	go formatterMock.Format("")
+	formatterMock.FormatMock.WaitUntilBlocked(2, time.Second)
+	release()
+	formatterMock.FormatMock.WaitForCalls(4, time.Second)
+}
+
+func BenchmarkFormatterMock_Format(b *testing.B) {
+	formatterMock := NewFormatterMock(b).FormatMock.Return("")
+
+	//the call reads the settings of the mock and the expectations under two read locks and is recorded under the lock
+	//of the history, the counters are atomic
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			formatterMock.Format("")
+		}
+	})
+}
+
+func BenchmarkFormatterMock_FormatWhen(b *testing.B) {
+	formatterMock := NewFormatterMock(b)
+	formatterMock.FormatMock.Optional()
+	for i := 0; i < 8; i++ {
+		formatterMock.FormatMock.When(strconv.Itoa(i)).Then("")
+	}
+
+	//the expectations set by When are read along with their results under the same read lock as the rest of the expectations
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			formatterMock.Format("7")
+		}
+	})
+}
+
+func TestFormatterMock_DoneWhileCalled(t *testing.T) {
+	formatterMock := NewFormatterMock(t).FormatMock.Return("")
+	formatterMock.FormatMock.When("a").Then("b")
+
+	stop := make(chan struct{})
+	wg := sync.WaitGroup{}
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					formatterMock.Format("a")
+				}
+			}
+		}()
+	}
+
+	//shouldn't produce data races while the calls are made
+	formatterMock.FormatMock.WaitForCalls(100, time.Second)
+	for i := 0; i < 100; i++ {
+		formatterMock.MinimockFormatDone()
+		formatterMock.FormatCallCount()
+	}
+	formatterMock.MinimockWait(time.Second)
+	formatterMock.MinimockFinish()
+
+	close(stop)
+	wg.Wait()
+}
+
+func TestFormatterMock_SetWhileCalled(t *testing.T) {
+	formatterMock := NewFormatterMock(t)
+	formatterMock.FormatMock.Set(func(string, ...interface{}) string { return "first" })
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				formatterMock.Format("")
+			}
+		}
+	}()
+
+	//the function is replaced while the background goroutine is calling the method, it shouldn't produce data races
+	formatterMock.FormatMock.WaitForCalls(1, time.Second)
+	formatterMock.FormatMock.Set(func(string, ...interface{}) string { return "second" })
+
+	close(stop)
+	<-done
+	assert.Equal(t, "second", formatterMock.Format(""))
+}
+
+func TestFormatterMock_SettersWhileCalled(t *testing.T) {
+	formatterMock := NewFormatterMock(t).FormatMock.Return("minimock")
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				formatterMock.Format("")
+				formatterMock.MinimockFormatDone()
+			}
+		}
+	}()
+
+	//the settings are changed while the background goroutine is calling the method, they shouldn't produce data races
+	formatterMock.FormatMock.WaitForCalls(1, time.Second)
+	formatterMock.FormatMock.Inspect(func(string, ...interface{}) {})
+	formatterMock.FormatMock.SetComparer(func(want, got interface{}) bool { return true })
+	formatterMock.FormatMock.Optional()
+	formatterMock.FormatMock.Times(1)
+	formatterMock.MinimockSetComparer(func(want, got interface{}) bool { return true })
+	formatterMock.MinimockSetSequence(&minimock.Sequence{})
+	formatterMock.MinimockSetClock(time.Now)
+	formatterMock.MinimockSetLenient(true)
+	formatterMock.MinimockSetAutoFinish(false)
+
+	close(stop)
+	<-done
+}
+
+func TestFormatterMock_ReturnWhileCalled(t *testing.T) {
+	formatterMock := NewFormatterMock(t).FormatMock.Return("first")
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				formatterMock.Format("a")
+			}
+		}
+	}()
+
+	formatterMock.FormatMock.WaitForCalls(1, time.Second)
+	formatterMock.FormatMock.Expect("a").Return("second")
+	formatterMock.FormatMock.MatchP1Param2(func([]interface{}) bool { return true })
+
+	close(stop)
+	<-done
+	assert.Equal(t, "second", formatterMock.Format("a"))
+}
+
+func TestFormatterMock_UnexpectedCounter(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	//the test survives the unexpected calls if they are made by the other goroutines
+	tester.FatalfMock.Return()
+	tester.ErrorfMock.Expect("FormatterMock.Format was called %d times without an implementation, first call params: %#v",
+		uint64(2), FormatterMockFormatParams{"first", nil}).Return()
+	tester.FailNowMock.Expect().Return()
+
+	formatterMock := NewFormatterMock(tester)
+	formatterMock.Format("first")
+	formatterMock.Format("second")
+
+	assert.Equal(t, uint64(2), formatterMock.FormatUnexpectedCounter())
+	assert.Equal(t, uint64(2), formatterMock.FormatAfterCounter())
+	assert.False(t, formatterMock.MinimockFormatDone())
+	formatterMock.MinimockFinish()
+}
+
+func TestFormatterMock_UnexpectedCallsAreNotCountedByTimes(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	tester.FatalfMock.Return()
+	tester.ErrorfMock.Expect("FormatterMock.Format was called %d times without an implementation, first call params: %#v",
+		uint64(1), FormatterMockFormatParams{"excess", nil}).Return()
+	tester.FailNowMock.Expect().Return()
+
+	formatterMock := NewFormatterMock(tester)
+	formatterMock.FormatMock.Times(1).ReturnOnce("queued")
+	assert.Equal(t, "queued", formatterMock.Format("expected"))
+	formatterMock.Format("excess")
+
+	formatterMock.MinimockFinish()
+}
@@ -0,0 +1,1284 @@
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+package tests
+
+//go:generate minimock -i github.com/gojuno/minimock/tests.Handler -o ./handler_mock.go
+
+import (
+	"context"
+	mm_sync "sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock"
+)
+
+// HandlerMock implements Handler
+//
+// Handler interface is used to test mocks of the methods with unnamed and blank parameters
+type HandlerMock struct {
+	state    minimock.MockState
+	mutex    mm_sync.RWMutex
+	delegate Handler
+
+	funcHandle func(ctx context.Context, s1 string, s2 string) (err error)
+	HandleMock *mHandlerMockHandle
+
+	funcSkip func(p0 int, s1 string) (b1 bool)
+	SkipMock *mHandlerMockSkip
+}
+
+var _ Handler = (*HandlerMock)(nil)
+
+// NewHandlerMock returns a mock for Handler
+func NewHandlerMock(t minimock.Tester) *HandlerMock {
+	m := &HandlerMock{}
+	m.state.Init(m, t, "HandlerMock", 0)
+
+	m.HandleMock = &mHandlerMockHandle{mock: m}
+	m.HandleMock.state.Init(&m.state, "Handle")
+
+	m.SkipMock = &mHandlerMockSkip{mock: m}
+	m.SkipMock.state.Init(&m.state, "Skip")
+
+	return m
+}
+
+type mHandlerMockHandle struct {
+	mock               *HandlerMock
+	state              minimock.MethodState
+	defaultExpectation *HandlerMockHandleExpectation
+	expectations       []*HandlerMockHandleExpectation
+	inspectHandle      func(ctx context.Context, s1 string, s2 string)
+	compare            minimock.Comparer
+	calls              []HandlerMockHandleParams
+	called             chan HandlerMockHandleParams
+	queued             []*HandlerMockHandleResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*HandlerMockHandleResults
+}
+
+// HandlerMockHandleExpectation specifies expectation struct of the Handler.Handle
+type HandlerMockHandleExpectation struct {
+	mock     *HandlerMock
+	params   *HandlerMockHandleParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *HandlerMockHandleResults
+	Counter  uint64
+}
+
+// HandlerMockHandleParams contains parameters of the Handler.Handle
+type HandlerMockHandleParams struct {
+	P0 context.Context
+	P1 string
+	P2 string
+}
+
+// HandlerMockHandleResults contains results of the Handler.Handle
+type HandlerMockHandleResults struct {
+	R0 error
+}
+
+// Expect sets up expected params for Handler.Handle
+func (mmHandle *mHandlerMockHandle) Expect(ctx context.Context, s1 string, s2 string) *mHandlerMockHandle {
+	if _, mm_func := mmHandle.current(); mm_func != nil {
+		mmHandle.mock.state.T().Fatalf("HandlerMock.Handle mock is already set by Set")
+	}
+
+	mm_params := &HandlerMockHandleParams{ctx, s1, s2}
+	mmHandle.updateDefault(func(e *HandlerMockHandleExpectation) {
+		if e.partial {
+			mmHandle.mock.state.T().Fatalf("HandlerMock.Handle params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmHandle.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmHandle.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmHandle
+}
+
+// updateDefault replaces the default expectation of Handler.Handle by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmHandle *mHandlerMockHandle) updateDefault(update func(e *HandlerMockHandleExpectation)) {
+	mmHandle.state.Mutex.Lock()
+	defer mmHandle.state.Mutex.Unlock()
+
+	e := &HandlerMockHandleExpectation{mock: mmHandle.mock}
+	if previous := mmHandle.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmHandle.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Handler.Handle
+func (mmHandle *mHandlerMockHandle) current() (*HandlerMockHandleExpectation, func(ctx context.Context, s1 string, s2 string) (err error)) {
+	mmHandle.state.Mutex.RLock()
+	defer mmHandle.state.Mutex.RUnlock()
+
+	return mmHandle.defaultExpectation, mmHandle.mock.funcHandle
+}
+
+// partialParams updates the default expectation of Handler.Handle by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmHandle *mHandlerMockHandle) partialParams(update func(e *HandlerMockHandleExpectation)) {
+	if _, mm_func := mmHandle.current(); mm_func != nil {
+		mmHandle.mock.state.T().Fatalf("HandlerMock.Handle mock is already set by Set")
+	}
+
+	mmHandle.updateDefault(func(e *HandlerMockHandleExpectation) {
+		if e.params == nil {
+			e.params = &HandlerMockHandleParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"P0": minimock.Anything,
+				"P1": minimock.Anything,
+				"P2": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectP0Param1 sets up the expected value of the param #1 of Handler.Handle,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmHandle *mHandlerMockHandle) ExpectP0Param1(P0 context.Context) *mHandlerMockHandle {
+	mmHandle.partialParams(func(e *HandlerMockHandleExpectation) {
+		e.params.P0 = P0
+		delete(e.matchers, "P0")
+	})
+	return mmHandle
+}
+
+// MatchP0Param1 sets up the predicate matching the param #1 of Handler.Handle,
+// it's used instead of the value of the param set by Expect
+func (mmHandle *mHandlerMockHandle) MatchP0Param1(f func(got context.Context) bool) *mHandlerMockHandle {
+	mm_matcher := minimock.Predicate("predicate func(got context.Context) bool", func(v interface{}) bool {
+		got, _ := v.(context.Context)
+		return f(got)
+	})
+	mmHandle.partialParams(func(e *HandlerMockHandleExpectation) {
+		e.matchers["P0"] = mm_matcher
+	})
+	return mmHandle
+}
+
+// ExpectP1Param2 sets up the expected value of the param #2 of Handler.Handle,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmHandle *mHandlerMockHandle) ExpectP1Param2(P1 string) *mHandlerMockHandle {
+	mmHandle.partialParams(func(e *HandlerMockHandleExpectation) {
+		e.params.P1 = P1
+		delete(e.matchers, "P1")
+	})
+	return mmHandle
+}
+
+// MatchP1Param2 sets up the predicate matching the param #2 of Handler.Handle,
+// it's used instead of the value of the param set by Expect
+func (mmHandle *mHandlerMockHandle) MatchP1Param2(f func(got string) bool) *mHandlerMockHandle {
+	mm_matcher := minimock.Predicate("predicate func(got string) bool", func(v interface{}) bool {
+		got, _ := v.(string)
+		return f(got)
+	})
+	mmHandle.partialParams(func(e *HandlerMockHandleExpectation) {
+		e.matchers["P1"] = mm_matcher
+	})
+	return mmHandle
+}
+
+// ExpectP2Param3 sets up the expected value of the param #3 of Handler.Handle,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmHandle *mHandlerMockHandle) ExpectP2Param3(P2 string) *mHandlerMockHandle {
+	mmHandle.partialParams(func(e *HandlerMockHandleExpectation) {
+		e.params.P2 = P2
+		delete(e.matchers, "P2")
+	})
+	return mmHandle
+}
+
+// MatchP2Param3 sets up the predicate matching the param #3 of Handler.Handle,
+// it's used instead of the value of the param set by Expect
+func (mmHandle *mHandlerMockHandle) MatchP2Param3(f func(got string) bool) *mHandlerMockHandle {
+	mm_matcher := minimock.Predicate("predicate func(got string) bool", func(v interface{}) bool {
+		got, _ := v.(string)
+		return f(got)
+	})
+	mmHandle.partialParams(func(e *HandlerMockHandleExpectation) {
+		e.matchers["P2"] = mm_matcher
+	})
+	return mmHandle
+}
+
+// Return sets up results that will be returned by Handler.Handle
+func (mmHandle *mHandlerMockHandle) Return(err error) *HandlerMock {
+	if _, mm_func := mmHandle.current(); mm_func != nil {
+		mmHandle.mock.state.T().Fatalf("HandlerMock.Handle mock is already set by Set")
+	}
+
+	mm_results := &HandlerMockHandleResults{err}
+	mmHandle.updateDefault(func(e *HandlerMockHandleExpectation) { e.results = mm_results })
+
+	return mmHandle.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Handler.Handle,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmHandle *mHandlerMockHandle) ReturnOnce(err error) *mHandlerMockHandle {
+	mmHandle.state.Mutex.Lock()
+	mmHandle.queued = append(mmHandle.queued, &HandlerMockHandleResults{err})
+	mmHandle.state.Mutex.Unlock()
+	mmHandle.state.PushResults()
+	return mmHandle
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmHandle *mHandlerMockHandle) popResults() *HandlerMockHandleResults {
+	mm_i, mm_ok := mmHandle.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmHandle.state.Mutex.RLock()
+	defer mmHandle.state.Mutex.RUnlock()
+
+	return mmHandle.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of Handler.Handle instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmHandle *mHandlerMockHandle) SetComparer(compare minimock.Comparer) *mHandlerMockHandle {
+	mmHandle.state.Mutex.Lock()
+	mmHandle.compare = compare
+	mmHandle.state.Mutex.Unlock()
+	return mmHandle
+}
+
+// whenExpectations returns the expectations of Handler.Handle set by When,
+// the expectations can be set while the method is called concurrently
+func (mmHandle *mHandlerMockHandle) whenExpectations() []*HandlerMockHandleExpectation {
+	mmHandle.state.Mutex.RLock()
+	defer mmHandle.state.Mutex.RUnlock()
+
+	return mmHandle.expectations
+}
+
+// Inspect sets up the function called with the params of every Handler.Handle call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmHandle *mHandlerMockHandle) Inspect(f func(ctx context.Context, s1 string, s2 string)) *mHandlerMockHandle {
+	mmHandle.state.Mutex.Lock()
+	mmHandle.inspectHandle = f
+	mmHandle.state.Mutex.Unlock()
+	return mmHandle
+}
+
+// expected returns everything set up for the Handler.Handle call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmHandle *mHandlerMockHandle) expected() (*HandlerMockHandleExpectation, func(ctx context.Context, s1 string, s2 string) (err error), func(ctx context.Context, s1 string, s2 string), minimock.Comparer, []*HandlerMockHandleExpectation, []*HandlerMockHandleResults) {
+	mmHandle.state.Mutex.RLock()
+	defer mmHandle.state.Mutex.RUnlock()
+
+	return mmHandle.defaultExpectation, mmHandle.mock.funcHandle, mmHandle.inspectHandle, mmHandle.compare, mmHandle.expectations, mmHandle.whenResults
+}
+
+// setup returns the expectations and the function set up for Handler.Handle to be checked when the mock is finished
+func (mmHandle *mHandlerMockHandle) setup() (setup minimock.Setup) {
+	mmHandle.state.Mutex.RLock()
+	defer mmHandle.state.Mutex.RUnlock()
+
+	setup.Func = mmHandle.mock.funcHandle != nil
+	if e := mmHandle.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmHandle.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Handler.Handle, the params of the calls are removed from the history as well
+func (mmHandle *mHandlerMockHandle) reset() {
+	mmHandle.state.Mutex.Lock()
+	mmHandle.defaultExpectation = nil
+	mmHandle.expectations = nil
+	mmHandle.queued = nil
+	mmHandle.whenResults = nil
+	mmHandle.state.Mutex.Unlock()
+
+	mmHandle.state.History.Lock()
+	mmHandle.calls = nil
+	mmHandle.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmHandle *mHandlerMockHandle) MethodName() string {
+	return mmHandle.state.Name()
+}
+
+// CallSequence returns the numbers of the Handler.Handle calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmHandle *mHandlerMockHandle) CallSequence() []uint64 {
+	return mmHandle.state.CallSequence()
+}
+
+// WaitForCalls waits until Handler.Handle is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmHandle *mHandlerMockHandle) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmHandle.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Handler.Handle calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmHandle *mHandlerMockHandle) Block() (release func()) {
+	return mmHandle.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Handler.Handle calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmHandle *mHandlerMockHandle) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmHandle.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Handler.Handle calls are in flight at once
+func (mmHandle *mHandlerMockHandle) LimitConcurrency(n int) *mHandlerMockHandle {
+	mmHandle.state.SetLimit(n)
+	return mmHandle
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Handler.Handle call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmHandle *mHandlerMockHandle) CaptureCalls(buffer int) *mHandlerMockHandle {
+	mmHandle.state.History.Lock()
+	mmHandle.called = make(chan HandlerMockHandleParams, buffer)
+	mmHandle.state.History.Unlock()
+	return mmHandle
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Handler.Handle call
+func (mmHandle *mHandlerMockHandle) Called() <-chan HandlerMockHandleParams {
+	mmHandle.state.History.Lock()
+	defer mmHandle.state.History.Unlock()
+
+	if mmHandle.called == nil {
+		mmHandle.mock.state.T().Fatalf("Calls of HandlerMock.Handle aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmHandle.called
+}
+
+// DroppedCalls returns the number of the Handler.Handle calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmHandle *mHandlerMockHandle) DroppedCalls() uint64 {
+	return mmHandle.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Handler.Handle calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmHandle *mHandlerMockHandle) Times(n uint64) *mHandlerMockHandle {
+	mmHandle.state.SetTimes(n)
+	return mmHandle
+}
+
+// Optional excludes Handler.Handle from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmHandle *mHandlerMockHandle) Optional() *mHandlerMockHandle {
+	mmHandle.state.SetOptional()
+	return mmHandle
+}
+
+// Set uses given function f to mock the Handler.Handle method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmHandle *mHandlerMockHandle) Set(f func(ctx context.Context, s1 string, s2 string) (err error)) *HandlerMock {
+	mmHandle.state.Mutex.Lock()
+	defer mmHandle.state.Mutex.Unlock()
+
+	if mmHandle.defaultExpectation != nil {
+		mmHandle.mock.state.T().Fatalf("Default expectation is already set for the Handler.Handle method")
+	}
+
+	if len(mmHandle.expectations) > 0 {
+		mmHandle.mock.state.T().Fatalf("Some expectations are already set for the Handler.Handle method")
+	}
+
+	mmHandle.mock.funcHandle = f
+	return mmHandle.mock
+}
+
+// When sets expectation for the Handler.Handle which will trigger the result defined by the following
+// Then helper
+func (mmHandle *mHandlerMockHandle) When(ctx context.Context, s1 string, s2 string) *HandlerMockHandleExpectation {
+	if _, mm_func := mmHandle.current(); mm_func != nil {
+		mmHandle.mock.state.T().Fatalf("HandlerMock.Handle mock is already set by Set")
+	}
+
+	expectation := &HandlerMockHandleExpectation{
+		mock:   mmHandle.mock,
+		params: &HandlerMockHandleParams{ctx, s1, s2},
+	}
+	mmHandle.state.Mutex.Lock()
+	mmHandle.expectations = append(mmHandle.expectations, expectation)
+	mmHandle.whenResults = append(mmHandle.whenResults, nil)
+	mmHandle.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up Handler.Handle return parameters for the expectation previously defined by the When method
+func (mmExpectation *HandlerMockHandleExpectation) Then(err error) *HandlerMock {
+	mm_handle := mmExpectation.mock.HandleMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &HandlerMockHandleResults{err}
+	mm_results := make([]*HandlerMockHandleResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Handle implements Handler
+func (mmHandle *HandlerMock) Handle(ctx context.Context, s1 string, s2 string) (err error) {
+	mm_method := mmHandle.HandleMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := HandlerMockHandleParams{ctx, s1, s2}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcHandle, mm_inspectHandle, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectHandle != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectHandle(ctx, s1, s2)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).R0
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcHandle == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcHandle != nil {
+		return mm_funcHandle(ctx, s1, s2)
+	}
+	if mm_delegate := mmHandle.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Handle(ctx, s1, s2)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, ctx, s1, s2)
+	return
+}
+
+// HandleAfterCounter returns a count of finished HandlerMock.Handle invocations
+func (mmHandle *HandlerMock) HandleAfterCounter() uint64 {
+	return mmHandle.HandleMock.state.AfterCalls()
+}
+
+// HandleBeforeCounter returns a count of HandlerMock.Handle invocations
+func (mmHandle *HandlerMock) HandleBeforeCounter() uint64 {
+	return mmHandle.HandleMock.state.BeforeCalls()
+}
+
+// HandleCalls returns the params of all HandlerMock.Handle calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmHandle *HandlerMock) HandleCalls() []HandlerMockHandleParams {
+	mmHandle.HandleMock.state.History.Lock()
+	defer mmHandle.HandleMock.state.History.Unlock()
+
+	calls := make([]HandlerMockHandleParams, len(mmHandle.HandleMock.calls))
+	copy(calls, mmHandle.HandleMock.calls)
+	return calls
+}
+
+// HandleLastParams returns the params of the latest HandlerMock.Handle call and false if there were no calls
+func (mmHandle *HandlerMock) HandleLastParams() (params HandlerMockHandleParams, ok bool) {
+	mmHandle.HandleMock.state.History.Lock()
+	defer mmHandle.HandleMock.state.History.Unlock()
+
+	if n := len(mmHandle.HandleMock.calls); n > 0 {
+		return mmHandle.HandleMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// HandleCallTimes returns the times of all HandlerMock.Handle calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmHandle *HandlerMock) HandleCallTimes() []mm_time.Time {
+	return mmHandle.HandleMock.state.CallTimes()
+}
+
+// HandleMaxInFlight returns the maximum number of the HandlerMock.Handle calls that have been in flight at once
+func (mmHandle *HandlerMock) HandleMaxInFlight() int {
+	return mmHandle.HandleMock.state.MaxInFlight()
+}
+
+// HandleUnexpectedCounter returns a count of HandlerMock.Handle invocations made without an implementation
+func (mmHandle *HandlerMock) HandleUnexpectedCounter() uint64 {
+	return mmHandle.HandleMock.state.UnexpectedCalls()
+}
+
+// HandleNotCalled returns true if HandlerMock.Handle hasn't been called
+func (mmHandle *HandlerMock) HandleNotCalled() bool {
+	return mmHandle.HandleMock.state.BeforeCalls() == 0
+}
+
+// HandleCallCount returns a count of HandlerMock.Handle invocations, it's the same as HandleBeforeCounter
+func (mmHandle *HandlerMock) HandleCallCount() uint64 {
+	return mmHandle.HandleMock.state.BeforeCalls()
+}
+
+// MinimockHandleDone returns true if the count of the Handle invocations corresponds
+// the number of defined expectations
+func (mmHandle *HandlerMock) MinimockHandleDone() bool {
+	return mmHandle.HandleMock.state.Done(mmHandle.HandleMock.setup())
+}
+
+// MinimockHandleInspect logs each unmet expectation
+func (mmHandle *HandlerMock) MinimockHandleInspect() {
+	mmHandle.HandleMock.state.Inspect(mmHandle.HandleMock.setup())
+}
+
+type mHandlerMockSkip struct {
+	mock               *HandlerMock
+	state              minimock.MethodState
+	defaultExpectation *HandlerMockSkipExpectation
+	expectations       []*HandlerMockSkipExpectation
+	inspectSkip        func(p0 int, s1 string)
+	compare            minimock.Comparer
+	calls              []HandlerMockSkipParams
+	called             chan HandlerMockSkipParams
+	queued             []*HandlerMockSkipResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*HandlerMockSkipResults
+}
+
+// HandlerMockSkipExpectation specifies expectation struct of the Handler.Skip
+type HandlerMockSkipExpectation struct {
+	mock     *HandlerMock
+	params   *HandlerMockSkipParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *HandlerMockSkipResults
+	Counter  uint64
+}
+
+// HandlerMockSkipParams contains parameters of the Handler.Skip
+type HandlerMockSkipParams struct {
+	P0 int
+	P1 string
+}
+
+// HandlerMockSkipResults contains results of the Handler.Skip
+type HandlerMockSkipResults struct {
+	R0 bool
+}
+
+// Expect sets up expected params for Handler.Skip
+func (mmSkip *mHandlerMockSkip) Expect(p0 int, s1 string) *mHandlerMockSkip {
+	if _, mm_func := mmSkip.current(); mm_func != nil {
+		mmSkip.mock.state.T().Fatalf("HandlerMock.Skip mock is already set by Set")
+	}
+
+	mm_params := &HandlerMockSkipParams{p0, s1}
+	mmSkip.updateDefault(func(e *HandlerMockSkipExpectation) {
+		if e.partial {
+			mmSkip.mock.state.T().Fatalf("HandlerMock.Skip params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmSkip.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmSkip.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmSkip
+}
+
+// updateDefault replaces the default expectation of Handler.Skip by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmSkip *mHandlerMockSkip) updateDefault(update func(e *HandlerMockSkipExpectation)) {
+	mmSkip.state.Mutex.Lock()
+	defer mmSkip.state.Mutex.Unlock()
+
+	e := &HandlerMockSkipExpectation{mock: mmSkip.mock}
+	if previous := mmSkip.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmSkip.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Handler.Skip
+func (mmSkip *mHandlerMockSkip) current() (*HandlerMockSkipExpectation, func(p0 int, s1 string) (b1 bool)) {
+	mmSkip.state.Mutex.RLock()
+	defer mmSkip.state.Mutex.RUnlock()
+
+	return mmSkip.defaultExpectation, mmSkip.mock.funcSkip
+}
+
+// partialParams updates the default expectation of Handler.Skip by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmSkip *mHandlerMockSkip) partialParams(update func(e *HandlerMockSkipExpectation)) {
+	if _, mm_func := mmSkip.current(); mm_func != nil {
+		mmSkip.mock.state.T().Fatalf("HandlerMock.Skip mock is already set by Set")
+	}
+
+	mmSkip.updateDefault(func(e *HandlerMockSkipExpectation) {
+		if e.params == nil {
+			e.params = &HandlerMockSkipParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"P0": minimock.Anything,
+				"P1": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectP0Param1 sets up the expected value of the param #1 of Handler.Skip,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmSkip *mHandlerMockSkip) ExpectP0Param1(P0 int) *mHandlerMockSkip {
+	mmSkip.partialParams(func(e *HandlerMockSkipExpectation) {
+		e.params.P0 = P0
+		delete(e.matchers, "P0")
+	})
+	return mmSkip
+}
+
+// MatchP0Param1 sets up the predicate matching the param #1 of Handler.Skip,
+// it's used instead of the value of the param set by Expect
+func (mmSkip *mHandlerMockSkip) MatchP0Param1(f func(got int) bool) *mHandlerMockSkip {
+	mm_matcher := minimock.Predicate("predicate func(got int) bool", func(v interface{}) bool {
+		got, _ := v.(int)
+		return f(got)
+	})
+	mmSkip.partialParams(func(e *HandlerMockSkipExpectation) {
+		e.matchers["P0"] = mm_matcher
+	})
+	return mmSkip
+}
+
+// ExpectP1Param2 sets up the expected value of the param #2 of Handler.Skip,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmSkip *mHandlerMockSkip) ExpectP1Param2(P1 string) *mHandlerMockSkip {
+	mmSkip.partialParams(func(e *HandlerMockSkipExpectation) {
+		e.params.P1 = P1
+		delete(e.matchers, "P1")
+	})
+	return mmSkip
+}
+
+// MatchP1Param2 sets up the predicate matching the param #2 of Handler.Skip,
+// it's used instead of the value of the param set by Expect
+func (mmSkip *mHandlerMockSkip) MatchP1Param2(f func(got string) bool) *mHandlerMockSkip {
+	mm_matcher := minimock.Predicate("predicate func(got string) bool", func(v interface{}) bool {
+		got, _ := v.(string)
+		return f(got)
+	})
+	mmSkip.partialParams(func(e *HandlerMockSkipExpectation) {
+		e.matchers["P1"] = mm_matcher
+	})
+	return mmSkip
+}
+
+// Return sets up results that will be returned by Handler.Skip
+func (mmSkip *mHandlerMockSkip) Return(b1 bool) *HandlerMock {
+	if _, mm_func := mmSkip.current(); mm_func != nil {
+		mmSkip.mock.state.T().Fatalf("HandlerMock.Skip mock is already set by Set")
+	}
+
+	mm_results := &HandlerMockSkipResults{b1}
+	mmSkip.updateDefault(func(e *HandlerMockSkipExpectation) { e.results = mm_results })
+
+	return mmSkip.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Handler.Skip,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmSkip *mHandlerMockSkip) ReturnOnce(b1 bool) *mHandlerMockSkip {
+	mmSkip.state.Mutex.Lock()
+	mmSkip.queued = append(mmSkip.queued, &HandlerMockSkipResults{b1})
+	mmSkip.state.Mutex.Unlock()
+	mmSkip.state.PushResults()
+	return mmSkip
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmSkip *mHandlerMockSkip) popResults() *HandlerMockSkipResults {
+	mm_i, mm_ok := mmSkip.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmSkip.state.Mutex.RLock()
+	defer mmSkip.state.Mutex.RUnlock()
+
+	return mmSkip.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of Handler.Skip instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmSkip *mHandlerMockSkip) SetComparer(compare minimock.Comparer) *mHandlerMockSkip {
+	mmSkip.state.Mutex.Lock()
+	mmSkip.compare = compare
+	mmSkip.state.Mutex.Unlock()
+	return mmSkip
+}
+
+// whenExpectations returns the expectations of Handler.Skip set by When,
+// the expectations can be set while the method is called concurrently
+func (mmSkip *mHandlerMockSkip) whenExpectations() []*HandlerMockSkipExpectation {
+	mmSkip.state.Mutex.RLock()
+	defer mmSkip.state.Mutex.RUnlock()
+
+	return mmSkip.expectations
+}
+
+// Inspect sets up the function called with the params of every Handler.Skip call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmSkip *mHandlerMockSkip) Inspect(f func(p0 int, s1 string)) *mHandlerMockSkip {
+	mmSkip.state.Mutex.Lock()
+	mmSkip.inspectSkip = f
+	mmSkip.state.Mutex.Unlock()
+	return mmSkip
+}
+
+// expected returns everything set up for the Handler.Skip call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmSkip *mHandlerMockSkip) expected() (*HandlerMockSkipExpectation, func(p0 int, s1 string) (b1 bool), func(p0 int, s1 string), minimock.Comparer, []*HandlerMockSkipExpectation, []*HandlerMockSkipResults) {
+	mmSkip.state.Mutex.RLock()
+	defer mmSkip.state.Mutex.RUnlock()
+
+	return mmSkip.defaultExpectation, mmSkip.mock.funcSkip, mmSkip.inspectSkip, mmSkip.compare, mmSkip.expectations, mmSkip.whenResults
+}
+
+// setup returns the expectations and the function set up for Handler.Skip to be checked when the mock is finished
+func (mmSkip *mHandlerMockSkip) setup() (setup minimock.Setup) {
+	mmSkip.state.Mutex.RLock()
+	defer mmSkip.state.Mutex.RUnlock()
+
+	setup.Func = mmSkip.mock.funcSkip != nil
+	if e := mmSkip.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmSkip.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Handler.Skip, the params of the calls are removed from the history as well
+func (mmSkip *mHandlerMockSkip) reset() {
+	mmSkip.state.Mutex.Lock()
+	mmSkip.defaultExpectation = nil
+	mmSkip.expectations = nil
+	mmSkip.queued = nil
+	mmSkip.whenResults = nil
+	mmSkip.state.Mutex.Unlock()
+
+	mmSkip.state.History.Lock()
+	mmSkip.calls = nil
+	mmSkip.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmSkip *mHandlerMockSkip) MethodName() string {
+	return mmSkip.state.Name()
+}
+
+// CallSequence returns the numbers of the Handler.Skip calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmSkip *mHandlerMockSkip) CallSequence() []uint64 {
+	return mmSkip.state.CallSequence()
+}
+
+// WaitForCalls waits until Handler.Skip is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmSkip *mHandlerMockSkip) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmSkip.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Handler.Skip calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmSkip *mHandlerMockSkip) Block() (release func()) {
+	return mmSkip.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Handler.Skip calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmSkip *mHandlerMockSkip) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmSkip.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Handler.Skip calls are in flight at once
+func (mmSkip *mHandlerMockSkip) LimitConcurrency(n int) *mHandlerMockSkip {
+	mmSkip.state.SetLimit(n)
+	return mmSkip
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Handler.Skip call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmSkip *mHandlerMockSkip) CaptureCalls(buffer int) *mHandlerMockSkip {
+	mmSkip.state.History.Lock()
+	mmSkip.called = make(chan HandlerMockSkipParams, buffer)
+	mmSkip.state.History.Unlock()
+	return mmSkip
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Handler.Skip call
+func (mmSkip *mHandlerMockSkip) Called() <-chan HandlerMockSkipParams {
+	mmSkip.state.History.Lock()
+	defer mmSkip.state.History.Unlock()
+
+	if mmSkip.called == nil {
+		mmSkip.mock.state.T().Fatalf("Calls of HandlerMock.Skip aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmSkip.called
+}
+
+// DroppedCalls returns the number of the Handler.Skip calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmSkip *mHandlerMockSkip) DroppedCalls() uint64 {
+	return mmSkip.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Handler.Skip calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmSkip *mHandlerMockSkip) Times(n uint64) *mHandlerMockSkip {
+	mmSkip.state.SetTimes(n)
+	return mmSkip
+}
+
+// Optional excludes Handler.Skip from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmSkip *mHandlerMockSkip) Optional() *mHandlerMockSkip {
+	mmSkip.state.SetOptional()
+	return mmSkip
+}
+
+// Set uses given function f to mock the Handler.Skip method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmSkip *mHandlerMockSkip) Set(f func(p0 int, s1 string) (b1 bool)) *HandlerMock {
+	mmSkip.state.Mutex.Lock()
+	defer mmSkip.state.Mutex.Unlock()
+
+	if mmSkip.defaultExpectation != nil {
+		mmSkip.mock.state.T().Fatalf("Default expectation is already set for the Handler.Skip method")
+	}
+
+	if len(mmSkip.expectations) > 0 {
+		mmSkip.mock.state.T().Fatalf("Some expectations are already set for the Handler.Skip method")
+	}
+
+	mmSkip.mock.funcSkip = f
+	return mmSkip.mock
+}
+
+// When sets expectation for the Handler.Skip which will trigger the result defined by the following
+// Then helper
+func (mmSkip *mHandlerMockSkip) When(p0 int, s1 string) *HandlerMockSkipExpectation {
+	if _, mm_func := mmSkip.current(); mm_func != nil {
+		mmSkip.mock.state.T().Fatalf("HandlerMock.Skip mock is already set by Set")
+	}
+
+	expectation := &HandlerMockSkipExpectation{
+		mock:   mmSkip.mock,
+		params: &HandlerMockSkipParams{p0, s1},
+	}
+	mmSkip.state.Mutex.Lock()
+	mmSkip.expectations = append(mmSkip.expectations, expectation)
+	mmSkip.whenResults = append(mmSkip.whenResults, nil)
+	mmSkip.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up Handler.Skip return parameters for the expectation previously defined by the When method
+func (mmExpectation *HandlerMockSkipExpectation) Then(b1 bool) *HandlerMock {
+	mm_handle := mmExpectation.mock.SkipMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &HandlerMockSkipResults{b1}
+	mm_results := make([]*HandlerMockSkipResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Skip implements Handler
+func (mmSkip *HandlerMock) Skip(p0 int, s1 string) (b1 bool) {
+	mm_method := mmSkip.SkipMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := HandlerMockSkipParams{p0, s1}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcSkip, mm_inspectSkip, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectSkip != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectSkip(p0, s1)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).R0
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcSkip == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcSkip != nil {
+		return mm_funcSkip(p0, s1)
+	}
+	if mm_delegate := mmSkip.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Skip(p0, s1)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, p0, s1)
+	return
+}
+
+// SkipAfterCounter returns a count of finished HandlerMock.Skip invocations
+func (mmSkip *HandlerMock) SkipAfterCounter() uint64 {
+	return mmSkip.SkipMock.state.AfterCalls()
+}
+
+// SkipBeforeCounter returns a count of HandlerMock.Skip invocations
+func (mmSkip *HandlerMock) SkipBeforeCounter() uint64 {
+	return mmSkip.SkipMock.state.BeforeCalls()
+}
+
+// SkipCalls returns the params of all HandlerMock.Skip calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmSkip *HandlerMock) SkipCalls() []HandlerMockSkipParams {
+	mmSkip.SkipMock.state.History.Lock()
+	defer mmSkip.SkipMock.state.History.Unlock()
+
+	calls := make([]HandlerMockSkipParams, len(mmSkip.SkipMock.calls))
+	copy(calls, mmSkip.SkipMock.calls)
+	return calls
+}
+
+// SkipLastParams returns the params of the latest HandlerMock.Skip call and false if there were no calls
+func (mmSkip *HandlerMock) SkipLastParams() (params HandlerMockSkipParams, ok bool) {
+	mmSkip.SkipMock.state.History.Lock()
+	defer mmSkip.SkipMock.state.History.Unlock()
+
+	if n := len(mmSkip.SkipMock.calls); n > 0 {
+		return mmSkip.SkipMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// SkipCallTimes returns the times of all HandlerMock.Skip calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmSkip *HandlerMock) SkipCallTimes() []mm_time.Time {
+	return mmSkip.SkipMock.state.CallTimes()
+}
+
+// SkipMaxInFlight returns the maximum number of the HandlerMock.Skip calls that have been in flight at once
+func (mmSkip *HandlerMock) SkipMaxInFlight() int {
+	return mmSkip.SkipMock.state.MaxInFlight()
+}
+
+// SkipUnexpectedCounter returns a count of HandlerMock.Skip invocations made without an implementation
+func (mmSkip *HandlerMock) SkipUnexpectedCounter() uint64 {
+	return mmSkip.SkipMock.state.UnexpectedCalls()
+}
+
+// SkipNotCalled returns true if HandlerMock.Skip hasn't been called
+func (mmSkip *HandlerMock) SkipNotCalled() bool {
+	return mmSkip.SkipMock.state.BeforeCalls() == 0
+}
+
+// SkipCallCount returns a count of HandlerMock.Skip invocations, it's the same as SkipBeforeCounter
+func (mmSkip *HandlerMock) SkipCallCount() uint64 {
+	return mmSkip.SkipMock.state.BeforeCalls()
+}
+
+// MinimockSkipDone returns true if the count of the Skip invocations corresponds
+// the number of defined expectations
+func (mmSkip *HandlerMock) MinimockSkipDone() bool {
+	return mmSkip.SkipMock.state.Done(mmSkip.SkipMock.setup())
+}
+
+// MinimockSkipInspect logs each unmet expectation
+func (mmSkip *HandlerMock) MinimockSkipInspect() {
+	mmSkip.SkipMock.state.Inspect(mmSkip.SkipMock.setup())
+}
+
+// MinimockSetComparer sets up the function comparing the expected and the actual params of all HandlerMock methods instead of minimock.Equal,
+// the params matched by the matchers aren't compared
+func (m *HandlerMock) MinimockSetComparer(compare minimock.Comparer) *HandlerMock {
+	m.state.SetComparer(compare)
+	return m
+}
+
+// MinimockSetSequence sets up the sequence numbering the HandlerMock calls, the mocks sharing the sequence
+// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+func (m *HandlerMock) MinimockSetSequence(sequence *minimock.Sequence) *HandlerMock {
+	m.state.SetSequence(sequence)
+	return m
+}
+
+// MinimockSetClock sets up the function returning the current time for the history of HandlerMock calls instead of time.Now
+func (m *HandlerMock) MinimockSetClock(clock func() mm_time.Time) *HandlerMock {
+	m.state.SetClock(clock)
+	return m
+}
+
+// MinimockSetAutoFinish enables or disables the check of HandlerMock made by the Cleanup of the tester passed to NewHandlerMock,
+// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+func (m *HandlerMock) MinimockSetAutoFinish(enabled bool) *HandlerMock {
+	m.state.SetAutoFinish(enabled)
+	return m
+}
+
+// MinimockSetDelegate sets up the implementation the calls of the HandlerMock methods are forwarded to when the methods
+// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+func (m *HandlerMock) MinimockSetDelegate(impl Handler) *HandlerMock {
+	m.mutex.Lock()
+	m.delegate = impl
+	m.mutex.Unlock()
+	return m
+}
+
+func (m *HandlerMock) minimockDelegate() Handler {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.delegate
+}
+
+// MinimockSetLenient enables or disables the lenient mode of HandlerMock: the calls of the methods that have neither
+// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+func (m *HandlerMock) MinimockSetLenient(enabled bool) *HandlerMock {
+	m.state.SetLenient(enabled)
+	return m
+}
+
+// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (m *HandlerMock) MinimockLenientCalls() map[string]uint64 {
+	return m.state.LenientCalls()
+}
+
+// MinimockAssertNotCalled fails the test without stopping it if any of the HandlerMock methods with the given names has been called,
+// it doesn't depend on the expectations and the functions set up for the methods
+func (m *HandlerMock) MinimockAssertNotCalled(methodNames ...string) {
+	m.state.AssertNotCalled(methodNames...)
+}
+
+// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+// queued by ReturnOnce of all HandlerMock methods, the functions set by Set and Inspect are kept.
+// It fails the test if any of the methods is being called
+func (m *HandlerMock) MinimockReset() {
+	m.state.Reset(
+		m.HandleMock.reset,
+		m.SkipMock.reset,
+	)
+}
+
+// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+func (m *HandlerMock) MinimockResetAll() {
+	m.MinimockReset()
+	m.HandleMock.state.Mutex.Lock()
+	m.funcHandle = nil
+	m.HandleMock.inspectHandle = nil
+	m.HandleMock.state.Mutex.Unlock()
+	m.SkipMock.state.Mutex.Lock()
+	m.funcSkip = nil
+	m.SkipMock.inspectSkip = nil
+	m.SkipMock.state.Mutex.Unlock()
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *HandlerMock) MinimockFinish() {
+	m.state.Finish(m.minimockDone,
+		m.MinimockHandleInspect,
+		m.MinimockSkipInspect,
+	)
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *HandlerMock) MinimockWait(timeout mm_time.Duration) {
+	m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
+}
+
+func (m *HandlerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockHandleDone() &&
+		m.MinimockSkipDone()
+}
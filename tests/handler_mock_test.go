@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerMock_UnnamedAndBlankParams(t *testing.T) {
+	handlerMock := NewHandlerMock(t).
+		HandleMock.Expect(context.Background(), "a", "b").Return(nil).
+		SkipMock.Expect(1, "c").Return(true)
+	defer handlerMock.MinimockFinish()
+
+	var handler Handler = handlerMock
+
+	assert.NoError(t, handler.Handle(context.Background(), "a", "b"))
+	assert.True(t, handler.Skip(1, "c"))
+}
+
+func TestHandlerMock_Matchers(t *testing.T) {
+	handlerMock := NewHandlerMock(t).
+		HandleMock.Expect(minimock.AnyContext, "", "b").MatchP1Param2(func(got string) bool { return len(got) > 0 }).Return(nil)
+	defer handlerMock.MinimockFinish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	assert.NoError(t, handlerMock.Handle(ctx, "any", "b"))
+}
+
+func TestHandlerMock_MatchersMismatch(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	tester.ErrorfMock.Set(func(s string, args ...interface{}) {
+		require.Len(t, args, 4)
+		assert.Equal(t, "\n\nMismatched params:\n  P0: want: minimock.AnyContext, got: nil\n  P1: want: predicate func(got string) bool, got: \"\"\n", args[2])
+	})
+
+	handlerMock := NewHandlerMock(tester).
+		HandleMock.Expect(minimock.AnyContext, "", "b").MatchP1Param2(func(got string) bool { return len(got) > 0 }).Return(nil)
+
+	assert.NoError(t, handlerMock.Handle(nil, "", "b"))
+}
+
+func TestHandlerMock_PartialParams(t *testing.T) {
+	handlerMock := NewHandlerMock(t).
+		HandleMock.ExpectP2Param3("b").Return(nil).
+		SkipMock.MatchP0Param1(func(got int) bool { return got > 0 }).Return(true)
+	defer handlerMock.MinimockFinish()
+
+	assert.NoError(t, handlerMock.Handle(context.Background(), "any", "b"))
+	assert.True(t, handlerMock.Skip(1, "any"))
+}
+
+func TestHandlerMock_PartialParamsMismatch(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	tester.ErrorfMock.Set(func(s string, args ...interface{}) {
+		require.Len(t, args, 4)
+		assert.Equal(t, "\n\nMismatched params:\n  P2: want: \"b\", got: \"c\"\nChecked params: P1, P2\n", args[2])
+	})
+
+	handlerMock := NewHandlerMock(tester).HandleMock.ExpectP1Param2("a").ExpectP2Param3("b").Return(nil)
+
+	assert.NoError(t, handlerMock.Handle(context.Background(), "a", "c"))
+}
+
+func TestHandlerMock_ExpectAfterPartialParams(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	tester.FatalfMock.Expect("HandlerMock.Handle params are already set by the Expect*Param* and Match*Param* helpers").Return()
+
+	NewHandlerMock(tester).HandleMock.ExpectP2Param3("b").Expect(context.Background(), "a", "b")
+}
+
+func TestHandlerMock_PartialParamsAfterExpect(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	tester.ErrorfMock.Set(func(s string, args ...interface{}) {
+		require.Len(t, args, 4)
+		assert.Equal(t, "\n\nMismatched params:\n  P1: want: \"a\", got: \"c\"\n", args[2])
+	})
+
+	//params set by Expect are all checked, the helper just overrides one of them
+	handlerMock := NewHandlerMock(tester).HandleMock.Expect(nil, "a", "").ExpectP2Param3("b").Return(nil)
+
+	assert.NoError(t, handlerMock.Handle(nil, "c", "b"))
+}
@@ -0,0 +1,44 @@
+package tests
+
+import (
+	"crypto/sha256"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/gojuno/minimock/tests/hashing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasherMock_Arrays(t *testing.T) {
+	data := [32]byte{1}
+	blocks := [][hashing.BlockSize]byte{{2}}
+
+	hasherMock := NewHasherMock(t).
+		HashMock.Expect(data).Return(sha256.Sum256(data[:])).
+		DigestMock.Expect(blocks).Return([32]byte{3})
+	defer hasherMock.MinimockFinish()
+
+	var hasher hashing.Hasher = hasherMock
+
+	assert.Equal(t, sha256.Sum256(data[:]), hasher.Hash(data))
+	assert.Equal(t, [32]byte{3}, hasher.Digest(blocks))
+}
+
+func TestHasherMock_PointerToInterface(t *testing.T) {
+	var r io.Reader
+
+	hasherMock := NewHasherMock(t)
+	defer hasherMock.MinimockFinish()
+
+	hasherMock.BindMock.Set(func(target *io.Reader) error {
+		*target = strings.NewReader("bound")
+		return nil
+	})
+
+	assert.NoError(t, hasherMock.Bind(&r))
+
+	data, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	assert.Equal(t, "bound", string(data))
+}
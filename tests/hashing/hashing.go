@@ -0,0 +1,23 @@
+// Package hashing is used to test mocks of the interfaces which methods use arrays and pointers to interfaces
+package hashing
+
+import (
+	"crypto/sha256"
+	"io"
+)
+
+// Hasher interface uses arrays which lengths are set by literals, by the constants of this package
+// and by the constants of other packages, its mock is generated into another package to check
+// that the array lengths referring to the unexported constants are evaluated
+type Hasher interface {
+	Hash(data [32]byte) [sha256.Size]byte
+	Digest(blocks [][BlockSize]byte) [digestSize * 2]byte
+	Bind(target *io.Reader) error
+}
+
+const (
+	// BlockSize is a size of the block accepted by the Hasher
+	BlockSize = 64
+
+	digestSize = 16
+)
@@ -0,0 +1,719 @@
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+package tests
+
+//go:generate minimock -i github.com/gojuno/minimock/tests.Locker -o ./locker_mock.go
+
+import (
+	"sync"
+	mm_sync "sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock"
+)
+
+// LockerMock implements Locker
+//
+// Locker interface is used to test mocks of the methods which params have the same names as the mock internals
+type LockerMock struct {
+	state    minimock.MockState
+	mutex    mm_sync.RWMutex
+	delegate Locker
+
+	funcLock func(m sync.Locker, mm time.Time, t int) (err error)
+	LockMock *mLockerMockLock
+}
+
+var _ Locker = (*LockerMock)(nil)
+
+// NewLockerMock returns a mock for Locker
+func NewLockerMock(t minimock.Tester) *LockerMock {
+	m := &LockerMock{}
+	m.state.Init(m, t, "LockerMock", 0)
+
+	m.LockMock = &mLockerMockLock{mock: m}
+	m.LockMock.state.Init(&m.state, "Lock")
+
+	return m
+}
+
+type mLockerMockLock struct {
+	mock               *LockerMock
+	state              minimock.MethodState
+	defaultExpectation *LockerMockLockExpectation
+	expectations       []*LockerMockLockExpectation
+	inspectLock        func(m sync.Locker, mm time.Time, t int)
+	compare            minimock.Comparer
+	calls              []LockerMockLockParams
+	called             chan LockerMockLockParams
+	queued             []*LockerMockLockResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*LockerMockLockResults
+}
+
+// LockerMockLockExpectation specifies expectation struct of the Locker.Lock
+type LockerMockLockExpectation struct {
+	mock     *LockerMock
+	params   *LockerMockLockParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *LockerMockLockResults
+	Counter  uint64
+}
+
+// LockerMockLockParams contains parameters of the Locker.Lock
+type LockerMockLockParams struct {
+	M  sync.Locker
+	Mm time.Time
+	T  int
+}
+
+// LockerMockLockResults contains results of the Locker.Lock
+type LockerMockLockResults struct {
+	E error
+}
+
+// Expect sets up expected params for Locker.Lock
+func (mmLock *mLockerMockLock) Expect(m sync.Locker, mm time.Time, t int) *mLockerMockLock {
+	if _, mm_func := mmLock.current(); mm_func != nil {
+		mmLock.mock.state.T().Fatalf("LockerMock.Lock mock is already set by Set")
+	}
+
+	mm_params := &LockerMockLockParams{m, mm, t}
+	mmLock.updateDefault(func(e *LockerMockLockExpectation) {
+		if e.partial {
+			mmLock.mock.state.T().Fatalf("LockerMock.Lock params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmLock.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmLock.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmLock
+}
+
+// updateDefault replaces the default expectation of Locker.Lock by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmLock *mLockerMockLock) updateDefault(update func(e *LockerMockLockExpectation)) {
+	mmLock.state.Mutex.Lock()
+	defer mmLock.state.Mutex.Unlock()
+
+	e := &LockerMockLockExpectation{mock: mmLock.mock}
+	if previous := mmLock.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmLock.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Locker.Lock
+func (mmLock *mLockerMockLock) current() (*LockerMockLockExpectation, func(m sync.Locker, mm time.Time, t int) (err error)) {
+	mmLock.state.Mutex.RLock()
+	defer mmLock.state.Mutex.RUnlock()
+
+	return mmLock.defaultExpectation, mmLock.mock.funcLock
+}
+
+// partialParams updates the default expectation of Locker.Lock by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmLock *mLockerMockLock) partialParams(update func(e *LockerMockLockExpectation)) {
+	if _, mm_func := mmLock.current(); mm_func != nil {
+		mmLock.mock.state.T().Fatalf("LockerMock.Lock mock is already set by Set")
+	}
+
+	mmLock.updateDefault(func(e *LockerMockLockExpectation) {
+		if e.params == nil {
+			e.params = &LockerMockLockParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"M":  minimock.Anything,
+				"Mm": minimock.Anything,
+				"T":  minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectMParam1 sets up the expected value of the param #1 of Locker.Lock,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmLock *mLockerMockLock) ExpectMParam1(M sync.Locker) *mLockerMockLock {
+	mmLock.partialParams(func(e *LockerMockLockExpectation) {
+		e.params.M = M
+		delete(e.matchers, "M")
+	})
+	return mmLock
+}
+
+// MatchMParam1 sets up the predicate matching the param #1 of Locker.Lock,
+// it's used instead of the value of the param set by Expect
+func (mmLock *mLockerMockLock) MatchMParam1(f func(got sync.Locker) bool) *mLockerMockLock {
+	mm_matcher := minimock.Predicate("predicate func(got sync.Locker) bool", func(v interface{}) bool {
+		got, _ := v.(sync.Locker)
+		return f(got)
+	})
+	mmLock.partialParams(func(e *LockerMockLockExpectation) {
+		e.matchers["M"] = mm_matcher
+	})
+	return mmLock
+}
+
+// ExpectMmParam2 sets up the expected value of the param #2 of Locker.Lock,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmLock *mLockerMockLock) ExpectMmParam2(Mm time.Time) *mLockerMockLock {
+	mmLock.partialParams(func(e *LockerMockLockExpectation) {
+		e.params.Mm = Mm
+		delete(e.matchers, "Mm")
+	})
+	return mmLock
+}
+
+// MatchMmParam2 sets up the predicate matching the param #2 of Locker.Lock,
+// it's used instead of the value of the param set by Expect
+func (mmLock *mLockerMockLock) MatchMmParam2(f func(got time.Time) bool) *mLockerMockLock {
+	mm_matcher := minimock.Predicate("predicate func(got time.Time) bool", func(v interface{}) bool {
+		got, _ := v.(time.Time)
+		return f(got)
+	})
+	mmLock.partialParams(func(e *LockerMockLockExpectation) {
+		e.matchers["Mm"] = mm_matcher
+	})
+	return mmLock
+}
+
+// ExpectTParam3 sets up the expected value of the param #3 of Locker.Lock,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmLock *mLockerMockLock) ExpectTParam3(T int) *mLockerMockLock {
+	mmLock.partialParams(func(e *LockerMockLockExpectation) {
+		e.params.T = T
+		delete(e.matchers, "T")
+	})
+	return mmLock
+}
+
+// MatchTParam3 sets up the predicate matching the param #3 of Locker.Lock,
+// it's used instead of the value of the param set by Expect
+func (mmLock *mLockerMockLock) MatchTParam3(f func(got int) bool) *mLockerMockLock {
+	mm_matcher := minimock.Predicate("predicate func(got int) bool", func(v interface{}) bool {
+		got, _ := v.(int)
+		return f(got)
+	})
+	mmLock.partialParams(func(e *LockerMockLockExpectation) {
+		e.matchers["T"] = mm_matcher
+	})
+	return mmLock
+}
+
+// Return sets up results that will be returned by Locker.Lock
+func (mmLock *mLockerMockLock) Return(err error) *LockerMock {
+	if _, mm_func := mmLock.current(); mm_func != nil {
+		mmLock.mock.state.T().Fatalf("LockerMock.Lock mock is already set by Set")
+	}
+
+	mm_results := &LockerMockLockResults{err}
+	mmLock.updateDefault(func(e *LockerMockLockExpectation) { e.results = mm_results })
+
+	return mmLock.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Locker.Lock,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmLock *mLockerMockLock) ReturnOnce(err error) *mLockerMockLock {
+	mmLock.state.Mutex.Lock()
+	mmLock.queued = append(mmLock.queued, &LockerMockLockResults{err})
+	mmLock.state.Mutex.Unlock()
+	mmLock.state.PushResults()
+	return mmLock
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmLock *mLockerMockLock) popResults() *LockerMockLockResults {
+	mm_i, mm_ok := mmLock.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmLock.state.Mutex.RLock()
+	defer mmLock.state.Mutex.RUnlock()
+
+	return mmLock.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of Locker.Lock instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmLock *mLockerMockLock) SetComparer(compare minimock.Comparer) *mLockerMockLock {
+	mmLock.state.Mutex.Lock()
+	mmLock.compare = compare
+	mmLock.state.Mutex.Unlock()
+	return mmLock
+}
+
+// whenExpectations returns the expectations of Locker.Lock set by When,
+// the expectations can be set while the method is called concurrently
+func (mmLock *mLockerMockLock) whenExpectations() []*LockerMockLockExpectation {
+	mmLock.state.Mutex.RLock()
+	defer mmLock.state.Mutex.RUnlock()
+
+	return mmLock.expectations
+}
+
+// Inspect sets up the function called with the params of every Locker.Lock call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmLock *mLockerMockLock) Inspect(f func(m sync.Locker, mm time.Time, t int)) *mLockerMockLock {
+	mmLock.state.Mutex.Lock()
+	mmLock.inspectLock = f
+	mmLock.state.Mutex.Unlock()
+	return mmLock
+}
+
+// expected returns everything set up for the Locker.Lock call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmLock *mLockerMockLock) expected() (*LockerMockLockExpectation, func(m sync.Locker, mm time.Time, t int) (err error), func(m sync.Locker, mm time.Time, t int), minimock.Comparer, []*LockerMockLockExpectation, []*LockerMockLockResults) {
+	mmLock.state.Mutex.RLock()
+	defer mmLock.state.Mutex.RUnlock()
+
+	return mmLock.defaultExpectation, mmLock.mock.funcLock, mmLock.inspectLock, mmLock.compare, mmLock.expectations, mmLock.whenResults
+}
+
+// setup returns the expectations and the function set up for Locker.Lock to be checked when the mock is finished
+func (mmLock *mLockerMockLock) setup() (setup minimock.Setup) {
+	mmLock.state.Mutex.RLock()
+	defer mmLock.state.Mutex.RUnlock()
+
+	setup.Func = mmLock.mock.funcLock != nil
+	if e := mmLock.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmLock.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Locker.Lock, the params of the calls are removed from the history as well
+func (mmLock *mLockerMockLock) reset() {
+	mmLock.state.Mutex.Lock()
+	mmLock.defaultExpectation = nil
+	mmLock.expectations = nil
+	mmLock.queued = nil
+	mmLock.whenResults = nil
+	mmLock.state.Mutex.Unlock()
+
+	mmLock.state.History.Lock()
+	mmLock.calls = nil
+	mmLock.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmLock *mLockerMockLock) MethodName() string {
+	return mmLock.state.Name()
+}
+
+// CallSequence returns the numbers of the Locker.Lock calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmLock *mLockerMockLock) CallSequence() []uint64 {
+	return mmLock.state.CallSequence()
+}
+
+// WaitForCalls waits until Locker.Lock is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmLock *mLockerMockLock) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmLock.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Locker.Lock calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmLock *mLockerMockLock) Block() (release func()) {
+	return mmLock.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Locker.Lock calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmLock *mLockerMockLock) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmLock.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Locker.Lock calls are in flight at once
+func (mmLock *mLockerMockLock) LimitConcurrency(n int) *mLockerMockLock {
+	mmLock.state.SetLimit(n)
+	return mmLock
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Locker.Lock call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmLock *mLockerMockLock) CaptureCalls(buffer int) *mLockerMockLock {
+	mmLock.state.History.Lock()
+	mmLock.called = make(chan LockerMockLockParams, buffer)
+	mmLock.state.History.Unlock()
+	return mmLock
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Locker.Lock call
+func (mmLock *mLockerMockLock) Called() <-chan LockerMockLockParams {
+	mmLock.state.History.Lock()
+	defer mmLock.state.History.Unlock()
+
+	if mmLock.called == nil {
+		mmLock.mock.state.T().Fatalf("Calls of LockerMock.Lock aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmLock.called
+}
+
+// DroppedCalls returns the number of the Locker.Lock calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmLock *mLockerMockLock) DroppedCalls() uint64 {
+	return mmLock.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Locker.Lock calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmLock *mLockerMockLock) Times(n uint64) *mLockerMockLock {
+	mmLock.state.SetTimes(n)
+	return mmLock
+}
+
+// Optional excludes Locker.Lock from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmLock *mLockerMockLock) Optional() *mLockerMockLock {
+	mmLock.state.SetOptional()
+	return mmLock
+}
+
+// Set uses given function f to mock the Locker.Lock method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmLock *mLockerMockLock) Set(f func(m sync.Locker, mm time.Time, t int) (err error)) *LockerMock {
+	mmLock.state.Mutex.Lock()
+	defer mmLock.state.Mutex.Unlock()
+
+	if mmLock.defaultExpectation != nil {
+		mmLock.mock.state.T().Fatalf("Default expectation is already set for the Locker.Lock method")
+	}
+
+	if len(mmLock.expectations) > 0 {
+		mmLock.mock.state.T().Fatalf("Some expectations are already set for the Locker.Lock method")
+	}
+
+	mmLock.mock.funcLock = f
+	return mmLock.mock
+}
+
+// When sets expectation for the Locker.Lock which will trigger the result defined by the following
+// Then helper
+func (mmLock *mLockerMockLock) When(m sync.Locker, mm time.Time, t int) *LockerMockLockExpectation {
+	if _, mm_func := mmLock.current(); mm_func != nil {
+		mmLock.mock.state.T().Fatalf("LockerMock.Lock mock is already set by Set")
+	}
+
+	expectation := &LockerMockLockExpectation{
+		mock:   mmLock.mock,
+		params: &LockerMockLockParams{m, mm, t},
+	}
+	mmLock.state.Mutex.Lock()
+	mmLock.expectations = append(mmLock.expectations, expectation)
+	mmLock.whenResults = append(mmLock.whenResults, nil)
+	mmLock.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up Locker.Lock return parameters for the expectation previously defined by the When method
+func (mmExpectation *LockerMockLockExpectation) Then(err error) *LockerMock {
+	mm_handle := mmExpectation.mock.LockMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &LockerMockLockResults{err}
+	mm_results := make([]*LockerMockLockResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Lock implements Locker
+func (mmLock *LockerMock) Lock(m sync.Locker, mm time.Time, t int) (err error) {
+	mm_method := mmLock.LockMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := LockerMockLockParams{m, mm, t}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcLock, mm_inspectLock, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectLock != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectLock(m, mm, t)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).E
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).E
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcLock == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).E
+	}
+	if mm_funcLock != nil {
+		return mm_funcLock(m, mm, t)
+	}
+	if mm_delegate := mmLock.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Lock(m, mm, t)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, m, mm, t)
+	return
+}
+
+// LockAfterCounter returns a count of finished LockerMock.Lock invocations
+func (mmLock *LockerMock) LockAfterCounter() uint64 {
+	return mmLock.LockMock.state.AfterCalls()
+}
+
+// LockBeforeCounter returns a count of LockerMock.Lock invocations
+func (mmLock *LockerMock) LockBeforeCounter() uint64 {
+	return mmLock.LockMock.state.BeforeCalls()
+}
+
+// LockCalls returns the params of all LockerMock.Lock calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmLock *LockerMock) LockCalls() []LockerMockLockParams {
+	mmLock.LockMock.state.History.Lock()
+	defer mmLock.LockMock.state.History.Unlock()
+
+	calls := make([]LockerMockLockParams, len(mmLock.LockMock.calls))
+	copy(calls, mmLock.LockMock.calls)
+	return calls
+}
+
+// LockLastParams returns the params of the latest LockerMock.Lock call and false if there were no calls
+func (mmLock *LockerMock) LockLastParams() (params LockerMockLockParams, ok bool) {
+	mmLock.LockMock.state.History.Lock()
+	defer mmLock.LockMock.state.History.Unlock()
+
+	if n := len(mmLock.LockMock.calls); n > 0 {
+		return mmLock.LockMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// LockCallTimes returns the times of all LockerMock.Lock calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmLock *LockerMock) LockCallTimes() []mm_time.Time {
+	return mmLock.LockMock.state.CallTimes()
+}
+
+// LockMaxInFlight returns the maximum number of the LockerMock.Lock calls that have been in flight at once
+func (mmLock *LockerMock) LockMaxInFlight() int {
+	return mmLock.LockMock.state.MaxInFlight()
+}
+
+// LockUnexpectedCounter returns a count of LockerMock.Lock invocations made without an implementation
+func (mmLock *LockerMock) LockUnexpectedCounter() uint64 {
+	return mmLock.LockMock.state.UnexpectedCalls()
+}
+
+// LockNotCalled returns true if LockerMock.Lock hasn't been called
+func (mmLock *LockerMock) LockNotCalled() bool {
+	return mmLock.LockMock.state.BeforeCalls() == 0
+}
+
+// LockCallCount returns a count of LockerMock.Lock invocations, it's the same as LockBeforeCounter
+func (mmLock *LockerMock) LockCallCount() uint64 {
+	return mmLock.LockMock.state.BeforeCalls()
+}
+
+// MinimockLockDone returns true if the count of the Lock invocations corresponds
+// the number of defined expectations
+func (mmLock *LockerMock) MinimockLockDone() bool {
+	return mmLock.LockMock.state.Done(mmLock.LockMock.setup())
+}
+
+// MinimockLockInspect logs each unmet expectation
+func (mmLock *LockerMock) MinimockLockInspect() {
+	mmLock.LockMock.state.Inspect(mmLock.LockMock.setup())
+}
+
+// MinimockSetComparer sets up the function comparing the expected and the actual params of all LockerMock methods instead of minimock.Equal,
+// the params matched by the matchers aren't compared
+func (m *LockerMock) MinimockSetComparer(compare minimock.Comparer) *LockerMock {
+	m.state.SetComparer(compare)
+	return m
+}
+
+// MinimockSetSequence sets up the sequence numbering the LockerMock calls, the mocks sharing the sequence
+// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+func (m *LockerMock) MinimockSetSequence(sequence *minimock.Sequence) *LockerMock {
+	m.state.SetSequence(sequence)
+	return m
+}
+
+// MinimockSetClock sets up the function returning the current time for the history of LockerMock calls instead of time.Now
+func (m *LockerMock) MinimockSetClock(clock func() mm_time.Time) *LockerMock {
+	m.state.SetClock(clock)
+	return m
+}
+
+// MinimockSetAutoFinish enables or disables the check of LockerMock made by the Cleanup of the tester passed to NewLockerMock,
+// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+func (m *LockerMock) MinimockSetAutoFinish(enabled bool) *LockerMock {
+	m.state.SetAutoFinish(enabled)
+	return m
+}
+
+// MinimockSetDelegate sets up the implementation the calls of the LockerMock methods are forwarded to when the methods
+// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+func (m *LockerMock) MinimockSetDelegate(impl Locker) *LockerMock {
+	m.mutex.Lock()
+	m.delegate = impl
+	m.mutex.Unlock()
+	return m
+}
+
+func (m *LockerMock) minimockDelegate() Locker {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.delegate
+}
+
+// MinimockSetLenient enables or disables the lenient mode of LockerMock: the calls of the methods that have neither
+// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+func (m *LockerMock) MinimockSetLenient(enabled bool) *LockerMock {
+	m.state.SetLenient(enabled)
+	return m
+}
+
+// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (m *LockerMock) MinimockLenientCalls() map[string]uint64 {
+	return m.state.LenientCalls()
+}
+
+// MinimockAssertNotCalled fails the test without stopping it if any of the LockerMock methods with the given names has been called,
+// it doesn't depend on the expectations and the functions set up for the methods
+func (m *LockerMock) MinimockAssertNotCalled(methodNames ...string) {
+	m.state.AssertNotCalled(methodNames...)
+}
+
+// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+// queued by ReturnOnce of all LockerMock methods, the functions set by Set and Inspect are kept.
+// It fails the test if any of the methods is being called
+func (m *LockerMock) MinimockReset() {
+	m.state.Reset(
+		m.LockMock.reset,
+	)
+}
+
+// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+func (m *LockerMock) MinimockResetAll() {
+	m.MinimockReset()
+	m.LockMock.state.Mutex.Lock()
+	m.funcLock = nil
+	m.LockMock.inspectLock = nil
+	m.LockMock.state.Mutex.Unlock()
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *LockerMock) MinimockFinish() {
+	m.state.Finish(m.minimockDone,
+		m.MinimockLockInspect,
+	)
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *LockerMock) MinimockWait(timeout mm_time.Duration) {
+	m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
+}
+
+func (m *LockerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockLockDone()
+}
@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockerMock_ParamsNamedAsMockInternals(t *testing.T) {
+	var (
+		mu  sync.Mutex
+		now = time.Now()
+	)
+
+	lockerMock := NewLockerMock(t)
+	lockerMock.LockMock.When(&mu, now, 1).Then(errors.New("locked"))
+	lockerMock.LockMock.When(&mu, now, 2).Then(nil)
+	defer lockerMock.MinimockFinish()
+
+	var locker Locker = lockerMock
+
+	assert.EqualError(t, locker.Lock(&mu, now, 1), "locked")
+	assert.NoError(t, locker.Lock(&mu, now, 2))
+}
+
+// equalTimes compares time.Time values with time.Time.Equal since the values of the same instant
+// can have different locations and monotonic clock readings which are compared by minimock.Equal
+func equalTimes(want, got interface{}) bool {
+	if wantTime, ok := want.(time.Time); ok {
+		gotTime, ok := got.(time.Time)
+		return ok && wantTime.Equal(gotTime)
+	}
+
+	return minimock.Equal(want, got)
+}
+
+func TestLockerMock_Comparer(t *testing.T) {
+	var mu sync.Mutex
+	now := time.Now()
+
+	lockerMock := NewLockerMock(t).MinimockSetComparer(equalTimes)
+	lockerMock.LockMock.Expect(&mu, now, 1).Return(nil)
+	defer lockerMock.MinimockFinish()
+
+	assert.NoError(t, lockerMock.Lock(&mu, now.UTC(), 1))
+}
+
+func TestLockerMock_MethodComparer(t *testing.T) {
+	var mu sync.Mutex
+	now := time.Now()
+
+	//comparer of the method overrides the comparer of the mock
+	lockerMock := NewLockerMock(t).MinimockSetComparer(func(want, got interface{}) bool { return false })
+	lockerMock.LockMock.SetComparer(equalTimes).When(&mu, now, 1).Then(errors.New("locked"))
+	defer lockerMock.MinimockFinish()
+
+	assert.EqualError(t, lockerMock.Lock(&mu, now.UTC(), 1), "locked")
+}
+
+func TestLockerMock_ComparerWithMatchers(t *testing.T) {
+	var mu sync.Mutex
+	now := time.Now()
+
+	var compared []interface{}
+	lockerMock := NewLockerMock(t)
+	lockerMock.LockMock.
+		SetComparer(func(want, got interface{}) bool {
+			compared = append(compared, want)
+			return equalTimes(want, got)
+		}).
+		Expect(&mu, now, 0).
+		MatchTParam3(func(got int) bool { return got > 0 }).
+		Return(nil)
+	defer lockerMock.MinimockFinish()
+
+	//the param matched by the matcher isn't passed to the comparer
+	assert.NoError(t, lockerMock.Lock(&mu, now.UTC(), 5))
+	assert.Equal(t, []interface{}{&mu, now}, compared)
+}
@@ -0,0 +1,1233 @@
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+package tests
+
+//go:generate minimock -i github.com/gojuno/minimock/tests.Logger -o ./logger_mock.go
+
+import (
+	mm_sync "sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock"
+)
+
+// LoggerMock implements Logger
+//
+// Logger interface is used to test mocks of the methods with variadic params of named and pointer types
+type LoggerMock struct {
+	state    minimock.MockState
+	mutex    mm_sync.RWMutex
+	delegate Logger
+
+	funcEnabled func(levels ...Level) (b1 bool)
+	EnabledMock *mLoggerMockEnabled
+
+	funcLog func(level Level, entries ...*entry) (i1 int)
+	LogMock *mLoggerMockLog
+}
+
+var _ Logger = (*LoggerMock)(nil)
+
+// NewLoggerMock returns a mock for Logger
+func NewLoggerMock(t minimock.Tester) *LoggerMock {
+	m := &LoggerMock{}
+	m.state.Init(m, t, "LoggerMock", 0)
+
+	m.EnabledMock = &mLoggerMockEnabled{mock: m}
+	m.EnabledMock.state.Init(&m.state, "Enabled")
+
+	m.LogMock = &mLoggerMockLog{mock: m}
+	m.LogMock.state.Init(&m.state, "Log")
+
+	return m
+}
+
+type mLoggerMockEnabled struct {
+	mock               *LoggerMock
+	state              minimock.MethodState
+	defaultExpectation *LoggerMockEnabledExpectation
+	expectations       []*LoggerMockEnabledExpectation
+	inspectEnabled     func(levels ...Level)
+	compare            minimock.Comparer
+	calls              []LoggerMockEnabledParams
+	called             chan LoggerMockEnabledParams
+	queued             []*LoggerMockEnabledResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*LoggerMockEnabledResults
+}
+
+// LoggerMockEnabledExpectation specifies expectation struct of the Logger.Enabled
+type LoggerMockEnabledExpectation struct {
+	mock     *LoggerMock
+	params   *LoggerMockEnabledParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *LoggerMockEnabledResults
+	Counter  uint64
+}
+
+// LoggerMockEnabledParams contains parameters of the Logger.Enabled
+type LoggerMockEnabledParams struct {
+	Levels []Level
+}
+
+// LoggerMockEnabledResults contains results of the Logger.Enabled
+type LoggerMockEnabledResults struct {
+	R0 bool
+}
+
+// Expect sets up expected params for Logger.Enabled
+func (mmEnabled *mLoggerMockEnabled) Expect(levels ...Level) *mLoggerMockEnabled {
+	if _, mm_func := mmEnabled.current(); mm_func != nil {
+		mmEnabled.mock.state.T().Fatalf("LoggerMock.Enabled mock is already set by Set")
+	}
+
+	mm_params := &LoggerMockEnabledParams{levels}
+	mmEnabled.updateDefault(func(e *LoggerMockEnabledExpectation) {
+		if e.partial {
+			mmEnabled.mock.state.T().Fatalf("LoggerMock.Enabled params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmEnabled.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmEnabled.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmEnabled
+}
+
+// updateDefault replaces the default expectation of Logger.Enabled by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmEnabled *mLoggerMockEnabled) updateDefault(update func(e *LoggerMockEnabledExpectation)) {
+	mmEnabled.state.Mutex.Lock()
+	defer mmEnabled.state.Mutex.Unlock()
+
+	e := &LoggerMockEnabledExpectation{mock: mmEnabled.mock}
+	if previous := mmEnabled.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmEnabled.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Logger.Enabled
+func (mmEnabled *mLoggerMockEnabled) current() (*LoggerMockEnabledExpectation, func(levels ...Level) (b1 bool)) {
+	mmEnabled.state.Mutex.RLock()
+	defer mmEnabled.state.Mutex.RUnlock()
+
+	return mmEnabled.defaultExpectation, mmEnabled.mock.funcEnabled
+}
+
+// partialParams updates the default expectation of Logger.Enabled by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmEnabled *mLoggerMockEnabled) partialParams(update func(e *LoggerMockEnabledExpectation)) {
+	if _, mm_func := mmEnabled.current(); mm_func != nil {
+		mmEnabled.mock.state.T().Fatalf("LoggerMock.Enabled mock is already set by Set")
+	}
+
+	mmEnabled.updateDefault(func(e *LoggerMockEnabledExpectation) {
+		if e.params == nil {
+			e.params = &LoggerMockEnabledParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"Levels": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectLevelsParam1 sets up the expected value of the param #1 of Logger.Enabled,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmEnabled *mLoggerMockEnabled) ExpectLevelsParam1(Levels []Level) *mLoggerMockEnabled {
+	mmEnabled.partialParams(func(e *LoggerMockEnabledExpectation) {
+		e.params.Levels = Levels
+		delete(e.matchers, "Levels")
+	})
+	return mmEnabled
+}
+
+// MatchLevelsParam1 sets up the predicate matching the param #1 of Logger.Enabled,
+// it's used instead of the value of the param set by Expect
+func (mmEnabled *mLoggerMockEnabled) MatchLevelsParam1(f func(got []Level) bool) *mLoggerMockEnabled {
+	mm_matcher := minimock.Predicate("predicate func(got []Level) bool", func(v interface{}) bool {
+		got, _ := v.([]Level)
+		return f(got)
+	})
+	mmEnabled.partialParams(func(e *LoggerMockEnabledExpectation) {
+		e.matchers["Levels"] = mm_matcher
+	})
+	return mmEnabled
+}
+
+// Return sets up results that will be returned by Logger.Enabled
+func (mmEnabled *mLoggerMockEnabled) Return(b1 bool) *LoggerMock {
+	if _, mm_func := mmEnabled.current(); mm_func != nil {
+		mmEnabled.mock.state.T().Fatalf("LoggerMock.Enabled mock is already set by Set")
+	}
+
+	mm_results := &LoggerMockEnabledResults{b1}
+	mmEnabled.updateDefault(func(e *LoggerMockEnabledExpectation) { e.results = mm_results })
+
+	return mmEnabled.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Logger.Enabled,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmEnabled *mLoggerMockEnabled) ReturnOnce(b1 bool) *mLoggerMockEnabled {
+	mmEnabled.state.Mutex.Lock()
+	mmEnabled.queued = append(mmEnabled.queued, &LoggerMockEnabledResults{b1})
+	mmEnabled.state.Mutex.Unlock()
+	mmEnabled.state.PushResults()
+	return mmEnabled
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmEnabled *mLoggerMockEnabled) popResults() *LoggerMockEnabledResults {
+	mm_i, mm_ok := mmEnabled.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmEnabled.state.Mutex.RLock()
+	defer mmEnabled.state.Mutex.RUnlock()
+
+	return mmEnabled.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of Logger.Enabled instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmEnabled *mLoggerMockEnabled) SetComparer(compare minimock.Comparer) *mLoggerMockEnabled {
+	mmEnabled.state.Mutex.Lock()
+	mmEnabled.compare = compare
+	mmEnabled.state.Mutex.Unlock()
+	return mmEnabled
+}
+
+// whenExpectations returns the expectations of Logger.Enabled set by When,
+// the expectations can be set while the method is called concurrently
+func (mmEnabled *mLoggerMockEnabled) whenExpectations() []*LoggerMockEnabledExpectation {
+	mmEnabled.state.Mutex.RLock()
+	defer mmEnabled.state.Mutex.RUnlock()
+
+	return mmEnabled.expectations
+}
+
+// Inspect sets up the function called with the params of every Logger.Enabled call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmEnabled *mLoggerMockEnabled) Inspect(f func(levels ...Level)) *mLoggerMockEnabled {
+	mmEnabled.state.Mutex.Lock()
+	mmEnabled.inspectEnabled = f
+	mmEnabled.state.Mutex.Unlock()
+	return mmEnabled
+}
+
+// expected returns everything set up for the Logger.Enabled call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmEnabled *mLoggerMockEnabled) expected() (*LoggerMockEnabledExpectation, func(levels ...Level) (b1 bool), func(levels ...Level), minimock.Comparer, []*LoggerMockEnabledExpectation, []*LoggerMockEnabledResults) {
+	mmEnabled.state.Mutex.RLock()
+	defer mmEnabled.state.Mutex.RUnlock()
+
+	return mmEnabled.defaultExpectation, mmEnabled.mock.funcEnabled, mmEnabled.inspectEnabled, mmEnabled.compare, mmEnabled.expectations, mmEnabled.whenResults
+}
+
+// setup returns the expectations and the function set up for Logger.Enabled to be checked when the mock is finished
+func (mmEnabled *mLoggerMockEnabled) setup() (setup minimock.Setup) {
+	mmEnabled.state.Mutex.RLock()
+	defer mmEnabled.state.Mutex.RUnlock()
+
+	setup.Func = mmEnabled.mock.funcEnabled != nil
+	if e := mmEnabled.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmEnabled.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Logger.Enabled, the params of the calls are removed from the history as well
+func (mmEnabled *mLoggerMockEnabled) reset() {
+	mmEnabled.state.Mutex.Lock()
+	mmEnabled.defaultExpectation = nil
+	mmEnabled.expectations = nil
+	mmEnabled.queued = nil
+	mmEnabled.whenResults = nil
+	mmEnabled.state.Mutex.Unlock()
+
+	mmEnabled.state.History.Lock()
+	mmEnabled.calls = nil
+	mmEnabled.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmEnabled *mLoggerMockEnabled) MethodName() string {
+	return mmEnabled.state.Name()
+}
+
+// CallSequence returns the numbers of the Logger.Enabled calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmEnabled *mLoggerMockEnabled) CallSequence() []uint64 {
+	return mmEnabled.state.CallSequence()
+}
+
+// WaitForCalls waits until Logger.Enabled is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmEnabled *mLoggerMockEnabled) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmEnabled.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Logger.Enabled calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmEnabled *mLoggerMockEnabled) Block() (release func()) {
+	return mmEnabled.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Logger.Enabled calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmEnabled *mLoggerMockEnabled) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmEnabled.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Logger.Enabled calls are in flight at once
+func (mmEnabled *mLoggerMockEnabled) LimitConcurrency(n int) *mLoggerMockEnabled {
+	mmEnabled.state.SetLimit(n)
+	return mmEnabled
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Logger.Enabled call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmEnabled *mLoggerMockEnabled) CaptureCalls(buffer int) *mLoggerMockEnabled {
+	mmEnabled.state.History.Lock()
+	mmEnabled.called = make(chan LoggerMockEnabledParams, buffer)
+	mmEnabled.state.History.Unlock()
+	return mmEnabled
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Logger.Enabled call
+func (mmEnabled *mLoggerMockEnabled) Called() <-chan LoggerMockEnabledParams {
+	mmEnabled.state.History.Lock()
+	defer mmEnabled.state.History.Unlock()
+
+	if mmEnabled.called == nil {
+		mmEnabled.mock.state.T().Fatalf("Calls of LoggerMock.Enabled aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmEnabled.called
+}
+
+// DroppedCalls returns the number of the Logger.Enabled calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmEnabled *mLoggerMockEnabled) DroppedCalls() uint64 {
+	return mmEnabled.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Logger.Enabled calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmEnabled *mLoggerMockEnabled) Times(n uint64) *mLoggerMockEnabled {
+	mmEnabled.state.SetTimes(n)
+	return mmEnabled
+}
+
+// Optional excludes Logger.Enabled from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmEnabled *mLoggerMockEnabled) Optional() *mLoggerMockEnabled {
+	mmEnabled.state.SetOptional()
+	return mmEnabled
+}
+
+// Set uses given function f to mock the Logger.Enabled method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmEnabled *mLoggerMockEnabled) Set(f func(levels ...Level) (b1 bool)) *LoggerMock {
+	mmEnabled.state.Mutex.Lock()
+	defer mmEnabled.state.Mutex.Unlock()
+
+	if mmEnabled.defaultExpectation != nil {
+		mmEnabled.mock.state.T().Fatalf("Default expectation is already set for the Logger.Enabled method")
+	}
+
+	if len(mmEnabled.expectations) > 0 {
+		mmEnabled.mock.state.T().Fatalf("Some expectations are already set for the Logger.Enabled method")
+	}
+
+	mmEnabled.mock.funcEnabled = f
+	return mmEnabled.mock
+}
+
+// When sets expectation for the Logger.Enabled which will trigger the result defined by the following
+// Then helper
+func (mmEnabled *mLoggerMockEnabled) When(levels ...Level) *LoggerMockEnabledExpectation {
+	if _, mm_func := mmEnabled.current(); mm_func != nil {
+		mmEnabled.mock.state.T().Fatalf("LoggerMock.Enabled mock is already set by Set")
+	}
+
+	expectation := &LoggerMockEnabledExpectation{
+		mock:   mmEnabled.mock,
+		params: &LoggerMockEnabledParams{levels},
+	}
+	mmEnabled.state.Mutex.Lock()
+	mmEnabled.expectations = append(mmEnabled.expectations, expectation)
+	mmEnabled.whenResults = append(mmEnabled.whenResults, nil)
+	mmEnabled.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up Logger.Enabled return parameters for the expectation previously defined by the When method
+func (mmExpectation *LoggerMockEnabledExpectation) Then(b1 bool) *LoggerMock {
+	mm_handle := mmExpectation.mock.EnabledMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &LoggerMockEnabledResults{b1}
+	mm_results := make([]*LoggerMockEnabledResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Enabled implements Logger
+func (mmEnabled *LoggerMock) Enabled(levels ...Level) (b1 bool) {
+	mm_method := mmEnabled.EnabledMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := LoggerMockEnabledParams{levels}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcEnabled, mm_inspectEnabled, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectEnabled != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectEnabled(levels...)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).R0
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcEnabled == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcEnabled != nil {
+		return mm_funcEnabled(levels...)
+	}
+	if mm_delegate := mmEnabled.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Enabled(levels...)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, levels)
+	return
+}
+
+// EnabledAfterCounter returns a count of finished LoggerMock.Enabled invocations
+func (mmEnabled *LoggerMock) EnabledAfterCounter() uint64 {
+	return mmEnabled.EnabledMock.state.AfterCalls()
+}
+
+// EnabledBeforeCounter returns a count of LoggerMock.Enabled invocations
+func (mmEnabled *LoggerMock) EnabledBeforeCounter() uint64 {
+	return mmEnabled.EnabledMock.state.BeforeCalls()
+}
+
+// EnabledCalls returns the params of all LoggerMock.Enabled calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmEnabled *LoggerMock) EnabledCalls() []LoggerMockEnabledParams {
+	mmEnabled.EnabledMock.state.History.Lock()
+	defer mmEnabled.EnabledMock.state.History.Unlock()
+
+	calls := make([]LoggerMockEnabledParams, len(mmEnabled.EnabledMock.calls))
+	copy(calls, mmEnabled.EnabledMock.calls)
+	return calls
+}
+
+// EnabledLastParams returns the params of the latest LoggerMock.Enabled call and false if there were no calls
+func (mmEnabled *LoggerMock) EnabledLastParams() (params LoggerMockEnabledParams, ok bool) {
+	mmEnabled.EnabledMock.state.History.Lock()
+	defer mmEnabled.EnabledMock.state.History.Unlock()
+
+	if n := len(mmEnabled.EnabledMock.calls); n > 0 {
+		return mmEnabled.EnabledMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// EnabledCallTimes returns the times of all LoggerMock.Enabled calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmEnabled *LoggerMock) EnabledCallTimes() []mm_time.Time {
+	return mmEnabled.EnabledMock.state.CallTimes()
+}
+
+// EnabledMaxInFlight returns the maximum number of the LoggerMock.Enabled calls that have been in flight at once
+func (mmEnabled *LoggerMock) EnabledMaxInFlight() int {
+	return mmEnabled.EnabledMock.state.MaxInFlight()
+}
+
+// EnabledUnexpectedCounter returns a count of LoggerMock.Enabled invocations made without an implementation
+func (mmEnabled *LoggerMock) EnabledUnexpectedCounter() uint64 {
+	return mmEnabled.EnabledMock.state.UnexpectedCalls()
+}
+
+// EnabledNotCalled returns true if LoggerMock.Enabled hasn't been called
+func (mmEnabled *LoggerMock) EnabledNotCalled() bool {
+	return mmEnabled.EnabledMock.state.BeforeCalls() == 0
+}
+
+// EnabledCallCount returns a count of LoggerMock.Enabled invocations, it's the same as EnabledBeforeCounter
+func (mmEnabled *LoggerMock) EnabledCallCount() uint64 {
+	return mmEnabled.EnabledMock.state.BeforeCalls()
+}
+
+// MinimockEnabledDone returns true if the count of the Enabled invocations corresponds
+// the number of defined expectations
+func (mmEnabled *LoggerMock) MinimockEnabledDone() bool {
+	return mmEnabled.EnabledMock.state.Done(mmEnabled.EnabledMock.setup())
+}
+
+// MinimockEnabledInspect logs each unmet expectation
+func (mmEnabled *LoggerMock) MinimockEnabledInspect() {
+	mmEnabled.EnabledMock.state.Inspect(mmEnabled.EnabledMock.setup())
+}
+
+type mLoggerMockLog struct {
+	mock               *LoggerMock
+	state              minimock.MethodState
+	defaultExpectation *LoggerMockLogExpectation
+	expectations       []*LoggerMockLogExpectation
+	inspectLog         func(level Level, entries ...*entry)
+	compare            minimock.Comparer
+	calls              []LoggerMockLogParams
+	called             chan LoggerMockLogParams
+	queued             []*LoggerMockLogResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*LoggerMockLogResults
+}
+
+// LoggerMockLogExpectation specifies expectation struct of the Logger.Log
+type LoggerMockLogExpectation struct {
+	mock     *LoggerMock
+	params   *LoggerMockLogParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *LoggerMockLogResults
+	Counter  uint64
+}
+
+// LoggerMockLogParams contains parameters of the Logger.Log
+type LoggerMockLogParams struct {
+	Level   Level
+	Entries []*entry
+}
+
+// LoggerMockLogResults contains results of the Logger.Log
+type LoggerMockLogResults struct {
+	R0 int
+}
+
+// Expect sets up expected params for Logger.Log
+func (mmLog *mLoggerMockLog) Expect(level Level, entries ...*entry) *mLoggerMockLog {
+	if _, mm_func := mmLog.current(); mm_func != nil {
+		mmLog.mock.state.T().Fatalf("LoggerMock.Log mock is already set by Set")
+	}
+
+	mm_params := &LoggerMockLogParams{level, entries}
+	mmLog.updateDefault(func(e *LoggerMockLogExpectation) {
+		if e.partial {
+			mmLog.mock.state.T().Fatalf("LoggerMock.Log params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmLog.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmLog.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmLog
+}
+
+// updateDefault replaces the default expectation of Logger.Log by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmLog *mLoggerMockLog) updateDefault(update func(e *LoggerMockLogExpectation)) {
+	mmLog.state.Mutex.Lock()
+	defer mmLog.state.Mutex.Unlock()
+
+	e := &LoggerMockLogExpectation{mock: mmLog.mock}
+	if previous := mmLog.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmLog.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Logger.Log
+func (mmLog *mLoggerMockLog) current() (*LoggerMockLogExpectation, func(level Level, entries ...*entry) (i1 int)) {
+	mmLog.state.Mutex.RLock()
+	defer mmLog.state.Mutex.RUnlock()
+
+	return mmLog.defaultExpectation, mmLog.mock.funcLog
+}
+
+// partialParams updates the default expectation of Logger.Log by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmLog *mLoggerMockLog) partialParams(update func(e *LoggerMockLogExpectation)) {
+	if _, mm_func := mmLog.current(); mm_func != nil {
+		mmLog.mock.state.T().Fatalf("LoggerMock.Log mock is already set by Set")
+	}
+
+	mmLog.updateDefault(func(e *LoggerMockLogExpectation) {
+		if e.params == nil {
+			e.params = &LoggerMockLogParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"Level":   minimock.Anything,
+				"Entries": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectLevelParam1 sets up the expected value of the param #1 of Logger.Log,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmLog *mLoggerMockLog) ExpectLevelParam1(Level Level) *mLoggerMockLog {
+	mmLog.partialParams(func(e *LoggerMockLogExpectation) {
+		e.params.Level = Level
+		delete(e.matchers, "Level")
+	})
+	return mmLog
+}
+
+// MatchLevelParam1 sets up the predicate matching the param #1 of Logger.Log,
+// it's used instead of the value of the param set by Expect
+func (mmLog *mLoggerMockLog) MatchLevelParam1(f func(got Level) bool) *mLoggerMockLog {
+	mm_matcher := minimock.Predicate("predicate func(got Level) bool", func(v interface{}) bool {
+		got, _ := v.(Level)
+		return f(got)
+	})
+	mmLog.partialParams(func(e *LoggerMockLogExpectation) {
+		e.matchers["Level"] = mm_matcher
+	})
+	return mmLog
+}
+
+// ExpectEntriesParam2 sets up the expected value of the param #2 of Logger.Log,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmLog *mLoggerMockLog) ExpectEntriesParam2(Entries []*entry) *mLoggerMockLog {
+	mmLog.partialParams(func(e *LoggerMockLogExpectation) {
+		e.params.Entries = Entries
+		delete(e.matchers, "Entries")
+	})
+	return mmLog
+}
+
+// MatchEntriesParam2 sets up the predicate matching the param #2 of Logger.Log,
+// it's used instead of the value of the param set by Expect
+func (mmLog *mLoggerMockLog) MatchEntriesParam2(f func(got []*entry) bool) *mLoggerMockLog {
+	mm_matcher := minimock.Predicate("predicate func(got []*entry) bool", func(v interface{}) bool {
+		got, _ := v.([]*entry)
+		return f(got)
+	})
+	mmLog.partialParams(func(e *LoggerMockLogExpectation) {
+		e.matchers["Entries"] = mm_matcher
+	})
+	return mmLog
+}
+
+// Return sets up results that will be returned by Logger.Log
+func (mmLog *mLoggerMockLog) Return(i1 int) *LoggerMock {
+	if _, mm_func := mmLog.current(); mm_func != nil {
+		mmLog.mock.state.T().Fatalf("LoggerMock.Log mock is already set by Set")
+	}
+
+	mm_results := &LoggerMockLogResults{i1}
+	mmLog.updateDefault(func(e *LoggerMockLogExpectation) { e.results = mm_results })
+
+	return mmLog.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Logger.Log,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmLog *mLoggerMockLog) ReturnOnce(i1 int) *mLoggerMockLog {
+	mmLog.state.Mutex.Lock()
+	mmLog.queued = append(mmLog.queued, &LoggerMockLogResults{i1})
+	mmLog.state.Mutex.Unlock()
+	mmLog.state.PushResults()
+	return mmLog
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmLog *mLoggerMockLog) popResults() *LoggerMockLogResults {
+	mm_i, mm_ok := mmLog.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmLog.state.Mutex.RLock()
+	defer mmLog.state.Mutex.RUnlock()
+
+	return mmLog.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of Logger.Log instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmLog *mLoggerMockLog) SetComparer(compare minimock.Comparer) *mLoggerMockLog {
+	mmLog.state.Mutex.Lock()
+	mmLog.compare = compare
+	mmLog.state.Mutex.Unlock()
+	return mmLog
+}
+
+// whenExpectations returns the expectations of Logger.Log set by When,
+// the expectations can be set while the method is called concurrently
+func (mmLog *mLoggerMockLog) whenExpectations() []*LoggerMockLogExpectation {
+	mmLog.state.Mutex.RLock()
+	defer mmLog.state.Mutex.RUnlock()
+
+	return mmLog.expectations
+}
+
+// Inspect sets up the function called with the params of every Logger.Log call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmLog *mLoggerMockLog) Inspect(f func(level Level, entries ...*entry)) *mLoggerMockLog {
+	mmLog.state.Mutex.Lock()
+	mmLog.inspectLog = f
+	mmLog.state.Mutex.Unlock()
+	return mmLog
+}
+
+// expected returns everything set up for the Logger.Log call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmLog *mLoggerMockLog) expected() (*LoggerMockLogExpectation, func(level Level, entries ...*entry) (i1 int), func(level Level, entries ...*entry), minimock.Comparer, []*LoggerMockLogExpectation, []*LoggerMockLogResults) {
+	mmLog.state.Mutex.RLock()
+	defer mmLog.state.Mutex.RUnlock()
+
+	return mmLog.defaultExpectation, mmLog.mock.funcLog, mmLog.inspectLog, mmLog.compare, mmLog.expectations, mmLog.whenResults
+}
+
+// setup returns the expectations and the function set up for Logger.Log to be checked when the mock is finished
+func (mmLog *mLoggerMockLog) setup() (setup minimock.Setup) {
+	mmLog.state.Mutex.RLock()
+	defer mmLog.state.Mutex.RUnlock()
+
+	setup.Func = mmLog.mock.funcLog != nil
+	if e := mmLog.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmLog.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Logger.Log, the params of the calls are removed from the history as well
+func (mmLog *mLoggerMockLog) reset() {
+	mmLog.state.Mutex.Lock()
+	mmLog.defaultExpectation = nil
+	mmLog.expectations = nil
+	mmLog.queued = nil
+	mmLog.whenResults = nil
+	mmLog.state.Mutex.Unlock()
+
+	mmLog.state.History.Lock()
+	mmLog.calls = nil
+	mmLog.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmLog *mLoggerMockLog) MethodName() string {
+	return mmLog.state.Name()
+}
+
+// CallSequence returns the numbers of the Logger.Log calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmLog *mLoggerMockLog) CallSequence() []uint64 {
+	return mmLog.state.CallSequence()
+}
+
+// WaitForCalls waits until Logger.Log is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmLog *mLoggerMockLog) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmLog.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Logger.Log calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmLog *mLoggerMockLog) Block() (release func()) {
+	return mmLog.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Logger.Log calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmLog *mLoggerMockLog) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmLog.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Logger.Log calls are in flight at once
+func (mmLog *mLoggerMockLog) LimitConcurrency(n int) *mLoggerMockLog {
+	mmLog.state.SetLimit(n)
+	return mmLog
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Logger.Log call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmLog *mLoggerMockLog) CaptureCalls(buffer int) *mLoggerMockLog {
+	mmLog.state.History.Lock()
+	mmLog.called = make(chan LoggerMockLogParams, buffer)
+	mmLog.state.History.Unlock()
+	return mmLog
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Logger.Log call
+func (mmLog *mLoggerMockLog) Called() <-chan LoggerMockLogParams {
+	mmLog.state.History.Lock()
+	defer mmLog.state.History.Unlock()
+
+	if mmLog.called == nil {
+		mmLog.mock.state.T().Fatalf("Calls of LoggerMock.Log aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmLog.called
+}
+
+// DroppedCalls returns the number of the Logger.Log calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmLog *mLoggerMockLog) DroppedCalls() uint64 {
+	return mmLog.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Logger.Log calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmLog *mLoggerMockLog) Times(n uint64) *mLoggerMockLog {
+	mmLog.state.SetTimes(n)
+	return mmLog
+}
+
+// Optional excludes Logger.Log from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmLog *mLoggerMockLog) Optional() *mLoggerMockLog {
+	mmLog.state.SetOptional()
+	return mmLog
+}
+
+// Set uses given function f to mock the Logger.Log method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmLog *mLoggerMockLog) Set(f func(level Level, entries ...*entry) (i1 int)) *LoggerMock {
+	mmLog.state.Mutex.Lock()
+	defer mmLog.state.Mutex.Unlock()
+
+	if mmLog.defaultExpectation != nil {
+		mmLog.mock.state.T().Fatalf("Default expectation is already set for the Logger.Log method")
+	}
+
+	if len(mmLog.expectations) > 0 {
+		mmLog.mock.state.T().Fatalf("Some expectations are already set for the Logger.Log method")
+	}
+
+	mmLog.mock.funcLog = f
+	return mmLog.mock
+}
+
+// When sets expectation for the Logger.Log which will trigger the result defined by the following
+// Then helper
+func (mmLog *mLoggerMockLog) When(level Level, entries ...*entry) *LoggerMockLogExpectation {
+	if _, mm_func := mmLog.current(); mm_func != nil {
+		mmLog.mock.state.T().Fatalf("LoggerMock.Log mock is already set by Set")
+	}
+
+	expectation := &LoggerMockLogExpectation{
+		mock:   mmLog.mock,
+		params: &LoggerMockLogParams{level, entries},
+	}
+	mmLog.state.Mutex.Lock()
+	mmLog.expectations = append(mmLog.expectations, expectation)
+	mmLog.whenResults = append(mmLog.whenResults, nil)
+	mmLog.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up Logger.Log return parameters for the expectation previously defined by the When method
+func (mmExpectation *LoggerMockLogExpectation) Then(i1 int) *LoggerMock {
+	mm_handle := mmExpectation.mock.LogMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &LoggerMockLogResults{i1}
+	mm_results := make([]*LoggerMockLogResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Log implements Logger
+func (mmLog *LoggerMock) Log(level Level, entries ...*entry) (i1 int) {
+	mm_method := mmLog.LogMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := LoggerMockLogParams{level, entries}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcLog, mm_inspectLog, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectLog != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectLog(level, entries...)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).R0
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcLog == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcLog != nil {
+		return mm_funcLog(level, entries...)
+	}
+	if mm_delegate := mmLog.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Log(level, entries...)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, level, entries)
+	return
+}
+
+// LogAfterCounter returns a count of finished LoggerMock.Log invocations
+func (mmLog *LoggerMock) LogAfterCounter() uint64 {
+	return mmLog.LogMock.state.AfterCalls()
+}
+
+// LogBeforeCounter returns a count of LoggerMock.Log invocations
+func (mmLog *LoggerMock) LogBeforeCounter() uint64 {
+	return mmLog.LogMock.state.BeforeCalls()
+}
+
+// LogCalls returns the params of all LoggerMock.Log calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmLog *LoggerMock) LogCalls() []LoggerMockLogParams {
+	mmLog.LogMock.state.History.Lock()
+	defer mmLog.LogMock.state.History.Unlock()
+
+	calls := make([]LoggerMockLogParams, len(mmLog.LogMock.calls))
+	copy(calls, mmLog.LogMock.calls)
+	return calls
+}
+
+// LogLastParams returns the params of the latest LoggerMock.Log call and false if there were no calls
+func (mmLog *LoggerMock) LogLastParams() (params LoggerMockLogParams, ok bool) {
+	mmLog.LogMock.state.History.Lock()
+	defer mmLog.LogMock.state.History.Unlock()
+
+	if n := len(mmLog.LogMock.calls); n > 0 {
+		return mmLog.LogMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// LogCallTimes returns the times of all LoggerMock.Log calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmLog *LoggerMock) LogCallTimes() []mm_time.Time {
+	return mmLog.LogMock.state.CallTimes()
+}
+
+// LogMaxInFlight returns the maximum number of the LoggerMock.Log calls that have been in flight at once
+func (mmLog *LoggerMock) LogMaxInFlight() int {
+	return mmLog.LogMock.state.MaxInFlight()
+}
+
+// LogUnexpectedCounter returns a count of LoggerMock.Log invocations made without an implementation
+func (mmLog *LoggerMock) LogUnexpectedCounter() uint64 {
+	return mmLog.LogMock.state.UnexpectedCalls()
+}
+
+// LogNotCalled returns true if LoggerMock.Log hasn't been called
+func (mmLog *LoggerMock) LogNotCalled() bool {
+	return mmLog.LogMock.state.BeforeCalls() == 0
+}
+
+// LogCallCount returns a count of LoggerMock.Log invocations, it's the same as LogBeforeCounter
+func (mmLog *LoggerMock) LogCallCount() uint64 {
+	return mmLog.LogMock.state.BeforeCalls()
+}
+
+// MinimockLogDone returns true if the count of the Log invocations corresponds
+// the number of defined expectations
+func (mmLog *LoggerMock) MinimockLogDone() bool {
+	return mmLog.LogMock.state.Done(mmLog.LogMock.setup())
+}
+
+// MinimockLogInspect logs each unmet expectation
+func (mmLog *LoggerMock) MinimockLogInspect() {
+	mmLog.LogMock.state.Inspect(mmLog.LogMock.setup())
+}
+
+// MinimockSetComparer sets up the function comparing the expected and the actual params of all LoggerMock methods instead of minimock.Equal,
+// the params matched by the matchers aren't compared
+func (m *LoggerMock) MinimockSetComparer(compare minimock.Comparer) *LoggerMock {
+	m.state.SetComparer(compare)
+	return m
+}
+
+// MinimockSetSequence sets up the sequence numbering the LoggerMock calls, the mocks sharing the sequence
+// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+func (m *LoggerMock) MinimockSetSequence(sequence *minimock.Sequence) *LoggerMock {
+	m.state.SetSequence(sequence)
+	return m
+}
+
+// MinimockSetClock sets up the function returning the current time for the history of LoggerMock calls instead of time.Now
+func (m *LoggerMock) MinimockSetClock(clock func() mm_time.Time) *LoggerMock {
+	m.state.SetClock(clock)
+	return m
+}
+
+// MinimockSetAutoFinish enables or disables the check of LoggerMock made by the Cleanup of the tester passed to NewLoggerMock,
+// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+func (m *LoggerMock) MinimockSetAutoFinish(enabled bool) *LoggerMock {
+	m.state.SetAutoFinish(enabled)
+	return m
+}
+
+// MinimockSetDelegate sets up the implementation the calls of the LoggerMock methods are forwarded to when the methods
+// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+func (m *LoggerMock) MinimockSetDelegate(impl Logger) *LoggerMock {
+	m.mutex.Lock()
+	m.delegate = impl
+	m.mutex.Unlock()
+	return m
+}
+
+func (m *LoggerMock) minimockDelegate() Logger {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.delegate
+}
+
+// MinimockSetLenient enables or disables the lenient mode of LoggerMock: the calls of the methods that have neither
+// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+func (m *LoggerMock) MinimockSetLenient(enabled bool) *LoggerMock {
+	m.state.SetLenient(enabled)
+	return m
+}
+
+// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (m *LoggerMock) MinimockLenientCalls() map[string]uint64 {
+	return m.state.LenientCalls()
+}
+
+// MinimockAssertNotCalled fails the test without stopping it if any of the LoggerMock methods with the given names has been called,
+// it doesn't depend on the expectations and the functions set up for the methods
+func (m *LoggerMock) MinimockAssertNotCalled(methodNames ...string) {
+	m.state.AssertNotCalled(methodNames...)
+}
+
+// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+// queued by ReturnOnce of all LoggerMock methods, the functions set by Set and Inspect are kept.
+// It fails the test if any of the methods is being called
+func (m *LoggerMock) MinimockReset() {
+	m.state.Reset(
+		m.EnabledMock.reset,
+		m.LogMock.reset,
+	)
+}
+
+// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+func (m *LoggerMock) MinimockResetAll() {
+	m.MinimockReset()
+	m.EnabledMock.state.Mutex.Lock()
+	m.funcEnabled = nil
+	m.EnabledMock.inspectEnabled = nil
+	m.EnabledMock.state.Mutex.Unlock()
+	m.LogMock.state.Mutex.Lock()
+	m.funcLog = nil
+	m.LogMock.inspectLog = nil
+	m.LogMock.state.Mutex.Unlock()
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *LoggerMock) MinimockFinish() {
+	m.state.Finish(m.minimockDone,
+		m.MinimockEnabledInspect,
+		m.MinimockLogInspect,
+	)
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *LoggerMock) MinimockWait(timeout mm_time.Duration) {
+	m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
+}
+
+func (m *LoggerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockEnabledDone() &&
+		m.MinimockLogDone()
+}
@@ -0,0 +1,27 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoggerMock_VariadicExpect(t *testing.T) {
+	first, second := &entry{message: "first"}, &entry{message: "second"}
+
+	loggerMock := NewLoggerMock(t).LogMock.Expect(Level(1), first, second).Return(2)
+	defer loggerMock.MinimockFinish()
+
+	var logger Logger = loggerMock
+	assert.Equal(t, 2, logger.Log(1, first, second))
+}
+
+func TestLoggerMock_VariadicPassThrough(t *testing.T) {
+	loggerMock := NewLoggerMock(t).EnabledMock.Set(func(levels ...Level) bool {
+		return len(levels) == 2 && levels[1] == 2
+	})
+	defer loggerMock.MinimockFinish()
+
+	var logger Logger = loggerMock
+	assert.True(t, logger.Enabled(1, 2))
+}
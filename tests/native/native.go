@@ -0,0 +1,11 @@
+// Package native is used to test mocks of the interfaces declared in the packages that use cgo
+package native
+
+// Device interface is declared in a plain Go file of the package that has cgo files
+type Device interface {
+	Read(p []byte) (int, error)
+	Status() Status
+}
+
+// Status is a status of the Device
+type Status int
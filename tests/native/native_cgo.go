@@ -0,0 +1,11 @@
+package native
+
+/*
+static int device_status() { return 1; }
+*/
+import "C"
+
+// DefaultStatus returns the status reported by the C code
+func DefaultStatus() Status {
+	return Status(C.device_status())
+}
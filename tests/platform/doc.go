@@ -0,0 +1,2 @@
+// Package platform is used to test mocks of the interfaces which methods depend on the target platform
+package platform
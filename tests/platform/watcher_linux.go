@@ -0,0 +1,7 @@
+package platform
+
+// Watcher interface has the linux specific Inotify method
+type Watcher interface {
+	Watch(path string) error
+	Inotify() int
+}
@@ -0,0 +1,9 @@
+//go:build !linux
+// +build !linux
+
+package platform
+
+// Watcher interface has no platform specific methods on the platforms other than linux
+type Watcher interface {
+	Watch(path string) error
+}
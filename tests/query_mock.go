@@ -0,0 +1,1210 @@
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+package tests
+
+//go:generate minimock -i github.com/gojuno/minimock/tests.Query -o ./query_mock.go
+
+import (
+	"context"
+	mm_sync "sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock"
+)
+
+// QueryMock implements Query
+//
+// Query interface is used to test mocks of the interfaces which methods return the interface itself
+type QueryMock struct {
+	state    minimock.MockState
+	mutex    mm_sync.RWMutex
+	delegate Query
+
+	funcRun func(ctx context.Context) (r1 Rows, err error)
+	RunMock *mQueryMockRun
+
+	funcWhere func(cond string) (q1 Query)
+	WhereMock *mQueryMockWhere
+}
+
+var _ Query = (*QueryMock)(nil)
+
+// NewQueryMock returns a mock for Query
+func NewQueryMock(t minimock.Tester) *QueryMock {
+	m := &QueryMock{}
+	m.state.Init(m, t, "QueryMock", 0)
+
+	m.RunMock = &mQueryMockRun{mock: m}
+	m.RunMock.state.Init(&m.state, "Run")
+
+	m.WhereMock = &mQueryMockWhere{mock: m}
+	m.WhereMock.state.Init(&m.state, "Where")
+
+	return m
+}
+
+type mQueryMockRun struct {
+	mock               *QueryMock
+	state              minimock.MethodState
+	defaultExpectation *QueryMockRunExpectation
+	expectations       []*QueryMockRunExpectation
+	inspectRun         func(ctx context.Context)
+	compare            minimock.Comparer
+	calls              []QueryMockRunParams
+	called             chan QueryMockRunParams
+	queued             []*QueryMockRunResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*QueryMockRunResults
+}
+
+// QueryMockRunExpectation specifies expectation struct of the Query.Run
+type QueryMockRunExpectation struct {
+	mock     *QueryMock
+	params   *QueryMockRunParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *QueryMockRunResults
+	Counter  uint64
+}
+
+// QueryMockRunParams contains parameters of the Query.Run
+type QueryMockRunParams struct {
+	Ctx context.Context
+}
+
+// QueryMockRunResults contains results of the Query.Run
+type QueryMockRunResults struct {
+	R0 Rows
+	R1 error
+}
+
+// Expect sets up expected params for Query.Run
+func (mmRun *mQueryMockRun) Expect(ctx context.Context) *mQueryMockRun {
+	if _, mm_func := mmRun.current(); mm_func != nil {
+		mmRun.mock.state.T().Fatalf("QueryMock.Run mock is already set by Set")
+	}
+
+	mm_params := &QueryMockRunParams{ctx}
+	mmRun.updateDefault(func(e *QueryMockRunExpectation) {
+		if e.partial {
+			mmRun.mock.state.T().Fatalf("QueryMock.Run params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmRun.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmRun.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmRun
+}
+
+// updateDefault replaces the default expectation of Query.Run by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmRun *mQueryMockRun) updateDefault(update func(e *QueryMockRunExpectation)) {
+	mmRun.state.Mutex.Lock()
+	defer mmRun.state.Mutex.Unlock()
+
+	e := &QueryMockRunExpectation{mock: mmRun.mock}
+	if previous := mmRun.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmRun.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Query.Run
+func (mmRun *mQueryMockRun) current() (*QueryMockRunExpectation, func(ctx context.Context) (r1 Rows, err error)) {
+	mmRun.state.Mutex.RLock()
+	defer mmRun.state.Mutex.RUnlock()
+
+	return mmRun.defaultExpectation, mmRun.mock.funcRun
+}
+
+// partialParams updates the default expectation of Query.Run by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmRun *mQueryMockRun) partialParams(update func(e *QueryMockRunExpectation)) {
+	if _, mm_func := mmRun.current(); mm_func != nil {
+		mmRun.mock.state.T().Fatalf("QueryMock.Run mock is already set by Set")
+	}
+
+	mmRun.updateDefault(func(e *QueryMockRunExpectation) {
+		if e.params == nil {
+			e.params = &QueryMockRunParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"Ctx": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectCtxParam1 sets up the expected value of the param #1 of Query.Run,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmRun *mQueryMockRun) ExpectCtxParam1(Ctx context.Context) *mQueryMockRun {
+	mmRun.partialParams(func(e *QueryMockRunExpectation) {
+		e.params.Ctx = Ctx
+		delete(e.matchers, "Ctx")
+	})
+	return mmRun
+}
+
+// MatchCtxParam1 sets up the predicate matching the param #1 of Query.Run,
+// it's used instead of the value of the param set by Expect
+func (mmRun *mQueryMockRun) MatchCtxParam1(f func(got context.Context) bool) *mQueryMockRun {
+	mm_matcher := minimock.Predicate("predicate func(got context.Context) bool", func(v interface{}) bool {
+		got, _ := v.(context.Context)
+		return f(got)
+	})
+	mmRun.partialParams(func(e *QueryMockRunExpectation) {
+		e.matchers["Ctx"] = mm_matcher
+	})
+	return mmRun
+}
+
+// Return sets up results that will be returned by Query.Run
+func (mmRun *mQueryMockRun) Return(r1 Rows, err error) *QueryMock {
+	if _, mm_func := mmRun.current(); mm_func != nil {
+		mmRun.mock.state.T().Fatalf("QueryMock.Run mock is already set by Set")
+	}
+
+	mm_results := &QueryMockRunResults{r1, err}
+	mmRun.updateDefault(func(e *QueryMockRunExpectation) { e.results = mm_results })
+
+	return mmRun.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Query.Run,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmRun *mQueryMockRun) ReturnOnce(r1 Rows, err error) *mQueryMockRun {
+	mmRun.state.Mutex.Lock()
+	mmRun.queued = append(mmRun.queued, &QueryMockRunResults{r1, err})
+	mmRun.state.Mutex.Unlock()
+	mmRun.state.PushResults()
+	return mmRun
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmRun *mQueryMockRun) popResults() *QueryMockRunResults {
+	mm_i, mm_ok := mmRun.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmRun.state.Mutex.RLock()
+	defer mmRun.state.Mutex.RUnlock()
+
+	return mmRun.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of Query.Run instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmRun *mQueryMockRun) SetComparer(compare minimock.Comparer) *mQueryMockRun {
+	mmRun.state.Mutex.Lock()
+	mmRun.compare = compare
+	mmRun.state.Mutex.Unlock()
+	return mmRun
+}
+
+// whenExpectations returns the expectations of Query.Run set by When,
+// the expectations can be set while the method is called concurrently
+func (mmRun *mQueryMockRun) whenExpectations() []*QueryMockRunExpectation {
+	mmRun.state.Mutex.RLock()
+	defer mmRun.state.Mutex.RUnlock()
+
+	return mmRun.expectations
+}
+
+// Inspect sets up the function called with the params of every Query.Run call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmRun *mQueryMockRun) Inspect(f func(ctx context.Context)) *mQueryMockRun {
+	mmRun.state.Mutex.Lock()
+	mmRun.inspectRun = f
+	mmRun.state.Mutex.Unlock()
+	return mmRun
+}
+
+// expected returns everything set up for the Query.Run call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmRun *mQueryMockRun) expected() (*QueryMockRunExpectation, func(ctx context.Context) (r1 Rows, err error), func(ctx context.Context), minimock.Comparer, []*QueryMockRunExpectation, []*QueryMockRunResults) {
+	mmRun.state.Mutex.RLock()
+	defer mmRun.state.Mutex.RUnlock()
+
+	return mmRun.defaultExpectation, mmRun.mock.funcRun, mmRun.inspectRun, mmRun.compare, mmRun.expectations, mmRun.whenResults
+}
+
+// setup returns the expectations and the function set up for Query.Run to be checked when the mock is finished
+func (mmRun *mQueryMockRun) setup() (setup minimock.Setup) {
+	mmRun.state.Mutex.RLock()
+	defer mmRun.state.Mutex.RUnlock()
+
+	setup.Func = mmRun.mock.funcRun != nil
+	if e := mmRun.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmRun.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Query.Run, the params of the calls are removed from the history as well
+func (mmRun *mQueryMockRun) reset() {
+	mmRun.state.Mutex.Lock()
+	mmRun.defaultExpectation = nil
+	mmRun.expectations = nil
+	mmRun.queued = nil
+	mmRun.whenResults = nil
+	mmRun.state.Mutex.Unlock()
+
+	mmRun.state.History.Lock()
+	mmRun.calls = nil
+	mmRun.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmRun *mQueryMockRun) MethodName() string {
+	return mmRun.state.Name()
+}
+
+// CallSequence returns the numbers of the Query.Run calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmRun *mQueryMockRun) CallSequence() []uint64 {
+	return mmRun.state.CallSequence()
+}
+
+// WaitForCalls waits until Query.Run is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmRun *mQueryMockRun) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmRun.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Query.Run calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmRun *mQueryMockRun) Block() (release func()) {
+	return mmRun.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Query.Run calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmRun *mQueryMockRun) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmRun.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Query.Run calls are in flight at once
+func (mmRun *mQueryMockRun) LimitConcurrency(n int) *mQueryMockRun {
+	mmRun.state.SetLimit(n)
+	return mmRun
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Query.Run call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmRun *mQueryMockRun) CaptureCalls(buffer int) *mQueryMockRun {
+	mmRun.state.History.Lock()
+	mmRun.called = make(chan QueryMockRunParams, buffer)
+	mmRun.state.History.Unlock()
+	return mmRun
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Query.Run call
+func (mmRun *mQueryMockRun) Called() <-chan QueryMockRunParams {
+	mmRun.state.History.Lock()
+	defer mmRun.state.History.Unlock()
+
+	if mmRun.called == nil {
+		mmRun.mock.state.T().Fatalf("Calls of QueryMock.Run aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmRun.called
+}
+
+// DroppedCalls returns the number of the Query.Run calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmRun *mQueryMockRun) DroppedCalls() uint64 {
+	return mmRun.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Query.Run calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmRun *mQueryMockRun) Times(n uint64) *mQueryMockRun {
+	mmRun.state.SetTimes(n)
+	return mmRun
+}
+
+// Optional excludes Query.Run from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmRun *mQueryMockRun) Optional() *mQueryMockRun {
+	mmRun.state.SetOptional()
+	return mmRun
+}
+
+// Set uses given function f to mock the Query.Run method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmRun *mQueryMockRun) Set(f func(ctx context.Context) (r1 Rows, err error)) *QueryMock {
+	mmRun.state.Mutex.Lock()
+	defer mmRun.state.Mutex.Unlock()
+
+	if mmRun.defaultExpectation != nil {
+		mmRun.mock.state.T().Fatalf("Default expectation is already set for the Query.Run method")
+	}
+
+	if len(mmRun.expectations) > 0 {
+		mmRun.mock.state.T().Fatalf("Some expectations are already set for the Query.Run method")
+	}
+
+	mmRun.mock.funcRun = f
+	return mmRun.mock
+}
+
+// When sets expectation for the Query.Run which will trigger the result defined by the following
+// Then helper
+func (mmRun *mQueryMockRun) When(ctx context.Context) *QueryMockRunExpectation {
+	if _, mm_func := mmRun.current(); mm_func != nil {
+		mmRun.mock.state.T().Fatalf("QueryMock.Run mock is already set by Set")
+	}
+
+	expectation := &QueryMockRunExpectation{
+		mock:   mmRun.mock,
+		params: &QueryMockRunParams{ctx},
+	}
+	mmRun.state.Mutex.Lock()
+	mmRun.expectations = append(mmRun.expectations, expectation)
+	mmRun.whenResults = append(mmRun.whenResults, nil)
+	mmRun.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up Query.Run return parameters for the expectation previously defined by the When method
+func (mmExpectation *QueryMockRunExpectation) Then(r1 Rows, err error) *QueryMock {
+	mm_handle := mmExpectation.mock.RunMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &QueryMockRunResults{r1, err}
+	mm_results := make([]*QueryMockRunResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Run implements Query
+func (mmRun *QueryMock) Run(ctx context.Context) (r1 Rows, err error) {
+	mm_method := mmRun.RunMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := QueryMockRunParams{ctx}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcRun, mm_inspectRun, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectRun != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectRun(ctx)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).R0, (*mm_results).R1
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).R0, (*mm_results).R1
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcRun == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0, (*mm_expectation.results).R1
+	}
+	if mm_funcRun != nil {
+		return mm_funcRun(ctx)
+	}
+	if mm_delegate := mmRun.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Run(ctx)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, ctx)
+	return
+}
+
+// RunAfterCounter returns a count of finished QueryMock.Run invocations
+func (mmRun *QueryMock) RunAfterCounter() uint64 {
+	return mmRun.RunMock.state.AfterCalls()
+}
+
+// RunBeforeCounter returns a count of QueryMock.Run invocations
+func (mmRun *QueryMock) RunBeforeCounter() uint64 {
+	return mmRun.RunMock.state.BeforeCalls()
+}
+
+// RunCalls returns the params of all QueryMock.Run calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmRun *QueryMock) RunCalls() []QueryMockRunParams {
+	mmRun.RunMock.state.History.Lock()
+	defer mmRun.RunMock.state.History.Unlock()
+
+	calls := make([]QueryMockRunParams, len(mmRun.RunMock.calls))
+	copy(calls, mmRun.RunMock.calls)
+	return calls
+}
+
+// RunLastParams returns the params of the latest QueryMock.Run call and false if there were no calls
+func (mmRun *QueryMock) RunLastParams() (params QueryMockRunParams, ok bool) {
+	mmRun.RunMock.state.History.Lock()
+	defer mmRun.RunMock.state.History.Unlock()
+
+	if n := len(mmRun.RunMock.calls); n > 0 {
+		return mmRun.RunMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// RunCallTimes returns the times of all QueryMock.Run calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmRun *QueryMock) RunCallTimes() []mm_time.Time {
+	return mmRun.RunMock.state.CallTimes()
+}
+
+// RunMaxInFlight returns the maximum number of the QueryMock.Run calls that have been in flight at once
+func (mmRun *QueryMock) RunMaxInFlight() int {
+	return mmRun.RunMock.state.MaxInFlight()
+}
+
+// RunUnexpectedCounter returns a count of QueryMock.Run invocations made without an implementation
+func (mmRun *QueryMock) RunUnexpectedCounter() uint64 {
+	return mmRun.RunMock.state.UnexpectedCalls()
+}
+
+// RunNotCalled returns true if QueryMock.Run hasn't been called
+func (mmRun *QueryMock) RunNotCalled() bool {
+	return mmRun.RunMock.state.BeforeCalls() == 0
+}
+
+// RunCallCount returns a count of QueryMock.Run invocations, it's the same as RunBeforeCounter
+func (mmRun *QueryMock) RunCallCount() uint64 {
+	return mmRun.RunMock.state.BeforeCalls()
+}
+
+// MinimockRunDone returns true if the count of the Run invocations corresponds
+// the number of defined expectations
+func (mmRun *QueryMock) MinimockRunDone() bool {
+	return mmRun.RunMock.state.Done(mmRun.RunMock.setup())
+}
+
+// MinimockRunInspect logs each unmet expectation
+func (mmRun *QueryMock) MinimockRunInspect() {
+	mmRun.RunMock.state.Inspect(mmRun.RunMock.setup())
+}
+
+type mQueryMockWhere struct {
+	mock               *QueryMock
+	state              minimock.MethodState
+	defaultExpectation *QueryMockWhereExpectation
+	expectations       []*QueryMockWhereExpectation
+	inspectWhere       func(cond string)
+	compare            minimock.Comparer
+	calls              []QueryMockWhereParams
+	called             chan QueryMockWhereParams
+	queued             []*QueryMockWhereResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*QueryMockWhereResults
+}
+
+// QueryMockWhereExpectation specifies expectation struct of the Query.Where
+type QueryMockWhereExpectation struct {
+	mock     *QueryMock
+	params   *QueryMockWhereParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *QueryMockWhereResults
+	Counter  uint64
+}
+
+// QueryMockWhereParams contains parameters of the Query.Where
+type QueryMockWhereParams struct {
+	Cond string
+}
+
+// QueryMockWhereResults contains results of the Query.Where
+type QueryMockWhereResults struct {
+	R0 Query
+}
+
+// Expect sets up expected params for Query.Where
+func (mmWhere *mQueryMockWhere) Expect(cond string) *mQueryMockWhere {
+	if _, mm_func := mmWhere.current(); mm_func != nil {
+		mmWhere.mock.state.T().Fatalf("QueryMock.Where mock is already set by Set")
+	}
+
+	mm_params := &QueryMockWhereParams{cond}
+	mmWhere.updateDefault(func(e *QueryMockWhereExpectation) {
+		if e.partial {
+			mmWhere.mock.state.T().Fatalf("QueryMock.Where params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmWhere.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmWhere.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmWhere
+}
+
+// updateDefault replaces the default expectation of Query.Where by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmWhere *mQueryMockWhere) updateDefault(update func(e *QueryMockWhereExpectation)) {
+	mmWhere.state.Mutex.Lock()
+	defer mmWhere.state.Mutex.Unlock()
+
+	e := &QueryMockWhereExpectation{mock: mmWhere.mock}
+	if previous := mmWhere.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmWhere.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Query.Where
+func (mmWhere *mQueryMockWhere) current() (*QueryMockWhereExpectation, func(cond string) (q1 Query)) {
+	mmWhere.state.Mutex.RLock()
+	defer mmWhere.state.Mutex.RUnlock()
+
+	return mmWhere.defaultExpectation, mmWhere.mock.funcWhere
+}
+
+// partialParams updates the default expectation of Query.Where by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmWhere *mQueryMockWhere) partialParams(update func(e *QueryMockWhereExpectation)) {
+	if _, mm_func := mmWhere.current(); mm_func != nil {
+		mmWhere.mock.state.T().Fatalf("QueryMock.Where mock is already set by Set")
+	}
+
+	mmWhere.updateDefault(func(e *QueryMockWhereExpectation) {
+		if e.params == nil {
+			e.params = &QueryMockWhereParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"Cond": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectCondParam1 sets up the expected value of the param #1 of Query.Where,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmWhere *mQueryMockWhere) ExpectCondParam1(Cond string) *mQueryMockWhere {
+	mmWhere.partialParams(func(e *QueryMockWhereExpectation) {
+		e.params.Cond = Cond
+		delete(e.matchers, "Cond")
+	})
+	return mmWhere
+}
+
+// MatchCondParam1 sets up the predicate matching the param #1 of Query.Where,
+// it's used instead of the value of the param set by Expect
+func (mmWhere *mQueryMockWhere) MatchCondParam1(f func(got string) bool) *mQueryMockWhere {
+	mm_matcher := minimock.Predicate("predicate func(got string) bool", func(v interface{}) bool {
+		got, _ := v.(string)
+		return f(got)
+	})
+	mmWhere.partialParams(func(e *QueryMockWhereExpectation) {
+		e.matchers["Cond"] = mm_matcher
+	})
+	return mmWhere
+}
+
+// Return sets up results that will be returned by Query.Where
+func (mmWhere *mQueryMockWhere) Return(q1 Query) *QueryMock {
+	if _, mm_func := mmWhere.current(); mm_func != nil {
+		mmWhere.mock.state.T().Fatalf("QueryMock.Where mock is already set by Set")
+	}
+
+	mm_results := &QueryMockWhereResults{q1}
+	mmWhere.updateDefault(func(e *QueryMockWhereExpectation) { e.results = mm_results })
+
+	return mmWhere.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Query.Where,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmWhere *mQueryMockWhere) ReturnOnce(q1 Query) *mQueryMockWhere {
+	mmWhere.state.Mutex.Lock()
+	mmWhere.queued = append(mmWhere.queued, &QueryMockWhereResults{q1})
+	mmWhere.state.Mutex.Unlock()
+	mmWhere.state.PushResults()
+	return mmWhere
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmWhere *mQueryMockWhere) popResults() *QueryMockWhereResults {
+	mm_i, mm_ok := mmWhere.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmWhere.state.Mutex.RLock()
+	defer mmWhere.state.Mutex.RUnlock()
+
+	return mmWhere.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of Query.Where instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmWhere *mQueryMockWhere) SetComparer(compare minimock.Comparer) *mQueryMockWhere {
+	mmWhere.state.Mutex.Lock()
+	mmWhere.compare = compare
+	mmWhere.state.Mutex.Unlock()
+	return mmWhere
+}
+
+// whenExpectations returns the expectations of Query.Where set by When,
+// the expectations can be set while the method is called concurrently
+func (mmWhere *mQueryMockWhere) whenExpectations() []*QueryMockWhereExpectation {
+	mmWhere.state.Mutex.RLock()
+	defer mmWhere.state.Mutex.RUnlock()
+
+	return mmWhere.expectations
+}
+
+// Inspect sets up the function called with the params of every Query.Where call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmWhere *mQueryMockWhere) Inspect(f func(cond string)) *mQueryMockWhere {
+	mmWhere.state.Mutex.Lock()
+	mmWhere.inspectWhere = f
+	mmWhere.state.Mutex.Unlock()
+	return mmWhere
+}
+
+// expected returns everything set up for the Query.Where call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmWhere *mQueryMockWhere) expected() (*QueryMockWhereExpectation, func(cond string) (q1 Query), func(cond string), minimock.Comparer, []*QueryMockWhereExpectation, []*QueryMockWhereResults) {
+	mmWhere.state.Mutex.RLock()
+	defer mmWhere.state.Mutex.RUnlock()
+
+	return mmWhere.defaultExpectation, mmWhere.mock.funcWhere, mmWhere.inspectWhere, mmWhere.compare, mmWhere.expectations, mmWhere.whenResults
+}
+
+// setup returns the expectations and the function set up for Query.Where to be checked when the mock is finished
+func (mmWhere *mQueryMockWhere) setup() (setup minimock.Setup) {
+	mmWhere.state.Mutex.RLock()
+	defer mmWhere.state.Mutex.RUnlock()
+
+	setup.Func = mmWhere.mock.funcWhere != nil
+	if e := mmWhere.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmWhere.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Query.Where, the params of the calls are removed from the history as well
+func (mmWhere *mQueryMockWhere) reset() {
+	mmWhere.state.Mutex.Lock()
+	mmWhere.defaultExpectation = nil
+	mmWhere.expectations = nil
+	mmWhere.queued = nil
+	mmWhere.whenResults = nil
+	mmWhere.state.Mutex.Unlock()
+
+	mmWhere.state.History.Lock()
+	mmWhere.calls = nil
+	mmWhere.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmWhere *mQueryMockWhere) MethodName() string {
+	return mmWhere.state.Name()
+}
+
+// CallSequence returns the numbers of the Query.Where calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmWhere *mQueryMockWhere) CallSequence() []uint64 {
+	return mmWhere.state.CallSequence()
+}
+
+// WaitForCalls waits until Query.Where is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmWhere *mQueryMockWhere) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmWhere.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Query.Where calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmWhere *mQueryMockWhere) Block() (release func()) {
+	return mmWhere.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Query.Where calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmWhere *mQueryMockWhere) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmWhere.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Query.Where calls are in flight at once
+func (mmWhere *mQueryMockWhere) LimitConcurrency(n int) *mQueryMockWhere {
+	mmWhere.state.SetLimit(n)
+	return mmWhere
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Query.Where call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmWhere *mQueryMockWhere) CaptureCalls(buffer int) *mQueryMockWhere {
+	mmWhere.state.History.Lock()
+	mmWhere.called = make(chan QueryMockWhereParams, buffer)
+	mmWhere.state.History.Unlock()
+	return mmWhere
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Query.Where call
+func (mmWhere *mQueryMockWhere) Called() <-chan QueryMockWhereParams {
+	mmWhere.state.History.Lock()
+	defer mmWhere.state.History.Unlock()
+
+	if mmWhere.called == nil {
+		mmWhere.mock.state.T().Fatalf("Calls of QueryMock.Where aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmWhere.called
+}
+
+// DroppedCalls returns the number of the Query.Where calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmWhere *mQueryMockWhere) DroppedCalls() uint64 {
+	return mmWhere.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Query.Where calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmWhere *mQueryMockWhere) Times(n uint64) *mQueryMockWhere {
+	mmWhere.state.SetTimes(n)
+	return mmWhere
+}
+
+// Optional excludes Query.Where from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmWhere *mQueryMockWhere) Optional() *mQueryMockWhere {
+	mmWhere.state.SetOptional()
+	return mmWhere
+}
+
+// Set uses given function f to mock the Query.Where method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmWhere *mQueryMockWhere) Set(f func(cond string) (q1 Query)) *QueryMock {
+	mmWhere.state.Mutex.Lock()
+	defer mmWhere.state.Mutex.Unlock()
+
+	if mmWhere.defaultExpectation != nil {
+		mmWhere.mock.state.T().Fatalf("Default expectation is already set for the Query.Where method")
+	}
+
+	if len(mmWhere.expectations) > 0 {
+		mmWhere.mock.state.T().Fatalf("Some expectations are already set for the Query.Where method")
+	}
+
+	mmWhere.mock.funcWhere = f
+	return mmWhere.mock
+}
+
+// When sets expectation for the Query.Where which will trigger the result defined by the following
+// Then helper
+func (mmWhere *mQueryMockWhere) When(cond string) *QueryMockWhereExpectation {
+	if _, mm_func := mmWhere.current(); mm_func != nil {
+		mmWhere.mock.state.T().Fatalf("QueryMock.Where mock is already set by Set")
+	}
+
+	expectation := &QueryMockWhereExpectation{
+		mock:   mmWhere.mock,
+		params: &QueryMockWhereParams{cond},
+	}
+	mmWhere.state.Mutex.Lock()
+	mmWhere.expectations = append(mmWhere.expectations, expectation)
+	mmWhere.whenResults = append(mmWhere.whenResults, nil)
+	mmWhere.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up Query.Where return parameters for the expectation previously defined by the When method
+func (mmExpectation *QueryMockWhereExpectation) Then(q1 Query) *QueryMock {
+	mm_handle := mmExpectation.mock.WhereMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &QueryMockWhereResults{q1}
+	mm_results := make([]*QueryMockWhereResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Where implements Query
+func (mmWhere *QueryMock) Where(cond string) (q1 Query) {
+	mm_method := mmWhere.WhereMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := QueryMockWhereParams{cond}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcWhere, mm_inspectWhere, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectWhere != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectWhere(cond)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).R0
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcWhere == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcWhere != nil {
+		return mm_funcWhere(cond)
+	}
+	if mm_delegate := mmWhere.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Where(cond)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, cond)
+	return
+}
+
+// WhereAfterCounter returns a count of finished QueryMock.Where invocations
+func (mmWhere *QueryMock) WhereAfterCounter() uint64 {
+	return mmWhere.WhereMock.state.AfterCalls()
+}
+
+// WhereBeforeCounter returns a count of QueryMock.Where invocations
+func (mmWhere *QueryMock) WhereBeforeCounter() uint64 {
+	return mmWhere.WhereMock.state.BeforeCalls()
+}
+
+// WhereCalls returns the params of all QueryMock.Where calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmWhere *QueryMock) WhereCalls() []QueryMockWhereParams {
+	mmWhere.WhereMock.state.History.Lock()
+	defer mmWhere.WhereMock.state.History.Unlock()
+
+	calls := make([]QueryMockWhereParams, len(mmWhere.WhereMock.calls))
+	copy(calls, mmWhere.WhereMock.calls)
+	return calls
+}
+
+// WhereLastParams returns the params of the latest QueryMock.Where call and false if there were no calls
+func (mmWhere *QueryMock) WhereLastParams() (params QueryMockWhereParams, ok bool) {
+	mmWhere.WhereMock.state.History.Lock()
+	defer mmWhere.WhereMock.state.History.Unlock()
+
+	if n := len(mmWhere.WhereMock.calls); n > 0 {
+		return mmWhere.WhereMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// WhereCallTimes returns the times of all QueryMock.Where calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmWhere *QueryMock) WhereCallTimes() []mm_time.Time {
+	return mmWhere.WhereMock.state.CallTimes()
+}
+
+// WhereMaxInFlight returns the maximum number of the QueryMock.Where calls that have been in flight at once
+func (mmWhere *QueryMock) WhereMaxInFlight() int {
+	return mmWhere.WhereMock.state.MaxInFlight()
+}
+
+// WhereUnexpectedCounter returns a count of QueryMock.Where invocations made without an implementation
+func (mmWhere *QueryMock) WhereUnexpectedCounter() uint64 {
+	return mmWhere.WhereMock.state.UnexpectedCalls()
+}
+
+// WhereNotCalled returns true if QueryMock.Where hasn't been called
+func (mmWhere *QueryMock) WhereNotCalled() bool {
+	return mmWhere.WhereMock.state.BeforeCalls() == 0
+}
+
+// WhereCallCount returns a count of QueryMock.Where invocations, it's the same as WhereBeforeCounter
+func (mmWhere *QueryMock) WhereCallCount() uint64 {
+	return mmWhere.WhereMock.state.BeforeCalls()
+}
+
+// MinimockWhereDone returns true if the count of the Where invocations corresponds
+// the number of defined expectations
+func (mmWhere *QueryMock) MinimockWhereDone() bool {
+	return mmWhere.WhereMock.state.Done(mmWhere.WhereMock.setup())
+}
+
+// MinimockWhereInspect logs each unmet expectation
+func (mmWhere *QueryMock) MinimockWhereInspect() {
+	mmWhere.WhereMock.state.Inspect(mmWhere.WhereMock.setup())
+}
+
+// MinimockSetComparer sets up the function comparing the expected and the actual params of all QueryMock methods instead of minimock.Equal,
+// the params matched by the matchers aren't compared
+func (m *QueryMock) MinimockSetComparer(compare minimock.Comparer) *QueryMock {
+	m.state.SetComparer(compare)
+	return m
+}
+
+// MinimockSetSequence sets up the sequence numbering the QueryMock calls, the mocks sharing the sequence
+// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+func (m *QueryMock) MinimockSetSequence(sequence *minimock.Sequence) *QueryMock {
+	m.state.SetSequence(sequence)
+	return m
+}
+
+// MinimockSetClock sets up the function returning the current time for the history of QueryMock calls instead of time.Now
+func (m *QueryMock) MinimockSetClock(clock func() mm_time.Time) *QueryMock {
+	m.state.SetClock(clock)
+	return m
+}
+
+// MinimockSetAutoFinish enables or disables the check of QueryMock made by the Cleanup of the tester passed to NewQueryMock,
+// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+func (m *QueryMock) MinimockSetAutoFinish(enabled bool) *QueryMock {
+	m.state.SetAutoFinish(enabled)
+	return m
+}
+
+// MinimockSetDelegate sets up the implementation the calls of the QueryMock methods are forwarded to when the methods
+// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+func (m *QueryMock) MinimockSetDelegate(impl Query) *QueryMock {
+	m.mutex.Lock()
+	m.delegate = impl
+	m.mutex.Unlock()
+	return m
+}
+
+func (m *QueryMock) minimockDelegate() Query {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.delegate
+}
+
+// MinimockSetLenient enables or disables the lenient mode of QueryMock: the calls of the methods that have neither
+// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+func (m *QueryMock) MinimockSetLenient(enabled bool) *QueryMock {
+	m.state.SetLenient(enabled)
+	return m
+}
+
+// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (m *QueryMock) MinimockLenientCalls() map[string]uint64 {
+	return m.state.LenientCalls()
+}
+
+// MinimockAssertNotCalled fails the test without stopping it if any of the QueryMock methods with the given names has been called,
+// it doesn't depend on the expectations and the functions set up for the methods
+func (m *QueryMock) MinimockAssertNotCalled(methodNames ...string) {
+	m.state.AssertNotCalled(methodNames...)
+}
+
+// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+// queued by ReturnOnce of all QueryMock methods, the functions set by Set and Inspect are kept.
+// It fails the test if any of the methods is being called
+func (m *QueryMock) MinimockReset() {
+	m.state.Reset(
+		m.RunMock.reset,
+		m.WhereMock.reset,
+	)
+}
+
+// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+func (m *QueryMock) MinimockResetAll() {
+	m.MinimockReset()
+	m.RunMock.state.Mutex.Lock()
+	m.funcRun = nil
+	m.RunMock.inspectRun = nil
+	m.RunMock.state.Mutex.Unlock()
+	m.WhereMock.state.Mutex.Lock()
+	m.funcWhere = nil
+	m.WhereMock.inspectWhere = nil
+	m.WhereMock.state.Mutex.Unlock()
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *QueryMock) MinimockFinish() {
+	m.state.Finish(m.minimockDone,
+		m.MinimockRunInspect,
+		m.MinimockWhereInspect,
+	)
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *QueryMock) MinimockWait(timeout mm_time.Duration) {
+	m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
+}
+
+func (m *QueryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockRunDone() &&
+		m.MinimockWhereDone()
+}
@@ -0,0 +1,26 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryMock_RecursiveInterface(t *testing.T) {
+	queryMock := NewQueryMock(t)
+	rowsMock := NewRowsMock(t).NextMock.Return(nil, false)
+
+	queryMock.WhereMock.Expect("id = 1").Return(queryMock).
+		RunMock.Return(rowsMock, nil)
+	defer queryMock.MinimockFinish()
+	defer rowsMock.MinimockFinish()
+
+	var q Query = queryMock
+
+	rows, err := q.Where("id = 1").Run(context.Background())
+	assert.NoError(t, err)
+
+	_, ok := rows.Next()
+	assert.False(t, ok)
+}
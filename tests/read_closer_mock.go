@@ -0,0 +1,1019 @@
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+package tests
+
+//go:generate minimock -i io.ReadCloser -o ./read_closer_mock.go
+
+import (
+	mm_io "io"
+	mm_sync "sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock"
+)
+
+// ReadCloserMock implements io.ReadCloser
+//
+// ReadCloser is the interface that groups the basic Read and Close methods.
+type ReadCloserMock struct {
+	state    minimock.MockState
+	mutex    mm_sync.RWMutex
+	delegate mm_io.ReadCloser
+
+	funcClose func() (err error)
+	CloseMock *mReadCloserMockClose
+
+	funcRead func(p []byte) (n int, err error)
+	ReadMock *mReadCloserMockRead
+}
+
+var _ mm_io.ReadCloser = (*ReadCloserMock)(nil)
+
+// NewReadCloserMock returns a mock for io.ReadCloser
+func NewReadCloserMock(t minimock.Tester) *ReadCloserMock {
+	m := &ReadCloserMock{}
+	m.state.Init(m, t, "ReadCloserMock", 0)
+
+	m.CloseMock = &mReadCloserMockClose{mock: m}
+	m.CloseMock.state.Init(&m.state, "Close")
+
+	m.ReadMock = &mReadCloserMockRead{mock: m}
+	m.ReadMock.state.Init(&m.state, "Read")
+
+	return m
+}
+
+type mReadCloserMockClose struct {
+	mock               *ReadCloserMock
+	state              minimock.MethodState
+	defaultExpectation *ReadCloserMockCloseExpectation
+	expectations       []*ReadCloserMockCloseExpectation
+	inspectClose       func()
+	called             chan struct{}
+	queued             []*ReadCloserMockCloseResults
+}
+
+// ReadCloserMockCloseExpectation specifies expectation struct of the ReadCloser.Close
+type ReadCloserMockCloseExpectation struct {
+	mock *ReadCloserMock
+
+	results *ReadCloserMockCloseResults
+	Counter uint64
+}
+
+// ReadCloserMockCloseResults contains results of the ReadCloser.Close
+type ReadCloserMockCloseResults struct {
+	R0 error
+}
+
+// Expect sets up expected params for ReadCloser.Close
+func (mmClose *mReadCloserMockClose) Expect() *mReadCloserMockClose {
+	if _, mm_func := mmClose.current(); mm_func != nil {
+		mmClose.mock.state.T().Fatalf("ReadCloserMock.Close mock is already set by Set")
+	}
+
+	mmClose.updateDefault(func(*ReadCloserMockCloseExpectation) {})
+
+	return mmClose
+}
+
+// updateDefault replaces the default expectation of ReadCloser.Close by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmClose *mReadCloserMockClose) updateDefault(update func(e *ReadCloserMockCloseExpectation)) {
+	mmClose.state.Mutex.Lock()
+	defer mmClose.state.Mutex.Unlock()
+
+	e := &ReadCloserMockCloseExpectation{mock: mmClose.mock}
+	if previous := mmClose.defaultExpectation; previous != nil {
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmClose.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for ReadCloser.Close
+func (mmClose *mReadCloserMockClose) current() (*ReadCloserMockCloseExpectation, func() (err error)) {
+	mmClose.state.Mutex.RLock()
+	defer mmClose.state.Mutex.RUnlock()
+
+	return mmClose.defaultExpectation, mmClose.mock.funcClose
+}
+
+// Return sets up results that will be returned by ReadCloser.Close
+func (mmClose *mReadCloserMockClose) Return(err error) *ReadCloserMock {
+	if _, mm_func := mmClose.current(); mm_func != nil {
+		mmClose.mock.state.T().Fatalf("ReadCloserMock.Close mock is already set by Set")
+	}
+
+	mm_results := &ReadCloserMockCloseResults{err}
+	mmClose.updateDefault(func(e *ReadCloserMockCloseExpectation) { e.results = mm_results })
+
+	return mmClose.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of ReadCloser.Close,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmClose *mReadCloserMockClose) ReturnOnce(err error) *mReadCloserMockClose {
+	mmClose.state.Mutex.Lock()
+	mmClose.queued = append(mmClose.queued, &ReadCloserMockCloseResults{err})
+	mmClose.state.Mutex.Unlock()
+	mmClose.state.PushResults()
+	return mmClose
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmClose *mReadCloserMockClose) popResults() *ReadCloserMockCloseResults {
+	mm_i, mm_ok := mmClose.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmClose.state.Mutex.RLock()
+	defer mmClose.state.Mutex.RUnlock()
+
+	return mmClose.queued[mm_i]
+}
+
+// Inspect sets up the function called with the params of every ReadCloser.Close call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmClose *mReadCloserMockClose) Inspect(f func()) *mReadCloserMockClose {
+	mmClose.state.Mutex.Lock()
+	mmClose.inspectClose = f
+	mmClose.state.Mutex.Unlock()
+	return mmClose
+}
+
+// expected returns everything set up for the ReadCloser.Close call under a single read lock: the default expectation,
+// the functions set by Set and Inspect
+func (mmClose *mReadCloserMockClose) expected() (*ReadCloserMockCloseExpectation, func() (err error), func()) {
+	mmClose.state.Mutex.RLock()
+	defer mmClose.state.Mutex.RUnlock()
+
+	return mmClose.defaultExpectation, mmClose.mock.funcClose, mmClose.inspectClose
+}
+
+// setup returns the expectations and the function set up for ReadCloser.Close to be checked when the mock is finished
+func (mmClose *mReadCloserMockClose) setup() (setup minimock.Setup) {
+	mmClose.state.Mutex.RLock()
+	defer mmClose.state.Mutex.RUnlock()
+
+	setup.Func = mmClose.mock.funcClose != nil
+	if e := mmClose.defaultExpectation; e != nil {
+		setup.Default = true
+	}
+
+	for _, e := range mmClose.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of ReadCloser.Close
+func (mmClose *mReadCloserMockClose) reset() {
+	mmClose.state.Mutex.Lock()
+	mmClose.defaultExpectation = nil
+	mmClose.expectations = nil
+	mmClose.queued = nil
+	mmClose.state.Mutex.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmClose *mReadCloserMockClose) MethodName() string {
+	return mmClose.state.Name()
+}
+
+// CallSequence returns the numbers of the ReadCloser.Close calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmClose *mReadCloserMockClose) CallSequence() []uint64 {
+	return mmClose.state.CallSequence()
+}
+
+// WaitForCalls waits until ReadCloser.Close is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmClose *mReadCloserMockClose) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmClose.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent ReadCloser.Close calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmClose *mReadCloserMockClose) Block() (release func()) {
+	return mmClose.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n ReadCloser.Close calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmClose *mReadCloserMockClose) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmClose.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n ReadCloser.Close calls are in flight at once
+func (mmClose *mReadCloserMockClose) LimitConcurrency(n int) *mReadCloserMockClose {
+	mmClose.state.SetLimit(n)
+	return mmClose
+}
+
+// CaptureCalls creates the buffered channel receiving the signal of each ReadCloser.Close call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmClose *mReadCloserMockClose) CaptureCalls(buffer int) *mReadCloserMockClose {
+	mmClose.state.History.Lock()
+	mmClose.called = make(chan struct{}, buffer)
+	mmClose.state.History.Unlock()
+	return mmClose
+}
+
+// Called returns the channel set up by CaptureCalls receiving the signal of each ReadCloser.Close call
+func (mmClose *mReadCloserMockClose) Called() <-chan struct{} {
+	mmClose.state.History.Lock()
+	defer mmClose.state.History.Unlock()
+
+	if mmClose.called == nil {
+		mmClose.mock.state.T().Fatalf("Calls of ReadCloserMock.Close aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmClose.called
+}
+
+// DroppedCalls returns the number of the ReadCloser.Close calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmClose *mReadCloserMockClose) DroppedCalls() uint64 {
+	return mmClose.state.DroppedCalls()
+}
+
+// Times sets the exact number of the ReadCloser.Close calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmClose *mReadCloserMockClose) Times(n uint64) *mReadCloserMockClose {
+	mmClose.state.SetTimes(n)
+	return mmClose
+}
+
+// Optional excludes ReadCloser.Close from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmClose *mReadCloserMockClose) Optional() *mReadCloserMockClose {
+	mmClose.state.SetOptional()
+	return mmClose
+}
+
+// Set uses given function f to mock the ReadCloser.Close method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmClose *mReadCloserMockClose) Set(f func() (err error)) *ReadCloserMock {
+	mmClose.state.Mutex.Lock()
+	defer mmClose.state.Mutex.Unlock()
+
+	if mmClose.defaultExpectation != nil {
+		mmClose.mock.state.T().Fatalf("Default expectation is already set for the ReadCloser.Close method")
+	}
+
+	if len(mmClose.expectations) > 0 {
+		mmClose.mock.state.T().Fatalf("Some expectations are already set for the ReadCloser.Close method")
+	}
+
+	mmClose.mock.funcClose = f
+	return mmClose.mock
+}
+
+// Close implements io.ReadCloser
+func (mmClose *ReadCloserMock) Close() (err error) {
+	mm_method := mmClose.CloseMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_method.state.Record()
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- struct{}{}:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcClose, mm_inspectClose := mm_method.expected()
+	if mm_inspectClose != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectClose()
+		}()
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcClose == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, nil) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcClose != nil {
+		return mm_funcClose()
+	}
+	if mm_delegate := mmClose.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Close()
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(nil)
+	return
+}
+
+// CloseAfterCounter returns a count of finished ReadCloserMock.Close invocations
+func (mmClose *ReadCloserMock) CloseAfterCounter() uint64 {
+	return mmClose.CloseMock.state.AfterCalls()
+}
+
+// CloseBeforeCounter returns a count of ReadCloserMock.Close invocations
+func (mmClose *ReadCloserMock) CloseBeforeCounter() uint64 {
+	return mmClose.CloseMock.state.BeforeCalls()
+}
+
+// CloseCallTimes returns the times of all ReadCloserMock.Close calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmClose *ReadCloserMock) CloseCallTimes() []mm_time.Time {
+	return mmClose.CloseMock.state.CallTimes()
+}
+
+// CloseMaxInFlight returns the maximum number of the ReadCloserMock.Close calls that have been in flight at once
+func (mmClose *ReadCloserMock) CloseMaxInFlight() int {
+	return mmClose.CloseMock.state.MaxInFlight()
+}
+
+// CloseUnexpectedCounter returns a count of ReadCloserMock.Close invocations made without an implementation
+func (mmClose *ReadCloserMock) CloseUnexpectedCounter() uint64 {
+	return mmClose.CloseMock.state.UnexpectedCalls()
+}
+
+// CloseNotCalled returns true if ReadCloserMock.Close hasn't been called
+func (mmClose *ReadCloserMock) CloseNotCalled() bool {
+	return mmClose.CloseMock.state.BeforeCalls() == 0
+}
+
+// CloseCallCount returns a count of ReadCloserMock.Close invocations, it's the same as CloseBeforeCounter
+func (mmClose *ReadCloserMock) CloseCallCount() uint64 {
+	return mmClose.CloseMock.state.BeforeCalls()
+}
+
+// MinimockCloseDone returns true if the count of the Close invocations corresponds
+// the number of defined expectations
+func (mmClose *ReadCloserMock) MinimockCloseDone() bool {
+	return mmClose.CloseMock.state.Done(mmClose.CloseMock.setup())
+}
+
+// MinimockCloseInspect logs each unmet expectation
+func (mmClose *ReadCloserMock) MinimockCloseInspect() {
+	mmClose.CloseMock.state.Inspect(mmClose.CloseMock.setup())
+}
+
+type mReadCloserMockRead struct {
+	mock               *ReadCloserMock
+	state              minimock.MethodState
+	defaultExpectation *ReadCloserMockReadExpectation
+	expectations       []*ReadCloserMockReadExpectation
+	inspectRead        func(p []byte)
+	compare            minimock.Comparer
+	calls              []ReadCloserMockReadParams
+	called             chan ReadCloserMockReadParams
+	queued             []*ReadCloserMockReadResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*ReadCloserMockReadResults
+}
+
+// ReadCloserMockReadExpectation specifies expectation struct of the ReadCloser.Read
+type ReadCloserMockReadExpectation struct {
+	mock     *ReadCloserMock
+	params   *ReadCloserMockReadParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *ReadCloserMockReadResults
+	Counter  uint64
+}
+
+// ReadCloserMockReadParams contains parameters of the ReadCloser.Read
+type ReadCloserMockReadParams struct {
+	P []byte
+}
+
+// ReadCloserMockReadResults contains results of the ReadCloser.Read
+type ReadCloserMockReadResults struct {
+	N   int
+	Err error
+}
+
+// Expect sets up expected params for ReadCloser.Read
+func (mmRead *mReadCloserMockRead) Expect(p []byte) *mReadCloserMockRead {
+	if _, mm_func := mmRead.current(); mm_func != nil {
+		mmRead.mock.state.T().Fatalf("ReadCloserMock.Read mock is already set by Set")
+	}
+
+	mm_params := &ReadCloserMockReadParams{p}
+	mmRead.updateDefault(func(e *ReadCloserMockReadExpectation) {
+		if e.partial {
+			mmRead.mock.state.T().Fatalf("ReadCloserMock.Read params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmRead.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmRead.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmRead
+}
+
+// updateDefault replaces the default expectation of ReadCloser.Read by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmRead *mReadCloserMockRead) updateDefault(update func(e *ReadCloserMockReadExpectation)) {
+	mmRead.state.Mutex.Lock()
+	defer mmRead.state.Mutex.Unlock()
+
+	e := &ReadCloserMockReadExpectation{mock: mmRead.mock}
+	if previous := mmRead.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmRead.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for ReadCloser.Read
+func (mmRead *mReadCloserMockRead) current() (*ReadCloserMockReadExpectation, func(p []byte) (n int, err error)) {
+	mmRead.state.Mutex.RLock()
+	defer mmRead.state.Mutex.RUnlock()
+
+	return mmRead.defaultExpectation, mmRead.mock.funcRead
+}
+
+// partialParams updates the default expectation of ReadCloser.Read by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmRead *mReadCloserMockRead) partialParams(update func(e *ReadCloserMockReadExpectation)) {
+	if _, mm_func := mmRead.current(); mm_func != nil {
+		mmRead.mock.state.T().Fatalf("ReadCloserMock.Read mock is already set by Set")
+	}
+
+	mmRead.updateDefault(func(e *ReadCloserMockReadExpectation) {
+		if e.params == nil {
+			e.params = &ReadCloserMockReadParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"P": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectPParam1 sets up the expected value of the param #1 of ReadCloser.Read,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmRead *mReadCloserMockRead) ExpectPParam1(P []byte) *mReadCloserMockRead {
+	mmRead.partialParams(func(e *ReadCloserMockReadExpectation) {
+		e.params.P = P
+		delete(e.matchers, "P")
+	})
+	return mmRead
+}
+
+// MatchPParam1 sets up the predicate matching the param #1 of ReadCloser.Read,
+// it's used instead of the value of the param set by Expect
+func (mmRead *mReadCloserMockRead) MatchPParam1(f func(got []byte) bool) *mReadCloserMockRead {
+	mm_matcher := minimock.Predicate("predicate func(got []byte) bool", func(v interface{}) bool {
+		got, _ := v.([]byte)
+		return f(got)
+	})
+	mmRead.partialParams(func(e *ReadCloserMockReadExpectation) {
+		e.matchers["P"] = mm_matcher
+	})
+	return mmRead
+}
+
+// Return sets up results that will be returned by ReadCloser.Read
+func (mmRead *mReadCloserMockRead) Return(n int, err error) *ReadCloserMock {
+	if _, mm_func := mmRead.current(); mm_func != nil {
+		mmRead.mock.state.T().Fatalf("ReadCloserMock.Read mock is already set by Set")
+	}
+
+	mm_results := &ReadCloserMockReadResults{n, err}
+	mmRead.updateDefault(func(e *ReadCloserMockReadExpectation) { e.results = mm_results })
+
+	return mmRead.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of ReadCloser.Read,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmRead *mReadCloserMockRead) ReturnOnce(n int, err error) *mReadCloserMockRead {
+	mmRead.state.Mutex.Lock()
+	mmRead.queued = append(mmRead.queued, &ReadCloserMockReadResults{n, err})
+	mmRead.state.Mutex.Unlock()
+	mmRead.state.PushResults()
+	return mmRead
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmRead *mReadCloserMockRead) popResults() *ReadCloserMockReadResults {
+	mm_i, mm_ok := mmRead.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmRead.state.Mutex.RLock()
+	defer mmRead.state.Mutex.RUnlock()
+
+	return mmRead.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of ReadCloser.Read instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmRead *mReadCloserMockRead) SetComparer(compare minimock.Comparer) *mReadCloserMockRead {
+	mmRead.state.Mutex.Lock()
+	mmRead.compare = compare
+	mmRead.state.Mutex.Unlock()
+	return mmRead
+}
+
+// whenExpectations returns the expectations of ReadCloser.Read set by When,
+// the expectations can be set while the method is called concurrently
+func (mmRead *mReadCloserMockRead) whenExpectations() []*ReadCloserMockReadExpectation {
+	mmRead.state.Mutex.RLock()
+	defer mmRead.state.Mutex.RUnlock()
+
+	return mmRead.expectations
+}
+
+// Inspect sets up the function called with the params of every ReadCloser.Read call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmRead *mReadCloserMockRead) Inspect(f func(p []byte)) *mReadCloserMockRead {
+	mmRead.state.Mutex.Lock()
+	mmRead.inspectRead = f
+	mmRead.state.Mutex.Unlock()
+	return mmRead
+}
+
+// expected returns everything set up for the ReadCloser.Read call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmRead *mReadCloserMockRead) expected() (*ReadCloserMockReadExpectation, func(p []byte) (n int, err error), func(p []byte), minimock.Comparer, []*ReadCloserMockReadExpectation, []*ReadCloserMockReadResults) {
+	mmRead.state.Mutex.RLock()
+	defer mmRead.state.Mutex.RUnlock()
+
+	return mmRead.defaultExpectation, mmRead.mock.funcRead, mmRead.inspectRead, mmRead.compare, mmRead.expectations, mmRead.whenResults
+}
+
+// setup returns the expectations and the function set up for ReadCloser.Read to be checked when the mock is finished
+func (mmRead *mReadCloserMockRead) setup() (setup minimock.Setup) {
+	mmRead.state.Mutex.RLock()
+	defer mmRead.state.Mutex.RUnlock()
+
+	setup.Func = mmRead.mock.funcRead != nil
+	if e := mmRead.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmRead.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of ReadCloser.Read, the params of the calls are removed from the history as well
+func (mmRead *mReadCloserMockRead) reset() {
+	mmRead.state.Mutex.Lock()
+	mmRead.defaultExpectation = nil
+	mmRead.expectations = nil
+	mmRead.queued = nil
+	mmRead.whenResults = nil
+	mmRead.state.Mutex.Unlock()
+
+	mmRead.state.History.Lock()
+	mmRead.calls = nil
+	mmRead.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmRead *mReadCloserMockRead) MethodName() string {
+	return mmRead.state.Name()
+}
+
+// CallSequence returns the numbers of the ReadCloser.Read calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmRead *mReadCloserMockRead) CallSequence() []uint64 {
+	return mmRead.state.CallSequence()
+}
+
+// WaitForCalls waits until ReadCloser.Read is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmRead *mReadCloserMockRead) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmRead.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent ReadCloser.Read calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmRead *mReadCloserMockRead) Block() (release func()) {
+	return mmRead.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n ReadCloser.Read calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmRead *mReadCloserMockRead) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmRead.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n ReadCloser.Read calls are in flight at once
+func (mmRead *mReadCloserMockRead) LimitConcurrency(n int) *mReadCloserMockRead {
+	mmRead.state.SetLimit(n)
+	return mmRead
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each ReadCloser.Read call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmRead *mReadCloserMockRead) CaptureCalls(buffer int) *mReadCloserMockRead {
+	mmRead.state.History.Lock()
+	mmRead.called = make(chan ReadCloserMockReadParams, buffer)
+	mmRead.state.History.Unlock()
+	return mmRead
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each ReadCloser.Read call
+func (mmRead *mReadCloserMockRead) Called() <-chan ReadCloserMockReadParams {
+	mmRead.state.History.Lock()
+	defer mmRead.state.History.Unlock()
+
+	if mmRead.called == nil {
+		mmRead.mock.state.T().Fatalf("Calls of ReadCloserMock.Read aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmRead.called
+}
+
+// DroppedCalls returns the number of the ReadCloser.Read calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmRead *mReadCloserMockRead) DroppedCalls() uint64 {
+	return mmRead.state.DroppedCalls()
+}
+
+// Times sets the exact number of the ReadCloser.Read calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmRead *mReadCloserMockRead) Times(n uint64) *mReadCloserMockRead {
+	mmRead.state.SetTimes(n)
+	return mmRead
+}
+
+// Optional excludes ReadCloser.Read from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmRead *mReadCloserMockRead) Optional() *mReadCloserMockRead {
+	mmRead.state.SetOptional()
+	return mmRead
+}
+
+// Set uses given function f to mock the ReadCloser.Read method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmRead *mReadCloserMockRead) Set(f func(p []byte) (n int, err error)) *ReadCloserMock {
+	mmRead.state.Mutex.Lock()
+	defer mmRead.state.Mutex.Unlock()
+
+	if mmRead.defaultExpectation != nil {
+		mmRead.mock.state.T().Fatalf("Default expectation is already set for the ReadCloser.Read method")
+	}
+
+	if len(mmRead.expectations) > 0 {
+		mmRead.mock.state.T().Fatalf("Some expectations are already set for the ReadCloser.Read method")
+	}
+
+	mmRead.mock.funcRead = f
+	return mmRead.mock
+}
+
+// When sets expectation for the ReadCloser.Read which will trigger the result defined by the following
+// Then helper
+func (mmRead *mReadCloserMockRead) When(p []byte) *ReadCloserMockReadExpectation {
+	if _, mm_func := mmRead.current(); mm_func != nil {
+		mmRead.mock.state.T().Fatalf("ReadCloserMock.Read mock is already set by Set")
+	}
+
+	expectation := &ReadCloserMockReadExpectation{
+		mock:   mmRead.mock,
+		params: &ReadCloserMockReadParams{p},
+	}
+	mmRead.state.Mutex.Lock()
+	mmRead.expectations = append(mmRead.expectations, expectation)
+	mmRead.whenResults = append(mmRead.whenResults, nil)
+	mmRead.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up ReadCloser.Read return parameters for the expectation previously defined by the When method
+func (mmExpectation *ReadCloserMockReadExpectation) Then(n int, err error) *ReadCloserMock {
+	mm_handle := mmExpectation.mock.ReadMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &ReadCloserMockReadResults{n, err}
+	mm_results := make([]*ReadCloserMockReadResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Read implements io.ReadCloser
+func (mmRead *ReadCloserMock) Read(p []byte) (n int, err error) {
+	mm_method := mmRead.ReadMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := ReadCloserMockReadParams{p}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcRead, mm_inspectRead, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectRead != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectRead(p)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).N, (*mm_results).Err
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).N, (*mm_results).Err
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcRead == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).N, (*mm_expectation.results).Err
+	}
+	if mm_funcRead != nil {
+		return mm_funcRead(p)
+	}
+	if mm_delegate := mmRead.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Read(p)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, p)
+	return
+}
+
+// ReadAfterCounter returns a count of finished ReadCloserMock.Read invocations
+func (mmRead *ReadCloserMock) ReadAfterCounter() uint64 {
+	return mmRead.ReadMock.state.AfterCalls()
+}
+
+// ReadBeforeCounter returns a count of ReadCloserMock.Read invocations
+func (mmRead *ReadCloserMock) ReadBeforeCounter() uint64 {
+	return mmRead.ReadMock.state.BeforeCalls()
+}
+
+// ReadCalls returns the params of all ReadCloserMock.Read calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmRead *ReadCloserMock) ReadCalls() []ReadCloserMockReadParams {
+	mmRead.ReadMock.state.History.Lock()
+	defer mmRead.ReadMock.state.History.Unlock()
+
+	calls := make([]ReadCloserMockReadParams, len(mmRead.ReadMock.calls))
+	copy(calls, mmRead.ReadMock.calls)
+	return calls
+}
+
+// ReadLastParams returns the params of the latest ReadCloserMock.Read call and false if there were no calls
+func (mmRead *ReadCloserMock) ReadLastParams() (params ReadCloserMockReadParams, ok bool) {
+	mmRead.ReadMock.state.History.Lock()
+	defer mmRead.ReadMock.state.History.Unlock()
+
+	if n := len(mmRead.ReadMock.calls); n > 0 {
+		return mmRead.ReadMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// ReadCallTimes returns the times of all ReadCloserMock.Read calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmRead *ReadCloserMock) ReadCallTimes() []mm_time.Time {
+	return mmRead.ReadMock.state.CallTimes()
+}
+
+// ReadMaxInFlight returns the maximum number of the ReadCloserMock.Read calls that have been in flight at once
+func (mmRead *ReadCloserMock) ReadMaxInFlight() int {
+	return mmRead.ReadMock.state.MaxInFlight()
+}
+
+// ReadUnexpectedCounter returns a count of ReadCloserMock.Read invocations made without an implementation
+func (mmRead *ReadCloserMock) ReadUnexpectedCounter() uint64 {
+	return mmRead.ReadMock.state.UnexpectedCalls()
+}
+
+// ReadNotCalled returns true if ReadCloserMock.Read hasn't been called
+func (mmRead *ReadCloserMock) ReadNotCalled() bool {
+	return mmRead.ReadMock.state.BeforeCalls() == 0
+}
+
+// ReadCallCount returns a count of ReadCloserMock.Read invocations, it's the same as ReadBeforeCounter
+func (mmRead *ReadCloserMock) ReadCallCount() uint64 {
+	return mmRead.ReadMock.state.BeforeCalls()
+}
+
+// MinimockReadDone returns true if the count of the Read invocations corresponds
+// the number of defined expectations
+func (mmRead *ReadCloserMock) MinimockReadDone() bool {
+	return mmRead.ReadMock.state.Done(mmRead.ReadMock.setup())
+}
+
+// MinimockReadInspect logs each unmet expectation
+func (mmRead *ReadCloserMock) MinimockReadInspect() {
+	mmRead.ReadMock.state.Inspect(mmRead.ReadMock.setup())
+}
+
+// MinimockSetComparer sets up the function comparing the expected and the actual params of all ReadCloserMock methods instead of minimock.Equal,
+// the params matched by the matchers aren't compared
+func (m *ReadCloserMock) MinimockSetComparer(compare minimock.Comparer) *ReadCloserMock {
+	m.state.SetComparer(compare)
+	return m
+}
+
+// MinimockSetSequence sets up the sequence numbering the ReadCloserMock calls, the mocks sharing the sequence
+// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+func (m *ReadCloserMock) MinimockSetSequence(sequence *minimock.Sequence) *ReadCloserMock {
+	m.state.SetSequence(sequence)
+	return m
+}
+
+// MinimockSetClock sets up the function returning the current time for the history of ReadCloserMock calls instead of time.Now
+func (m *ReadCloserMock) MinimockSetClock(clock func() mm_time.Time) *ReadCloserMock {
+	m.state.SetClock(clock)
+	return m
+}
+
+// MinimockSetAutoFinish enables or disables the check of ReadCloserMock made by the Cleanup of the tester passed to NewReadCloserMock,
+// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+func (m *ReadCloserMock) MinimockSetAutoFinish(enabled bool) *ReadCloserMock {
+	m.state.SetAutoFinish(enabled)
+	return m
+}
+
+// MinimockSetDelegate sets up the implementation the calls of the ReadCloserMock methods are forwarded to when the methods
+// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+func (m *ReadCloserMock) MinimockSetDelegate(impl mm_io.ReadCloser) *ReadCloserMock {
+	m.mutex.Lock()
+	m.delegate = impl
+	m.mutex.Unlock()
+	return m
+}
+
+func (m *ReadCloserMock) minimockDelegate() mm_io.ReadCloser {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.delegate
+}
+
+// MinimockSetLenient enables or disables the lenient mode of ReadCloserMock: the calls of the methods that have neither
+// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+func (m *ReadCloserMock) MinimockSetLenient(enabled bool) *ReadCloserMock {
+	m.state.SetLenient(enabled)
+	return m
+}
+
+// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (m *ReadCloserMock) MinimockLenientCalls() map[string]uint64 {
+	return m.state.LenientCalls()
+}
+
+// MinimockAssertNotCalled fails the test without stopping it if any of the ReadCloserMock methods with the given names has been called,
+// it doesn't depend on the expectations and the functions set up for the methods
+func (m *ReadCloserMock) MinimockAssertNotCalled(methodNames ...string) {
+	m.state.AssertNotCalled(methodNames...)
+}
+
+// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+// queued by ReturnOnce of all ReadCloserMock methods, the functions set by Set and Inspect are kept.
+// It fails the test if any of the methods is being called
+func (m *ReadCloserMock) MinimockReset() {
+	m.state.Reset(
+		m.CloseMock.reset,
+		m.ReadMock.reset,
+	)
+}
+
+// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+func (m *ReadCloserMock) MinimockResetAll() {
+	m.MinimockReset()
+	m.CloseMock.state.Mutex.Lock()
+	m.funcClose = nil
+	m.CloseMock.inspectClose = nil
+	m.CloseMock.state.Mutex.Unlock()
+	m.ReadMock.state.Mutex.Lock()
+	m.funcRead = nil
+	m.ReadMock.inspectRead = nil
+	m.ReadMock.state.Mutex.Unlock()
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ReadCloserMock) MinimockFinish() {
+	m.state.Finish(m.minimockDone,
+		m.MinimockCloseInspect,
+		m.MinimockReadInspect,
+	)
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ReadCloserMock) MinimockWait(timeout mm_time.Duration) {
+	m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
+}
+
+func (m *ReadCloserMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCloseDone() &&
+		m.MinimockReadDone()
+}
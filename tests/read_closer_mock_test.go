@@ -0,0 +1,306 @@
+package tests
+
+import (
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCloserMock_EmbeddedStdlibInterfaces(t *testing.T) {
+	readCloserMock := NewReadCloserMock(t).
+		ReadMock.Return(0, io.EOF).
+		CloseMock.Return(nil)
+	defer readCloserMock.MinimockFinish()
+
+	var rc io.ReadCloser = readCloserMock
+
+	_, err := rc.Read(nil)
+	assert.Equal(t, io.EOF, err)
+	assert.NoError(t, rc.Close())
+}
+
+func TestReadCloserMock_Optional(t *testing.T) {
+	readCloserMock := NewReadCloserMock(t).
+		ReadMock.Return(0, io.EOF).
+		CloseMock.Optional().Return(nil)
+	defer readCloserMock.MinimockFinish()
+
+	_, err := readCloserMock.Read(nil)
+	assert.Equal(t, io.EOF, err)
+	assert.EqualValues(t, 0, readCloserMock.CloseAfterCounter())
+}
+
+func TestReadCloserMock_MinimockFinish_WithOptional(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	var errors []string
+	tester.ErrorMock.Set(func(args ...interface{}) {
+		errors = append(errors, fmt.Sprint(args...))
+	})
+	tester.ErrorfMock.Set(func(format string, args ...interface{}) {
+		errors = append(errors, fmt.Sprintf(format, args...))
+	})
+	tester.FailNowMock.Expect().Return()
+
+	readCloserMock := NewReadCloserMock(tester).
+		ReadMock.Return(0, io.EOF).
+		CloseMock.Optional().Return(nil)
+
+	readCloserMock.Close()
+	readCloserMock.MinimockFinish()
+
+	assert.Equal(t, []string{
+		"Expectations of ReadCloserMock.Close are optional and aren't checked, it's called 1 times",
+		"Expected call to ReadCloserMock.Read",
+	}, errors)
+}
+
+func TestReadCloserMock_CallTimes(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+
+	readCloserMock := NewReadCloserMock(t).
+		MinimockSetClock(func() time.Time { return now }).
+		CloseMock.Return(nil)
+	defer readCloserMock.MinimockFinish()
+
+	readCloserMock.Close()
+	now = now.Add(time.Second)
+	readCloserMock.Close()
+
+	assert.Equal(t, []time.Time{start, start.Add(time.Second)}, readCloserMock.CloseCallTimes())
+	assert.Empty(t, readCloserMock.ReadCallTimes())
+}
+
+func TestReadCloserMock_ClockCallsMock(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	readCloserMock := NewReadCloserMock(t).ReadMock.Return(0, nil)
+	defer readCloserMock.MinimockFinish()
+
+	//the clock is called before the history of the call is locked, so it can look into the history
+	readCloserMock.MinimockSetClock(func() time.Time {
+		return start.Add(time.Duration(len(readCloserMock.ReadCalls())+len(readCloserMock.ReadCallTimes())) * time.Second)
+	})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		readCloserMock.Read(nil)
+		readCloserMock.Read(nil)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("ReadCloserMock.Read is deadlocked by the clock calling the mock")
+	}
+
+	assert.Equal(t, []time.Time{start, start.Add(2 * time.Second)}, readCloserMock.ReadCallTimes())
+}
+
+func TestReadCloserMock_CallTimesWithoutClock(t *testing.T) {
+	readCloserMock := NewReadCloserMock(t).ReadMock.Return(0, nil)
+	defer readCloserMock.MinimockFinish()
+
+	before := time.Now()
+	readCloserMock.Read(nil)
+
+	times := readCloserMock.ReadCallTimes()
+	require.Len(t, times, 1)
+	assert.False(t, times[0].Before(before))
+	assert.False(t, times[0].After(time.Now()))
+}
+
+func TestReadCloserMock_NotCalled(t *testing.T) {
+	readCloserMock := NewReadCloserMock(t).ReadMock.Return(0, io.EOF)
+
+	readCloserMock.Read(nil)
+
+	assert.False(t, readCloserMock.ReadNotCalled())
+	assert.True(t, readCloserMock.CloseNotCalled())
+	readCloserMock.MinimockAssertNotCalled("Close")
+}
+
+func TestReadCloserMock_MinimockAssertNotCalled(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	var messages []string
+	tester.ErrorfMock.Set(func(format string, args ...interface{}) {
+		messages = append(messages, fmt.Sprintf(format, args...))
+	})
+
+	readCloserMock := NewReadCloserMock(tester).ReadMock.Return(0, io.EOF)
+	readCloserMock.Read(nil)
+	readCloserMock.Read(nil)
+
+	readCloserMock.MinimockAssertNotCalled("Close", "Read", "Write")
+	assert.Equal(t, []string{
+		"Expected ReadCloserMock.Read not to be called, but it's called 2 times",
+		"ReadCloserMock has no method Write",
+	}, messages)
+}
+
+func TestReadCloserMock_InOrder(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	readCloserMock := NewReadCloserMock(mc).ReadMock.Return(0, io.EOF).CloseMock.Return(nil)
+	formatterMock := NewFormatterMock(mc).FormatMock.Return("")
+
+	readCloserMock.Read(nil)
+	formatterMock.Format("")
+	readCloserMock.Close()
+
+	//the sequence of the calls is shared by the mocks created with the same controller
+	assert.True(t, minimock.InOrder(t, readCloserMock.ReadMock, formatterMock.FormatMock, readCloserMock.CloseMock))
+	assert.Equal(t, []uint64{2}, formatterMock.FormatMock.CallSequence())
+
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	tester.ErrorMock.Expect("Expected ReadCloserMock.Read to be called after ReadCloserMock.Close, but ReadCloserMock.Read call #1 is made before ReadCloserMock.Close call #3, " +
+		"the calls are made in the order: ReadCloserMock.Read #1, ReadCloserMock.Close #3").Return()
+	assert.False(t, minimock.InOrder(tester, readCloserMock.CloseMock, readCloserMock.ReadMock))
+}
+
+func TestReadCloserMock_DetachedSequence(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	readCloserMock := NewReadCloserMock(mc).CloseMock.Return(nil)
+	formatterMock := NewFormatterMock(mc).FormatMock.Return("").MinimockSetSequence(nil)
+
+	formatterMock.Format("")
+	readCloserMock.Close()
+
+	//the calls of the detached mock aren't numbered, the other mocks keep numbering their calls
+	assert.Empty(t, formatterMock.FormatMock.CallSequence())
+	assert.Len(t, formatterMock.FormatCallTimes(), 1)
+	assert.Equal(t, []uint64{1}, readCloserMock.CloseMock.CallSequence())
+}
+
+func TestReadCloserMock_ExpectOrder(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	readCloserMock := NewReadCloserMock(mc).ReadMock.Return(0, io.EOF).CloseMock.Return(nil)
+	formatterMock := NewFormatterMock(mc).FormatMock.Return("")
+
+	//the order is checked by Finish, the calls of the unordered group can be made in any order
+	mc.ExpectOrder(readCloserMock.ReadMock, minimock.Unordered(formatterMock.FormatMock, readCloserMock.CloseMock))
+
+	readCloserMock.Read(nil)
+	readCloserMock.Close()
+	formatterMock.Format("")
+}
+
+func TestReadCloserMock_CaptureCalls(t *testing.T) {
+	readCloserMock := NewReadCloserMock(t).CloseMock.CaptureCalls(1).Return(nil)
+
+	go readCloserMock.Close()
+	<-readCloserMock.CloseMock.Called()
+	assert.Equal(t, uint64(0), readCloserMock.CloseMock.DroppedCalls())
+}
+
+type logTester struct {
+	*TesterMock
+	logs []string
+}
+
+func (t *logTester) Logf(format string, args ...interface{}) {
+	t.logs = append(t.logs, fmt.Sprintf(format, args...))
+}
+
+func TestReadCloserMock_Lenient(t *testing.T) {
+	tester := &logTester{TesterMock: NewTesterMock(t)}
+	defer tester.MinimockFinish()
+
+	readCloserMock := NewReadCloserMock(tester).MinimockSetLenient(true).ReadMock.Return(3, nil)
+
+	n, err := readCloserMock.Read(make([]byte, 3))
+	assert.Equal(t, 3, n)
+	assert.NoError(t, err)
+
+	//unconfigured Close returns zero values instead of failing the test
+	assert.NoError(t, readCloserMock.Close())
+	assert.NoError(t, readCloserMock.Close())
+
+	assert.Equal(t, uint64(2), readCloserMock.CloseAfterCounter())
+	assert.Equal(t, map[string]uint64{"Close": 2}, readCloserMock.MinimockLenientCalls())
+
+	readCloserMock.MinimockFinish()
+	assert.Equal(t, []string{"ReadCloserMock.Close is called 2 times in the lenient mode and returned zero values"}, tester.logs)
+}
+
+func TestReadCloserMock_LenientDisabled(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	tester.FatalfMock.Expect("Unexpected call to ReadCloserMock.Close.").Return()
+
+	readCloserMock := NewReadCloserMock(tester).MinimockSetLenient(true).MinimockSetLenient(false)
+	readCloserMock.Close()
+
+	assert.Empty(t, readCloserMock.MinimockLenientCalls())
+}
+
+type closeCounter struct {
+	io.Reader
+	closed int
+}
+
+func (c *closeCounter) Close() error {
+	c.closed++
+	return nil
+}
+
+func TestReadCloserMock_Delegate(t *testing.T) {
+	impl := &closeCounter{}
+	readCloserMock := NewReadCloserMock(t).MinimockSetDelegate(impl).ReadMock.Return(3, nil)
+
+	n, err := readCloserMock.Read(make([]byte, 3))
+	assert.Equal(t, 3, n)
+	assert.NoError(t, err)
+
+	//Close isn't mocked, so the call is forwarded to the delegate and counted by the mock
+	assert.NoError(t, readCloserMock.Close())
+	assert.Equal(t, 1, impl.closed)
+	assert.Equal(t, uint64(1), readCloserMock.CloseAfterCounter())
+
+	//the methods covered by the delegate only aren't expected to be called
+	assert.True(t, readCloserMock.MinimockCloseDone())
+	readCloserMock.MinimockFinish()
+}
+
+func TestReadCloserMock_NilDelegate(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	tester.FatalfMock.Expect("Unexpected call to ReadCloserMock.Close.").Return()
+
+	readCloserMock := NewReadCloserMock(tester).MinimockSetDelegate(&closeCounter{}).MinimockSetDelegate(nil)
+	readCloserMock.Close()
+}
+
+func TestReadCloserMock_OrderWithTestingT(t *testing.T) {
+	readCloserMock := NewReadCloserMock(t).ReadMock.Return(0, nil).CloseMock.Return(nil)
+	formatterMock := NewFormatterMock(t).FormatMock.Return("")
+
+	formatterMock.Format("")
+	readCloserMock.Read(nil)
+	readCloserMock.Close()
+
+	//the mocks created with the same *testing.T share the sequence, so the order is checked across them
+	assert.NoError(t, minimock.CheckOrder(minimock.Ordered(formatterMock.FormatMock, readCloserMock.ReadMock, readCloserMock.CloseMock)))
+	assert.EqualError(t, minimock.CheckOrder(minimock.Ordered(readCloserMock.ReadMock, formatterMock.FormatMock)),
+		"Expected FormatterMock.Format to be called after ReadCloserMock.Read, but FormatterMock.Format call #1 is made before ReadCloserMock.Read call #2, "+
+			"the calls are made in the order: FormatterMock.Format #1, ReadCloserMock.Read #2")
+}
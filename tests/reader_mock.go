@@ -0,0 +1,668 @@
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+package tests
+
+//go:generate minimock -i github.com/gojuno/minimock/tests.reader -o ./reader_mock.go -t readerMock
+
+import (
+	mm_sync "sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock"
+)
+
+// readerMock implements reader
+//
+// reader type is used to test mocks of the unexported named types which underlying type is an interface from another package
+type readerMock struct {
+	state    minimock.MockState
+	mutex    mm_sync.RWMutex
+	delegate reader
+
+	funcRead func(p []byte) (n int, err error)
+	ReadMock *mreaderMockRead
+}
+
+var _ reader = (*readerMock)(nil)
+
+// newReaderMock returns a mock for reader
+func newReaderMock(t minimock.Tester) *readerMock {
+	m := &readerMock{}
+	m.state.Init(m, t, "readerMock", 0)
+
+	m.ReadMock = &mreaderMockRead{mock: m}
+	m.ReadMock.state.Init(&m.state, "Read")
+
+	return m
+}
+
+type mreaderMockRead struct {
+	mock               *readerMock
+	state              minimock.MethodState
+	defaultExpectation *readerMockReadExpectation
+	expectations       []*readerMockReadExpectation
+	inspectRead        func(p []byte)
+	compare            minimock.Comparer
+	calls              []readerMockReadParams
+	called             chan readerMockReadParams
+	queued             []*readerMockReadResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*readerMockReadResults
+}
+
+// readerMockReadExpectation specifies expectation struct of the reader.Read
+type readerMockReadExpectation struct {
+	mock     *readerMock
+	params   *readerMockReadParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *readerMockReadResults
+	Counter  uint64
+}
+
+// readerMockReadParams contains parameters of the reader.Read
+type readerMockReadParams struct {
+	P []byte
+}
+
+// readerMockReadResults contains results of the reader.Read
+type readerMockReadResults struct {
+	N   int
+	Err error
+}
+
+// Expect sets up expected params for reader.Read
+func (mmRead *mreaderMockRead) Expect(p []byte) *mreaderMockRead {
+	if _, mm_func := mmRead.current(); mm_func != nil {
+		mmRead.mock.state.T().Fatalf("readerMock.Read mock is already set by Set")
+	}
+
+	mm_params := &readerMockReadParams{p}
+	mmRead.updateDefault(func(e *readerMockReadExpectation) {
+		if e.partial {
+			mmRead.mock.state.T().Fatalf("readerMock.Read params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmRead.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmRead.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmRead
+}
+
+// updateDefault replaces the default expectation of reader.Read by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmRead *mreaderMockRead) updateDefault(update func(e *readerMockReadExpectation)) {
+	mmRead.state.Mutex.Lock()
+	defer mmRead.state.Mutex.Unlock()
+
+	e := &readerMockReadExpectation{mock: mmRead.mock}
+	if previous := mmRead.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmRead.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for reader.Read
+func (mmRead *mreaderMockRead) current() (*readerMockReadExpectation, func(p []byte) (n int, err error)) {
+	mmRead.state.Mutex.RLock()
+	defer mmRead.state.Mutex.RUnlock()
+
+	return mmRead.defaultExpectation, mmRead.mock.funcRead
+}
+
+// partialParams updates the default expectation of reader.Read by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmRead *mreaderMockRead) partialParams(update func(e *readerMockReadExpectation)) {
+	if _, mm_func := mmRead.current(); mm_func != nil {
+		mmRead.mock.state.T().Fatalf("readerMock.Read mock is already set by Set")
+	}
+
+	mmRead.updateDefault(func(e *readerMockReadExpectation) {
+		if e.params == nil {
+			e.params = &readerMockReadParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"P": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectPParam1 sets up the expected value of the param #1 of reader.Read,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmRead *mreaderMockRead) ExpectPParam1(P []byte) *mreaderMockRead {
+	mmRead.partialParams(func(e *readerMockReadExpectation) {
+		e.params.P = P
+		delete(e.matchers, "P")
+	})
+	return mmRead
+}
+
+// MatchPParam1 sets up the predicate matching the param #1 of reader.Read,
+// it's used instead of the value of the param set by Expect
+func (mmRead *mreaderMockRead) MatchPParam1(f func(got []byte) bool) *mreaderMockRead {
+	mm_matcher := minimock.Predicate("predicate func(got []byte) bool", func(v interface{}) bool {
+		got, _ := v.([]byte)
+		return f(got)
+	})
+	mmRead.partialParams(func(e *readerMockReadExpectation) {
+		e.matchers["P"] = mm_matcher
+	})
+	return mmRead
+}
+
+// Return sets up results that will be returned by reader.Read
+func (mmRead *mreaderMockRead) Return(n int, err error) *readerMock {
+	if _, mm_func := mmRead.current(); mm_func != nil {
+		mmRead.mock.state.T().Fatalf("readerMock.Read mock is already set by Set")
+	}
+
+	mm_results := &readerMockReadResults{n, err}
+	mmRead.updateDefault(func(e *readerMockReadExpectation) { e.results = mm_results })
+
+	return mmRead.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of reader.Read,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmRead *mreaderMockRead) ReturnOnce(n int, err error) *mreaderMockRead {
+	mmRead.state.Mutex.Lock()
+	mmRead.queued = append(mmRead.queued, &readerMockReadResults{n, err})
+	mmRead.state.Mutex.Unlock()
+	mmRead.state.PushResults()
+	return mmRead
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmRead *mreaderMockRead) popResults() *readerMockReadResults {
+	mm_i, mm_ok := mmRead.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmRead.state.Mutex.RLock()
+	defer mmRead.state.Mutex.RUnlock()
+
+	return mmRead.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of reader.Read instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmRead *mreaderMockRead) SetComparer(compare minimock.Comparer) *mreaderMockRead {
+	mmRead.state.Mutex.Lock()
+	mmRead.compare = compare
+	mmRead.state.Mutex.Unlock()
+	return mmRead
+}
+
+// whenExpectations returns the expectations of reader.Read set by When,
+// the expectations can be set while the method is called concurrently
+func (mmRead *mreaderMockRead) whenExpectations() []*readerMockReadExpectation {
+	mmRead.state.Mutex.RLock()
+	defer mmRead.state.Mutex.RUnlock()
+
+	return mmRead.expectations
+}
+
+// Inspect sets up the function called with the params of every reader.Read call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmRead *mreaderMockRead) Inspect(f func(p []byte)) *mreaderMockRead {
+	mmRead.state.Mutex.Lock()
+	mmRead.inspectRead = f
+	mmRead.state.Mutex.Unlock()
+	return mmRead
+}
+
+// expected returns everything set up for the reader.Read call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmRead *mreaderMockRead) expected() (*readerMockReadExpectation, func(p []byte) (n int, err error), func(p []byte), minimock.Comparer, []*readerMockReadExpectation, []*readerMockReadResults) {
+	mmRead.state.Mutex.RLock()
+	defer mmRead.state.Mutex.RUnlock()
+
+	return mmRead.defaultExpectation, mmRead.mock.funcRead, mmRead.inspectRead, mmRead.compare, mmRead.expectations, mmRead.whenResults
+}
+
+// setup returns the expectations and the function set up for reader.Read to be checked when the mock is finished
+func (mmRead *mreaderMockRead) setup() (setup minimock.Setup) {
+	mmRead.state.Mutex.RLock()
+	defer mmRead.state.Mutex.RUnlock()
+
+	setup.Func = mmRead.mock.funcRead != nil
+	if e := mmRead.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmRead.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of reader.Read, the params of the calls are removed from the history as well
+func (mmRead *mreaderMockRead) reset() {
+	mmRead.state.Mutex.Lock()
+	mmRead.defaultExpectation = nil
+	mmRead.expectations = nil
+	mmRead.queued = nil
+	mmRead.whenResults = nil
+	mmRead.state.Mutex.Unlock()
+
+	mmRead.state.History.Lock()
+	mmRead.calls = nil
+	mmRead.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmRead *mreaderMockRead) MethodName() string {
+	return mmRead.state.Name()
+}
+
+// CallSequence returns the numbers of the reader.Read calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmRead *mreaderMockRead) CallSequence() []uint64 {
+	return mmRead.state.CallSequence()
+}
+
+// WaitForCalls waits until reader.Read is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmRead *mreaderMockRead) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmRead.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent reader.Read calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmRead *mreaderMockRead) Block() (release func()) {
+	return mmRead.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n reader.Read calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmRead *mreaderMockRead) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmRead.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n reader.Read calls are in flight at once
+func (mmRead *mreaderMockRead) LimitConcurrency(n int) *mreaderMockRead {
+	mmRead.state.SetLimit(n)
+	return mmRead
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each reader.Read call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmRead *mreaderMockRead) CaptureCalls(buffer int) *mreaderMockRead {
+	mmRead.state.History.Lock()
+	mmRead.called = make(chan readerMockReadParams, buffer)
+	mmRead.state.History.Unlock()
+	return mmRead
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each reader.Read call
+func (mmRead *mreaderMockRead) Called() <-chan readerMockReadParams {
+	mmRead.state.History.Lock()
+	defer mmRead.state.History.Unlock()
+
+	if mmRead.called == nil {
+		mmRead.mock.state.T().Fatalf("Calls of readerMock.Read aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmRead.called
+}
+
+// DroppedCalls returns the number of the reader.Read calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmRead *mreaderMockRead) DroppedCalls() uint64 {
+	return mmRead.state.DroppedCalls()
+}
+
+// Times sets the exact number of the reader.Read calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmRead *mreaderMockRead) Times(n uint64) *mreaderMockRead {
+	mmRead.state.SetTimes(n)
+	return mmRead
+}
+
+// Optional excludes reader.Read from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmRead *mreaderMockRead) Optional() *mreaderMockRead {
+	mmRead.state.SetOptional()
+	return mmRead
+}
+
+// Set uses given function f to mock the reader.Read method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmRead *mreaderMockRead) Set(f func(p []byte) (n int, err error)) *readerMock {
+	mmRead.state.Mutex.Lock()
+	defer mmRead.state.Mutex.Unlock()
+
+	if mmRead.defaultExpectation != nil {
+		mmRead.mock.state.T().Fatalf("Default expectation is already set for the reader.Read method")
+	}
+
+	if len(mmRead.expectations) > 0 {
+		mmRead.mock.state.T().Fatalf("Some expectations are already set for the reader.Read method")
+	}
+
+	mmRead.mock.funcRead = f
+	return mmRead.mock
+}
+
+// When sets expectation for the reader.Read which will trigger the result defined by the following
+// Then helper
+func (mmRead *mreaderMockRead) When(p []byte) *readerMockReadExpectation {
+	if _, mm_func := mmRead.current(); mm_func != nil {
+		mmRead.mock.state.T().Fatalf("readerMock.Read mock is already set by Set")
+	}
+
+	expectation := &readerMockReadExpectation{
+		mock:   mmRead.mock,
+		params: &readerMockReadParams{p},
+	}
+	mmRead.state.Mutex.Lock()
+	mmRead.expectations = append(mmRead.expectations, expectation)
+	mmRead.whenResults = append(mmRead.whenResults, nil)
+	mmRead.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up reader.Read return parameters for the expectation previously defined by the When method
+func (mmExpectation *readerMockReadExpectation) Then(n int, err error) *readerMock {
+	mm_handle := mmExpectation.mock.ReadMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &readerMockReadResults{n, err}
+	mm_results := make([]*readerMockReadResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Read implements reader
+func (mmRead *readerMock) Read(p []byte) (n int, err error) {
+	mm_method := mmRead.ReadMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := readerMockReadParams{p}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcRead, mm_inspectRead, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectRead != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectRead(p)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).N, (*mm_results).Err
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).N, (*mm_results).Err
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcRead == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).N, (*mm_expectation.results).Err
+	}
+	if mm_funcRead != nil {
+		return mm_funcRead(p)
+	}
+	if mm_delegate := mmRead.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Read(p)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, p)
+	return
+}
+
+// ReadAfterCounter returns a count of finished readerMock.Read invocations
+func (mmRead *readerMock) ReadAfterCounter() uint64 {
+	return mmRead.ReadMock.state.AfterCalls()
+}
+
+// ReadBeforeCounter returns a count of readerMock.Read invocations
+func (mmRead *readerMock) ReadBeforeCounter() uint64 {
+	return mmRead.ReadMock.state.BeforeCalls()
+}
+
+// ReadCalls returns the params of all readerMock.Read calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmRead *readerMock) ReadCalls() []readerMockReadParams {
+	mmRead.ReadMock.state.History.Lock()
+	defer mmRead.ReadMock.state.History.Unlock()
+
+	calls := make([]readerMockReadParams, len(mmRead.ReadMock.calls))
+	copy(calls, mmRead.ReadMock.calls)
+	return calls
+}
+
+// ReadLastParams returns the params of the latest readerMock.Read call and false if there were no calls
+func (mmRead *readerMock) ReadLastParams() (params readerMockReadParams, ok bool) {
+	mmRead.ReadMock.state.History.Lock()
+	defer mmRead.ReadMock.state.History.Unlock()
+
+	if n := len(mmRead.ReadMock.calls); n > 0 {
+		return mmRead.ReadMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// ReadCallTimes returns the times of all readerMock.Read calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmRead *readerMock) ReadCallTimes() []mm_time.Time {
+	return mmRead.ReadMock.state.CallTimes()
+}
+
+// ReadMaxInFlight returns the maximum number of the readerMock.Read calls that have been in flight at once
+func (mmRead *readerMock) ReadMaxInFlight() int {
+	return mmRead.ReadMock.state.MaxInFlight()
+}
+
+// ReadUnexpectedCounter returns a count of readerMock.Read invocations made without an implementation
+func (mmRead *readerMock) ReadUnexpectedCounter() uint64 {
+	return mmRead.ReadMock.state.UnexpectedCalls()
+}
+
+// ReadNotCalled returns true if readerMock.Read hasn't been called
+func (mmRead *readerMock) ReadNotCalled() bool {
+	return mmRead.ReadMock.state.BeforeCalls() == 0
+}
+
+// ReadCallCount returns a count of readerMock.Read invocations, it's the same as ReadBeforeCounter
+func (mmRead *readerMock) ReadCallCount() uint64 {
+	return mmRead.ReadMock.state.BeforeCalls()
+}
+
+// MinimockReadDone returns true if the count of the Read invocations corresponds
+// the number of defined expectations
+func (mmRead *readerMock) MinimockReadDone() bool {
+	return mmRead.ReadMock.state.Done(mmRead.ReadMock.setup())
+}
+
+// MinimockReadInspect logs each unmet expectation
+func (mmRead *readerMock) MinimockReadInspect() {
+	mmRead.ReadMock.state.Inspect(mmRead.ReadMock.setup())
+}
+
+// MinimockSetComparer sets up the function comparing the expected and the actual params of all readerMock methods instead of minimock.Equal,
+// the params matched by the matchers aren't compared
+func (m *readerMock) MinimockSetComparer(compare minimock.Comparer) *readerMock {
+	m.state.SetComparer(compare)
+	return m
+}
+
+// MinimockSetSequence sets up the sequence numbering the readerMock calls, the mocks sharing the sequence
+// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+func (m *readerMock) MinimockSetSequence(sequence *minimock.Sequence) *readerMock {
+	m.state.SetSequence(sequence)
+	return m
+}
+
+// MinimockSetClock sets up the function returning the current time for the history of readerMock calls instead of time.Now
+func (m *readerMock) MinimockSetClock(clock func() mm_time.Time) *readerMock {
+	m.state.SetClock(clock)
+	return m
+}
+
+// MinimockSetAutoFinish enables or disables the check of readerMock made by the Cleanup of the tester passed to newReaderMock,
+// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+func (m *readerMock) MinimockSetAutoFinish(enabled bool) *readerMock {
+	m.state.SetAutoFinish(enabled)
+	return m
+}
+
+// MinimockSetDelegate sets up the implementation the calls of the readerMock methods are forwarded to when the methods
+// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+func (m *readerMock) MinimockSetDelegate(impl reader) *readerMock {
+	m.mutex.Lock()
+	m.delegate = impl
+	m.mutex.Unlock()
+	return m
+}
+
+func (m *readerMock) minimockDelegate() reader {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.delegate
+}
+
+// MinimockSetLenient enables or disables the lenient mode of readerMock: the calls of the methods that have neither
+// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+func (m *readerMock) MinimockSetLenient(enabled bool) *readerMock {
+	m.state.SetLenient(enabled)
+	return m
+}
+
+// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (m *readerMock) MinimockLenientCalls() map[string]uint64 {
+	return m.state.LenientCalls()
+}
+
+// MinimockAssertNotCalled fails the test without stopping it if any of the readerMock methods with the given names has been called,
+// it doesn't depend on the expectations and the functions set up for the methods
+func (m *readerMock) MinimockAssertNotCalled(methodNames ...string) {
+	m.state.AssertNotCalled(methodNames...)
+}
+
+// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+// queued by ReturnOnce of all readerMock methods, the functions set by Set and Inspect are kept.
+// It fails the test if any of the methods is being called
+func (m *readerMock) MinimockReset() {
+	m.state.Reset(
+		m.ReadMock.reset,
+	)
+}
+
+// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+func (m *readerMock) MinimockResetAll() {
+	m.MinimockReset()
+	m.ReadMock.state.Mutex.Lock()
+	m.funcRead = nil
+	m.ReadMock.inspectRead = nil
+	m.ReadMock.state.Mutex.Unlock()
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *readerMock) MinimockFinish() {
+	m.state.Finish(m.minimockDone,
+		m.MinimockReadInspect,
+	)
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *readerMock) MinimockWait(timeout mm_time.Duration) {
+	m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
+}
+
+func (m *readerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockReadDone()
+}
@@ -0,0 +1,19 @@
+package tests
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReaderMock_UnexportedNamedType(t *testing.T) {
+	readerMock := newReaderMock(t).ReadMock.Return(5, io.EOF)
+	defer readerMock.MinimockFinish()
+
+	var r reader = readerMock
+
+	n, err := r.Read(make([]byte, 5))
+	assert.Equal(t, 5, n)
+	assert.Equal(t, io.EOF, err)
+}
@@ -0,0 +1,668 @@
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+package tests
+
+//go:generate minimock -i github.com/gojuno/minimock/tests.Recorder -o ./recorder_mock.go
+
+import (
+	mm_sync "sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock"
+)
+
+// RecorderMock implements Recorder
+//
+// Recorder interface is used to test mocks generated into the same package as the interface
+type RecorderMock struct {
+	state    minimock.MockState
+	mutex    mm_sync.RWMutex
+	delegate Recorder
+
+	funcRecord func(e entry) (id int, err error)
+	RecordMock *mRecorderMockRecord
+}
+
+var _ Recorder = (*RecorderMock)(nil)
+
+// NewRecorderMock returns a mock for Recorder
+func NewRecorderMock(t minimock.Tester) *RecorderMock {
+	m := &RecorderMock{}
+	m.state.Init(m, t, "RecorderMock", 0)
+
+	m.RecordMock = &mRecorderMockRecord{mock: m}
+	m.RecordMock.state.Init(&m.state, "Record")
+
+	return m
+}
+
+type mRecorderMockRecord struct {
+	mock               *RecorderMock
+	state              minimock.MethodState
+	defaultExpectation *RecorderMockRecordExpectation
+	expectations       []*RecorderMockRecordExpectation
+	inspectRecord      func(e entry)
+	compare            minimock.Comparer
+	calls              []RecorderMockRecordParams
+	called             chan RecorderMockRecordParams
+	queued             []*RecorderMockRecordResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*RecorderMockRecordResults
+}
+
+// RecorderMockRecordExpectation specifies expectation struct of the Recorder.Record
+type RecorderMockRecordExpectation struct {
+	mock     *RecorderMock
+	params   *RecorderMockRecordParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *RecorderMockRecordResults
+	Counter  uint64
+}
+
+// RecorderMockRecordParams contains parameters of the Recorder.Record
+type RecorderMockRecordParams struct {
+	E entry
+}
+
+// RecorderMockRecordResults contains results of the Recorder.Record
+type RecorderMockRecordResults struct {
+	Id  int
+	Err error
+}
+
+// Expect sets up expected params for Recorder.Record
+func (mmRecord *mRecorderMockRecord) Expect(e entry) *mRecorderMockRecord {
+	if _, mm_func := mmRecord.current(); mm_func != nil {
+		mmRecord.mock.state.T().Fatalf("RecorderMock.Record mock is already set by Set")
+	}
+
+	mm_params := &RecorderMockRecordParams{e}
+	mmRecord.updateDefault(func(e *RecorderMockRecordExpectation) {
+		if e.partial {
+			mmRecord.mock.state.T().Fatalf("RecorderMock.Record params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmRecord.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmRecord.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmRecord
+}
+
+// updateDefault replaces the default expectation of Recorder.Record by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmRecord *mRecorderMockRecord) updateDefault(update func(e *RecorderMockRecordExpectation)) {
+	mmRecord.state.Mutex.Lock()
+	defer mmRecord.state.Mutex.Unlock()
+
+	e := &RecorderMockRecordExpectation{mock: mmRecord.mock}
+	if previous := mmRecord.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmRecord.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Recorder.Record
+func (mmRecord *mRecorderMockRecord) current() (*RecorderMockRecordExpectation, func(e entry) (id int, err error)) {
+	mmRecord.state.Mutex.RLock()
+	defer mmRecord.state.Mutex.RUnlock()
+
+	return mmRecord.defaultExpectation, mmRecord.mock.funcRecord
+}
+
+// partialParams updates the default expectation of Recorder.Record by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmRecord *mRecorderMockRecord) partialParams(update func(e *RecorderMockRecordExpectation)) {
+	if _, mm_func := mmRecord.current(); mm_func != nil {
+		mmRecord.mock.state.T().Fatalf("RecorderMock.Record mock is already set by Set")
+	}
+
+	mmRecord.updateDefault(func(e *RecorderMockRecordExpectation) {
+		if e.params == nil {
+			e.params = &RecorderMockRecordParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"E": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectEParam1 sets up the expected value of the param #1 of Recorder.Record,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmRecord *mRecorderMockRecord) ExpectEParam1(E entry) *mRecorderMockRecord {
+	mmRecord.partialParams(func(e *RecorderMockRecordExpectation) {
+		e.params.E = E
+		delete(e.matchers, "E")
+	})
+	return mmRecord
+}
+
+// MatchEParam1 sets up the predicate matching the param #1 of Recorder.Record,
+// it's used instead of the value of the param set by Expect
+func (mmRecord *mRecorderMockRecord) MatchEParam1(f func(got entry) bool) *mRecorderMockRecord {
+	mm_matcher := minimock.Predicate("predicate func(got entry) bool", func(v interface{}) bool {
+		got, _ := v.(entry)
+		return f(got)
+	})
+	mmRecord.partialParams(func(e *RecorderMockRecordExpectation) {
+		e.matchers["E"] = mm_matcher
+	})
+	return mmRecord
+}
+
+// Return sets up results that will be returned by Recorder.Record
+func (mmRecord *mRecorderMockRecord) Return(id int, err error) *RecorderMock {
+	if _, mm_func := mmRecord.current(); mm_func != nil {
+		mmRecord.mock.state.T().Fatalf("RecorderMock.Record mock is already set by Set")
+	}
+
+	mm_results := &RecorderMockRecordResults{id, err}
+	mmRecord.updateDefault(func(e *RecorderMockRecordExpectation) { e.results = mm_results })
+
+	return mmRecord.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Recorder.Record,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmRecord *mRecorderMockRecord) ReturnOnce(id int, err error) *mRecorderMockRecord {
+	mmRecord.state.Mutex.Lock()
+	mmRecord.queued = append(mmRecord.queued, &RecorderMockRecordResults{id, err})
+	mmRecord.state.Mutex.Unlock()
+	mmRecord.state.PushResults()
+	return mmRecord
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmRecord *mRecorderMockRecord) popResults() *RecorderMockRecordResults {
+	mm_i, mm_ok := mmRecord.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmRecord.state.Mutex.RLock()
+	defer mmRecord.state.Mutex.RUnlock()
+
+	return mmRecord.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of Recorder.Record instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmRecord *mRecorderMockRecord) SetComparer(compare minimock.Comparer) *mRecorderMockRecord {
+	mmRecord.state.Mutex.Lock()
+	mmRecord.compare = compare
+	mmRecord.state.Mutex.Unlock()
+	return mmRecord
+}
+
+// whenExpectations returns the expectations of Recorder.Record set by When,
+// the expectations can be set while the method is called concurrently
+func (mmRecord *mRecorderMockRecord) whenExpectations() []*RecorderMockRecordExpectation {
+	mmRecord.state.Mutex.RLock()
+	defer mmRecord.state.Mutex.RUnlock()
+
+	return mmRecord.expectations
+}
+
+// Inspect sets up the function called with the params of every Recorder.Record call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmRecord *mRecorderMockRecord) Inspect(f func(e entry)) *mRecorderMockRecord {
+	mmRecord.state.Mutex.Lock()
+	mmRecord.inspectRecord = f
+	mmRecord.state.Mutex.Unlock()
+	return mmRecord
+}
+
+// expected returns everything set up for the Recorder.Record call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmRecord *mRecorderMockRecord) expected() (*RecorderMockRecordExpectation, func(e entry) (id int, err error), func(e entry), minimock.Comparer, []*RecorderMockRecordExpectation, []*RecorderMockRecordResults) {
+	mmRecord.state.Mutex.RLock()
+	defer mmRecord.state.Mutex.RUnlock()
+
+	return mmRecord.defaultExpectation, mmRecord.mock.funcRecord, mmRecord.inspectRecord, mmRecord.compare, mmRecord.expectations, mmRecord.whenResults
+}
+
+// setup returns the expectations and the function set up for Recorder.Record to be checked when the mock is finished
+func (mmRecord *mRecorderMockRecord) setup() (setup minimock.Setup) {
+	mmRecord.state.Mutex.RLock()
+	defer mmRecord.state.Mutex.RUnlock()
+
+	setup.Func = mmRecord.mock.funcRecord != nil
+	if e := mmRecord.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmRecord.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Recorder.Record, the params of the calls are removed from the history as well
+func (mmRecord *mRecorderMockRecord) reset() {
+	mmRecord.state.Mutex.Lock()
+	mmRecord.defaultExpectation = nil
+	mmRecord.expectations = nil
+	mmRecord.queued = nil
+	mmRecord.whenResults = nil
+	mmRecord.state.Mutex.Unlock()
+
+	mmRecord.state.History.Lock()
+	mmRecord.calls = nil
+	mmRecord.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmRecord *mRecorderMockRecord) MethodName() string {
+	return mmRecord.state.Name()
+}
+
+// CallSequence returns the numbers of the Recorder.Record calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmRecord *mRecorderMockRecord) CallSequence() []uint64 {
+	return mmRecord.state.CallSequence()
+}
+
+// WaitForCalls waits until Recorder.Record is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmRecord *mRecorderMockRecord) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmRecord.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Recorder.Record calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmRecord *mRecorderMockRecord) Block() (release func()) {
+	return mmRecord.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Recorder.Record calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmRecord *mRecorderMockRecord) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmRecord.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Recorder.Record calls are in flight at once
+func (mmRecord *mRecorderMockRecord) LimitConcurrency(n int) *mRecorderMockRecord {
+	mmRecord.state.SetLimit(n)
+	return mmRecord
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Recorder.Record call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmRecord *mRecorderMockRecord) CaptureCalls(buffer int) *mRecorderMockRecord {
+	mmRecord.state.History.Lock()
+	mmRecord.called = make(chan RecorderMockRecordParams, buffer)
+	mmRecord.state.History.Unlock()
+	return mmRecord
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Recorder.Record call
+func (mmRecord *mRecorderMockRecord) Called() <-chan RecorderMockRecordParams {
+	mmRecord.state.History.Lock()
+	defer mmRecord.state.History.Unlock()
+
+	if mmRecord.called == nil {
+		mmRecord.mock.state.T().Fatalf("Calls of RecorderMock.Record aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmRecord.called
+}
+
+// DroppedCalls returns the number of the Recorder.Record calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmRecord *mRecorderMockRecord) DroppedCalls() uint64 {
+	return mmRecord.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Recorder.Record calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmRecord *mRecorderMockRecord) Times(n uint64) *mRecorderMockRecord {
+	mmRecord.state.SetTimes(n)
+	return mmRecord
+}
+
+// Optional excludes Recorder.Record from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmRecord *mRecorderMockRecord) Optional() *mRecorderMockRecord {
+	mmRecord.state.SetOptional()
+	return mmRecord
+}
+
+// Set uses given function f to mock the Recorder.Record method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmRecord *mRecorderMockRecord) Set(f func(e entry) (id int, err error)) *RecorderMock {
+	mmRecord.state.Mutex.Lock()
+	defer mmRecord.state.Mutex.Unlock()
+
+	if mmRecord.defaultExpectation != nil {
+		mmRecord.mock.state.T().Fatalf("Default expectation is already set for the Recorder.Record method")
+	}
+
+	if len(mmRecord.expectations) > 0 {
+		mmRecord.mock.state.T().Fatalf("Some expectations are already set for the Recorder.Record method")
+	}
+
+	mmRecord.mock.funcRecord = f
+	return mmRecord.mock
+}
+
+// When sets expectation for the Recorder.Record which will trigger the result defined by the following
+// Then helper
+func (mmRecord *mRecorderMockRecord) When(e entry) *RecorderMockRecordExpectation {
+	if _, mm_func := mmRecord.current(); mm_func != nil {
+		mmRecord.mock.state.T().Fatalf("RecorderMock.Record mock is already set by Set")
+	}
+
+	expectation := &RecorderMockRecordExpectation{
+		mock:   mmRecord.mock,
+		params: &RecorderMockRecordParams{e},
+	}
+	mmRecord.state.Mutex.Lock()
+	mmRecord.expectations = append(mmRecord.expectations, expectation)
+	mmRecord.whenResults = append(mmRecord.whenResults, nil)
+	mmRecord.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up Recorder.Record return parameters for the expectation previously defined by the When method
+func (mmExpectation *RecorderMockRecordExpectation) Then(id int, err error) *RecorderMock {
+	mm_handle := mmExpectation.mock.RecordMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &RecorderMockRecordResults{id, err}
+	mm_results := make([]*RecorderMockRecordResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Record implements Recorder
+func (mmRecord *RecorderMock) Record(e entry) (id int, err error) {
+	mm_method := mmRecord.RecordMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := RecorderMockRecordParams{e}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcRecord, mm_inspectRecord, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectRecord != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectRecord(e)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).Id, (*mm_results).Err
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).Id, (*mm_results).Err
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcRecord == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).Id, (*mm_expectation.results).Err
+	}
+	if mm_funcRecord != nil {
+		return mm_funcRecord(e)
+	}
+	if mm_delegate := mmRecord.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Record(e)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, e)
+	return
+}
+
+// RecordAfterCounter returns a count of finished RecorderMock.Record invocations
+func (mmRecord *RecorderMock) RecordAfterCounter() uint64 {
+	return mmRecord.RecordMock.state.AfterCalls()
+}
+
+// RecordBeforeCounter returns a count of RecorderMock.Record invocations
+func (mmRecord *RecorderMock) RecordBeforeCounter() uint64 {
+	return mmRecord.RecordMock.state.BeforeCalls()
+}
+
+// RecordCalls returns the params of all RecorderMock.Record calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmRecord *RecorderMock) RecordCalls() []RecorderMockRecordParams {
+	mmRecord.RecordMock.state.History.Lock()
+	defer mmRecord.RecordMock.state.History.Unlock()
+
+	calls := make([]RecorderMockRecordParams, len(mmRecord.RecordMock.calls))
+	copy(calls, mmRecord.RecordMock.calls)
+	return calls
+}
+
+// RecordLastParams returns the params of the latest RecorderMock.Record call and false if there were no calls
+func (mmRecord *RecorderMock) RecordLastParams() (params RecorderMockRecordParams, ok bool) {
+	mmRecord.RecordMock.state.History.Lock()
+	defer mmRecord.RecordMock.state.History.Unlock()
+
+	if n := len(mmRecord.RecordMock.calls); n > 0 {
+		return mmRecord.RecordMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// RecordCallTimes returns the times of all RecorderMock.Record calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmRecord *RecorderMock) RecordCallTimes() []mm_time.Time {
+	return mmRecord.RecordMock.state.CallTimes()
+}
+
+// RecordMaxInFlight returns the maximum number of the RecorderMock.Record calls that have been in flight at once
+func (mmRecord *RecorderMock) RecordMaxInFlight() int {
+	return mmRecord.RecordMock.state.MaxInFlight()
+}
+
+// RecordUnexpectedCounter returns a count of RecorderMock.Record invocations made without an implementation
+func (mmRecord *RecorderMock) RecordUnexpectedCounter() uint64 {
+	return mmRecord.RecordMock.state.UnexpectedCalls()
+}
+
+// RecordNotCalled returns true if RecorderMock.Record hasn't been called
+func (mmRecord *RecorderMock) RecordNotCalled() bool {
+	return mmRecord.RecordMock.state.BeforeCalls() == 0
+}
+
+// RecordCallCount returns a count of RecorderMock.Record invocations, it's the same as RecordBeforeCounter
+func (mmRecord *RecorderMock) RecordCallCount() uint64 {
+	return mmRecord.RecordMock.state.BeforeCalls()
+}
+
+// MinimockRecordDone returns true if the count of the Record invocations corresponds
+// the number of defined expectations
+func (mmRecord *RecorderMock) MinimockRecordDone() bool {
+	return mmRecord.RecordMock.state.Done(mmRecord.RecordMock.setup())
+}
+
+// MinimockRecordInspect logs each unmet expectation
+func (mmRecord *RecorderMock) MinimockRecordInspect() {
+	mmRecord.RecordMock.state.Inspect(mmRecord.RecordMock.setup())
+}
+
+// MinimockSetComparer sets up the function comparing the expected and the actual params of all RecorderMock methods instead of minimock.Equal,
+// the params matched by the matchers aren't compared
+func (m *RecorderMock) MinimockSetComparer(compare minimock.Comparer) *RecorderMock {
+	m.state.SetComparer(compare)
+	return m
+}
+
+// MinimockSetSequence sets up the sequence numbering the RecorderMock calls, the mocks sharing the sequence
+// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+func (m *RecorderMock) MinimockSetSequence(sequence *minimock.Sequence) *RecorderMock {
+	m.state.SetSequence(sequence)
+	return m
+}
+
+// MinimockSetClock sets up the function returning the current time for the history of RecorderMock calls instead of time.Now
+func (m *RecorderMock) MinimockSetClock(clock func() mm_time.Time) *RecorderMock {
+	m.state.SetClock(clock)
+	return m
+}
+
+// MinimockSetAutoFinish enables or disables the check of RecorderMock made by the Cleanup of the tester passed to NewRecorderMock,
+// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+func (m *RecorderMock) MinimockSetAutoFinish(enabled bool) *RecorderMock {
+	m.state.SetAutoFinish(enabled)
+	return m
+}
+
+// MinimockSetDelegate sets up the implementation the calls of the RecorderMock methods are forwarded to when the methods
+// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+func (m *RecorderMock) MinimockSetDelegate(impl Recorder) *RecorderMock {
+	m.mutex.Lock()
+	m.delegate = impl
+	m.mutex.Unlock()
+	return m
+}
+
+func (m *RecorderMock) minimockDelegate() Recorder {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.delegate
+}
+
+// MinimockSetLenient enables or disables the lenient mode of RecorderMock: the calls of the methods that have neither
+// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+func (m *RecorderMock) MinimockSetLenient(enabled bool) *RecorderMock {
+	m.state.SetLenient(enabled)
+	return m
+}
+
+// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (m *RecorderMock) MinimockLenientCalls() map[string]uint64 {
+	return m.state.LenientCalls()
+}
+
+// MinimockAssertNotCalled fails the test without stopping it if any of the RecorderMock methods with the given names has been called,
+// it doesn't depend on the expectations and the functions set up for the methods
+func (m *RecorderMock) MinimockAssertNotCalled(methodNames ...string) {
+	m.state.AssertNotCalled(methodNames...)
+}
+
+// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+// queued by ReturnOnce of all RecorderMock methods, the functions set by Set and Inspect are kept.
+// It fails the test if any of the methods is being called
+func (m *RecorderMock) MinimockReset() {
+	m.state.Reset(
+		m.RecordMock.reset,
+	)
+}
+
+// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+func (m *RecorderMock) MinimockResetAll() {
+	m.MinimockReset()
+	m.RecordMock.state.Mutex.Lock()
+	m.funcRecord = nil
+	m.RecordMock.inspectRecord = nil
+	m.RecordMock.state.Mutex.Unlock()
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RecorderMock) MinimockFinish() {
+	m.state.Finish(m.minimockDone,
+		m.MinimockRecordInspect,
+	)
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RecorderMock) MinimockWait(timeout mm_time.Duration) {
+	m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
+}
+
+func (m *RecorderMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockRecordDone()
+}
@@ -0,0 +1,18 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorderMock_UnexportedParamType(t *testing.T) {
+	recorderMock := NewRecorderMock(t).RecordMock.Expect(entry{message: "hello"}).Return(1, nil)
+	defer recorderMock.MinimockFinish()
+
+	var recorder Recorder = recorderMock
+
+	id, err := recorder.Record(entry{message: "hello"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, id)
+}
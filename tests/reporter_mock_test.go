@@ -0,0 +1,48 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/tests/reporting"
+	"github.com/stretchr/testify/assert"
+)
+
+type entryHandler struct{}
+
+func (entryHandler) Handle(e reporting.Entry) error {
+	return errors.New(e.Message)
+}
+
+func TestReporterMock_AnonymousStructResult(t *testing.T) {
+	reporterMock := NewReporterMock(t)
+	defer reporterMock.MinimockFinish()
+
+	report := reporterMock.ReportMock.Set(func() (st1 struct {
+		Count int
+		Err   error
+		Last  struct {
+			Entry   *reporting.Entry
+			Created time.Time
+		}
+	}) {
+		st1.Count = 1
+		st1.Last.Entry = &reporting.Entry{Message: "last"}
+		return st1
+	}).Report()
+
+	assert.Equal(t, 1, report.Count)
+	assert.Equal(t, "last", report.Last.Entry.Message)
+}
+
+func TestReporterMock_InlineInterfaceParam(t *testing.T) {
+	h := entryHandler{}
+
+	reporterMock := NewReporterMock(t).SubscribeMock.Expect(h).Return(nil)
+	defer reporterMock.MinimockFinish()
+
+	var reporter reporting.Reporter = reporterMock
+
+	assert.NoError(t, reporter.Subscribe(h))
+}
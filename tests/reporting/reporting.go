@@ -0,0 +1,23 @@
+// Package reporting is used to test mocks of the interfaces which methods use anonymous struct and inline interface types
+package reporting
+
+import "time"
+
+// Reporter interface refers to the types of this package from the anonymous struct and inline interface types,
+// its mock is generated into another package to check that these types are qualified
+type Reporter interface {
+	Report() struct {
+		Count int
+		Err   error
+		Last  struct {
+			Entry   *Entry
+			Created time.Time
+		}
+	}
+	Subscribe(h interface{ Handle(e Entry) error }) error
+}
+
+// Entry is a type that is referred to only from the anonymous struct and inline interface types
+type Entry struct {
+	Message string
+}
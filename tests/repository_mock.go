@@ -0,0 +1,668 @@
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+package tests
+
+//go:generate minimock -i github.com/gojuno/minimock/tests.repository -o ./repository_mock.go -t repositoryMock
+
+import (
+	mm_sync "sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock"
+)
+
+// repositoryMock implements repository
+//
+// repository interface is used to test unexported mocks of unexported interfaces
+type repositoryMock struct {
+	state    minimock.MockState
+	mutex    mm_sync.RWMutex
+	delegate repository
+
+	funcFind func(id int) (e1 entry, b1 bool)
+	FindMock *mrepositoryMockFind
+}
+
+var _ repository = (*repositoryMock)(nil)
+
+// newRepositoryMock returns a mock for repository
+func newRepositoryMock(t minimock.Tester) *repositoryMock {
+	m := &repositoryMock{}
+	m.state.Init(m, t, "repositoryMock", 0)
+
+	m.FindMock = &mrepositoryMockFind{mock: m}
+	m.FindMock.state.Init(&m.state, "Find")
+
+	return m
+}
+
+type mrepositoryMockFind struct {
+	mock               *repositoryMock
+	state              minimock.MethodState
+	defaultExpectation *repositoryMockFindExpectation
+	expectations       []*repositoryMockFindExpectation
+	inspectFind        func(id int)
+	compare            minimock.Comparer
+	calls              []repositoryMockFindParams
+	called             chan repositoryMockFindParams
+	queued             []*repositoryMockFindResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*repositoryMockFindResults
+}
+
+// repositoryMockFindExpectation specifies expectation struct of the repository.Find
+type repositoryMockFindExpectation struct {
+	mock     *repositoryMock
+	params   *repositoryMockFindParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *repositoryMockFindResults
+	Counter  uint64
+}
+
+// repositoryMockFindParams contains parameters of the repository.Find
+type repositoryMockFindParams struct {
+	Id int
+}
+
+// repositoryMockFindResults contains results of the repository.Find
+type repositoryMockFindResults struct {
+	R0 entry
+	R1 bool
+}
+
+// Expect sets up expected params for repository.Find
+func (mmFind *mrepositoryMockFind) Expect(id int) *mrepositoryMockFind {
+	if _, mm_func := mmFind.current(); mm_func != nil {
+		mmFind.mock.state.T().Fatalf("repositoryMock.Find mock is already set by Set")
+	}
+
+	mm_params := &repositoryMockFindParams{id}
+	mmFind.updateDefault(func(e *repositoryMockFindExpectation) {
+		if e.partial {
+			mmFind.mock.state.T().Fatalf("repositoryMock.Find params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmFind.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmFind.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmFind
+}
+
+// updateDefault replaces the default expectation of repository.Find by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmFind *mrepositoryMockFind) updateDefault(update func(e *repositoryMockFindExpectation)) {
+	mmFind.state.Mutex.Lock()
+	defer mmFind.state.Mutex.Unlock()
+
+	e := &repositoryMockFindExpectation{mock: mmFind.mock}
+	if previous := mmFind.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmFind.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for repository.Find
+func (mmFind *mrepositoryMockFind) current() (*repositoryMockFindExpectation, func(id int) (e1 entry, b1 bool)) {
+	mmFind.state.Mutex.RLock()
+	defer mmFind.state.Mutex.RUnlock()
+
+	return mmFind.defaultExpectation, mmFind.mock.funcFind
+}
+
+// partialParams updates the default expectation of repository.Find by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmFind *mrepositoryMockFind) partialParams(update func(e *repositoryMockFindExpectation)) {
+	if _, mm_func := mmFind.current(); mm_func != nil {
+		mmFind.mock.state.T().Fatalf("repositoryMock.Find mock is already set by Set")
+	}
+
+	mmFind.updateDefault(func(e *repositoryMockFindExpectation) {
+		if e.params == nil {
+			e.params = &repositoryMockFindParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"Id": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectIdParam1 sets up the expected value of the param #1 of repository.Find,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmFind *mrepositoryMockFind) ExpectIdParam1(Id int) *mrepositoryMockFind {
+	mmFind.partialParams(func(e *repositoryMockFindExpectation) {
+		e.params.Id = Id
+		delete(e.matchers, "Id")
+	})
+	return mmFind
+}
+
+// MatchIdParam1 sets up the predicate matching the param #1 of repository.Find,
+// it's used instead of the value of the param set by Expect
+func (mmFind *mrepositoryMockFind) MatchIdParam1(f func(got int) bool) *mrepositoryMockFind {
+	mm_matcher := minimock.Predicate("predicate func(got int) bool", func(v interface{}) bool {
+		got, _ := v.(int)
+		return f(got)
+	})
+	mmFind.partialParams(func(e *repositoryMockFindExpectation) {
+		e.matchers["Id"] = mm_matcher
+	})
+	return mmFind
+}
+
+// Return sets up results that will be returned by repository.Find
+func (mmFind *mrepositoryMockFind) Return(e1 entry, b1 bool) *repositoryMock {
+	if _, mm_func := mmFind.current(); mm_func != nil {
+		mmFind.mock.state.T().Fatalf("repositoryMock.Find mock is already set by Set")
+	}
+
+	mm_results := &repositoryMockFindResults{e1, b1}
+	mmFind.updateDefault(func(e *repositoryMockFindExpectation) { e.results = mm_results })
+
+	return mmFind.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of repository.Find,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmFind *mrepositoryMockFind) ReturnOnce(e1 entry, b1 bool) *mrepositoryMockFind {
+	mmFind.state.Mutex.Lock()
+	mmFind.queued = append(mmFind.queued, &repositoryMockFindResults{e1, b1})
+	mmFind.state.Mutex.Unlock()
+	mmFind.state.PushResults()
+	return mmFind
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmFind *mrepositoryMockFind) popResults() *repositoryMockFindResults {
+	mm_i, mm_ok := mmFind.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmFind.state.Mutex.RLock()
+	defer mmFind.state.Mutex.RUnlock()
+
+	return mmFind.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of repository.Find instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmFind *mrepositoryMockFind) SetComparer(compare minimock.Comparer) *mrepositoryMockFind {
+	mmFind.state.Mutex.Lock()
+	mmFind.compare = compare
+	mmFind.state.Mutex.Unlock()
+	return mmFind
+}
+
+// whenExpectations returns the expectations of repository.Find set by When,
+// the expectations can be set while the method is called concurrently
+func (mmFind *mrepositoryMockFind) whenExpectations() []*repositoryMockFindExpectation {
+	mmFind.state.Mutex.RLock()
+	defer mmFind.state.Mutex.RUnlock()
+
+	return mmFind.expectations
+}
+
+// Inspect sets up the function called with the params of every repository.Find call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmFind *mrepositoryMockFind) Inspect(f func(id int)) *mrepositoryMockFind {
+	mmFind.state.Mutex.Lock()
+	mmFind.inspectFind = f
+	mmFind.state.Mutex.Unlock()
+	return mmFind
+}
+
+// expected returns everything set up for the repository.Find call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmFind *mrepositoryMockFind) expected() (*repositoryMockFindExpectation, func(id int) (e1 entry, b1 bool), func(id int), minimock.Comparer, []*repositoryMockFindExpectation, []*repositoryMockFindResults) {
+	mmFind.state.Mutex.RLock()
+	defer mmFind.state.Mutex.RUnlock()
+
+	return mmFind.defaultExpectation, mmFind.mock.funcFind, mmFind.inspectFind, mmFind.compare, mmFind.expectations, mmFind.whenResults
+}
+
+// setup returns the expectations and the function set up for repository.Find to be checked when the mock is finished
+func (mmFind *mrepositoryMockFind) setup() (setup minimock.Setup) {
+	mmFind.state.Mutex.RLock()
+	defer mmFind.state.Mutex.RUnlock()
+
+	setup.Func = mmFind.mock.funcFind != nil
+	if e := mmFind.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmFind.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of repository.Find, the params of the calls are removed from the history as well
+func (mmFind *mrepositoryMockFind) reset() {
+	mmFind.state.Mutex.Lock()
+	mmFind.defaultExpectation = nil
+	mmFind.expectations = nil
+	mmFind.queued = nil
+	mmFind.whenResults = nil
+	mmFind.state.Mutex.Unlock()
+
+	mmFind.state.History.Lock()
+	mmFind.calls = nil
+	mmFind.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmFind *mrepositoryMockFind) MethodName() string {
+	return mmFind.state.Name()
+}
+
+// CallSequence returns the numbers of the repository.Find calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmFind *mrepositoryMockFind) CallSequence() []uint64 {
+	return mmFind.state.CallSequence()
+}
+
+// WaitForCalls waits until repository.Find is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmFind *mrepositoryMockFind) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmFind.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent repository.Find calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmFind *mrepositoryMockFind) Block() (release func()) {
+	return mmFind.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n repository.Find calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmFind *mrepositoryMockFind) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmFind.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n repository.Find calls are in flight at once
+func (mmFind *mrepositoryMockFind) LimitConcurrency(n int) *mrepositoryMockFind {
+	mmFind.state.SetLimit(n)
+	return mmFind
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each repository.Find call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmFind *mrepositoryMockFind) CaptureCalls(buffer int) *mrepositoryMockFind {
+	mmFind.state.History.Lock()
+	mmFind.called = make(chan repositoryMockFindParams, buffer)
+	mmFind.state.History.Unlock()
+	return mmFind
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each repository.Find call
+func (mmFind *mrepositoryMockFind) Called() <-chan repositoryMockFindParams {
+	mmFind.state.History.Lock()
+	defer mmFind.state.History.Unlock()
+
+	if mmFind.called == nil {
+		mmFind.mock.state.T().Fatalf("Calls of repositoryMock.Find aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmFind.called
+}
+
+// DroppedCalls returns the number of the repository.Find calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmFind *mrepositoryMockFind) DroppedCalls() uint64 {
+	return mmFind.state.DroppedCalls()
+}
+
+// Times sets the exact number of the repository.Find calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmFind *mrepositoryMockFind) Times(n uint64) *mrepositoryMockFind {
+	mmFind.state.SetTimes(n)
+	return mmFind
+}
+
+// Optional excludes repository.Find from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmFind *mrepositoryMockFind) Optional() *mrepositoryMockFind {
+	mmFind.state.SetOptional()
+	return mmFind
+}
+
+// Set uses given function f to mock the repository.Find method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmFind *mrepositoryMockFind) Set(f func(id int) (e1 entry, b1 bool)) *repositoryMock {
+	mmFind.state.Mutex.Lock()
+	defer mmFind.state.Mutex.Unlock()
+
+	if mmFind.defaultExpectation != nil {
+		mmFind.mock.state.T().Fatalf("Default expectation is already set for the repository.Find method")
+	}
+
+	if len(mmFind.expectations) > 0 {
+		mmFind.mock.state.T().Fatalf("Some expectations are already set for the repository.Find method")
+	}
+
+	mmFind.mock.funcFind = f
+	return mmFind.mock
+}
+
+// When sets expectation for the repository.Find which will trigger the result defined by the following
+// Then helper
+func (mmFind *mrepositoryMockFind) When(id int) *repositoryMockFindExpectation {
+	if _, mm_func := mmFind.current(); mm_func != nil {
+		mmFind.mock.state.T().Fatalf("repositoryMock.Find mock is already set by Set")
+	}
+
+	expectation := &repositoryMockFindExpectation{
+		mock:   mmFind.mock,
+		params: &repositoryMockFindParams{id},
+	}
+	mmFind.state.Mutex.Lock()
+	mmFind.expectations = append(mmFind.expectations, expectation)
+	mmFind.whenResults = append(mmFind.whenResults, nil)
+	mmFind.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up repository.Find return parameters for the expectation previously defined by the When method
+func (mmExpectation *repositoryMockFindExpectation) Then(e1 entry, b1 bool) *repositoryMock {
+	mm_handle := mmExpectation.mock.FindMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &repositoryMockFindResults{e1, b1}
+	mm_results := make([]*repositoryMockFindResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Find implements repository
+func (mmFind *repositoryMock) Find(id int) (e1 entry, b1 bool) {
+	mm_method := mmFind.FindMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := repositoryMockFindParams{id}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcFind, mm_inspectFind, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectFind != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectFind(id)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).R0, (*mm_results).R1
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).R0, (*mm_results).R1
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcFind == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0, (*mm_expectation.results).R1
+	}
+	if mm_funcFind != nil {
+		return mm_funcFind(id)
+	}
+	if mm_delegate := mmFind.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Find(id)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, id)
+	return
+}
+
+// FindAfterCounter returns a count of finished repositoryMock.Find invocations
+func (mmFind *repositoryMock) FindAfterCounter() uint64 {
+	return mmFind.FindMock.state.AfterCalls()
+}
+
+// FindBeforeCounter returns a count of repositoryMock.Find invocations
+func (mmFind *repositoryMock) FindBeforeCounter() uint64 {
+	return mmFind.FindMock.state.BeforeCalls()
+}
+
+// FindCalls returns the params of all repositoryMock.Find calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmFind *repositoryMock) FindCalls() []repositoryMockFindParams {
+	mmFind.FindMock.state.History.Lock()
+	defer mmFind.FindMock.state.History.Unlock()
+
+	calls := make([]repositoryMockFindParams, len(mmFind.FindMock.calls))
+	copy(calls, mmFind.FindMock.calls)
+	return calls
+}
+
+// FindLastParams returns the params of the latest repositoryMock.Find call and false if there were no calls
+func (mmFind *repositoryMock) FindLastParams() (params repositoryMockFindParams, ok bool) {
+	mmFind.FindMock.state.History.Lock()
+	defer mmFind.FindMock.state.History.Unlock()
+
+	if n := len(mmFind.FindMock.calls); n > 0 {
+		return mmFind.FindMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// FindCallTimes returns the times of all repositoryMock.Find calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmFind *repositoryMock) FindCallTimes() []mm_time.Time {
+	return mmFind.FindMock.state.CallTimes()
+}
+
+// FindMaxInFlight returns the maximum number of the repositoryMock.Find calls that have been in flight at once
+func (mmFind *repositoryMock) FindMaxInFlight() int {
+	return mmFind.FindMock.state.MaxInFlight()
+}
+
+// FindUnexpectedCounter returns a count of repositoryMock.Find invocations made without an implementation
+func (mmFind *repositoryMock) FindUnexpectedCounter() uint64 {
+	return mmFind.FindMock.state.UnexpectedCalls()
+}
+
+// FindNotCalled returns true if repositoryMock.Find hasn't been called
+func (mmFind *repositoryMock) FindNotCalled() bool {
+	return mmFind.FindMock.state.BeforeCalls() == 0
+}
+
+// FindCallCount returns a count of repositoryMock.Find invocations, it's the same as FindBeforeCounter
+func (mmFind *repositoryMock) FindCallCount() uint64 {
+	return mmFind.FindMock.state.BeforeCalls()
+}
+
+// MinimockFindDone returns true if the count of the Find invocations corresponds
+// the number of defined expectations
+func (mmFind *repositoryMock) MinimockFindDone() bool {
+	return mmFind.FindMock.state.Done(mmFind.FindMock.setup())
+}
+
+// MinimockFindInspect logs each unmet expectation
+func (mmFind *repositoryMock) MinimockFindInspect() {
+	mmFind.FindMock.state.Inspect(mmFind.FindMock.setup())
+}
+
+// MinimockSetComparer sets up the function comparing the expected and the actual params of all repositoryMock methods instead of minimock.Equal,
+// the params matched by the matchers aren't compared
+func (m *repositoryMock) MinimockSetComparer(compare minimock.Comparer) *repositoryMock {
+	m.state.SetComparer(compare)
+	return m
+}
+
+// MinimockSetSequence sets up the sequence numbering the repositoryMock calls, the mocks sharing the sequence
+// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+func (m *repositoryMock) MinimockSetSequence(sequence *minimock.Sequence) *repositoryMock {
+	m.state.SetSequence(sequence)
+	return m
+}
+
+// MinimockSetClock sets up the function returning the current time for the history of repositoryMock calls instead of time.Now
+func (m *repositoryMock) MinimockSetClock(clock func() mm_time.Time) *repositoryMock {
+	m.state.SetClock(clock)
+	return m
+}
+
+// MinimockSetAutoFinish enables or disables the check of repositoryMock made by the Cleanup of the tester passed to newRepositoryMock,
+// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+func (m *repositoryMock) MinimockSetAutoFinish(enabled bool) *repositoryMock {
+	m.state.SetAutoFinish(enabled)
+	return m
+}
+
+// MinimockSetDelegate sets up the implementation the calls of the repositoryMock methods are forwarded to when the methods
+// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+func (m *repositoryMock) MinimockSetDelegate(impl repository) *repositoryMock {
+	m.mutex.Lock()
+	m.delegate = impl
+	m.mutex.Unlock()
+	return m
+}
+
+func (m *repositoryMock) minimockDelegate() repository {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.delegate
+}
+
+// MinimockSetLenient enables or disables the lenient mode of repositoryMock: the calls of the methods that have neither
+// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+func (m *repositoryMock) MinimockSetLenient(enabled bool) *repositoryMock {
+	m.state.SetLenient(enabled)
+	return m
+}
+
+// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (m *repositoryMock) MinimockLenientCalls() map[string]uint64 {
+	return m.state.LenientCalls()
+}
+
+// MinimockAssertNotCalled fails the test without stopping it if any of the repositoryMock methods with the given names has been called,
+// it doesn't depend on the expectations and the functions set up for the methods
+func (m *repositoryMock) MinimockAssertNotCalled(methodNames ...string) {
+	m.state.AssertNotCalled(methodNames...)
+}
+
+// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+// queued by ReturnOnce of all repositoryMock methods, the functions set by Set and Inspect are kept.
+// It fails the test if any of the methods is being called
+func (m *repositoryMock) MinimockReset() {
+	m.state.Reset(
+		m.FindMock.reset,
+	)
+}
+
+// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+func (m *repositoryMock) MinimockResetAll() {
+	m.MinimockReset()
+	m.FindMock.state.Mutex.Lock()
+	m.funcFind = nil
+	m.FindMock.inspectFind = nil
+	m.FindMock.state.Mutex.Unlock()
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *repositoryMock) MinimockFinish() {
+	m.state.Finish(m.minimockDone,
+		m.MinimockFindInspect,
+	)
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *repositoryMock) MinimockWait(timeout mm_time.Duration) {
+	m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
+}
+
+func (m *repositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockFindDone()
+}
@@ -0,0 +1,18 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepositoryMock_Unexported(t *testing.T) {
+	repositoryMock := newRepositoryMock(t).FindMock.Expect(1).Return(entry{message: "hello"}, true)
+	defer repositoryMock.MinimockFinish()
+
+	var repo repository = repositoryMock
+
+	e, ok := repo.Find(1)
+	assert.True(t, ok)
+	assert.Equal(t, entry{message: "hello"}, e)
+}
@@ -0,0 +1,827 @@
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+package tests
+
+//go:generate minimock -i github.com/gojuno/minimock/tests.RichError -o ./rich_error_mock.go
+
+import (
+	mm_sync "sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock"
+)
+
+// RichErrorMock implements RichError
+//
+// RichError interface is used to test mocks of the interfaces with the Error() string method,
+// embedding of the predeclared error interface isn't supported by the generator
+type RichErrorMock struct {
+	state    minimock.MockState
+	mutex    mm_sync.RWMutex
+	delegate RichError
+
+	funcCode func() (i1 int)
+	CodeMock *mRichErrorMockCode
+
+	funcError func() (s1 string)
+	ErrorMock *mRichErrorMockError
+}
+
+var _ RichError = (*RichErrorMock)(nil)
+
+// NewRichErrorMock returns a mock for RichError
+func NewRichErrorMock(t minimock.Tester) *RichErrorMock {
+	m := &RichErrorMock{}
+	m.state.Init(m, t, "RichErrorMock", 0)
+
+	m.CodeMock = &mRichErrorMockCode{mock: m}
+	m.CodeMock.state.Init(&m.state, "Code")
+
+	m.ErrorMock = &mRichErrorMockError{mock: m}
+	m.ErrorMock.state.Init(&m.state, "Error")
+
+	return m
+}
+
+type mRichErrorMockCode struct {
+	mock               *RichErrorMock
+	state              minimock.MethodState
+	defaultExpectation *RichErrorMockCodeExpectation
+	expectations       []*RichErrorMockCodeExpectation
+	inspectCode        func()
+	called             chan struct{}
+	queued             []*RichErrorMockCodeResults
+}
+
+// RichErrorMockCodeExpectation specifies expectation struct of the RichError.Code
+type RichErrorMockCodeExpectation struct {
+	mock *RichErrorMock
+
+	results *RichErrorMockCodeResults
+	Counter uint64
+}
+
+// RichErrorMockCodeResults contains results of the RichError.Code
+type RichErrorMockCodeResults struct {
+	R0 int
+}
+
+// Expect sets up expected params for RichError.Code
+func (mmCode *mRichErrorMockCode) Expect() *mRichErrorMockCode {
+	if _, mm_func := mmCode.current(); mm_func != nil {
+		mmCode.mock.state.T().Fatalf("RichErrorMock.Code mock is already set by Set")
+	}
+
+	mmCode.updateDefault(func(*RichErrorMockCodeExpectation) {})
+
+	return mmCode
+}
+
+// updateDefault replaces the default expectation of RichError.Code by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmCode *mRichErrorMockCode) updateDefault(update func(e *RichErrorMockCodeExpectation)) {
+	mmCode.state.Mutex.Lock()
+	defer mmCode.state.Mutex.Unlock()
+
+	e := &RichErrorMockCodeExpectation{mock: mmCode.mock}
+	if previous := mmCode.defaultExpectation; previous != nil {
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmCode.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for RichError.Code
+func (mmCode *mRichErrorMockCode) current() (*RichErrorMockCodeExpectation, func() (i1 int)) {
+	mmCode.state.Mutex.RLock()
+	defer mmCode.state.Mutex.RUnlock()
+
+	return mmCode.defaultExpectation, mmCode.mock.funcCode
+}
+
+// Return sets up results that will be returned by RichError.Code
+func (mmCode *mRichErrorMockCode) Return(i1 int) *RichErrorMock {
+	if _, mm_func := mmCode.current(); mm_func != nil {
+		mmCode.mock.state.T().Fatalf("RichErrorMock.Code mock is already set by Set")
+	}
+
+	mm_results := &RichErrorMockCodeResults{i1}
+	mmCode.updateDefault(func(e *RichErrorMockCodeExpectation) { e.results = mm_results })
+
+	return mmCode.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of RichError.Code,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmCode *mRichErrorMockCode) ReturnOnce(i1 int) *mRichErrorMockCode {
+	mmCode.state.Mutex.Lock()
+	mmCode.queued = append(mmCode.queued, &RichErrorMockCodeResults{i1})
+	mmCode.state.Mutex.Unlock()
+	mmCode.state.PushResults()
+	return mmCode
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmCode *mRichErrorMockCode) popResults() *RichErrorMockCodeResults {
+	mm_i, mm_ok := mmCode.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmCode.state.Mutex.RLock()
+	defer mmCode.state.Mutex.RUnlock()
+
+	return mmCode.queued[mm_i]
+}
+
+// Inspect sets up the function called with the params of every RichError.Code call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmCode *mRichErrorMockCode) Inspect(f func()) *mRichErrorMockCode {
+	mmCode.state.Mutex.Lock()
+	mmCode.inspectCode = f
+	mmCode.state.Mutex.Unlock()
+	return mmCode
+}
+
+// expected returns everything set up for the RichError.Code call under a single read lock: the default expectation,
+// the functions set by Set and Inspect
+func (mmCode *mRichErrorMockCode) expected() (*RichErrorMockCodeExpectation, func() (i1 int), func()) {
+	mmCode.state.Mutex.RLock()
+	defer mmCode.state.Mutex.RUnlock()
+
+	return mmCode.defaultExpectation, mmCode.mock.funcCode, mmCode.inspectCode
+}
+
+// setup returns the expectations and the function set up for RichError.Code to be checked when the mock is finished
+func (mmCode *mRichErrorMockCode) setup() (setup minimock.Setup) {
+	mmCode.state.Mutex.RLock()
+	defer mmCode.state.Mutex.RUnlock()
+
+	setup.Func = mmCode.mock.funcCode != nil
+	if e := mmCode.defaultExpectation; e != nil {
+		setup.Default = true
+	}
+
+	for _, e := range mmCode.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of RichError.Code
+func (mmCode *mRichErrorMockCode) reset() {
+	mmCode.state.Mutex.Lock()
+	mmCode.defaultExpectation = nil
+	mmCode.expectations = nil
+	mmCode.queued = nil
+	mmCode.state.Mutex.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmCode *mRichErrorMockCode) MethodName() string {
+	return mmCode.state.Name()
+}
+
+// CallSequence returns the numbers of the RichError.Code calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmCode *mRichErrorMockCode) CallSequence() []uint64 {
+	return mmCode.state.CallSequence()
+}
+
+// WaitForCalls waits until RichError.Code is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmCode *mRichErrorMockCode) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmCode.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent RichError.Code calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmCode *mRichErrorMockCode) Block() (release func()) {
+	return mmCode.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n RichError.Code calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmCode *mRichErrorMockCode) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmCode.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n RichError.Code calls are in flight at once
+func (mmCode *mRichErrorMockCode) LimitConcurrency(n int) *mRichErrorMockCode {
+	mmCode.state.SetLimit(n)
+	return mmCode
+}
+
+// CaptureCalls creates the buffered channel receiving the signal of each RichError.Code call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmCode *mRichErrorMockCode) CaptureCalls(buffer int) *mRichErrorMockCode {
+	mmCode.state.History.Lock()
+	mmCode.called = make(chan struct{}, buffer)
+	mmCode.state.History.Unlock()
+	return mmCode
+}
+
+// Called returns the channel set up by CaptureCalls receiving the signal of each RichError.Code call
+func (mmCode *mRichErrorMockCode) Called() <-chan struct{} {
+	mmCode.state.History.Lock()
+	defer mmCode.state.History.Unlock()
+
+	if mmCode.called == nil {
+		mmCode.mock.state.T().Fatalf("Calls of RichErrorMock.Code aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmCode.called
+}
+
+// DroppedCalls returns the number of the RichError.Code calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmCode *mRichErrorMockCode) DroppedCalls() uint64 {
+	return mmCode.state.DroppedCalls()
+}
+
+// Times sets the exact number of the RichError.Code calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmCode *mRichErrorMockCode) Times(n uint64) *mRichErrorMockCode {
+	mmCode.state.SetTimes(n)
+	return mmCode
+}
+
+// Optional excludes RichError.Code from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmCode *mRichErrorMockCode) Optional() *mRichErrorMockCode {
+	mmCode.state.SetOptional()
+	return mmCode
+}
+
+// Set uses given function f to mock the RichError.Code method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmCode *mRichErrorMockCode) Set(f func() (i1 int)) *RichErrorMock {
+	mmCode.state.Mutex.Lock()
+	defer mmCode.state.Mutex.Unlock()
+
+	if mmCode.defaultExpectation != nil {
+		mmCode.mock.state.T().Fatalf("Default expectation is already set for the RichError.Code method")
+	}
+
+	if len(mmCode.expectations) > 0 {
+		mmCode.mock.state.T().Fatalf("Some expectations are already set for the RichError.Code method")
+	}
+
+	mmCode.mock.funcCode = f
+	return mmCode.mock
+}
+
+// Code implements RichError
+func (mmCode *RichErrorMock) Code() (i1 int) {
+	mm_method := mmCode.CodeMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_method.state.Record()
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- struct{}{}:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcCode, mm_inspectCode := mm_method.expected()
+	if mm_inspectCode != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectCode()
+		}()
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcCode == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, nil) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcCode != nil {
+		return mm_funcCode()
+	}
+	if mm_delegate := mmCode.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Code()
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(nil)
+	return
+}
+
+// CodeAfterCounter returns a count of finished RichErrorMock.Code invocations
+func (mmCode *RichErrorMock) CodeAfterCounter() uint64 {
+	return mmCode.CodeMock.state.AfterCalls()
+}
+
+// CodeBeforeCounter returns a count of RichErrorMock.Code invocations
+func (mmCode *RichErrorMock) CodeBeforeCounter() uint64 {
+	return mmCode.CodeMock.state.BeforeCalls()
+}
+
+// CodeCallTimes returns the times of all RichErrorMock.Code calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmCode *RichErrorMock) CodeCallTimes() []mm_time.Time {
+	return mmCode.CodeMock.state.CallTimes()
+}
+
+// CodeMaxInFlight returns the maximum number of the RichErrorMock.Code calls that have been in flight at once
+func (mmCode *RichErrorMock) CodeMaxInFlight() int {
+	return mmCode.CodeMock.state.MaxInFlight()
+}
+
+// CodeUnexpectedCounter returns a count of RichErrorMock.Code invocations made without an implementation
+func (mmCode *RichErrorMock) CodeUnexpectedCounter() uint64 {
+	return mmCode.CodeMock.state.UnexpectedCalls()
+}
+
+// CodeNotCalled returns true if RichErrorMock.Code hasn't been called
+func (mmCode *RichErrorMock) CodeNotCalled() bool {
+	return mmCode.CodeMock.state.BeforeCalls() == 0
+}
+
+// CodeCallCount returns a count of RichErrorMock.Code invocations, it's the same as CodeBeforeCounter
+func (mmCode *RichErrorMock) CodeCallCount() uint64 {
+	return mmCode.CodeMock.state.BeforeCalls()
+}
+
+// MinimockCodeDone returns true if the count of the Code invocations corresponds
+// the number of defined expectations
+func (mmCode *RichErrorMock) MinimockCodeDone() bool {
+	return mmCode.CodeMock.state.Done(mmCode.CodeMock.setup())
+}
+
+// MinimockCodeInspect logs each unmet expectation
+func (mmCode *RichErrorMock) MinimockCodeInspect() {
+	mmCode.CodeMock.state.Inspect(mmCode.CodeMock.setup())
+}
+
+type mRichErrorMockError struct {
+	mock               *RichErrorMock
+	state              minimock.MethodState
+	defaultExpectation *RichErrorMockErrorExpectation
+	expectations       []*RichErrorMockErrorExpectation
+	inspectError       func()
+	called             chan struct{}
+	queued             []*RichErrorMockErrorResults
+}
+
+// RichErrorMockErrorExpectation specifies expectation struct of the RichError.Error
+type RichErrorMockErrorExpectation struct {
+	mock *RichErrorMock
+
+	results *RichErrorMockErrorResults
+	Counter uint64
+}
+
+// RichErrorMockErrorResults contains results of the RichError.Error
+type RichErrorMockErrorResults struct {
+	R0 string
+}
+
+// Expect sets up expected params for RichError.Error
+func (mmError *mRichErrorMockError) Expect() *mRichErrorMockError {
+	if _, mm_func := mmError.current(); mm_func != nil {
+		mmError.mock.state.T().Fatalf("RichErrorMock.Error mock is already set by Set")
+	}
+
+	mmError.updateDefault(func(*RichErrorMockErrorExpectation) {})
+
+	return mmError
+}
+
+// updateDefault replaces the default expectation of RichError.Error by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmError *mRichErrorMockError) updateDefault(update func(e *RichErrorMockErrorExpectation)) {
+	mmError.state.Mutex.Lock()
+	defer mmError.state.Mutex.Unlock()
+
+	e := &RichErrorMockErrorExpectation{mock: mmError.mock}
+	if previous := mmError.defaultExpectation; previous != nil {
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmError.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for RichError.Error
+func (mmError *mRichErrorMockError) current() (*RichErrorMockErrorExpectation, func() (s1 string)) {
+	mmError.state.Mutex.RLock()
+	defer mmError.state.Mutex.RUnlock()
+
+	return mmError.defaultExpectation, mmError.mock.funcError
+}
+
+// Return sets up results that will be returned by RichError.Error
+func (mmError *mRichErrorMockError) Return(s1 string) *RichErrorMock {
+	if _, mm_func := mmError.current(); mm_func != nil {
+		mmError.mock.state.T().Fatalf("RichErrorMock.Error mock is already set by Set")
+	}
+
+	mm_results := &RichErrorMockErrorResults{s1}
+	mmError.updateDefault(func(e *RichErrorMockErrorExpectation) { e.results = mm_results })
+
+	return mmError.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of RichError.Error,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmError *mRichErrorMockError) ReturnOnce(s1 string) *mRichErrorMockError {
+	mmError.state.Mutex.Lock()
+	mmError.queued = append(mmError.queued, &RichErrorMockErrorResults{s1})
+	mmError.state.Mutex.Unlock()
+	mmError.state.PushResults()
+	return mmError
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmError *mRichErrorMockError) popResults() *RichErrorMockErrorResults {
+	mm_i, mm_ok := mmError.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmError.state.Mutex.RLock()
+	defer mmError.state.Mutex.RUnlock()
+
+	return mmError.queued[mm_i]
+}
+
+// Inspect sets up the function called with the params of every RichError.Error call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmError *mRichErrorMockError) Inspect(f func()) *mRichErrorMockError {
+	mmError.state.Mutex.Lock()
+	mmError.inspectError = f
+	mmError.state.Mutex.Unlock()
+	return mmError
+}
+
+// expected returns everything set up for the RichError.Error call under a single read lock: the default expectation,
+// the functions set by Set and Inspect
+func (mmError *mRichErrorMockError) expected() (*RichErrorMockErrorExpectation, func() (s1 string), func()) {
+	mmError.state.Mutex.RLock()
+	defer mmError.state.Mutex.RUnlock()
+
+	return mmError.defaultExpectation, mmError.mock.funcError, mmError.inspectError
+}
+
+// setup returns the expectations and the function set up for RichError.Error to be checked when the mock is finished
+func (mmError *mRichErrorMockError) setup() (setup minimock.Setup) {
+	mmError.state.Mutex.RLock()
+	defer mmError.state.Mutex.RUnlock()
+
+	setup.Func = mmError.mock.funcError != nil
+	if e := mmError.defaultExpectation; e != nil {
+		setup.Default = true
+	}
+
+	for _, e := range mmError.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of RichError.Error
+func (mmError *mRichErrorMockError) reset() {
+	mmError.state.Mutex.Lock()
+	mmError.defaultExpectation = nil
+	mmError.expectations = nil
+	mmError.queued = nil
+	mmError.state.Mutex.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmError *mRichErrorMockError) MethodName() string {
+	return mmError.state.Name()
+}
+
+// CallSequence returns the numbers of the RichError.Error calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmError *mRichErrorMockError) CallSequence() []uint64 {
+	return mmError.state.CallSequence()
+}
+
+// WaitForCalls waits until RichError.Error is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmError *mRichErrorMockError) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmError.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent RichError.Error calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmError *mRichErrorMockError) Block() (release func()) {
+	return mmError.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n RichError.Error calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmError *mRichErrorMockError) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmError.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n RichError.Error calls are in flight at once
+func (mmError *mRichErrorMockError) LimitConcurrency(n int) *mRichErrorMockError {
+	mmError.state.SetLimit(n)
+	return mmError
+}
+
+// CaptureCalls creates the buffered channel receiving the signal of each RichError.Error call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmError *mRichErrorMockError) CaptureCalls(buffer int) *mRichErrorMockError {
+	mmError.state.History.Lock()
+	mmError.called = make(chan struct{}, buffer)
+	mmError.state.History.Unlock()
+	return mmError
+}
+
+// Called returns the channel set up by CaptureCalls receiving the signal of each RichError.Error call
+func (mmError *mRichErrorMockError) Called() <-chan struct{} {
+	mmError.state.History.Lock()
+	defer mmError.state.History.Unlock()
+
+	if mmError.called == nil {
+		mmError.mock.state.T().Fatalf("Calls of RichErrorMock.Error aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmError.called
+}
+
+// DroppedCalls returns the number of the RichError.Error calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmError *mRichErrorMockError) DroppedCalls() uint64 {
+	return mmError.state.DroppedCalls()
+}
+
+// Times sets the exact number of the RichError.Error calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmError *mRichErrorMockError) Times(n uint64) *mRichErrorMockError {
+	mmError.state.SetTimes(n)
+	return mmError
+}
+
+// Optional excludes RichError.Error from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmError *mRichErrorMockError) Optional() *mRichErrorMockError {
+	mmError.state.SetOptional()
+	return mmError
+}
+
+// Set uses given function f to mock the RichError.Error method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmError *mRichErrorMockError) Set(f func() (s1 string)) *RichErrorMock {
+	mmError.state.Mutex.Lock()
+	defer mmError.state.Mutex.Unlock()
+
+	if mmError.defaultExpectation != nil {
+		mmError.mock.state.T().Fatalf("Default expectation is already set for the RichError.Error method")
+	}
+
+	if len(mmError.expectations) > 0 {
+		mmError.mock.state.T().Fatalf("Some expectations are already set for the RichError.Error method")
+	}
+
+	mmError.mock.funcError = f
+	return mmError.mock
+}
+
+// Error implements RichError
+func (mmError *RichErrorMock) Error() (s1 string) {
+	mm_method := mmError.ErrorMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_method.state.Record()
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- struct{}{}:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcError, mm_inspectError := mm_method.expected()
+	if mm_inspectError != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectError()
+		}()
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcError == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, nil) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcError != nil {
+		return mm_funcError()
+	}
+	if mm_delegate := mmError.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Error()
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(nil)
+	return
+}
+
+// ErrorAfterCounter returns a count of finished RichErrorMock.Error invocations
+func (mmError *RichErrorMock) ErrorAfterCounter() uint64 {
+	return mmError.ErrorMock.state.AfterCalls()
+}
+
+// ErrorBeforeCounter returns a count of RichErrorMock.Error invocations
+func (mmError *RichErrorMock) ErrorBeforeCounter() uint64 {
+	return mmError.ErrorMock.state.BeforeCalls()
+}
+
+// ErrorCallTimes returns the times of all RichErrorMock.Error calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmError *RichErrorMock) ErrorCallTimes() []mm_time.Time {
+	return mmError.ErrorMock.state.CallTimes()
+}
+
+// ErrorMaxInFlight returns the maximum number of the RichErrorMock.Error calls that have been in flight at once
+func (mmError *RichErrorMock) ErrorMaxInFlight() int {
+	return mmError.ErrorMock.state.MaxInFlight()
+}
+
+// ErrorUnexpectedCounter returns a count of RichErrorMock.Error invocations made without an implementation
+func (mmError *RichErrorMock) ErrorUnexpectedCounter() uint64 {
+	return mmError.ErrorMock.state.UnexpectedCalls()
+}
+
+// ErrorNotCalled returns true if RichErrorMock.Error hasn't been called
+func (mmError *RichErrorMock) ErrorNotCalled() bool {
+	return mmError.ErrorMock.state.BeforeCalls() == 0
+}
+
+// ErrorCallCount returns a count of RichErrorMock.Error invocations, it's the same as ErrorBeforeCounter
+func (mmError *RichErrorMock) ErrorCallCount() uint64 {
+	return mmError.ErrorMock.state.BeforeCalls()
+}
+
+// MinimockErrorDone returns true if the count of the Error invocations corresponds
+// the number of defined expectations
+func (mmError *RichErrorMock) MinimockErrorDone() bool {
+	return mmError.ErrorMock.state.Done(mmError.ErrorMock.setup())
+}
+
+// MinimockErrorInspect logs each unmet expectation
+func (mmError *RichErrorMock) MinimockErrorInspect() {
+	mmError.ErrorMock.state.Inspect(mmError.ErrorMock.setup())
+}
+
+// MinimockSetComparer sets up the function comparing the expected and the actual params of all RichErrorMock methods instead of minimock.Equal,
+// the params matched by the matchers aren't compared
+func (m *RichErrorMock) MinimockSetComparer(compare minimock.Comparer) *RichErrorMock {
+	m.state.SetComparer(compare)
+	return m
+}
+
+// MinimockSetSequence sets up the sequence numbering the RichErrorMock calls, the mocks sharing the sequence
+// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+func (m *RichErrorMock) MinimockSetSequence(sequence *minimock.Sequence) *RichErrorMock {
+	m.state.SetSequence(sequence)
+	return m
+}
+
+// MinimockSetClock sets up the function returning the current time for the history of RichErrorMock calls instead of time.Now
+func (m *RichErrorMock) MinimockSetClock(clock func() mm_time.Time) *RichErrorMock {
+	m.state.SetClock(clock)
+	return m
+}
+
+// MinimockSetAutoFinish enables or disables the check of RichErrorMock made by the Cleanup of the tester passed to NewRichErrorMock,
+// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+func (m *RichErrorMock) MinimockSetAutoFinish(enabled bool) *RichErrorMock {
+	m.state.SetAutoFinish(enabled)
+	return m
+}
+
+// MinimockSetDelegate sets up the implementation the calls of the RichErrorMock methods are forwarded to when the methods
+// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+func (m *RichErrorMock) MinimockSetDelegate(impl RichError) *RichErrorMock {
+	m.mutex.Lock()
+	m.delegate = impl
+	m.mutex.Unlock()
+	return m
+}
+
+func (m *RichErrorMock) minimockDelegate() RichError {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.delegate
+}
+
+// MinimockSetLenient enables or disables the lenient mode of RichErrorMock: the calls of the methods that have neither
+// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+func (m *RichErrorMock) MinimockSetLenient(enabled bool) *RichErrorMock {
+	m.state.SetLenient(enabled)
+	return m
+}
+
+// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (m *RichErrorMock) MinimockLenientCalls() map[string]uint64 {
+	return m.state.LenientCalls()
+}
+
+// MinimockAssertNotCalled fails the test without stopping it if any of the RichErrorMock methods with the given names has been called,
+// it doesn't depend on the expectations and the functions set up for the methods
+func (m *RichErrorMock) MinimockAssertNotCalled(methodNames ...string) {
+	m.state.AssertNotCalled(methodNames...)
+}
+
+// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+// queued by ReturnOnce of all RichErrorMock methods, the functions set by Set and Inspect are kept.
+// It fails the test if any of the methods is being called
+func (m *RichErrorMock) MinimockReset() {
+	m.state.Reset(
+		m.CodeMock.reset,
+		m.ErrorMock.reset,
+	)
+}
+
+// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+func (m *RichErrorMock) MinimockResetAll() {
+	m.MinimockReset()
+	m.CodeMock.state.Mutex.Lock()
+	m.funcCode = nil
+	m.CodeMock.inspectCode = nil
+	m.CodeMock.state.Mutex.Unlock()
+	m.ErrorMock.state.Mutex.Lock()
+	m.funcError = nil
+	m.ErrorMock.inspectError = nil
+	m.ErrorMock.state.Mutex.Unlock()
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RichErrorMock) MinimockFinish() {
+	m.state.Finish(m.minimockDone,
+		m.MinimockCodeInspect,
+		m.MinimockErrorInspect,
+	)
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RichErrorMock) MinimockWait(timeout mm_time.Duration) {
+	m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
+}
+
+func (m *RichErrorMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCodeDone() &&
+		m.MinimockErrorDone()
+}
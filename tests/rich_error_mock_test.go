@@ -0,0 +1,19 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRichErrorMock_ErrorMethod(t *testing.T) {
+	richErrorMock := NewRichErrorMock(t).
+		ErrorMock.Return("not found").
+		CodeMock.Return(404)
+	defer richErrorMock.MinimockFinish()
+
+	var err error = richErrorMock
+
+	assert.EqualError(t, err, "not found")
+	assert.Equal(t, 404, richErrorMock.Code())
+}
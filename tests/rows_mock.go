@@ -0,0 +1,477 @@
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+package tests
+
+//go:generate minimock -i github.com/gojuno/minimock/tests.Rows -o ./rows_mock.go
+
+import (
+	mm_sync "sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock"
+)
+
+// RowsMock implements Rows
+//
+// Rows and Row interfaces are used to test mutually recursive interfaces
+type RowsMock struct {
+	state    minimock.MockState
+	mutex    mm_sync.RWMutex
+	delegate Rows
+
+	funcNext func() (r1 Row, b1 bool)
+	NextMock *mRowsMockNext
+}
+
+var _ Rows = (*RowsMock)(nil)
+
+// NewRowsMock returns a mock for Rows
+func NewRowsMock(t minimock.Tester) *RowsMock {
+	m := &RowsMock{}
+	m.state.Init(m, t, "RowsMock", 0)
+
+	m.NextMock = &mRowsMockNext{mock: m}
+	m.NextMock.state.Init(&m.state, "Next")
+
+	return m
+}
+
+type mRowsMockNext struct {
+	mock               *RowsMock
+	state              minimock.MethodState
+	defaultExpectation *RowsMockNextExpectation
+	expectations       []*RowsMockNextExpectation
+	inspectNext        func()
+	called             chan struct{}
+	queued             []*RowsMockNextResults
+}
+
+// RowsMockNextExpectation specifies expectation struct of the Rows.Next
+type RowsMockNextExpectation struct {
+	mock *RowsMock
+
+	results *RowsMockNextResults
+	Counter uint64
+}
+
+// RowsMockNextResults contains results of the Rows.Next
+type RowsMockNextResults struct {
+	R0 Row
+	R1 bool
+}
+
+// Expect sets up expected params for Rows.Next
+func (mmNext *mRowsMockNext) Expect() *mRowsMockNext {
+	if _, mm_func := mmNext.current(); mm_func != nil {
+		mmNext.mock.state.T().Fatalf("RowsMock.Next mock is already set by Set")
+	}
+
+	mmNext.updateDefault(func(*RowsMockNextExpectation) {})
+
+	return mmNext
+}
+
+// updateDefault replaces the default expectation of Rows.Next by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmNext *mRowsMockNext) updateDefault(update func(e *RowsMockNextExpectation)) {
+	mmNext.state.Mutex.Lock()
+	defer mmNext.state.Mutex.Unlock()
+
+	e := &RowsMockNextExpectation{mock: mmNext.mock}
+	if previous := mmNext.defaultExpectation; previous != nil {
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmNext.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Rows.Next
+func (mmNext *mRowsMockNext) current() (*RowsMockNextExpectation, func() (r1 Row, b1 bool)) {
+	mmNext.state.Mutex.RLock()
+	defer mmNext.state.Mutex.RUnlock()
+
+	return mmNext.defaultExpectation, mmNext.mock.funcNext
+}
+
+// Return sets up results that will be returned by Rows.Next
+func (mmNext *mRowsMockNext) Return(r1 Row, b1 bool) *RowsMock {
+	if _, mm_func := mmNext.current(); mm_func != nil {
+		mmNext.mock.state.T().Fatalf("RowsMock.Next mock is already set by Set")
+	}
+
+	mm_results := &RowsMockNextResults{r1, b1}
+	mmNext.updateDefault(func(e *RowsMockNextExpectation) { e.results = mm_results })
+
+	return mmNext.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Rows.Next,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmNext *mRowsMockNext) ReturnOnce(r1 Row, b1 bool) *mRowsMockNext {
+	mmNext.state.Mutex.Lock()
+	mmNext.queued = append(mmNext.queued, &RowsMockNextResults{r1, b1})
+	mmNext.state.Mutex.Unlock()
+	mmNext.state.PushResults()
+	return mmNext
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmNext *mRowsMockNext) popResults() *RowsMockNextResults {
+	mm_i, mm_ok := mmNext.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmNext.state.Mutex.RLock()
+	defer mmNext.state.Mutex.RUnlock()
+
+	return mmNext.queued[mm_i]
+}
+
+// Inspect sets up the function called with the params of every Rows.Next call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmNext *mRowsMockNext) Inspect(f func()) *mRowsMockNext {
+	mmNext.state.Mutex.Lock()
+	mmNext.inspectNext = f
+	mmNext.state.Mutex.Unlock()
+	return mmNext
+}
+
+// expected returns everything set up for the Rows.Next call under a single read lock: the default expectation,
+// the functions set by Set and Inspect
+func (mmNext *mRowsMockNext) expected() (*RowsMockNextExpectation, func() (r1 Row, b1 bool), func()) {
+	mmNext.state.Mutex.RLock()
+	defer mmNext.state.Mutex.RUnlock()
+
+	return mmNext.defaultExpectation, mmNext.mock.funcNext, mmNext.inspectNext
+}
+
+// setup returns the expectations and the function set up for Rows.Next to be checked when the mock is finished
+func (mmNext *mRowsMockNext) setup() (setup minimock.Setup) {
+	mmNext.state.Mutex.RLock()
+	defer mmNext.state.Mutex.RUnlock()
+
+	setup.Func = mmNext.mock.funcNext != nil
+	if e := mmNext.defaultExpectation; e != nil {
+		setup.Default = true
+	}
+
+	for _, e := range mmNext.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Rows.Next
+func (mmNext *mRowsMockNext) reset() {
+	mmNext.state.Mutex.Lock()
+	mmNext.defaultExpectation = nil
+	mmNext.expectations = nil
+	mmNext.queued = nil
+	mmNext.state.Mutex.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmNext *mRowsMockNext) MethodName() string {
+	return mmNext.state.Name()
+}
+
+// CallSequence returns the numbers of the Rows.Next calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmNext *mRowsMockNext) CallSequence() []uint64 {
+	return mmNext.state.CallSequence()
+}
+
+// WaitForCalls waits until Rows.Next is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmNext *mRowsMockNext) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmNext.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Rows.Next calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmNext *mRowsMockNext) Block() (release func()) {
+	return mmNext.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Rows.Next calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmNext *mRowsMockNext) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmNext.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Rows.Next calls are in flight at once
+func (mmNext *mRowsMockNext) LimitConcurrency(n int) *mRowsMockNext {
+	mmNext.state.SetLimit(n)
+	return mmNext
+}
+
+// CaptureCalls creates the buffered channel receiving the signal of each Rows.Next call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmNext *mRowsMockNext) CaptureCalls(buffer int) *mRowsMockNext {
+	mmNext.state.History.Lock()
+	mmNext.called = make(chan struct{}, buffer)
+	mmNext.state.History.Unlock()
+	return mmNext
+}
+
+// Called returns the channel set up by CaptureCalls receiving the signal of each Rows.Next call
+func (mmNext *mRowsMockNext) Called() <-chan struct{} {
+	mmNext.state.History.Lock()
+	defer mmNext.state.History.Unlock()
+
+	if mmNext.called == nil {
+		mmNext.mock.state.T().Fatalf("Calls of RowsMock.Next aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmNext.called
+}
+
+// DroppedCalls returns the number of the Rows.Next calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmNext *mRowsMockNext) DroppedCalls() uint64 {
+	return mmNext.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Rows.Next calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmNext *mRowsMockNext) Times(n uint64) *mRowsMockNext {
+	mmNext.state.SetTimes(n)
+	return mmNext
+}
+
+// Optional excludes Rows.Next from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmNext *mRowsMockNext) Optional() *mRowsMockNext {
+	mmNext.state.SetOptional()
+	return mmNext
+}
+
+// Set uses given function f to mock the Rows.Next method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmNext *mRowsMockNext) Set(f func() (r1 Row, b1 bool)) *RowsMock {
+	mmNext.state.Mutex.Lock()
+	defer mmNext.state.Mutex.Unlock()
+
+	if mmNext.defaultExpectation != nil {
+		mmNext.mock.state.T().Fatalf("Default expectation is already set for the Rows.Next method")
+	}
+
+	if len(mmNext.expectations) > 0 {
+		mmNext.mock.state.T().Fatalf("Some expectations are already set for the Rows.Next method")
+	}
+
+	mmNext.mock.funcNext = f
+	return mmNext.mock
+}
+
+// Next implements Rows
+func (mmNext *RowsMock) Next() (r1 Row, b1 bool) {
+	mm_method := mmNext.NextMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_method.state.Record()
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- struct{}{}:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcNext, mm_inspectNext := mm_method.expected()
+	if mm_inspectNext != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectNext()
+		}()
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		return (*mm_results).R0, (*mm_results).R1
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcNext == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, nil) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0, (*mm_expectation.results).R1
+	}
+	if mm_funcNext != nil {
+		return mm_funcNext()
+	}
+	if mm_delegate := mmNext.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Next()
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(nil)
+	return
+}
+
+// NextAfterCounter returns a count of finished RowsMock.Next invocations
+func (mmNext *RowsMock) NextAfterCounter() uint64 {
+	return mmNext.NextMock.state.AfterCalls()
+}
+
+// NextBeforeCounter returns a count of RowsMock.Next invocations
+func (mmNext *RowsMock) NextBeforeCounter() uint64 {
+	return mmNext.NextMock.state.BeforeCalls()
+}
+
+// NextCallTimes returns the times of all RowsMock.Next calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmNext *RowsMock) NextCallTimes() []mm_time.Time {
+	return mmNext.NextMock.state.CallTimes()
+}
+
+// NextMaxInFlight returns the maximum number of the RowsMock.Next calls that have been in flight at once
+func (mmNext *RowsMock) NextMaxInFlight() int {
+	return mmNext.NextMock.state.MaxInFlight()
+}
+
+// NextUnexpectedCounter returns a count of RowsMock.Next invocations made without an implementation
+func (mmNext *RowsMock) NextUnexpectedCounter() uint64 {
+	return mmNext.NextMock.state.UnexpectedCalls()
+}
+
+// NextNotCalled returns true if RowsMock.Next hasn't been called
+func (mmNext *RowsMock) NextNotCalled() bool {
+	return mmNext.NextMock.state.BeforeCalls() == 0
+}
+
+// NextCallCount returns a count of RowsMock.Next invocations, it's the same as NextBeforeCounter
+func (mmNext *RowsMock) NextCallCount() uint64 {
+	return mmNext.NextMock.state.BeforeCalls()
+}
+
+// MinimockNextDone returns true if the count of the Next invocations corresponds
+// the number of defined expectations
+func (mmNext *RowsMock) MinimockNextDone() bool {
+	return mmNext.NextMock.state.Done(mmNext.NextMock.setup())
+}
+
+// MinimockNextInspect logs each unmet expectation
+func (mmNext *RowsMock) MinimockNextInspect() {
+	mmNext.NextMock.state.Inspect(mmNext.NextMock.setup())
+}
+
+// MinimockSetComparer sets up the function comparing the expected and the actual params of all RowsMock methods instead of minimock.Equal,
+// the params matched by the matchers aren't compared
+func (m *RowsMock) MinimockSetComparer(compare minimock.Comparer) *RowsMock {
+	m.state.SetComparer(compare)
+	return m
+}
+
+// MinimockSetSequence sets up the sequence numbering the RowsMock calls, the mocks sharing the sequence
+// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+func (m *RowsMock) MinimockSetSequence(sequence *minimock.Sequence) *RowsMock {
+	m.state.SetSequence(sequence)
+	return m
+}
+
+// MinimockSetClock sets up the function returning the current time for the history of RowsMock calls instead of time.Now
+func (m *RowsMock) MinimockSetClock(clock func() mm_time.Time) *RowsMock {
+	m.state.SetClock(clock)
+	return m
+}
+
+// MinimockSetAutoFinish enables or disables the check of RowsMock made by the Cleanup of the tester passed to NewRowsMock,
+// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+func (m *RowsMock) MinimockSetAutoFinish(enabled bool) *RowsMock {
+	m.state.SetAutoFinish(enabled)
+	return m
+}
+
+// MinimockSetDelegate sets up the implementation the calls of the RowsMock methods are forwarded to when the methods
+// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+func (m *RowsMock) MinimockSetDelegate(impl Rows) *RowsMock {
+	m.mutex.Lock()
+	m.delegate = impl
+	m.mutex.Unlock()
+	return m
+}
+
+func (m *RowsMock) minimockDelegate() Rows {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.delegate
+}
+
+// MinimockSetLenient enables or disables the lenient mode of RowsMock: the calls of the methods that have neither
+// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+func (m *RowsMock) MinimockSetLenient(enabled bool) *RowsMock {
+	m.state.SetLenient(enabled)
+	return m
+}
+
+// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (m *RowsMock) MinimockLenientCalls() map[string]uint64 {
+	return m.state.LenientCalls()
+}
+
+// MinimockAssertNotCalled fails the test without stopping it if any of the RowsMock methods with the given names has been called,
+// it doesn't depend on the expectations and the functions set up for the methods
+func (m *RowsMock) MinimockAssertNotCalled(methodNames ...string) {
+	m.state.AssertNotCalled(methodNames...)
+}
+
+// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+// queued by ReturnOnce of all RowsMock methods, the functions set by Set and Inspect are kept.
+// It fails the test if any of the methods is being called
+func (m *RowsMock) MinimockReset() {
+	m.state.Reset(
+		m.NextMock.reset,
+	)
+}
+
+// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+func (m *RowsMock) MinimockResetAll() {
+	m.MinimockReset()
+	m.NextMock.state.Mutex.Lock()
+	m.funcNext = nil
+	m.NextMock.inspectNext = nil
+	m.NextMock.state.Mutex.Unlock()
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RowsMock) MinimockFinish() {
+	m.state.Finish(m.minimockDone,
+		m.MinimockNextInspect,
+	)
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RowsMock) MinimockWait(timeout mm_time.Duration) {
+	m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
+}
+
+func (m *RowsMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockNextDone()
+}
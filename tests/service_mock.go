@@ -0,0 +1,3019 @@
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+package tests
+
+//go:generate minimock -i github.com/gojuno/minimock/tests.Service -o ./service_mock.go
+
+import (
+	"context"
+	"io"
+	mm_sync "sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock"
+)
+
+// ServiceMock implements Service
+//
+// Service interface is used to test flattening of the interfaces embedded on several levels across packages
+type ServiceMock struct {
+	state    minimock.MockState
+	mutex    mm_sync.RWMutex
+	delegate Service
+
+	funcClose func() (err error)
+	CloseMock *mServiceMockClose
+
+	funcFormat func(s1 string, p1 ...interface{}) (s2 string)
+	FormatMock *mServiceMockFormat
+
+	funcRead func(p []byte) (n int, err error)
+	ReadMock *mServiceMockRead
+
+	funcStart func(ctx context.Context) (err error)
+	StartMock *mServiceMockStart
+
+	funcString func() (s1 string)
+	StringMock *mServiceMockString
+
+	funcWriteTo func(w io.Writer) (n int64, err error)
+	WriteToMock *mServiceMockWriteTo
+}
+
+var _ Service = (*ServiceMock)(nil)
+
+// NewServiceMock returns a mock for Service
+func NewServiceMock(t minimock.Tester) *ServiceMock {
+	m := &ServiceMock{}
+	m.state.Init(m, t, "ServiceMock", 0)
+
+	m.CloseMock = &mServiceMockClose{mock: m}
+	m.CloseMock.state.Init(&m.state, "Close")
+
+	m.FormatMock = &mServiceMockFormat{mock: m}
+	m.FormatMock.state.Init(&m.state, "Format")
+
+	m.ReadMock = &mServiceMockRead{mock: m}
+	m.ReadMock.state.Init(&m.state, "Read")
+
+	m.StartMock = &mServiceMockStart{mock: m}
+	m.StartMock.state.Init(&m.state, "Start")
+
+	m.StringMock = &mServiceMockString{mock: m}
+	m.StringMock.state.Init(&m.state, "String")
+
+	m.WriteToMock = &mServiceMockWriteTo{mock: m}
+	m.WriteToMock.state.Init(&m.state, "WriteTo")
+
+	return m
+}
+
+type mServiceMockClose struct {
+	mock               *ServiceMock
+	state              minimock.MethodState
+	defaultExpectation *ServiceMockCloseExpectation
+	expectations       []*ServiceMockCloseExpectation
+	inspectClose       func()
+	called             chan struct{}
+	queued             []*ServiceMockCloseResults
+}
+
+// ServiceMockCloseExpectation specifies expectation struct of the Service.Close
+type ServiceMockCloseExpectation struct {
+	mock *ServiceMock
+
+	results *ServiceMockCloseResults
+	Counter uint64
+}
+
+// ServiceMockCloseResults contains results of the Service.Close
+type ServiceMockCloseResults struct {
+	R0 error
+}
+
+// Expect sets up expected params for Service.Close
+func (mmClose *mServiceMockClose) Expect() *mServiceMockClose {
+	if _, mm_func := mmClose.current(); mm_func != nil {
+		mmClose.mock.state.T().Fatalf("ServiceMock.Close mock is already set by Set")
+	}
+
+	mmClose.updateDefault(func(*ServiceMockCloseExpectation) {})
+
+	return mmClose
+}
+
+// updateDefault replaces the default expectation of Service.Close by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmClose *mServiceMockClose) updateDefault(update func(e *ServiceMockCloseExpectation)) {
+	mmClose.state.Mutex.Lock()
+	defer mmClose.state.Mutex.Unlock()
+
+	e := &ServiceMockCloseExpectation{mock: mmClose.mock}
+	if previous := mmClose.defaultExpectation; previous != nil {
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmClose.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Service.Close
+func (mmClose *mServiceMockClose) current() (*ServiceMockCloseExpectation, func() (err error)) {
+	mmClose.state.Mutex.RLock()
+	defer mmClose.state.Mutex.RUnlock()
+
+	return mmClose.defaultExpectation, mmClose.mock.funcClose
+}
+
+// Return sets up results that will be returned by Service.Close
+func (mmClose *mServiceMockClose) Return(err error) *ServiceMock {
+	if _, mm_func := mmClose.current(); mm_func != nil {
+		mmClose.mock.state.T().Fatalf("ServiceMock.Close mock is already set by Set")
+	}
+
+	mm_results := &ServiceMockCloseResults{err}
+	mmClose.updateDefault(func(e *ServiceMockCloseExpectation) { e.results = mm_results })
+
+	return mmClose.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Service.Close,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmClose *mServiceMockClose) ReturnOnce(err error) *mServiceMockClose {
+	mmClose.state.Mutex.Lock()
+	mmClose.queued = append(mmClose.queued, &ServiceMockCloseResults{err})
+	mmClose.state.Mutex.Unlock()
+	mmClose.state.PushResults()
+	return mmClose
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmClose *mServiceMockClose) popResults() *ServiceMockCloseResults {
+	mm_i, mm_ok := mmClose.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmClose.state.Mutex.RLock()
+	defer mmClose.state.Mutex.RUnlock()
+
+	return mmClose.queued[mm_i]
+}
+
+// Inspect sets up the function called with the params of every Service.Close call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmClose *mServiceMockClose) Inspect(f func()) *mServiceMockClose {
+	mmClose.state.Mutex.Lock()
+	mmClose.inspectClose = f
+	mmClose.state.Mutex.Unlock()
+	return mmClose
+}
+
+// expected returns everything set up for the Service.Close call under a single read lock: the default expectation,
+// the functions set by Set and Inspect
+func (mmClose *mServiceMockClose) expected() (*ServiceMockCloseExpectation, func() (err error), func()) {
+	mmClose.state.Mutex.RLock()
+	defer mmClose.state.Mutex.RUnlock()
+
+	return mmClose.defaultExpectation, mmClose.mock.funcClose, mmClose.inspectClose
+}
+
+// setup returns the expectations and the function set up for Service.Close to be checked when the mock is finished
+func (mmClose *mServiceMockClose) setup() (setup minimock.Setup) {
+	mmClose.state.Mutex.RLock()
+	defer mmClose.state.Mutex.RUnlock()
+
+	setup.Func = mmClose.mock.funcClose != nil
+	if e := mmClose.defaultExpectation; e != nil {
+		setup.Default = true
+	}
+
+	for _, e := range mmClose.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Service.Close
+func (mmClose *mServiceMockClose) reset() {
+	mmClose.state.Mutex.Lock()
+	mmClose.defaultExpectation = nil
+	mmClose.expectations = nil
+	mmClose.queued = nil
+	mmClose.state.Mutex.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmClose *mServiceMockClose) MethodName() string {
+	return mmClose.state.Name()
+}
+
+// CallSequence returns the numbers of the Service.Close calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmClose *mServiceMockClose) CallSequence() []uint64 {
+	return mmClose.state.CallSequence()
+}
+
+// WaitForCalls waits until Service.Close is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmClose *mServiceMockClose) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmClose.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Service.Close calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmClose *mServiceMockClose) Block() (release func()) {
+	return mmClose.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Service.Close calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmClose *mServiceMockClose) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmClose.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Service.Close calls are in flight at once
+func (mmClose *mServiceMockClose) LimitConcurrency(n int) *mServiceMockClose {
+	mmClose.state.SetLimit(n)
+	return mmClose
+}
+
+// CaptureCalls creates the buffered channel receiving the signal of each Service.Close call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmClose *mServiceMockClose) CaptureCalls(buffer int) *mServiceMockClose {
+	mmClose.state.History.Lock()
+	mmClose.called = make(chan struct{}, buffer)
+	mmClose.state.History.Unlock()
+	return mmClose
+}
+
+// Called returns the channel set up by CaptureCalls receiving the signal of each Service.Close call
+func (mmClose *mServiceMockClose) Called() <-chan struct{} {
+	mmClose.state.History.Lock()
+	defer mmClose.state.History.Unlock()
+
+	if mmClose.called == nil {
+		mmClose.mock.state.T().Fatalf("Calls of ServiceMock.Close aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmClose.called
+}
+
+// DroppedCalls returns the number of the Service.Close calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmClose *mServiceMockClose) DroppedCalls() uint64 {
+	return mmClose.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Service.Close calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmClose *mServiceMockClose) Times(n uint64) *mServiceMockClose {
+	mmClose.state.SetTimes(n)
+	return mmClose
+}
+
+// Optional excludes Service.Close from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmClose *mServiceMockClose) Optional() *mServiceMockClose {
+	mmClose.state.SetOptional()
+	return mmClose
+}
+
+// Set uses given function f to mock the Service.Close method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmClose *mServiceMockClose) Set(f func() (err error)) *ServiceMock {
+	mmClose.state.Mutex.Lock()
+	defer mmClose.state.Mutex.Unlock()
+
+	if mmClose.defaultExpectation != nil {
+		mmClose.mock.state.T().Fatalf("Default expectation is already set for the Service.Close method")
+	}
+
+	if len(mmClose.expectations) > 0 {
+		mmClose.mock.state.T().Fatalf("Some expectations are already set for the Service.Close method")
+	}
+
+	mmClose.mock.funcClose = f
+	return mmClose.mock
+}
+
+// Close implements Service
+func (mmClose *ServiceMock) Close() (err error) {
+	mm_method := mmClose.CloseMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_method.state.Record()
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- struct{}{}:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcClose, mm_inspectClose := mm_method.expected()
+	if mm_inspectClose != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectClose()
+		}()
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcClose == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, nil) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcClose != nil {
+		return mm_funcClose()
+	}
+	if mm_delegate := mmClose.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Close()
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(nil)
+	return
+}
+
+// CloseAfterCounter returns a count of finished ServiceMock.Close invocations
+func (mmClose *ServiceMock) CloseAfterCounter() uint64 {
+	return mmClose.CloseMock.state.AfterCalls()
+}
+
+// CloseBeforeCounter returns a count of ServiceMock.Close invocations
+func (mmClose *ServiceMock) CloseBeforeCounter() uint64 {
+	return mmClose.CloseMock.state.BeforeCalls()
+}
+
+// CloseCallTimes returns the times of all ServiceMock.Close calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmClose *ServiceMock) CloseCallTimes() []mm_time.Time {
+	return mmClose.CloseMock.state.CallTimes()
+}
+
+// CloseMaxInFlight returns the maximum number of the ServiceMock.Close calls that have been in flight at once
+func (mmClose *ServiceMock) CloseMaxInFlight() int {
+	return mmClose.CloseMock.state.MaxInFlight()
+}
+
+// CloseUnexpectedCounter returns a count of ServiceMock.Close invocations made without an implementation
+func (mmClose *ServiceMock) CloseUnexpectedCounter() uint64 {
+	return mmClose.CloseMock.state.UnexpectedCalls()
+}
+
+// CloseNotCalled returns true if ServiceMock.Close hasn't been called
+func (mmClose *ServiceMock) CloseNotCalled() bool {
+	return mmClose.CloseMock.state.BeforeCalls() == 0
+}
+
+// CloseCallCount returns a count of ServiceMock.Close invocations, it's the same as CloseBeforeCounter
+func (mmClose *ServiceMock) CloseCallCount() uint64 {
+	return mmClose.CloseMock.state.BeforeCalls()
+}
+
+// MinimockCloseDone returns true if the count of the Close invocations corresponds
+// the number of defined expectations
+func (mmClose *ServiceMock) MinimockCloseDone() bool {
+	return mmClose.CloseMock.state.Done(mmClose.CloseMock.setup())
+}
+
+// MinimockCloseInspect logs each unmet expectation
+func (mmClose *ServiceMock) MinimockCloseInspect() {
+	mmClose.CloseMock.state.Inspect(mmClose.CloseMock.setup())
+}
+
+type mServiceMockFormat struct {
+	mock               *ServiceMock
+	state              minimock.MethodState
+	defaultExpectation *ServiceMockFormatExpectation
+	expectations       []*ServiceMockFormatExpectation
+	inspectFormat      func(s1 string, p1 ...interface{})
+	compare            minimock.Comparer
+	calls              []ServiceMockFormatParams
+	called             chan ServiceMockFormatParams
+	queued             []*ServiceMockFormatResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*ServiceMockFormatResults
+}
+
+// ServiceMockFormatExpectation specifies expectation struct of the Service.Format
+type ServiceMockFormatExpectation struct {
+	mock     *ServiceMock
+	params   *ServiceMockFormatParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *ServiceMockFormatResults
+	Counter  uint64
+}
+
+// ServiceMockFormatParams contains parameters of the Service.Format
+type ServiceMockFormatParams struct {
+	P0 string
+	P1 []interface{}
+}
+
+// ServiceMockFormatResults contains results of the Service.Format
+type ServiceMockFormatResults struct {
+	R0 string
+}
+
+// Expect sets up expected params for Service.Format
+func (mmFormat *mServiceMockFormat) Expect(s1 string, p1 ...interface{}) *mServiceMockFormat {
+	if _, mm_func := mmFormat.current(); mm_func != nil {
+		mmFormat.mock.state.T().Fatalf("ServiceMock.Format mock is already set by Set")
+	}
+
+	mm_params := &ServiceMockFormatParams{s1, p1}
+	mmFormat.updateDefault(func(e *ServiceMockFormatExpectation) {
+		if e.partial {
+			mmFormat.mock.state.T().Fatalf("ServiceMock.Format params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmFormat.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmFormat.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmFormat
+}
+
+// updateDefault replaces the default expectation of Service.Format by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmFormat *mServiceMockFormat) updateDefault(update func(e *ServiceMockFormatExpectation)) {
+	mmFormat.state.Mutex.Lock()
+	defer mmFormat.state.Mutex.Unlock()
+
+	e := &ServiceMockFormatExpectation{mock: mmFormat.mock}
+	if previous := mmFormat.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmFormat.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Service.Format
+func (mmFormat *mServiceMockFormat) current() (*ServiceMockFormatExpectation, func(s1 string, p1 ...interface{}) (s2 string)) {
+	mmFormat.state.Mutex.RLock()
+	defer mmFormat.state.Mutex.RUnlock()
+
+	return mmFormat.defaultExpectation, mmFormat.mock.funcFormat
+}
+
+// partialParams updates the default expectation of Service.Format by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmFormat *mServiceMockFormat) partialParams(update func(e *ServiceMockFormatExpectation)) {
+	if _, mm_func := mmFormat.current(); mm_func != nil {
+		mmFormat.mock.state.T().Fatalf("ServiceMock.Format mock is already set by Set")
+	}
+
+	mmFormat.updateDefault(func(e *ServiceMockFormatExpectation) {
+		if e.params == nil {
+			e.params = &ServiceMockFormatParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"P0": minimock.Anything,
+				"P1": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectP0Param1 sets up the expected value of the param #1 of Service.Format,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmFormat *mServiceMockFormat) ExpectP0Param1(P0 string) *mServiceMockFormat {
+	mmFormat.partialParams(func(e *ServiceMockFormatExpectation) {
+		e.params.P0 = P0
+		delete(e.matchers, "P0")
+	})
+	return mmFormat
+}
+
+// MatchP0Param1 sets up the predicate matching the param #1 of Service.Format,
+// it's used instead of the value of the param set by Expect
+func (mmFormat *mServiceMockFormat) MatchP0Param1(f func(got string) bool) *mServiceMockFormat {
+	mm_matcher := minimock.Predicate("predicate func(got string) bool", func(v interface{}) bool {
+		got, _ := v.(string)
+		return f(got)
+	})
+	mmFormat.partialParams(func(e *ServiceMockFormatExpectation) {
+		e.matchers["P0"] = mm_matcher
+	})
+	return mmFormat
+}
+
+// ExpectP1Param2 sets up the expected value of the param #2 of Service.Format,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmFormat *mServiceMockFormat) ExpectP1Param2(P1 []interface{}) *mServiceMockFormat {
+	mmFormat.partialParams(func(e *ServiceMockFormatExpectation) {
+		e.params.P1 = P1
+		delete(e.matchers, "P1")
+	})
+	return mmFormat
+}
+
+// MatchP1Param2 sets up the predicate matching the param #2 of Service.Format,
+// it's used instead of the value of the param set by Expect
+func (mmFormat *mServiceMockFormat) MatchP1Param2(f func(got []interface{}) bool) *mServiceMockFormat {
+	mm_matcher := minimock.Predicate("predicate func(got []interface{}) bool", func(v interface{}) bool {
+		got, _ := v.([]interface{})
+		return f(got)
+	})
+	mmFormat.partialParams(func(e *ServiceMockFormatExpectation) {
+		e.matchers["P1"] = mm_matcher
+	})
+	return mmFormat
+}
+
+// Return sets up results that will be returned by Service.Format
+func (mmFormat *mServiceMockFormat) Return(s2 string) *ServiceMock {
+	if _, mm_func := mmFormat.current(); mm_func != nil {
+		mmFormat.mock.state.T().Fatalf("ServiceMock.Format mock is already set by Set")
+	}
+
+	mm_results := &ServiceMockFormatResults{s2}
+	mmFormat.updateDefault(func(e *ServiceMockFormatExpectation) { e.results = mm_results })
+
+	return mmFormat.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Service.Format,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmFormat *mServiceMockFormat) ReturnOnce(s2 string) *mServiceMockFormat {
+	mmFormat.state.Mutex.Lock()
+	mmFormat.queued = append(mmFormat.queued, &ServiceMockFormatResults{s2})
+	mmFormat.state.Mutex.Unlock()
+	mmFormat.state.PushResults()
+	return mmFormat
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmFormat *mServiceMockFormat) popResults() *ServiceMockFormatResults {
+	mm_i, mm_ok := mmFormat.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmFormat.state.Mutex.RLock()
+	defer mmFormat.state.Mutex.RUnlock()
+
+	return mmFormat.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of Service.Format instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmFormat *mServiceMockFormat) SetComparer(compare minimock.Comparer) *mServiceMockFormat {
+	mmFormat.state.Mutex.Lock()
+	mmFormat.compare = compare
+	mmFormat.state.Mutex.Unlock()
+	return mmFormat
+}
+
+// whenExpectations returns the expectations of Service.Format set by When,
+// the expectations can be set while the method is called concurrently
+func (mmFormat *mServiceMockFormat) whenExpectations() []*ServiceMockFormatExpectation {
+	mmFormat.state.Mutex.RLock()
+	defer mmFormat.state.Mutex.RUnlock()
+
+	return mmFormat.expectations
+}
+
+// Inspect sets up the function called with the params of every Service.Format call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmFormat *mServiceMockFormat) Inspect(f func(s1 string, p1 ...interface{})) *mServiceMockFormat {
+	mmFormat.state.Mutex.Lock()
+	mmFormat.inspectFormat = f
+	mmFormat.state.Mutex.Unlock()
+	return mmFormat
+}
+
+// expected returns everything set up for the Service.Format call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmFormat *mServiceMockFormat) expected() (*ServiceMockFormatExpectation, func(s1 string, p1 ...interface{}) (s2 string), func(s1 string, p1 ...interface{}), minimock.Comparer, []*ServiceMockFormatExpectation, []*ServiceMockFormatResults) {
+	mmFormat.state.Mutex.RLock()
+	defer mmFormat.state.Mutex.RUnlock()
+
+	return mmFormat.defaultExpectation, mmFormat.mock.funcFormat, mmFormat.inspectFormat, mmFormat.compare, mmFormat.expectations, mmFormat.whenResults
+}
+
+// setup returns the expectations and the function set up for Service.Format to be checked when the mock is finished
+func (mmFormat *mServiceMockFormat) setup() (setup minimock.Setup) {
+	mmFormat.state.Mutex.RLock()
+	defer mmFormat.state.Mutex.RUnlock()
+
+	setup.Func = mmFormat.mock.funcFormat != nil
+	if e := mmFormat.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmFormat.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Service.Format, the params of the calls are removed from the history as well
+func (mmFormat *mServiceMockFormat) reset() {
+	mmFormat.state.Mutex.Lock()
+	mmFormat.defaultExpectation = nil
+	mmFormat.expectations = nil
+	mmFormat.queued = nil
+	mmFormat.whenResults = nil
+	mmFormat.state.Mutex.Unlock()
+
+	mmFormat.state.History.Lock()
+	mmFormat.calls = nil
+	mmFormat.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmFormat *mServiceMockFormat) MethodName() string {
+	return mmFormat.state.Name()
+}
+
+// CallSequence returns the numbers of the Service.Format calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmFormat *mServiceMockFormat) CallSequence() []uint64 {
+	return mmFormat.state.CallSequence()
+}
+
+// WaitForCalls waits until Service.Format is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmFormat *mServiceMockFormat) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmFormat.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Service.Format calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmFormat *mServiceMockFormat) Block() (release func()) {
+	return mmFormat.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Service.Format calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmFormat *mServiceMockFormat) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmFormat.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Service.Format calls are in flight at once
+func (mmFormat *mServiceMockFormat) LimitConcurrency(n int) *mServiceMockFormat {
+	mmFormat.state.SetLimit(n)
+	return mmFormat
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Service.Format call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmFormat *mServiceMockFormat) CaptureCalls(buffer int) *mServiceMockFormat {
+	mmFormat.state.History.Lock()
+	mmFormat.called = make(chan ServiceMockFormatParams, buffer)
+	mmFormat.state.History.Unlock()
+	return mmFormat
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Service.Format call
+func (mmFormat *mServiceMockFormat) Called() <-chan ServiceMockFormatParams {
+	mmFormat.state.History.Lock()
+	defer mmFormat.state.History.Unlock()
+
+	if mmFormat.called == nil {
+		mmFormat.mock.state.T().Fatalf("Calls of ServiceMock.Format aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmFormat.called
+}
+
+// DroppedCalls returns the number of the Service.Format calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmFormat *mServiceMockFormat) DroppedCalls() uint64 {
+	return mmFormat.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Service.Format calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmFormat *mServiceMockFormat) Times(n uint64) *mServiceMockFormat {
+	mmFormat.state.SetTimes(n)
+	return mmFormat
+}
+
+// Optional excludes Service.Format from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmFormat *mServiceMockFormat) Optional() *mServiceMockFormat {
+	mmFormat.state.SetOptional()
+	return mmFormat
+}
+
+// Set uses given function f to mock the Service.Format method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmFormat *mServiceMockFormat) Set(f func(s1 string, p1 ...interface{}) (s2 string)) *ServiceMock {
+	mmFormat.state.Mutex.Lock()
+	defer mmFormat.state.Mutex.Unlock()
+
+	if mmFormat.defaultExpectation != nil {
+		mmFormat.mock.state.T().Fatalf("Default expectation is already set for the Service.Format method")
+	}
+
+	if len(mmFormat.expectations) > 0 {
+		mmFormat.mock.state.T().Fatalf("Some expectations are already set for the Service.Format method")
+	}
+
+	mmFormat.mock.funcFormat = f
+	return mmFormat.mock
+}
+
+// When sets expectation for the Service.Format which will trigger the result defined by the following
+// Then helper
+func (mmFormat *mServiceMockFormat) When(s1 string, p1 ...interface{}) *ServiceMockFormatExpectation {
+	if _, mm_func := mmFormat.current(); mm_func != nil {
+		mmFormat.mock.state.T().Fatalf("ServiceMock.Format mock is already set by Set")
+	}
+
+	expectation := &ServiceMockFormatExpectation{
+		mock:   mmFormat.mock,
+		params: &ServiceMockFormatParams{s1, p1},
+	}
+	mmFormat.state.Mutex.Lock()
+	mmFormat.expectations = append(mmFormat.expectations, expectation)
+	mmFormat.whenResults = append(mmFormat.whenResults, nil)
+	mmFormat.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up Service.Format return parameters for the expectation previously defined by the When method
+func (mmExpectation *ServiceMockFormatExpectation) Then(s2 string) *ServiceMock {
+	mm_handle := mmExpectation.mock.FormatMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &ServiceMockFormatResults{s2}
+	mm_results := make([]*ServiceMockFormatResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Format implements Service
+func (mmFormat *ServiceMock) Format(s1 string, p1 ...interface{}) (s2 string) {
+	mm_method := mmFormat.FormatMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := ServiceMockFormatParams{s1, p1}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcFormat, mm_inspectFormat, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectFormat != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectFormat(s1, p1...)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).R0
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcFormat == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcFormat != nil {
+		return mm_funcFormat(s1, p1...)
+	}
+	if mm_delegate := mmFormat.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Format(s1, p1...)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, s1, p1)
+	return
+}
+
+// FormatAfterCounter returns a count of finished ServiceMock.Format invocations
+func (mmFormat *ServiceMock) FormatAfterCounter() uint64 {
+	return mmFormat.FormatMock.state.AfterCalls()
+}
+
+// FormatBeforeCounter returns a count of ServiceMock.Format invocations
+func (mmFormat *ServiceMock) FormatBeforeCounter() uint64 {
+	return mmFormat.FormatMock.state.BeforeCalls()
+}
+
+// FormatCalls returns the params of all ServiceMock.Format calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmFormat *ServiceMock) FormatCalls() []ServiceMockFormatParams {
+	mmFormat.FormatMock.state.History.Lock()
+	defer mmFormat.FormatMock.state.History.Unlock()
+
+	calls := make([]ServiceMockFormatParams, len(mmFormat.FormatMock.calls))
+	copy(calls, mmFormat.FormatMock.calls)
+	return calls
+}
+
+// FormatLastParams returns the params of the latest ServiceMock.Format call and false if there were no calls
+func (mmFormat *ServiceMock) FormatLastParams() (params ServiceMockFormatParams, ok bool) {
+	mmFormat.FormatMock.state.History.Lock()
+	defer mmFormat.FormatMock.state.History.Unlock()
+
+	if n := len(mmFormat.FormatMock.calls); n > 0 {
+		return mmFormat.FormatMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// FormatCallTimes returns the times of all ServiceMock.Format calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmFormat *ServiceMock) FormatCallTimes() []mm_time.Time {
+	return mmFormat.FormatMock.state.CallTimes()
+}
+
+// FormatMaxInFlight returns the maximum number of the ServiceMock.Format calls that have been in flight at once
+func (mmFormat *ServiceMock) FormatMaxInFlight() int {
+	return mmFormat.FormatMock.state.MaxInFlight()
+}
+
+// FormatUnexpectedCounter returns a count of ServiceMock.Format invocations made without an implementation
+func (mmFormat *ServiceMock) FormatUnexpectedCounter() uint64 {
+	return mmFormat.FormatMock.state.UnexpectedCalls()
+}
+
+// FormatNotCalled returns true if ServiceMock.Format hasn't been called
+func (mmFormat *ServiceMock) FormatNotCalled() bool {
+	return mmFormat.FormatMock.state.BeforeCalls() == 0
+}
+
+// FormatCallCount returns a count of ServiceMock.Format invocations, it's the same as FormatBeforeCounter
+func (mmFormat *ServiceMock) FormatCallCount() uint64 {
+	return mmFormat.FormatMock.state.BeforeCalls()
+}
+
+// MinimockFormatDone returns true if the count of the Format invocations corresponds
+// the number of defined expectations
+func (mmFormat *ServiceMock) MinimockFormatDone() bool {
+	return mmFormat.FormatMock.state.Done(mmFormat.FormatMock.setup())
+}
+
+// MinimockFormatInspect logs each unmet expectation
+func (mmFormat *ServiceMock) MinimockFormatInspect() {
+	mmFormat.FormatMock.state.Inspect(mmFormat.FormatMock.setup())
+}
+
+type mServiceMockRead struct {
+	mock               *ServiceMock
+	state              minimock.MethodState
+	defaultExpectation *ServiceMockReadExpectation
+	expectations       []*ServiceMockReadExpectation
+	inspectRead        func(p []byte)
+	compare            minimock.Comparer
+	calls              []ServiceMockReadParams
+	called             chan ServiceMockReadParams
+	queued             []*ServiceMockReadResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*ServiceMockReadResults
+}
+
+// ServiceMockReadExpectation specifies expectation struct of the Service.Read
+type ServiceMockReadExpectation struct {
+	mock     *ServiceMock
+	params   *ServiceMockReadParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *ServiceMockReadResults
+	Counter  uint64
+}
+
+// ServiceMockReadParams contains parameters of the Service.Read
+type ServiceMockReadParams struct {
+	P []byte
+}
+
+// ServiceMockReadResults contains results of the Service.Read
+type ServiceMockReadResults struct {
+	N   int
+	Err error
+}
+
+// Expect sets up expected params for Service.Read
+func (mmRead *mServiceMockRead) Expect(p []byte) *mServiceMockRead {
+	if _, mm_func := mmRead.current(); mm_func != nil {
+		mmRead.mock.state.T().Fatalf("ServiceMock.Read mock is already set by Set")
+	}
+
+	mm_params := &ServiceMockReadParams{p}
+	mmRead.updateDefault(func(e *ServiceMockReadExpectation) {
+		if e.partial {
+			mmRead.mock.state.T().Fatalf("ServiceMock.Read params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmRead.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmRead.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmRead
+}
+
+// updateDefault replaces the default expectation of Service.Read by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmRead *mServiceMockRead) updateDefault(update func(e *ServiceMockReadExpectation)) {
+	mmRead.state.Mutex.Lock()
+	defer mmRead.state.Mutex.Unlock()
+
+	e := &ServiceMockReadExpectation{mock: mmRead.mock}
+	if previous := mmRead.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmRead.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Service.Read
+func (mmRead *mServiceMockRead) current() (*ServiceMockReadExpectation, func(p []byte) (n int, err error)) {
+	mmRead.state.Mutex.RLock()
+	defer mmRead.state.Mutex.RUnlock()
+
+	return mmRead.defaultExpectation, mmRead.mock.funcRead
+}
+
+// partialParams updates the default expectation of Service.Read by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmRead *mServiceMockRead) partialParams(update func(e *ServiceMockReadExpectation)) {
+	if _, mm_func := mmRead.current(); mm_func != nil {
+		mmRead.mock.state.T().Fatalf("ServiceMock.Read mock is already set by Set")
+	}
+
+	mmRead.updateDefault(func(e *ServiceMockReadExpectation) {
+		if e.params == nil {
+			e.params = &ServiceMockReadParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"P": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectPParam1 sets up the expected value of the param #1 of Service.Read,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmRead *mServiceMockRead) ExpectPParam1(P []byte) *mServiceMockRead {
+	mmRead.partialParams(func(e *ServiceMockReadExpectation) {
+		e.params.P = P
+		delete(e.matchers, "P")
+	})
+	return mmRead
+}
+
+// MatchPParam1 sets up the predicate matching the param #1 of Service.Read,
+// it's used instead of the value of the param set by Expect
+func (mmRead *mServiceMockRead) MatchPParam1(f func(got []byte) bool) *mServiceMockRead {
+	mm_matcher := minimock.Predicate("predicate func(got []byte) bool", func(v interface{}) bool {
+		got, _ := v.([]byte)
+		return f(got)
+	})
+	mmRead.partialParams(func(e *ServiceMockReadExpectation) {
+		e.matchers["P"] = mm_matcher
+	})
+	return mmRead
+}
+
+// Return sets up results that will be returned by Service.Read
+func (mmRead *mServiceMockRead) Return(n int, err error) *ServiceMock {
+	if _, mm_func := mmRead.current(); mm_func != nil {
+		mmRead.mock.state.T().Fatalf("ServiceMock.Read mock is already set by Set")
+	}
+
+	mm_results := &ServiceMockReadResults{n, err}
+	mmRead.updateDefault(func(e *ServiceMockReadExpectation) { e.results = mm_results })
+
+	return mmRead.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Service.Read,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmRead *mServiceMockRead) ReturnOnce(n int, err error) *mServiceMockRead {
+	mmRead.state.Mutex.Lock()
+	mmRead.queued = append(mmRead.queued, &ServiceMockReadResults{n, err})
+	mmRead.state.Mutex.Unlock()
+	mmRead.state.PushResults()
+	return mmRead
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmRead *mServiceMockRead) popResults() *ServiceMockReadResults {
+	mm_i, mm_ok := mmRead.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmRead.state.Mutex.RLock()
+	defer mmRead.state.Mutex.RUnlock()
+
+	return mmRead.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of Service.Read instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmRead *mServiceMockRead) SetComparer(compare minimock.Comparer) *mServiceMockRead {
+	mmRead.state.Mutex.Lock()
+	mmRead.compare = compare
+	mmRead.state.Mutex.Unlock()
+	return mmRead
+}
+
+// whenExpectations returns the expectations of Service.Read set by When,
+// the expectations can be set while the method is called concurrently
+func (mmRead *mServiceMockRead) whenExpectations() []*ServiceMockReadExpectation {
+	mmRead.state.Mutex.RLock()
+	defer mmRead.state.Mutex.RUnlock()
+
+	return mmRead.expectations
+}
+
+// Inspect sets up the function called with the params of every Service.Read call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmRead *mServiceMockRead) Inspect(f func(p []byte)) *mServiceMockRead {
+	mmRead.state.Mutex.Lock()
+	mmRead.inspectRead = f
+	mmRead.state.Mutex.Unlock()
+	return mmRead
+}
+
+// expected returns everything set up for the Service.Read call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmRead *mServiceMockRead) expected() (*ServiceMockReadExpectation, func(p []byte) (n int, err error), func(p []byte), minimock.Comparer, []*ServiceMockReadExpectation, []*ServiceMockReadResults) {
+	mmRead.state.Mutex.RLock()
+	defer mmRead.state.Mutex.RUnlock()
+
+	return mmRead.defaultExpectation, mmRead.mock.funcRead, mmRead.inspectRead, mmRead.compare, mmRead.expectations, mmRead.whenResults
+}
+
+// setup returns the expectations and the function set up for Service.Read to be checked when the mock is finished
+func (mmRead *mServiceMockRead) setup() (setup minimock.Setup) {
+	mmRead.state.Mutex.RLock()
+	defer mmRead.state.Mutex.RUnlock()
+
+	setup.Func = mmRead.mock.funcRead != nil
+	if e := mmRead.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmRead.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Service.Read, the params of the calls are removed from the history as well
+func (mmRead *mServiceMockRead) reset() {
+	mmRead.state.Mutex.Lock()
+	mmRead.defaultExpectation = nil
+	mmRead.expectations = nil
+	mmRead.queued = nil
+	mmRead.whenResults = nil
+	mmRead.state.Mutex.Unlock()
+
+	mmRead.state.History.Lock()
+	mmRead.calls = nil
+	mmRead.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmRead *mServiceMockRead) MethodName() string {
+	return mmRead.state.Name()
+}
+
+// CallSequence returns the numbers of the Service.Read calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmRead *mServiceMockRead) CallSequence() []uint64 {
+	return mmRead.state.CallSequence()
+}
+
+// WaitForCalls waits until Service.Read is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmRead *mServiceMockRead) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmRead.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Service.Read calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmRead *mServiceMockRead) Block() (release func()) {
+	return mmRead.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Service.Read calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmRead *mServiceMockRead) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmRead.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Service.Read calls are in flight at once
+func (mmRead *mServiceMockRead) LimitConcurrency(n int) *mServiceMockRead {
+	mmRead.state.SetLimit(n)
+	return mmRead
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Service.Read call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmRead *mServiceMockRead) CaptureCalls(buffer int) *mServiceMockRead {
+	mmRead.state.History.Lock()
+	mmRead.called = make(chan ServiceMockReadParams, buffer)
+	mmRead.state.History.Unlock()
+	return mmRead
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Service.Read call
+func (mmRead *mServiceMockRead) Called() <-chan ServiceMockReadParams {
+	mmRead.state.History.Lock()
+	defer mmRead.state.History.Unlock()
+
+	if mmRead.called == nil {
+		mmRead.mock.state.T().Fatalf("Calls of ServiceMock.Read aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmRead.called
+}
+
+// DroppedCalls returns the number of the Service.Read calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmRead *mServiceMockRead) DroppedCalls() uint64 {
+	return mmRead.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Service.Read calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmRead *mServiceMockRead) Times(n uint64) *mServiceMockRead {
+	mmRead.state.SetTimes(n)
+	return mmRead
+}
+
+// Optional excludes Service.Read from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmRead *mServiceMockRead) Optional() *mServiceMockRead {
+	mmRead.state.SetOptional()
+	return mmRead
+}
+
+// Set uses given function f to mock the Service.Read method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmRead *mServiceMockRead) Set(f func(p []byte) (n int, err error)) *ServiceMock {
+	mmRead.state.Mutex.Lock()
+	defer mmRead.state.Mutex.Unlock()
+
+	if mmRead.defaultExpectation != nil {
+		mmRead.mock.state.T().Fatalf("Default expectation is already set for the Service.Read method")
+	}
+
+	if len(mmRead.expectations) > 0 {
+		mmRead.mock.state.T().Fatalf("Some expectations are already set for the Service.Read method")
+	}
+
+	mmRead.mock.funcRead = f
+	return mmRead.mock
+}
+
+// When sets expectation for the Service.Read which will trigger the result defined by the following
+// Then helper
+func (mmRead *mServiceMockRead) When(p []byte) *ServiceMockReadExpectation {
+	if _, mm_func := mmRead.current(); mm_func != nil {
+		mmRead.mock.state.T().Fatalf("ServiceMock.Read mock is already set by Set")
+	}
+
+	expectation := &ServiceMockReadExpectation{
+		mock:   mmRead.mock,
+		params: &ServiceMockReadParams{p},
+	}
+	mmRead.state.Mutex.Lock()
+	mmRead.expectations = append(mmRead.expectations, expectation)
+	mmRead.whenResults = append(mmRead.whenResults, nil)
+	mmRead.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up Service.Read return parameters for the expectation previously defined by the When method
+func (mmExpectation *ServiceMockReadExpectation) Then(n int, err error) *ServiceMock {
+	mm_handle := mmExpectation.mock.ReadMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &ServiceMockReadResults{n, err}
+	mm_results := make([]*ServiceMockReadResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Read implements Service
+func (mmRead *ServiceMock) Read(p []byte) (n int, err error) {
+	mm_method := mmRead.ReadMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := ServiceMockReadParams{p}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcRead, mm_inspectRead, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectRead != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectRead(p)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).N, (*mm_results).Err
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).N, (*mm_results).Err
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcRead == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).N, (*mm_expectation.results).Err
+	}
+	if mm_funcRead != nil {
+		return mm_funcRead(p)
+	}
+	if mm_delegate := mmRead.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Read(p)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, p)
+	return
+}
+
+// ReadAfterCounter returns a count of finished ServiceMock.Read invocations
+func (mmRead *ServiceMock) ReadAfterCounter() uint64 {
+	return mmRead.ReadMock.state.AfterCalls()
+}
+
+// ReadBeforeCounter returns a count of ServiceMock.Read invocations
+func (mmRead *ServiceMock) ReadBeforeCounter() uint64 {
+	return mmRead.ReadMock.state.BeforeCalls()
+}
+
+// ReadCalls returns the params of all ServiceMock.Read calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmRead *ServiceMock) ReadCalls() []ServiceMockReadParams {
+	mmRead.ReadMock.state.History.Lock()
+	defer mmRead.ReadMock.state.History.Unlock()
+
+	calls := make([]ServiceMockReadParams, len(mmRead.ReadMock.calls))
+	copy(calls, mmRead.ReadMock.calls)
+	return calls
+}
+
+// ReadLastParams returns the params of the latest ServiceMock.Read call and false if there were no calls
+func (mmRead *ServiceMock) ReadLastParams() (params ServiceMockReadParams, ok bool) {
+	mmRead.ReadMock.state.History.Lock()
+	defer mmRead.ReadMock.state.History.Unlock()
+
+	if n := len(mmRead.ReadMock.calls); n > 0 {
+		return mmRead.ReadMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// ReadCallTimes returns the times of all ServiceMock.Read calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmRead *ServiceMock) ReadCallTimes() []mm_time.Time {
+	return mmRead.ReadMock.state.CallTimes()
+}
+
+// ReadMaxInFlight returns the maximum number of the ServiceMock.Read calls that have been in flight at once
+func (mmRead *ServiceMock) ReadMaxInFlight() int {
+	return mmRead.ReadMock.state.MaxInFlight()
+}
+
+// ReadUnexpectedCounter returns a count of ServiceMock.Read invocations made without an implementation
+func (mmRead *ServiceMock) ReadUnexpectedCounter() uint64 {
+	return mmRead.ReadMock.state.UnexpectedCalls()
+}
+
+// ReadNotCalled returns true if ServiceMock.Read hasn't been called
+func (mmRead *ServiceMock) ReadNotCalled() bool {
+	return mmRead.ReadMock.state.BeforeCalls() == 0
+}
+
+// ReadCallCount returns a count of ServiceMock.Read invocations, it's the same as ReadBeforeCounter
+func (mmRead *ServiceMock) ReadCallCount() uint64 {
+	return mmRead.ReadMock.state.BeforeCalls()
+}
+
+// MinimockReadDone returns true if the count of the Read invocations corresponds
+// the number of defined expectations
+func (mmRead *ServiceMock) MinimockReadDone() bool {
+	return mmRead.ReadMock.state.Done(mmRead.ReadMock.setup())
+}
+
+// MinimockReadInspect logs each unmet expectation
+func (mmRead *ServiceMock) MinimockReadInspect() {
+	mmRead.ReadMock.state.Inspect(mmRead.ReadMock.setup())
+}
+
+type mServiceMockStart struct {
+	mock               *ServiceMock
+	state              minimock.MethodState
+	defaultExpectation *ServiceMockStartExpectation
+	expectations       []*ServiceMockStartExpectation
+	inspectStart       func(ctx context.Context)
+	compare            minimock.Comparer
+	calls              []ServiceMockStartParams
+	called             chan ServiceMockStartParams
+	queued             []*ServiceMockStartResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*ServiceMockStartResults
+}
+
+// ServiceMockStartExpectation specifies expectation struct of the Service.Start
+type ServiceMockStartExpectation struct {
+	mock     *ServiceMock
+	params   *ServiceMockStartParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *ServiceMockStartResults
+	Counter  uint64
+}
+
+// ServiceMockStartParams contains parameters of the Service.Start
+type ServiceMockStartParams struct {
+	Ctx context.Context
+}
+
+// ServiceMockStartResults contains results of the Service.Start
+type ServiceMockStartResults struct {
+	R0 error
+}
+
+// Expect sets up expected params for Service.Start
+func (mmStart *mServiceMockStart) Expect(ctx context.Context) *mServiceMockStart {
+	if _, mm_func := mmStart.current(); mm_func != nil {
+		mmStart.mock.state.T().Fatalf("ServiceMock.Start mock is already set by Set")
+	}
+
+	mm_params := &ServiceMockStartParams{ctx}
+	mmStart.updateDefault(func(e *ServiceMockStartExpectation) {
+		if e.partial {
+			mmStart.mock.state.T().Fatalf("ServiceMock.Start params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmStart.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmStart.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmStart
+}
+
+// updateDefault replaces the default expectation of Service.Start by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmStart *mServiceMockStart) updateDefault(update func(e *ServiceMockStartExpectation)) {
+	mmStart.state.Mutex.Lock()
+	defer mmStart.state.Mutex.Unlock()
+
+	e := &ServiceMockStartExpectation{mock: mmStart.mock}
+	if previous := mmStart.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmStart.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Service.Start
+func (mmStart *mServiceMockStart) current() (*ServiceMockStartExpectation, func(ctx context.Context) (err error)) {
+	mmStart.state.Mutex.RLock()
+	defer mmStart.state.Mutex.RUnlock()
+
+	return mmStart.defaultExpectation, mmStart.mock.funcStart
+}
+
+// partialParams updates the default expectation of Service.Start by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmStart *mServiceMockStart) partialParams(update func(e *ServiceMockStartExpectation)) {
+	if _, mm_func := mmStart.current(); mm_func != nil {
+		mmStart.mock.state.T().Fatalf("ServiceMock.Start mock is already set by Set")
+	}
+
+	mmStart.updateDefault(func(e *ServiceMockStartExpectation) {
+		if e.params == nil {
+			e.params = &ServiceMockStartParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"Ctx": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectCtxParam1 sets up the expected value of the param #1 of Service.Start,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmStart *mServiceMockStart) ExpectCtxParam1(Ctx context.Context) *mServiceMockStart {
+	mmStart.partialParams(func(e *ServiceMockStartExpectation) {
+		e.params.Ctx = Ctx
+		delete(e.matchers, "Ctx")
+	})
+	return mmStart
+}
+
+// MatchCtxParam1 sets up the predicate matching the param #1 of Service.Start,
+// it's used instead of the value of the param set by Expect
+func (mmStart *mServiceMockStart) MatchCtxParam1(f func(got context.Context) bool) *mServiceMockStart {
+	mm_matcher := minimock.Predicate("predicate func(got context.Context) bool", func(v interface{}) bool {
+		got, _ := v.(context.Context)
+		return f(got)
+	})
+	mmStart.partialParams(func(e *ServiceMockStartExpectation) {
+		e.matchers["Ctx"] = mm_matcher
+	})
+	return mmStart
+}
+
+// Return sets up results that will be returned by Service.Start
+func (mmStart *mServiceMockStart) Return(err error) *ServiceMock {
+	if _, mm_func := mmStart.current(); mm_func != nil {
+		mmStart.mock.state.T().Fatalf("ServiceMock.Start mock is already set by Set")
+	}
+
+	mm_results := &ServiceMockStartResults{err}
+	mmStart.updateDefault(func(e *ServiceMockStartExpectation) { e.results = mm_results })
+
+	return mmStart.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Service.Start,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmStart *mServiceMockStart) ReturnOnce(err error) *mServiceMockStart {
+	mmStart.state.Mutex.Lock()
+	mmStart.queued = append(mmStart.queued, &ServiceMockStartResults{err})
+	mmStart.state.Mutex.Unlock()
+	mmStart.state.PushResults()
+	return mmStart
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmStart *mServiceMockStart) popResults() *ServiceMockStartResults {
+	mm_i, mm_ok := mmStart.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmStart.state.Mutex.RLock()
+	defer mmStart.state.Mutex.RUnlock()
+
+	return mmStart.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of Service.Start instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmStart *mServiceMockStart) SetComparer(compare minimock.Comparer) *mServiceMockStart {
+	mmStart.state.Mutex.Lock()
+	mmStart.compare = compare
+	mmStart.state.Mutex.Unlock()
+	return mmStart
+}
+
+// whenExpectations returns the expectations of Service.Start set by When,
+// the expectations can be set while the method is called concurrently
+func (mmStart *mServiceMockStart) whenExpectations() []*ServiceMockStartExpectation {
+	mmStart.state.Mutex.RLock()
+	defer mmStart.state.Mutex.RUnlock()
+
+	return mmStart.expectations
+}
+
+// Inspect sets up the function called with the params of every Service.Start call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmStart *mServiceMockStart) Inspect(f func(ctx context.Context)) *mServiceMockStart {
+	mmStart.state.Mutex.Lock()
+	mmStart.inspectStart = f
+	mmStart.state.Mutex.Unlock()
+	return mmStart
+}
+
+// expected returns everything set up for the Service.Start call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmStart *mServiceMockStart) expected() (*ServiceMockStartExpectation, func(ctx context.Context) (err error), func(ctx context.Context), minimock.Comparer, []*ServiceMockStartExpectation, []*ServiceMockStartResults) {
+	mmStart.state.Mutex.RLock()
+	defer mmStart.state.Mutex.RUnlock()
+
+	return mmStart.defaultExpectation, mmStart.mock.funcStart, mmStart.inspectStart, mmStart.compare, mmStart.expectations, mmStart.whenResults
+}
+
+// setup returns the expectations and the function set up for Service.Start to be checked when the mock is finished
+func (mmStart *mServiceMockStart) setup() (setup minimock.Setup) {
+	mmStart.state.Mutex.RLock()
+	defer mmStart.state.Mutex.RUnlock()
+
+	setup.Func = mmStart.mock.funcStart != nil
+	if e := mmStart.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmStart.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Service.Start, the params of the calls are removed from the history as well
+func (mmStart *mServiceMockStart) reset() {
+	mmStart.state.Mutex.Lock()
+	mmStart.defaultExpectation = nil
+	mmStart.expectations = nil
+	mmStart.queued = nil
+	mmStart.whenResults = nil
+	mmStart.state.Mutex.Unlock()
+
+	mmStart.state.History.Lock()
+	mmStart.calls = nil
+	mmStart.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmStart *mServiceMockStart) MethodName() string {
+	return mmStart.state.Name()
+}
+
+// CallSequence returns the numbers of the Service.Start calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmStart *mServiceMockStart) CallSequence() []uint64 {
+	return mmStart.state.CallSequence()
+}
+
+// WaitForCalls waits until Service.Start is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmStart *mServiceMockStart) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmStart.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Service.Start calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmStart *mServiceMockStart) Block() (release func()) {
+	return mmStart.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Service.Start calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmStart *mServiceMockStart) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmStart.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Service.Start calls are in flight at once
+func (mmStart *mServiceMockStart) LimitConcurrency(n int) *mServiceMockStart {
+	mmStart.state.SetLimit(n)
+	return mmStart
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Service.Start call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmStart *mServiceMockStart) CaptureCalls(buffer int) *mServiceMockStart {
+	mmStart.state.History.Lock()
+	mmStart.called = make(chan ServiceMockStartParams, buffer)
+	mmStart.state.History.Unlock()
+	return mmStart
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Service.Start call
+func (mmStart *mServiceMockStart) Called() <-chan ServiceMockStartParams {
+	mmStart.state.History.Lock()
+	defer mmStart.state.History.Unlock()
+
+	if mmStart.called == nil {
+		mmStart.mock.state.T().Fatalf("Calls of ServiceMock.Start aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmStart.called
+}
+
+// DroppedCalls returns the number of the Service.Start calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmStart *mServiceMockStart) DroppedCalls() uint64 {
+	return mmStart.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Service.Start calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmStart *mServiceMockStart) Times(n uint64) *mServiceMockStart {
+	mmStart.state.SetTimes(n)
+	return mmStart
+}
+
+// Optional excludes Service.Start from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmStart *mServiceMockStart) Optional() *mServiceMockStart {
+	mmStart.state.SetOptional()
+	return mmStart
+}
+
+// Set uses given function f to mock the Service.Start method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmStart *mServiceMockStart) Set(f func(ctx context.Context) (err error)) *ServiceMock {
+	mmStart.state.Mutex.Lock()
+	defer mmStart.state.Mutex.Unlock()
+
+	if mmStart.defaultExpectation != nil {
+		mmStart.mock.state.T().Fatalf("Default expectation is already set for the Service.Start method")
+	}
+
+	if len(mmStart.expectations) > 0 {
+		mmStart.mock.state.T().Fatalf("Some expectations are already set for the Service.Start method")
+	}
+
+	mmStart.mock.funcStart = f
+	return mmStart.mock
+}
+
+// When sets expectation for the Service.Start which will trigger the result defined by the following
+// Then helper
+func (mmStart *mServiceMockStart) When(ctx context.Context) *ServiceMockStartExpectation {
+	if _, mm_func := mmStart.current(); mm_func != nil {
+		mmStart.mock.state.T().Fatalf("ServiceMock.Start mock is already set by Set")
+	}
+
+	expectation := &ServiceMockStartExpectation{
+		mock:   mmStart.mock,
+		params: &ServiceMockStartParams{ctx},
+	}
+	mmStart.state.Mutex.Lock()
+	mmStart.expectations = append(mmStart.expectations, expectation)
+	mmStart.whenResults = append(mmStart.whenResults, nil)
+	mmStart.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up Service.Start return parameters for the expectation previously defined by the When method
+func (mmExpectation *ServiceMockStartExpectation) Then(err error) *ServiceMock {
+	mm_handle := mmExpectation.mock.StartMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &ServiceMockStartResults{err}
+	mm_results := make([]*ServiceMockStartResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Start implements Service
+func (mmStart *ServiceMock) Start(ctx context.Context) (err error) {
+	mm_method := mmStart.StartMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := ServiceMockStartParams{ctx}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcStart, mm_inspectStart, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectStart != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectStart(ctx)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).R0
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcStart == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcStart != nil {
+		return mm_funcStart(ctx)
+	}
+	if mm_delegate := mmStart.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Start(ctx)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, ctx)
+	return
+}
+
+// StartAfterCounter returns a count of finished ServiceMock.Start invocations
+func (mmStart *ServiceMock) StartAfterCounter() uint64 {
+	return mmStart.StartMock.state.AfterCalls()
+}
+
+// StartBeforeCounter returns a count of ServiceMock.Start invocations
+func (mmStart *ServiceMock) StartBeforeCounter() uint64 {
+	return mmStart.StartMock.state.BeforeCalls()
+}
+
+// StartCalls returns the params of all ServiceMock.Start calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmStart *ServiceMock) StartCalls() []ServiceMockStartParams {
+	mmStart.StartMock.state.History.Lock()
+	defer mmStart.StartMock.state.History.Unlock()
+
+	calls := make([]ServiceMockStartParams, len(mmStart.StartMock.calls))
+	copy(calls, mmStart.StartMock.calls)
+	return calls
+}
+
+// StartLastParams returns the params of the latest ServiceMock.Start call and false if there were no calls
+func (mmStart *ServiceMock) StartLastParams() (params ServiceMockStartParams, ok bool) {
+	mmStart.StartMock.state.History.Lock()
+	defer mmStart.StartMock.state.History.Unlock()
+
+	if n := len(mmStart.StartMock.calls); n > 0 {
+		return mmStart.StartMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// StartCallTimes returns the times of all ServiceMock.Start calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmStart *ServiceMock) StartCallTimes() []mm_time.Time {
+	return mmStart.StartMock.state.CallTimes()
+}
+
+// StartMaxInFlight returns the maximum number of the ServiceMock.Start calls that have been in flight at once
+func (mmStart *ServiceMock) StartMaxInFlight() int {
+	return mmStart.StartMock.state.MaxInFlight()
+}
+
+// StartUnexpectedCounter returns a count of ServiceMock.Start invocations made without an implementation
+func (mmStart *ServiceMock) StartUnexpectedCounter() uint64 {
+	return mmStart.StartMock.state.UnexpectedCalls()
+}
+
+// StartNotCalled returns true if ServiceMock.Start hasn't been called
+func (mmStart *ServiceMock) StartNotCalled() bool {
+	return mmStart.StartMock.state.BeforeCalls() == 0
+}
+
+// StartCallCount returns a count of ServiceMock.Start invocations, it's the same as StartBeforeCounter
+func (mmStart *ServiceMock) StartCallCount() uint64 {
+	return mmStart.StartMock.state.BeforeCalls()
+}
+
+// MinimockStartDone returns true if the count of the Start invocations corresponds
+// the number of defined expectations
+func (mmStart *ServiceMock) MinimockStartDone() bool {
+	return mmStart.StartMock.state.Done(mmStart.StartMock.setup())
+}
+
+// MinimockStartInspect logs each unmet expectation
+func (mmStart *ServiceMock) MinimockStartInspect() {
+	mmStart.StartMock.state.Inspect(mmStart.StartMock.setup())
+}
+
+type mServiceMockString struct {
+	mock               *ServiceMock
+	state              minimock.MethodState
+	defaultExpectation *ServiceMockStringExpectation
+	expectations       []*ServiceMockStringExpectation
+	inspectString      func()
+	called             chan struct{}
+	queued             []*ServiceMockStringResults
+}
+
+// ServiceMockStringExpectation specifies expectation struct of the Service.String
+type ServiceMockStringExpectation struct {
+	mock *ServiceMock
+
+	results *ServiceMockStringResults
+	Counter uint64
+}
+
+// ServiceMockStringResults contains results of the Service.String
+type ServiceMockStringResults struct {
+	R0 string
+}
+
+// Expect sets up expected params for Service.String
+func (mmString *mServiceMockString) Expect() *mServiceMockString {
+	if _, mm_func := mmString.current(); mm_func != nil {
+		mmString.mock.state.T().Fatalf("ServiceMock.String mock is already set by Set")
+	}
+
+	mmString.updateDefault(func(*ServiceMockStringExpectation) {})
+
+	return mmString
+}
+
+// updateDefault replaces the default expectation of Service.String by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmString *mServiceMockString) updateDefault(update func(e *ServiceMockStringExpectation)) {
+	mmString.state.Mutex.Lock()
+	defer mmString.state.Mutex.Unlock()
+
+	e := &ServiceMockStringExpectation{mock: mmString.mock}
+	if previous := mmString.defaultExpectation; previous != nil {
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmString.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Service.String
+func (mmString *mServiceMockString) current() (*ServiceMockStringExpectation, func() (s1 string)) {
+	mmString.state.Mutex.RLock()
+	defer mmString.state.Mutex.RUnlock()
+
+	return mmString.defaultExpectation, mmString.mock.funcString
+}
+
+// Return sets up results that will be returned by Service.String
+func (mmString *mServiceMockString) Return(s1 string) *ServiceMock {
+	if _, mm_func := mmString.current(); mm_func != nil {
+		mmString.mock.state.T().Fatalf("ServiceMock.String mock is already set by Set")
+	}
+
+	mm_results := &ServiceMockStringResults{s1}
+	mmString.updateDefault(func(e *ServiceMockStringExpectation) { e.results = mm_results })
+
+	return mmString.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Service.String,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmString *mServiceMockString) ReturnOnce(s1 string) *mServiceMockString {
+	mmString.state.Mutex.Lock()
+	mmString.queued = append(mmString.queued, &ServiceMockStringResults{s1})
+	mmString.state.Mutex.Unlock()
+	mmString.state.PushResults()
+	return mmString
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmString *mServiceMockString) popResults() *ServiceMockStringResults {
+	mm_i, mm_ok := mmString.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmString.state.Mutex.RLock()
+	defer mmString.state.Mutex.RUnlock()
+
+	return mmString.queued[mm_i]
+}
+
+// Inspect sets up the function called with the params of every Service.String call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmString *mServiceMockString) Inspect(f func()) *mServiceMockString {
+	mmString.state.Mutex.Lock()
+	mmString.inspectString = f
+	mmString.state.Mutex.Unlock()
+	return mmString
+}
+
+// expected returns everything set up for the Service.String call under a single read lock: the default expectation,
+// the functions set by Set and Inspect
+func (mmString *mServiceMockString) expected() (*ServiceMockStringExpectation, func() (s1 string), func()) {
+	mmString.state.Mutex.RLock()
+	defer mmString.state.Mutex.RUnlock()
+
+	return mmString.defaultExpectation, mmString.mock.funcString, mmString.inspectString
+}
+
+// setup returns the expectations and the function set up for Service.String to be checked when the mock is finished
+func (mmString *mServiceMockString) setup() (setup minimock.Setup) {
+	mmString.state.Mutex.RLock()
+	defer mmString.state.Mutex.RUnlock()
+
+	setup.Func = mmString.mock.funcString != nil
+	if e := mmString.defaultExpectation; e != nil {
+		setup.Default = true
+	}
+
+	for _, e := range mmString.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Service.String
+func (mmString *mServiceMockString) reset() {
+	mmString.state.Mutex.Lock()
+	mmString.defaultExpectation = nil
+	mmString.expectations = nil
+	mmString.queued = nil
+	mmString.state.Mutex.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmString *mServiceMockString) MethodName() string {
+	return mmString.state.Name()
+}
+
+// CallSequence returns the numbers of the Service.String calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmString *mServiceMockString) CallSequence() []uint64 {
+	return mmString.state.CallSequence()
+}
+
+// WaitForCalls waits until Service.String is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmString *mServiceMockString) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmString.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Service.String calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmString *mServiceMockString) Block() (release func()) {
+	return mmString.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Service.String calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmString *mServiceMockString) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmString.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Service.String calls are in flight at once
+func (mmString *mServiceMockString) LimitConcurrency(n int) *mServiceMockString {
+	mmString.state.SetLimit(n)
+	return mmString
+}
+
+// CaptureCalls creates the buffered channel receiving the signal of each Service.String call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmString *mServiceMockString) CaptureCalls(buffer int) *mServiceMockString {
+	mmString.state.History.Lock()
+	mmString.called = make(chan struct{}, buffer)
+	mmString.state.History.Unlock()
+	return mmString
+}
+
+// Called returns the channel set up by CaptureCalls receiving the signal of each Service.String call
+func (mmString *mServiceMockString) Called() <-chan struct{} {
+	mmString.state.History.Lock()
+	defer mmString.state.History.Unlock()
+
+	if mmString.called == nil {
+		mmString.mock.state.T().Fatalf("Calls of ServiceMock.String aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmString.called
+}
+
+// DroppedCalls returns the number of the Service.String calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmString *mServiceMockString) DroppedCalls() uint64 {
+	return mmString.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Service.String calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmString *mServiceMockString) Times(n uint64) *mServiceMockString {
+	mmString.state.SetTimes(n)
+	return mmString
+}
+
+// Optional excludes Service.String from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmString *mServiceMockString) Optional() *mServiceMockString {
+	mmString.state.SetOptional()
+	return mmString
+}
+
+// Set uses given function f to mock the Service.String method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmString *mServiceMockString) Set(f func() (s1 string)) *ServiceMock {
+	mmString.state.Mutex.Lock()
+	defer mmString.state.Mutex.Unlock()
+
+	if mmString.defaultExpectation != nil {
+		mmString.mock.state.T().Fatalf("Default expectation is already set for the Service.String method")
+	}
+
+	if len(mmString.expectations) > 0 {
+		mmString.mock.state.T().Fatalf("Some expectations are already set for the Service.String method")
+	}
+
+	mmString.mock.funcString = f
+	return mmString.mock
+}
+
+// String implements Service
+func (mmString *ServiceMock) String() (s1 string) {
+	mm_method := mmString.StringMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_method.state.Record()
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- struct{}{}:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcString, mm_inspectString := mm_method.expected()
+	if mm_inspectString != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectString()
+		}()
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcString == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, nil) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcString != nil {
+		return mm_funcString()
+	}
+	if mm_delegate := mmString.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.String()
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(nil)
+	return
+}
+
+// StringAfterCounter returns a count of finished ServiceMock.String invocations
+func (mmString *ServiceMock) StringAfterCounter() uint64 {
+	return mmString.StringMock.state.AfterCalls()
+}
+
+// StringBeforeCounter returns a count of ServiceMock.String invocations
+func (mmString *ServiceMock) StringBeforeCounter() uint64 {
+	return mmString.StringMock.state.BeforeCalls()
+}
+
+// StringCallTimes returns the times of all ServiceMock.String calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmString *ServiceMock) StringCallTimes() []mm_time.Time {
+	return mmString.StringMock.state.CallTimes()
+}
+
+// StringMaxInFlight returns the maximum number of the ServiceMock.String calls that have been in flight at once
+func (mmString *ServiceMock) StringMaxInFlight() int {
+	return mmString.StringMock.state.MaxInFlight()
+}
+
+// StringUnexpectedCounter returns a count of ServiceMock.String invocations made without an implementation
+func (mmString *ServiceMock) StringUnexpectedCounter() uint64 {
+	return mmString.StringMock.state.UnexpectedCalls()
+}
+
+// StringNotCalled returns true if ServiceMock.String hasn't been called
+func (mmString *ServiceMock) StringNotCalled() bool {
+	return mmString.StringMock.state.BeforeCalls() == 0
+}
+
+// StringCallCount returns a count of ServiceMock.String invocations, it's the same as StringBeforeCounter
+func (mmString *ServiceMock) StringCallCount() uint64 {
+	return mmString.StringMock.state.BeforeCalls()
+}
+
+// MinimockStringDone returns true if the count of the String invocations corresponds
+// the number of defined expectations
+func (mmString *ServiceMock) MinimockStringDone() bool {
+	return mmString.StringMock.state.Done(mmString.StringMock.setup())
+}
+
+// MinimockStringInspect logs each unmet expectation
+func (mmString *ServiceMock) MinimockStringInspect() {
+	mmString.StringMock.state.Inspect(mmString.StringMock.setup())
+}
+
+type mServiceMockWriteTo struct {
+	mock               *ServiceMock
+	state              minimock.MethodState
+	defaultExpectation *ServiceMockWriteToExpectation
+	expectations       []*ServiceMockWriteToExpectation
+	inspectWriteTo     func(w io.Writer)
+	compare            minimock.Comparer
+	calls              []ServiceMockWriteToParams
+	called             chan ServiceMockWriteToParams
+	queued             []*ServiceMockWriteToResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*ServiceMockWriteToResults
+}
+
+// ServiceMockWriteToExpectation specifies expectation struct of the Service.WriteTo
+type ServiceMockWriteToExpectation struct {
+	mock     *ServiceMock
+	params   *ServiceMockWriteToParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *ServiceMockWriteToResults
+	Counter  uint64
+}
+
+// ServiceMockWriteToParams contains parameters of the Service.WriteTo
+type ServiceMockWriteToParams struct {
+	W io.Writer
+}
+
+// ServiceMockWriteToResults contains results of the Service.WriteTo
+type ServiceMockWriteToResults struct {
+	N   int64
+	Err error
+}
+
+// Expect sets up expected params for Service.WriteTo
+func (mmWriteTo *mServiceMockWriteTo) Expect(w io.Writer) *mServiceMockWriteTo {
+	if _, mm_func := mmWriteTo.current(); mm_func != nil {
+		mmWriteTo.mock.state.T().Fatalf("ServiceMock.WriteTo mock is already set by Set")
+	}
+
+	mm_params := &ServiceMockWriteToParams{w}
+	mmWriteTo.updateDefault(func(e *ServiceMockWriteToExpectation) {
+		if e.partial {
+			mmWriteTo.mock.state.T().Fatalf("ServiceMock.WriteTo params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmWriteTo.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmWriteTo.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmWriteTo
+}
+
+// updateDefault replaces the default expectation of Service.WriteTo by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmWriteTo *mServiceMockWriteTo) updateDefault(update func(e *ServiceMockWriteToExpectation)) {
+	mmWriteTo.state.Mutex.Lock()
+	defer mmWriteTo.state.Mutex.Unlock()
+
+	e := &ServiceMockWriteToExpectation{mock: mmWriteTo.mock}
+	if previous := mmWriteTo.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmWriteTo.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Service.WriteTo
+func (mmWriteTo *mServiceMockWriteTo) current() (*ServiceMockWriteToExpectation, func(w io.Writer) (n int64, err error)) {
+	mmWriteTo.state.Mutex.RLock()
+	defer mmWriteTo.state.Mutex.RUnlock()
+
+	return mmWriteTo.defaultExpectation, mmWriteTo.mock.funcWriteTo
+}
+
+// partialParams updates the default expectation of Service.WriteTo by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmWriteTo *mServiceMockWriteTo) partialParams(update func(e *ServiceMockWriteToExpectation)) {
+	if _, mm_func := mmWriteTo.current(); mm_func != nil {
+		mmWriteTo.mock.state.T().Fatalf("ServiceMock.WriteTo mock is already set by Set")
+	}
+
+	mmWriteTo.updateDefault(func(e *ServiceMockWriteToExpectation) {
+		if e.params == nil {
+			e.params = &ServiceMockWriteToParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"W": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectWParam1 sets up the expected value of the param #1 of Service.WriteTo,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmWriteTo *mServiceMockWriteTo) ExpectWParam1(W io.Writer) *mServiceMockWriteTo {
+	mmWriteTo.partialParams(func(e *ServiceMockWriteToExpectation) {
+		e.params.W = W
+		delete(e.matchers, "W")
+	})
+	return mmWriteTo
+}
+
+// MatchWParam1 sets up the predicate matching the param #1 of Service.WriteTo,
+// it's used instead of the value of the param set by Expect
+func (mmWriteTo *mServiceMockWriteTo) MatchWParam1(f func(got io.Writer) bool) *mServiceMockWriteTo {
+	mm_matcher := minimock.Predicate("predicate func(got io.Writer) bool", func(v interface{}) bool {
+		got, _ := v.(io.Writer)
+		return f(got)
+	})
+	mmWriteTo.partialParams(func(e *ServiceMockWriteToExpectation) {
+		e.matchers["W"] = mm_matcher
+	})
+	return mmWriteTo
+}
+
+// Return sets up results that will be returned by Service.WriteTo
+func (mmWriteTo *mServiceMockWriteTo) Return(n int64, err error) *ServiceMock {
+	if _, mm_func := mmWriteTo.current(); mm_func != nil {
+		mmWriteTo.mock.state.T().Fatalf("ServiceMock.WriteTo mock is already set by Set")
+	}
+
+	mm_results := &ServiceMockWriteToResults{n, err}
+	mmWriteTo.updateDefault(func(e *ServiceMockWriteToExpectation) { e.results = mm_results })
+
+	return mmWriteTo.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Service.WriteTo,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmWriteTo *mServiceMockWriteTo) ReturnOnce(n int64, err error) *mServiceMockWriteTo {
+	mmWriteTo.state.Mutex.Lock()
+	mmWriteTo.queued = append(mmWriteTo.queued, &ServiceMockWriteToResults{n, err})
+	mmWriteTo.state.Mutex.Unlock()
+	mmWriteTo.state.PushResults()
+	return mmWriteTo
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmWriteTo *mServiceMockWriteTo) popResults() *ServiceMockWriteToResults {
+	mm_i, mm_ok := mmWriteTo.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmWriteTo.state.Mutex.RLock()
+	defer mmWriteTo.state.Mutex.RUnlock()
+
+	return mmWriteTo.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of Service.WriteTo instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmWriteTo *mServiceMockWriteTo) SetComparer(compare minimock.Comparer) *mServiceMockWriteTo {
+	mmWriteTo.state.Mutex.Lock()
+	mmWriteTo.compare = compare
+	mmWriteTo.state.Mutex.Unlock()
+	return mmWriteTo
+}
+
+// whenExpectations returns the expectations of Service.WriteTo set by When,
+// the expectations can be set while the method is called concurrently
+func (mmWriteTo *mServiceMockWriteTo) whenExpectations() []*ServiceMockWriteToExpectation {
+	mmWriteTo.state.Mutex.RLock()
+	defer mmWriteTo.state.Mutex.RUnlock()
+
+	return mmWriteTo.expectations
+}
+
+// Inspect sets up the function called with the params of every Service.WriteTo call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmWriteTo *mServiceMockWriteTo) Inspect(f func(w io.Writer)) *mServiceMockWriteTo {
+	mmWriteTo.state.Mutex.Lock()
+	mmWriteTo.inspectWriteTo = f
+	mmWriteTo.state.Mutex.Unlock()
+	return mmWriteTo
+}
+
+// expected returns everything set up for the Service.WriteTo call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmWriteTo *mServiceMockWriteTo) expected() (*ServiceMockWriteToExpectation, func(w io.Writer) (n int64, err error), func(w io.Writer), minimock.Comparer, []*ServiceMockWriteToExpectation, []*ServiceMockWriteToResults) {
+	mmWriteTo.state.Mutex.RLock()
+	defer mmWriteTo.state.Mutex.RUnlock()
+
+	return mmWriteTo.defaultExpectation, mmWriteTo.mock.funcWriteTo, mmWriteTo.inspectWriteTo, mmWriteTo.compare, mmWriteTo.expectations, mmWriteTo.whenResults
+}
+
+// setup returns the expectations and the function set up for Service.WriteTo to be checked when the mock is finished
+func (mmWriteTo *mServiceMockWriteTo) setup() (setup minimock.Setup) {
+	mmWriteTo.state.Mutex.RLock()
+	defer mmWriteTo.state.Mutex.RUnlock()
+
+	setup.Func = mmWriteTo.mock.funcWriteTo != nil
+	if e := mmWriteTo.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmWriteTo.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Service.WriteTo, the params of the calls are removed from the history as well
+func (mmWriteTo *mServiceMockWriteTo) reset() {
+	mmWriteTo.state.Mutex.Lock()
+	mmWriteTo.defaultExpectation = nil
+	mmWriteTo.expectations = nil
+	mmWriteTo.queued = nil
+	mmWriteTo.whenResults = nil
+	mmWriteTo.state.Mutex.Unlock()
+
+	mmWriteTo.state.History.Lock()
+	mmWriteTo.calls = nil
+	mmWriteTo.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmWriteTo *mServiceMockWriteTo) MethodName() string {
+	return mmWriteTo.state.Name()
+}
+
+// CallSequence returns the numbers of the Service.WriteTo calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmWriteTo *mServiceMockWriteTo) CallSequence() []uint64 {
+	return mmWriteTo.state.CallSequence()
+}
+
+// WaitForCalls waits until Service.WriteTo is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmWriteTo *mServiceMockWriteTo) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmWriteTo.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Service.WriteTo calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmWriteTo *mServiceMockWriteTo) Block() (release func()) {
+	return mmWriteTo.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Service.WriteTo calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmWriteTo *mServiceMockWriteTo) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmWriteTo.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Service.WriteTo calls are in flight at once
+func (mmWriteTo *mServiceMockWriteTo) LimitConcurrency(n int) *mServiceMockWriteTo {
+	mmWriteTo.state.SetLimit(n)
+	return mmWriteTo
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Service.WriteTo call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmWriteTo *mServiceMockWriteTo) CaptureCalls(buffer int) *mServiceMockWriteTo {
+	mmWriteTo.state.History.Lock()
+	mmWriteTo.called = make(chan ServiceMockWriteToParams, buffer)
+	mmWriteTo.state.History.Unlock()
+	return mmWriteTo
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Service.WriteTo call
+func (mmWriteTo *mServiceMockWriteTo) Called() <-chan ServiceMockWriteToParams {
+	mmWriteTo.state.History.Lock()
+	defer mmWriteTo.state.History.Unlock()
+
+	if mmWriteTo.called == nil {
+		mmWriteTo.mock.state.T().Fatalf("Calls of ServiceMock.WriteTo aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmWriteTo.called
+}
+
+// DroppedCalls returns the number of the Service.WriteTo calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmWriteTo *mServiceMockWriteTo) DroppedCalls() uint64 {
+	return mmWriteTo.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Service.WriteTo calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmWriteTo *mServiceMockWriteTo) Times(n uint64) *mServiceMockWriteTo {
+	mmWriteTo.state.SetTimes(n)
+	return mmWriteTo
+}
+
+// Optional excludes Service.WriteTo from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmWriteTo *mServiceMockWriteTo) Optional() *mServiceMockWriteTo {
+	mmWriteTo.state.SetOptional()
+	return mmWriteTo
+}
+
+// Set uses given function f to mock the Service.WriteTo method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmWriteTo *mServiceMockWriteTo) Set(f func(w io.Writer) (n int64, err error)) *ServiceMock {
+	mmWriteTo.state.Mutex.Lock()
+	defer mmWriteTo.state.Mutex.Unlock()
+
+	if mmWriteTo.defaultExpectation != nil {
+		mmWriteTo.mock.state.T().Fatalf("Default expectation is already set for the Service.WriteTo method")
+	}
+
+	if len(mmWriteTo.expectations) > 0 {
+		mmWriteTo.mock.state.T().Fatalf("Some expectations are already set for the Service.WriteTo method")
+	}
+
+	mmWriteTo.mock.funcWriteTo = f
+	return mmWriteTo.mock
+}
+
+// When sets expectation for the Service.WriteTo which will trigger the result defined by the following
+// Then helper
+func (mmWriteTo *mServiceMockWriteTo) When(w io.Writer) *ServiceMockWriteToExpectation {
+	if _, mm_func := mmWriteTo.current(); mm_func != nil {
+		mmWriteTo.mock.state.T().Fatalf("ServiceMock.WriteTo mock is already set by Set")
+	}
+
+	expectation := &ServiceMockWriteToExpectation{
+		mock:   mmWriteTo.mock,
+		params: &ServiceMockWriteToParams{w},
+	}
+	mmWriteTo.state.Mutex.Lock()
+	mmWriteTo.expectations = append(mmWriteTo.expectations, expectation)
+	mmWriteTo.whenResults = append(mmWriteTo.whenResults, nil)
+	mmWriteTo.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up Service.WriteTo return parameters for the expectation previously defined by the When method
+func (mmExpectation *ServiceMockWriteToExpectation) Then(n int64, err error) *ServiceMock {
+	mm_handle := mmExpectation.mock.WriteToMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &ServiceMockWriteToResults{n, err}
+	mm_results := make([]*ServiceMockWriteToResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// WriteTo implements Service
+func (mmWriteTo *ServiceMock) WriteTo(w io.Writer) (n int64, err error) {
+	mm_method := mmWriteTo.WriteToMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := ServiceMockWriteToParams{w}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcWriteTo, mm_inspectWriteTo, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectWriteTo != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectWriteTo(w)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).N, (*mm_results).Err
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).N, (*mm_results).Err
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcWriteTo == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).N, (*mm_expectation.results).Err
+	}
+	if mm_funcWriteTo != nil {
+		return mm_funcWriteTo(w)
+	}
+	if mm_delegate := mmWriteTo.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.WriteTo(w)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, w)
+	return
+}
+
+// WriteToAfterCounter returns a count of finished ServiceMock.WriteTo invocations
+func (mmWriteTo *ServiceMock) WriteToAfterCounter() uint64 {
+	return mmWriteTo.WriteToMock.state.AfterCalls()
+}
+
+// WriteToBeforeCounter returns a count of ServiceMock.WriteTo invocations
+func (mmWriteTo *ServiceMock) WriteToBeforeCounter() uint64 {
+	return mmWriteTo.WriteToMock.state.BeforeCalls()
+}
+
+// WriteToCalls returns the params of all ServiceMock.WriteTo calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmWriteTo *ServiceMock) WriteToCalls() []ServiceMockWriteToParams {
+	mmWriteTo.WriteToMock.state.History.Lock()
+	defer mmWriteTo.WriteToMock.state.History.Unlock()
+
+	calls := make([]ServiceMockWriteToParams, len(mmWriteTo.WriteToMock.calls))
+	copy(calls, mmWriteTo.WriteToMock.calls)
+	return calls
+}
+
+// WriteToLastParams returns the params of the latest ServiceMock.WriteTo call and false if there were no calls
+func (mmWriteTo *ServiceMock) WriteToLastParams() (params ServiceMockWriteToParams, ok bool) {
+	mmWriteTo.WriteToMock.state.History.Lock()
+	defer mmWriteTo.WriteToMock.state.History.Unlock()
+
+	if n := len(mmWriteTo.WriteToMock.calls); n > 0 {
+		return mmWriteTo.WriteToMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// WriteToCallTimes returns the times of all ServiceMock.WriteTo calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmWriteTo *ServiceMock) WriteToCallTimes() []mm_time.Time {
+	return mmWriteTo.WriteToMock.state.CallTimes()
+}
+
+// WriteToMaxInFlight returns the maximum number of the ServiceMock.WriteTo calls that have been in flight at once
+func (mmWriteTo *ServiceMock) WriteToMaxInFlight() int {
+	return mmWriteTo.WriteToMock.state.MaxInFlight()
+}
+
+// WriteToUnexpectedCounter returns a count of ServiceMock.WriteTo invocations made without an implementation
+func (mmWriteTo *ServiceMock) WriteToUnexpectedCounter() uint64 {
+	return mmWriteTo.WriteToMock.state.UnexpectedCalls()
+}
+
+// WriteToNotCalled returns true if ServiceMock.WriteTo hasn't been called
+func (mmWriteTo *ServiceMock) WriteToNotCalled() bool {
+	return mmWriteTo.WriteToMock.state.BeforeCalls() == 0
+}
+
+// WriteToCallCount returns a count of ServiceMock.WriteTo invocations, it's the same as WriteToBeforeCounter
+func (mmWriteTo *ServiceMock) WriteToCallCount() uint64 {
+	return mmWriteTo.WriteToMock.state.BeforeCalls()
+}
+
+// MinimockWriteToDone returns true if the count of the WriteTo invocations corresponds
+// the number of defined expectations
+func (mmWriteTo *ServiceMock) MinimockWriteToDone() bool {
+	return mmWriteTo.WriteToMock.state.Done(mmWriteTo.WriteToMock.setup())
+}
+
+// MinimockWriteToInspect logs each unmet expectation
+func (mmWriteTo *ServiceMock) MinimockWriteToInspect() {
+	mmWriteTo.WriteToMock.state.Inspect(mmWriteTo.WriteToMock.setup())
+}
+
+// MinimockSetComparer sets up the function comparing the expected and the actual params of all ServiceMock methods instead of minimock.Equal,
+// the params matched by the matchers aren't compared
+func (m *ServiceMock) MinimockSetComparer(compare minimock.Comparer) *ServiceMock {
+	m.state.SetComparer(compare)
+	return m
+}
+
+// MinimockSetSequence sets up the sequence numbering the ServiceMock calls, the mocks sharing the sequence
+// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+func (m *ServiceMock) MinimockSetSequence(sequence *minimock.Sequence) *ServiceMock {
+	m.state.SetSequence(sequence)
+	return m
+}
+
+// MinimockSetClock sets up the function returning the current time for the history of ServiceMock calls instead of time.Now
+func (m *ServiceMock) MinimockSetClock(clock func() mm_time.Time) *ServiceMock {
+	m.state.SetClock(clock)
+	return m
+}
+
+// MinimockSetAutoFinish enables or disables the check of ServiceMock made by the Cleanup of the tester passed to NewServiceMock,
+// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+func (m *ServiceMock) MinimockSetAutoFinish(enabled bool) *ServiceMock {
+	m.state.SetAutoFinish(enabled)
+	return m
+}
+
+// MinimockSetDelegate sets up the implementation the calls of the ServiceMock methods are forwarded to when the methods
+// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+func (m *ServiceMock) MinimockSetDelegate(impl Service) *ServiceMock {
+	m.mutex.Lock()
+	m.delegate = impl
+	m.mutex.Unlock()
+	return m
+}
+
+func (m *ServiceMock) minimockDelegate() Service {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.delegate
+}
+
+// MinimockSetLenient enables or disables the lenient mode of ServiceMock: the calls of the methods that have neither
+// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+func (m *ServiceMock) MinimockSetLenient(enabled bool) *ServiceMock {
+	m.state.SetLenient(enabled)
+	return m
+}
+
+// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (m *ServiceMock) MinimockLenientCalls() map[string]uint64 {
+	return m.state.LenientCalls()
+}
+
+// MinimockAssertNotCalled fails the test without stopping it if any of the ServiceMock methods with the given names has been called,
+// it doesn't depend on the expectations and the functions set up for the methods
+func (m *ServiceMock) MinimockAssertNotCalled(methodNames ...string) {
+	m.state.AssertNotCalled(methodNames...)
+}
+
+// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+// queued by ReturnOnce of all ServiceMock methods, the functions set by Set and Inspect are kept.
+// It fails the test if any of the methods is being called
+func (m *ServiceMock) MinimockReset() {
+	m.state.Reset(
+		m.CloseMock.reset,
+		m.FormatMock.reset,
+		m.ReadMock.reset,
+		m.StartMock.reset,
+		m.StringMock.reset,
+		m.WriteToMock.reset,
+	)
+}
+
+// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+func (m *ServiceMock) MinimockResetAll() {
+	m.MinimockReset()
+	m.CloseMock.state.Mutex.Lock()
+	m.funcClose = nil
+	m.CloseMock.inspectClose = nil
+	m.CloseMock.state.Mutex.Unlock()
+	m.FormatMock.state.Mutex.Lock()
+	m.funcFormat = nil
+	m.FormatMock.inspectFormat = nil
+	m.FormatMock.state.Mutex.Unlock()
+	m.ReadMock.state.Mutex.Lock()
+	m.funcRead = nil
+	m.ReadMock.inspectRead = nil
+	m.ReadMock.state.Mutex.Unlock()
+	m.StartMock.state.Mutex.Lock()
+	m.funcStart = nil
+	m.StartMock.inspectStart = nil
+	m.StartMock.state.Mutex.Unlock()
+	m.StringMock.state.Mutex.Lock()
+	m.funcString = nil
+	m.StringMock.inspectString = nil
+	m.StringMock.state.Mutex.Unlock()
+	m.WriteToMock.state.Mutex.Lock()
+	m.funcWriteTo = nil
+	m.WriteToMock.inspectWriteTo = nil
+	m.WriteToMock.state.Mutex.Unlock()
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ServiceMock) MinimockFinish() {
+	m.state.Finish(m.minimockDone,
+		m.MinimockCloseInspect,
+		m.MinimockFormatInspect,
+		m.MinimockReadInspect,
+		m.MinimockStartInspect,
+		m.MinimockStringInspect,
+		m.MinimockWriteToInspect,
+	)
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ServiceMock) MinimockWait(timeout mm_time.Duration) {
+	m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
+}
+
+func (m *ServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCloseDone() &&
+		m.MinimockFormatDone() &&
+		m.MinimockReadDone() &&
+		m.MinimockStartDone() &&
+		m.MinimockStringDone() &&
+		m.MinimockWriteToDone()
+}
@@ -0,0 +1,33 @@
+package tests
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceMock_EmbeddedInterfaces(t *testing.T) {
+	serviceMock := NewServiceMock(t).
+		StringMock.Return("service").
+		FormatMock.Return("formatted").
+		StartMock.Return(nil).
+		ReadMock.Return(0, io.EOF).
+		CloseMock.Return(nil).
+		WriteToMock.Return(0, nil)
+	defer serviceMock.MinimockFinish()
+
+	var service Service = serviceMock
+
+	assert.Equal(t, "service", service.String())
+	assert.Equal(t, "formatted", service.Format("%d", 1))
+	assert.NoError(t, service.Start(context.Background()))
+
+	_, err := service.Read(nil)
+	assert.Equal(t, io.EOF, err)
+	assert.NoError(t, service.Close())
+
+	_, err = service.WriteTo(io.Discard)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,7 @@
+// Package types is used to test mocks of the interfaces referring to several packages with the same name
+package types
+
+// Parcel is a shipped parcel
+type Parcel struct {
+	Items int
+}
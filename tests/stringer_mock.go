@@ -0,0 +1,476 @@
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+package tests
+
+//go:generate minimock -i github.com/gojuno/minimock/tests.Stringer -o ./stringer_mock.go
+
+import (
+	mm_sync "sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock"
+)
+
+// StringerMock implements Stringer
+//
+// Stringer type is used to test mocks of the named types which underlying type is an interface from another package
+type StringerMock struct {
+	state    minimock.MockState
+	mutex    mm_sync.RWMutex
+	delegate Stringer
+
+	funcString func() (s1 string)
+	StringMock *mStringerMockString
+}
+
+var _ Stringer = (*StringerMock)(nil)
+
+// NewStringerMock returns a mock for Stringer
+func NewStringerMock(t minimock.Tester) *StringerMock {
+	m := &StringerMock{}
+	m.state.Init(m, t, "StringerMock", 0)
+
+	m.StringMock = &mStringerMockString{mock: m}
+	m.StringMock.state.Init(&m.state, "String")
+
+	return m
+}
+
+type mStringerMockString struct {
+	mock               *StringerMock
+	state              minimock.MethodState
+	defaultExpectation *StringerMockStringExpectation
+	expectations       []*StringerMockStringExpectation
+	inspectString      func()
+	called             chan struct{}
+	queued             []*StringerMockStringResults
+}
+
+// StringerMockStringExpectation specifies expectation struct of the Stringer.String
+type StringerMockStringExpectation struct {
+	mock *StringerMock
+
+	results *StringerMockStringResults
+	Counter uint64
+}
+
+// StringerMockStringResults contains results of the Stringer.String
+type StringerMockStringResults struct {
+	R0 string
+}
+
+// Expect sets up expected params for Stringer.String
+func (mmString *mStringerMockString) Expect() *mStringerMockString {
+	if _, mm_func := mmString.current(); mm_func != nil {
+		mmString.mock.state.T().Fatalf("StringerMock.String mock is already set by Set")
+	}
+
+	mmString.updateDefault(func(*StringerMockStringExpectation) {})
+
+	return mmString
+}
+
+// updateDefault replaces the default expectation of Stringer.String by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmString *mStringerMockString) updateDefault(update func(e *StringerMockStringExpectation)) {
+	mmString.state.Mutex.Lock()
+	defer mmString.state.Mutex.Unlock()
+
+	e := &StringerMockStringExpectation{mock: mmString.mock}
+	if previous := mmString.defaultExpectation; previous != nil {
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmString.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Stringer.String
+func (mmString *mStringerMockString) current() (*StringerMockStringExpectation, func() (s1 string)) {
+	mmString.state.Mutex.RLock()
+	defer mmString.state.Mutex.RUnlock()
+
+	return mmString.defaultExpectation, mmString.mock.funcString
+}
+
+// Return sets up results that will be returned by Stringer.String
+func (mmString *mStringerMockString) Return(s1 string) *StringerMock {
+	if _, mm_func := mmString.current(); mm_func != nil {
+		mmString.mock.state.T().Fatalf("StringerMock.String mock is already set by Set")
+	}
+
+	mm_results := &StringerMockStringResults{s1}
+	mmString.updateDefault(func(e *StringerMockStringExpectation) { e.results = mm_results })
+
+	return mmString.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Stringer.String,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmString *mStringerMockString) ReturnOnce(s1 string) *mStringerMockString {
+	mmString.state.Mutex.Lock()
+	mmString.queued = append(mmString.queued, &StringerMockStringResults{s1})
+	mmString.state.Mutex.Unlock()
+	mmString.state.PushResults()
+	return mmString
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmString *mStringerMockString) popResults() *StringerMockStringResults {
+	mm_i, mm_ok := mmString.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmString.state.Mutex.RLock()
+	defer mmString.state.Mutex.RUnlock()
+
+	return mmString.queued[mm_i]
+}
+
+// Inspect sets up the function called with the params of every Stringer.String call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmString *mStringerMockString) Inspect(f func()) *mStringerMockString {
+	mmString.state.Mutex.Lock()
+	mmString.inspectString = f
+	mmString.state.Mutex.Unlock()
+	return mmString
+}
+
+// expected returns everything set up for the Stringer.String call under a single read lock: the default expectation,
+// the functions set by Set and Inspect
+func (mmString *mStringerMockString) expected() (*StringerMockStringExpectation, func() (s1 string), func()) {
+	mmString.state.Mutex.RLock()
+	defer mmString.state.Mutex.RUnlock()
+
+	return mmString.defaultExpectation, mmString.mock.funcString, mmString.inspectString
+}
+
+// setup returns the expectations and the function set up for Stringer.String to be checked when the mock is finished
+func (mmString *mStringerMockString) setup() (setup minimock.Setup) {
+	mmString.state.Mutex.RLock()
+	defer mmString.state.Mutex.RUnlock()
+
+	setup.Func = mmString.mock.funcString != nil
+	if e := mmString.defaultExpectation; e != nil {
+		setup.Default = true
+	}
+
+	for _, e := range mmString.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Stringer.String
+func (mmString *mStringerMockString) reset() {
+	mmString.state.Mutex.Lock()
+	mmString.defaultExpectation = nil
+	mmString.expectations = nil
+	mmString.queued = nil
+	mmString.state.Mutex.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmString *mStringerMockString) MethodName() string {
+	return mmString.state.Name()
+}
+
+// CallSequence returns the numbers of the Stringer.String calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmString *mStringerMockString) CallSequence() []uint64 {
+	return mmString.state.CallSequence()
+}
+
+// WaitForCalls waits until Stringer.String is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmString *mStringerMockString) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmString.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Stringer.String calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmString *mStringerMockString) Block() (release func()) {
+	return mmString.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Stringer.String calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmString *mStringerMockString) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmString.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Stringer.String calls are in flight at once
+func (mmString *mStringerMockString) LimitConcurrency(n int) *mStringerMockString {
+	mmString.state.SetLimit(n)
+	return mmString
+}
+
+// CaptureCalls creates the buffered channel receiving the signal of each Stringer.String call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmString *mStringerMockString) CaptureCalls(buffer int) *mStringerMockString {
+	mmString.state.History.Lock()
+	mmString.called = make(chan struct{}, buffer)
+	mmString.state.History.Unlock()
+	return mmString
+}
+
+// Called returns the channel set up by CaptureCalls receiving the signal of each Stringer.String call
+func (mmString *mStringerMockString) Called() <-chan struct{} {
+	mmString.state.History.Lock()
+	defer mmString.state.History.Unlock()
+
+	if mmString.called == nil {
+		mmString.mock.state.T().Fatalf("Calls of StringerMock.String aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmString.called
+}
+
+// DroppedCalls returns the number of the Stringer.String calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmString *mStringerMockString) DroppedCalls() uint64 {
+	return mmString.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Stringer.String calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmString *mStringerMockString) Times(n uint64) *mStringerMockString {
+	mmString.state.SetTimes(n)
+	return mmString
+}
+
+// Optional excludes Stringer.String from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmString *mStringerMockString) Optional() *mStringerMockString {
+	mmString.state.SetOptional()
+	return mmString
+}
+
+// Set uses given function f to mock the Stringer.String method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmString *mStringerMockString) Set(f func() (s1 string)) *StringerMock {
+	mmString.state.Mutex.Lock()
+	defer mmString.state.Mutex.Unlock()
+
+	if mmString.defaultExpectation != nil {
+		mmString.mock.state.T().Fatalf("Default expectation is already set for the Stringer.String method")
+	}
+
+	if len(mmString.expectations) > 0 {
+		mmString.mock.state.T().Fatalf("Some expectations are already set for the Stringer.String method")
+	}
+
+	mmString.mock.funcString = f
+	return mmString.mock
+}
+
+// String implements Stringer
+func (mmString *StringerMock) String() (s1 string) {
+	mm_method := mmString.StringMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_method.state.Record()
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- struct{}{}:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcString, mm_inspectString := mm_method.expected()
+	if mm_inspectString != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectString()
+		}()
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		return (*mm_results).R0
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcString == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, nil) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).R0
+	}
+	if mm_funcString != nil {
+		return mm_funcString()
+	}
+	if mm_delegate := mmString.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.String()
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(nil)
+	return
+}
+
+// StringAfterCounter returns a count of finished StringerMock.String invocations
+func (mmString *StringerMock) StringAfterCounter() uint64 {
+	return mmString.StringMock.state.AfterCalls()
+}
+
+// StringBeforeCounter returns a count of StringerMock.String invocations
+func (mmString *StringerMock) StringBeforeCounter() uint64 {
+	return mmString.StringMock.state.BeforeCalls()
+}
+
+// StringCallTimes returns the times of all StringerMock.String calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmString *StringerMock) StringCallTimes() []mm_time.Time {
+	return mmString.StringMock.state.CallTimes()
+}
+
+// StringMaxInFlight returns the maximum number of the StringerMock.String calls that have been in flight at once
+func (mmString *StringerMock) StringMaxInFlight() int {
+	return mmString.StringMock.state.MaxInFlight()
+}
+
+// StringUnexpectedCounter returns a count of StringerMock.String invocations made without an implementation
+func (mmString *StringerMock) StringUnexpectedCounter() uint64 {
+	return mmString.StringMock.state.UnexpectedCalls()
+}
+
+// StringNotCalled returns true if StringerMock.String hasn't been called
+func (mmString *StringerMock) StringNotCalled() bool {
+	return mmString.StringMock.state.BeforeCalls() == 0
+}
+
+// StringCallCount returns a count of StringerMock.String invocations, it's the same as StringBeforeCounter
+func (mmString *StringerMock) StringCallCount() uint64 {
+	return mmString.StringMock.state.BeforeCalls()
+}
+
+// MinimockStringDone returns true if the count of the String invocations corresponds
+// the number of defined expectations
+func (mmString *StringerMock) MinimockStringDone() bool {
+	return mmString.StringMock.state.Done(mmString.StringMock.setup())
+}
+
+// MinimockStringInspect logs each unmet expectation
+func (mmString *StringerMock) MinimockStringInspect() {
+	mmString.StringMock.state.Inspect(mmString.StringMock.setup())
+}
+
+// MinimockSetComparer sets up the function comparing the expected and the actual params of all StringerMock methods instead of minimock.Equal,
+// the params matched by the matchers aren't compared
+func (m *StringerMock) MinimockSetComparer(compare minimock.Comparer) *StringerMock {
+	m.state.SetComparer(compare)
+	return m
+}
+
+// MinimockSetSequence sets up the sequence numbering the StringerMock calls, the mocks sharing the sequence
+// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+func (m *StringerMock) MinimockSetSequence(sequence *minimock.Sequence) *StringerMock {
+	m.state.SetSequence(sequence)
+	return m
+}
+
+// MinimockSetClock sets up the function returning the current time for the history of StringerMock calls instead of time.Now
+func (m *StringerMock) MinimockSetClock(clock func() mm_time.Time) *StringerMock {
+	m.state.SetClock(clock)
+	return m
+}
+
+// MinimockSetAutoFinish enables or disables the check of StringerMock made by the Cleanup of the tester passed to NewStringerMock,
+// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+func (m *StringerMock) MinimockSetAutoFinish(enabled bool) *StringerMock {
+	m.state.SetAutoFinish(enabled)
+	return m
+}
+
+// MinimockSetDelegate sets up the implementation the calls of the StringerMock methods are forwarded to when the methods
+// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+func (m *StringerMock) MinimockSetDelegate(impl Stringer) *StringerMock {
+	m.mutex.Lock()
+	m.delegate = impl
+	m.mutex.Unlock()
+	return m
+}
+
+func (m *StringerMock) minimockDelegate() Stringer {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.delegate
+}
+
+// MinimockSetLenient enables or disables the lenient mode of StringerMock: the calls of the methods that have neither
+// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+func (m *StringerMock) MinimockSetLenient(enabled bool) *StringerMock {
+	m.state.SetLenient(enabled)
+	return m
+}
+
+// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (m *StringerMock) MinimockLenientCalls() map[string]uint64 {
+	return m.state.LenientCalls()
+}
+
+// MinimockAssertNotCalled fails the test without stopping it if any of the StringerMock methods with the given names has been called,
+// it doesn't depend on the expectations and the functions set up for the methods
+func (m *StringerMock) MinimockAssertNotCalled(methodNames ...string) {
+	m.state.AssertNotCalled(methodNames...)
+}
+
+// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+// queued by ReturnOnce of all StringerMock methods, the functions set by Set and Inspect are kept.
+// It fails the test if any of the methods is being called
+func (m *StringerMock) MinimockReset() {
+	m.state.Reset(
+		m.StringMock.reset,
+	)
+}
+
+// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+func (m *StringerMock) MinimockResetAll() {
+	m.MinimockReset()
+	m.StringMock.state.Mutex.Lock()
+	m.funcString = nil
+	m.StringMock.inspectString = nil
+	m.StringMock.state.Mutex.Unlock()
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *StringerMock) MinimockFinish() {
+	m.state.Finish(m.minimockDone,
+		m.MinimockStringInspect,
+	)
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *StringerMock) MinimockWait(timeout mm_time.Duration) {
+	m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
+}
+
+func (m *StringerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockStringDone()
+}
@@ -0,0 +1,18 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringerMock_NamedType(t *testing.T) {
+	stringerMock := NewStringerMock(t).StringMock.Return("hello")
+	defer stringerMock.MinimockFinish()
+
+	var stringer Stringer = stringerMock
+	assert.Equal(t, "hello", stringer.String())
+
+	var _ fmt.Stringer = stringerMock
+}
@@ -0,0 +1,743 @@
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+package tests
+
+//go:generate minimock -i github.com/gojuno/minimock/tests.Swapper -o ./swapper_mock.go
+
+import (
+	mm_sync "sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock"
+)
+
+// SwapperMock implements Swapper
+//
+// Swapper interface is used to test names of the Params and Results struct fields that collide with each other
+type SwapperMock struct {
+	state    minimock.MockState
+	mutex    mm_sync.RWMutex
+	delegate Swapper
+
+	funcSwap func(x int, X int, p2_ bool, p2 ...string) (ok bool, err error)
+	SwapMock *mSwapperMockSwap
+}
+
+var _ Swapper = (*SwapperMock)(nil)
+
+// NewSwapperMock returns a mock for Swapper
+func NewSwapperMock(t minimock.Tester) *SwapperMock {
+	m := &SwapperMock{}
+	m.state.Init(m, t, "SwapperMock", 0)
+
+	m.SwapMock = &mSwapperMockSwap{mock: m}
+	m.SwapMock.state.Init(&m.state, "Swap")
+
+	return m
+}
+
+type mSwapperMockSwap struct {
+	mock               *SwapperMock
+	state              minimock.MethodState
+	defaultExpectation *SwapperMockSwapExpectation
+	expectations       []*SwapperMockSwapExpectation
+	inspectSwap        func(x int, X int, p2_ bool, p2 ...string)
+	compare            minimock.Comparer
+	calls              []SwapperMockSwapParams
+	called             chan SwapperMockSwapParams
+	queued             []*SwapperMockSwapResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*SwapperMockSwapResults
+}
+
+// SwapperMockSwapExpectation specifies expectation struct of the Swapper.Swap
+type SwapperMockSwapExpectation struct {
+	mock     *SwapperMock
+	params   *SwapperMockSwapParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *SwapperMockSwapResults
+	Counter  uint64
+}
+
+// SwapperMockSwapParams contains parameters of the Swapper.Swap
+type SwapperMockSwapParams struct {
+	X   int
+	X_  int
+	P2  bool
+	P2_ []string
+}
+
+// SwapperMockSwapResults contains results of the Swapper.Swap
+type SwapperMockSwapResults struct {
+	Ok bool
+	R1 error
+}
+
+// Expect sets up expected params for Swapper.Swap
+func (mmSwap *mSwapperMockSwap) Expect(x int, X int, p2_ bool, p2 ...string) *mSwapperMockSwap {
+	if _, mm_func := mmSwap.current(); mm_func != nil {
+		mmSwap.mock.state.T().Fatalf("SwapperMock.Swap mock is already set by Set")
+	}
+
+	mm_params := &SwapperMockSwapParams{x, X, p2_, p2}
+	mmSwap.updateDefault(func(e *SwapperMockSwapExpectation) {
+		if e.partial {
+			mmSwap.mock.state.T().Fatalf("SwapperMock.Swap params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmSwap.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmSwap.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmSwap
+}
+
+// updateDefault replaces the default expectation of Swapper.Swap by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmSwap *mSwapperMockSwap) updateDefault(update func(e *SwapperMockSwapExpectation)) {
+	mmSwap.state.Mutex.Lock()
+	defer mmSwap.state.Mutex.Unlock()
+
+	e := &SwapperMockSwapExpectation{mock: mmSwap.mock}
+	if previous := mmSwap.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmSwap.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Swapper.Swap
+func (mmSwap *mSwapperMockSwap) current() (*SwapperMockSwapExpectation, func(x int, X int, p2_ bool, p2 ...string) (ok bool, err error)) {
+	mmSwap.state.Mutex.RLock()
+	defer mmSwap.state.Mutex.RUnlock()
+
+	return mmSwap.defaultExpectation, mmSwap.mock.funcSwap
+}
+
+// partialParams updates the default expectation of Swapper.Swap by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmSwap *mSwapperMockSwap) partialParams(update func(e *SwapperMockSwapExpectation)) {
+	if _, mm_func := mmSwap.current(); mm_func != nil {
+		mmSwap.mock.state.T().Fatalf("SwapperMock.Swap mock is already set by Set")
+	}
+
+	mmSwap.updateDefault(func(e *SwapperMockSwapExpectation) {
+		if e.params == nil {
+			e.params = &SwapperMockSwapParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"X":   minimock.Anything,
+				"X_":  minimock.Anything,
+				"P2":  minimock.Anything,
+				"P2_": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectXParam1 sets up the expected value of the param #1 of Swapper.Swap,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmSwap *mSwapperMockSwap) ExpectXParam1(X int) *mSwapperMockSwap {
+	mmSwap.partialParams(func(e *SwapperMockSwapExpectation) {
+		e.params.X = X
+		delete(e.matchers, "X")
+	})
+	return mmSwap
+}
+
+// MatchXParam1 sets up the predicate matching the param #1 of Swapper.Swap,
+// it's used instead of the value of the param set by Expect
+func (mmSwap *mSwapperMockSwap) MatchXParam1(f func(got int) bool) *mSwapperMockSwap {
+	mm_matcher := minimock.Predicate("predicate func(got int) bool", func(v interface{}) bool {
+		got, _ := v.(int)
+		return f(got)
+	})
+	mmSwap.partialParams(func(e *SwapperMockSwapExpectation) {
+		e.matchers["X"] = mm_matcher
+	})
+	return mmSwap
+}
+
+// ExpectX_Param2 sets up the expected value of the param #2 of Swapper.Swap,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmSwap *mSwapperMockSwap) ExpectX_Param2(X_ int) *mSwapperMockSwap {
+	mmSwap.partialParams(func(e *SwapperMockSwapExpectation) {
+		e.params.X_ = X_
+		delete(e.matchers, "X_")
+	})
+	return mmSwap
+}
+
+// MatchX_Param2 sets up the predicate matching the param #2 of Swapper.Swap,
+// it's used instead of the value of the param set by Expect
+func (mmSwap *mSwapperMockSwap) MatchX_Param2(f func(got int) bool) *mSwapperMockSwap {
+	mm_matcher := minimock.Predicate("predicate func(got int) bool", func(v interface{}) bool {
+		got, _ := v.(int)
+		return f(got)
+	})
+	mmSwap.partialParams(func(e *SwapperMockSwapExpectation) {
+		e.matchers["X_"] = mm_matcher
+	})
+	return mmSwap
+}
+
+// ExpectP2Param3 sets up the expected value of the param #3 of Swapper.Swap,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmSwap *mSwapperMockSwap) ExpectP2Param3(P2 bool) *mSwapperMockSwap {
+	mmSwap.partialParams(func(e *SwapperMockSwapExpectation) {
+		e.params.P2 = P2
+		delete(e.matchers, "P2")
+	})
+	return mmSwap
+}
+
+// MatchP2Param3 sets up the predicate matching the param #3 of Swapper.Swap,
+// it's used instead of the value of the param set by Expect
+func (mmSwap *mSwapperMockSwap) MatchP2Param3(f func(got bool) bool) *mSwapperMockSwap {
+	mm_matcher := minimock.Predicate("predicate func(got bool) bool", func(v interface{}) bool {
+		got, _ := v.(bool)
+		return f(got)
+	})
+	mmSwap.partialParams(func(e *SwapperMockSwapExpectation) {
+		e.matchers["P2"] = mm_matcher
+	})
+	return mmSwap
+}
+
+// ExpectP2_Param4 sets up the expected value of the param #4 of Swapper.Swap,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmSwap *mSwapperMockSwap) ExpectP2_Param4(P2_ []string) *mSwapperMockSwap {
+	mmSwap.partialParams(func(e *SwapperMockSwapExpectation) {
+		e.params.P2_ = P2_
+		delete(e.matchers, "P2_")
+	})
+	return mmSwap
+}
+
+// MatchP2_Param4 sets up the predicate matching the param #4 of Swapper.Swap,
+// it's used instead of the value of the param set by Expect
+func (mmSwap *mSwapperMockSwap) MatchP2_Param4(f func(got []string) bool) *mSwapperMockSwap {
+	mm_matcher := minimock.Predicate("predicate func(got []string) bool", func(v interface{}) bool {
+		got, _ := v.([]string)
+		return f(got)
+	})
+	mmSwap.partialParams(func(e *SwapperMockSwapExpectation) {
+		e.matchers["P2_"] = mm_matcher
+	})
+	return mmSwap
+}
+
+// Return sets up results that will be returned by Swapper.Swap
+func (mmSwap *mSwapperMockSwap) Return(ok bool, err error) *SwapperMock {
+	if _, mm_func := mmSwap.current(); mm_func != nil {
+		mmSwap.mock.state.T().Fatalf("SwapperMock.Swap mock is already set by Set")
+	}
+
+	mm_results := &SwapperMockSwapResults{ok, err}
+	mmSwap.updateDefault(func(e *SwapperMockSwapExpectation) { e.results = mm_results })
+
+	return mmSwap.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Swapper.Swap,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmSwap *mSwapperMockSwap) ReturnOnce(ok bool, err error) *mSwapperMockSwap {
+	mmSwap.state.Mutex.Lock()
+	mmSwap.queued = append(mmSwap.queued, &SwapperMockSwapResults{ok, err})
+	mmSwap.state.Mutex.Unlock()
+	mmSwap.state.PushResults()
+	return mmSwap
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmSwap *mSwapperMockSwap) popResults() *SwapperMockSwapResults {
+	mm_i, mm_ok := mmSwap.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmSwap.state.Mutex.RLock()
+	defer mmSwap.state.Mutex.RUnlock()
+
+	return mmSwap.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of Swapper.Swap instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmSwap *mSwapperMockSwap) SetComparer(compare minimock.Comparer) *mSwapperMockSwap {
+	mmSwap.state.Mutex.Lock()
+	mmSwap.compare = compare
+	mmSwap.state.Mutex.Unlock()
+	return mmSwap
+}
+
+// whenExpectations returns the expectations of Swapper.Swap set by When,
+// the expectations can be set while the method is called concurrently
+func (mmSwap *mSwapperMockSwap) whenExpectations() []*SwapperMockSwapExpectation {
+	mmSwap.state.Mutex.RLock()
+	defer mmSwap.state.Mutex.RUnlock()
+
+	return mmSwap.expectations
+}
+
+// Inspect sets up the function called with the params of every Swapper.Swap call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmSwap *mSwapperMockSwap) Inspect(f func(x int, X int, p2_ bool, p2 ...string)) *mSwapperMockSwap {
+	mmSwap.state.Mutex.Lock()
+	mmSwap.inspectSwap = f
+	mmSwap.state.Mutex.Unlock()
+	return mmSwap
+}
+
+// expected returns everything set up for the Swapper.Swap call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmSwap *mSwapperMockSwap) expected() (*SwapperMockSwapExpectation, func(x int, X int, p2_ bool, p2 ...string) (ok bool, err error), func(x int, X int, p2_ bool, p2 ...string), minimock.Comparer, []*SwapperMockSwapExpectation, []*SwapperMockSwapResults) {
+	mmSwap.state.Mutex.RLock()
+	defer mmSwap.state.Mutex.RUnlock()
+
+	return mmSwap.defaultExpectation, mmSwap.mock.funcSwap, mmSwap.inspectSwap, mmSwap.compare, mmSwap.expectations, mmSwap.whenResults
+}
+
+// setup returns the expectations and the function set up for Swapper.Swap to be checked when the mock is finished
+func (mmSwap *mSwapperMockSwap) setup() (setup minimock.Setup) {
+	mmSwap.state.Mutex.RLock()
+	defer mmSwap.state.Mutex.RUnlock()
+
+	setup.Func = mmSwap.mock.funcSwap != nil
+	if e := mmSwap.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmSwap.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Swapper.Swap, the params of the calls are removed from the history as well
+func (mmSwap *mSwapperMockSwap) reset() {
+	mmSwap.state.Mutex.Lock()
+	mmSwap.defaultExpectation = nil
+	mmSwap.expectations = nil
+	mmSwap.queued = nil
+	mmSwap.whenResults = nil
+	mmSwap.state.Mutex.Unlock()
+
+	mmSwap.state.History.Lock()
+	mmSwap.calls = nil
+	mmSwap.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmSwap *mSwapperMockSwap) MethodName() string {
+	return mmSwap.state.Name()
+}
+
+// CallSequence returns the numbers of the Swapper.Swap calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmSwap *mSwapperMockSwap) CallSequence() []uint64 {
+	return mmSwap.state.CallSequence()
+}
+
+// WaitForCalls waits until Swapper.Swap is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmSwap *mSwapperMockSwap) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmSwap.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Swapper.Swap calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmSwap *mSwapperMockSwap) Block() (release func()) {
+	return mmSwap.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Swapper.Swap calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmSwap *mSwapperMockSwap) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmSwap.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Swapper.Swap calls are in flight at once
+func (mmSwap *mSwapperMockSwap) LimitConcurrency(n int) *mSwapperMockSwap {
+	mmSwap.state.SetLimit(n)
+	return mmSwap
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Swapper.Swap call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmSwap *mSwapperMockSwap) CaptureCalls(buffer int) *mSwapperMockSwap {
+	mmSwap.state.History.Lock()
+	mmSwap.called = make(chan SwapperMockSwapParams, buffer)
+	mmSwap.state.History.Unlock()
+	return mmSwap
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Swapper.Swap call
+func (mmSwap *mSwapperMockSwap) Called() <-chan SwapperMockSwapParams {
+	mmSwap.state.History.Lock()
+	defer mmSwap.state.History.Unlock()
+
+	if mmSwap.called == nil {
+		mmSwap.mock.state.T().Fatalf("Calls of SwapperMock.Swap aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmSwap.called
+}
+
+// DroppedCalls returns the number of the Swapper.Swap calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmSwap *mSwapperMockSwap) DroppedCalls() uint64 {
+	return mmSwap.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Swapper.Swap calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmSwap *mSwapperMockSwap) Times(n uint64) *mSwapperMockSwap {
+	mmSwap.state.SetTimes(n)
+	return mmSwap
+}
+
+// Optional excludes Swapper.Swap from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmSwap *mSwapperMockSwap) Optional() *mSwapperMockSwap {
+	mmSwap.state.SetOptional()
+	return mmSwap
+}
+
+// Set uses given function f to mock the Swapper.Swap method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmSwap *mSwapperMockSwap) Set(f func(x int, X int, p2_ bool, p2 ...string) (ok bool, err error)) *SwapperMock {
+	mmSwap.state.Mutex.Lock()
+	defer mmSwap.state.Mutex.Unlock()
+
+	if mmSwap.defaultExpectation != nil {
+		mmSwap.mock.state.T().Fatalf("Default expectation is already set for the Swapper.Swap method")
+	}
+
+	if len(mmSwap.expectations) > 0 {
+		mmSwap.mock.state.T().Fatalf("Some expectations are already set for the Swapper.Swap method")
+	}
+
+	mmSwap.mock.funcSwap = f
+	return mmSwap.mock
+}
+
+// When sets expectation for the Swapper.Swap which will trigger the result defined by the following
+// Then helper
+func (mmSwap *mSwapperMockSwap) When(x int, X int, p2_ bool, p2 ...string) *SwapperMockSwapExpectation {
+	if _, mm_func := mmSwap.current(); mm_func != nil {
+		mmSwap.mock.state.T().Fatalf("SwapperMock.Swap mock is already set by Set")
+	}
+
+	expectation := &SwapperMockSwapExpectation{
+		mock:   mmSwap.mock,
+		params: &SwapperMockSwapParams{x, X, p2_, p2},
+	}
+	mmSwap.state.Mutex.Lock()
+	mmSwap.expectations = append(mmSwap.expectations, expectation)
+	mmSwap.whenResults = append(mmSwap.whenResults, nil)
+	mmSwap.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up Swapper.Swap return parameters for the expectation previously defined by the When method
+func (mmExpectation *SwapperMockSwapExpectation) Then(ok bool, err error) *SwapperMock {
+	mm_handle := mmExpectation.mock.SwapMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &SwapperMockSwapResults{ok, err}
+	mm_results := make([]*SwapperMockSwapResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Swap implements Swapper
+func (mmSwap *SwapperMock) Swap(x int, X int, p2_ bool, p2 ...string) (ok bool, err error) {
+	mm_method := mmSwap.SwapMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := SwapperMockSwapParams{x, X, p2_, p2}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcSwap, mm_inspectSwap, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectSwap != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectSwap(x, X, p2_, p2...)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).Ok, (*mm_results).R1
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).Ok, (*mm_results).R1
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcSwap == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+		}
+		return (*mm_expectation.results).Ok, (*mm_expectation.results).R1
+	}
+	if mm_funcSwap != nil {
+		return mm_funcSwap(x, X, p2_, p2...)
+	}
+	if mm_delegate := mmSwap.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Swap(x, X, p2_, p2...)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, x, X, p2_, p2)
+	return
+}
+
+// SwapAfterCounter returns a count of finished SwapperMock.Swap invocations
+func (mmSwap *SwapperMock) SwapAfterCounter() uint64 {
+	return mmSwap.SwapMock.state.AfterCalls()
+}
+
+// SwapBeforeCounter returns a count of SwapperMock.Swap invocations
+func (mmSwap *SwapperMock) SwapBeforeCounter() uint64 {
+	return mmSwap.SwapMock.state.BeforeCalls()
+}
+
+// SwapCalls returns the params of all SwapperMock.Swap calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmSwap *SwapperMock) SwapCalls() []SwapperMockSwapParams {
+	mmSwap.SwapMock.state.History.Lock()
+	defer mmSwap.SwapMock.state.History.Unlock()
+
+	calls := make([]SwapperMockSwapParams, len(mmSwap.SwapMock.calls))
+	copy(calls, mmSwap.SwapMock.calls)
+	return calls
+}
+
+// SwapLastParams returns the params of the latest SwapperMock.Swap call and false if there were no calls
+func (mmSwap *SwapperMock) SwapLastParams() (params SwapperMockSwapParams, ok bool) {
+	mmSwap.SwapMock.state.History.Lock()
+	defer mmSwap.SwapMock.state.History.Unlock()
+
+	if n := len(mmSwap.SwapMock.calls); n > 0 {
+		return mmSwap.SwapMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// SwapCallTimes returns the times of all SwapperMock.Swap calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmSwap *SwapperMock) SwapCallTimes() []mm_time.Time {
+	return mmSwap.SwapMock.state.CallTimes()
+}
+
+// SwapMaxInFlight returns the maximum number of the SwapperMock.Swap calls that have been in flight at once
+func (mmSwap *SwapperMock) SwapMaxInFlight() int {
+	return mmSwap.SwapMock.state.MaxInFlight()
+}
+
+// SwapUnexpectedCounter returns a count of SwapperMock.Swap invocations made without an implementation
+func (mmSwap *SwapperMock) SwapUnexpectedCounter() uint64 {
+	return mmSwap.SwapMock.state.UnexpectedCalls()
+}
+
+// SwapNotCalled returns true if SwapperMock.Swap hasn't been called
+func (mmSwap *SwapperMock) SwapNotCalled() bool {
+	return mmSwap.SwapMock.state.BeforeCalls() == 0
+}
+
+// SwapCallCount returns a count of SwapperMock.Swap invocations, it's the same as SwapBeforeCounter
+func (mmSwap *SwapperMock) SwapCallCount() uint64 {
+	return mmSwap.SwapMock.state.BeforeCalls()
+}
+
+// MinimockSwapDone returns true if the count of the Swap invocations corresponds
+// the number of defined expectations
+func (mmSwap *SwapperMock) MinimockSwapDone() bool {
+	return mmSwap.SwapMock.state.Done(mmSwap.SwapMock.setup())
+}
+
+// MinimockSwapInspect logs each unmet expectation
+func (mmSwap *SwapperMock) MinimockSwapInspect() {
+	mmSwap.SwapMock.state.Inspect(mmSwap.SwapMock.setup())
+}
+
+// MinimockSetComparer sets up the function comparing the expected and the actual params of all SwapperMock methods instead of minimock.Equal,
+// the params matched by the matchers aren't compared
+func (m *SwapperMock) MinimockSetComparer(compare minimock.Comparer) *SwapperMock {
+	m.state.SetComparer(compare)
+	return m
+}
+
+// MinimockSetSequence sets up the sequence numbering the SwapperMock calls, the mocks sharing the sequence
+// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+func (m *SwapperMock) MinimockSetSequence(sequence *minimock.Sequence) *SwapperMock {
+	m.state.SetSequence(sequence)
+	return m
+}
+
+// MinimockSetClock sets up the function returning the current time for the history of SwapperMock calls instead of time.Now
+func (m *SwapperMock) MinimockSetClock(clock func() mm_time.Time) *SwapperMock {
+	m.state.SetClock(clock)
+	return m
+}
+
+// MinimockSetAutoFinish enables or disables the check of SwapperMock made by the Cleanup of the tester passed to NewSwapperMock,
+// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+func (m *SwapperMock) MinimockSetAutoFinish(enabled bool) *SwapperMock {
+	m.state.SetAutoFinish(enabled)
+	return m
+}
+
+// MinimockSetDelegate sets up the implementation the calls of the SwapperMock methods are forwarded to when the methods
+// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+func (m *SwapperMock) MinimockSetDelegate(impl Swapper) *SwapperMock {
+	m.mutex.Lock()
+	m.delegate = impl
+	m.mutex.Unlock()
+	return m
+}
+
+func (m *SwapperMock) minimockDelegate() Swapper {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.delegate
+}
+
+// MinimockSetLenient enables or disables the lenient mode of SwapperMock: the calls of the methods that have neither
+// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+func (m *SwapperMock) MinimockSetLenient(enabled bool) *SwapperMock {
+	m.state.SetLenient(enabled)
+	return m
+}
+
+// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (m *SwapperMock) MinimockLenientCalls() map[string]uint64 {
+	return m.state.LenientCalls()
+}
+
+// MinimockAssertNotCalled fails the test without stopping it if any of the SwapperMock methods with the given names has been called,
+// it doesn't depend on the expectations and the functions set up for the methods
+func (m *SwapperMock) MinimockAssertNotCalled(methodNames ...string) {
+	m.state.AssertNotCalled(methodNames...)
+}
+
+// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+// queued by ReturnOnce of all SwapperMock methods, the functions set by Set and Inspect are kept.
+// It fails the test if any of the methods is being called
+func (m *SwapperMock) MinimockReset() {
+	m.state.Reset(
+		m.SwapMock.reset,
+	)
+}
+
+// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+func (m *SwapperMock) MinimockResetAll() {
+	m.MinimockReset()
+	m.SwapMock.state.Mutex.Lock()
+	m.funcSwap = nil
+	m.SwapMock.inspectSwap = nil
+	m.SwapMock.state.Mutex.Unlock()
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *SwapperMock) MinimockFinish() {
+	m.state.Finish(m.minimockDone,
+		m.MinimockSwapInspect,
+	)
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *SwapperMock) MinimockWait(timeout mm_time.Duration) {
+	m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
+}
+
+func (m *SwapperMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockSwapDone()
+}
@@ -0,0 +1,28 @@
+package tests
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSwapperMock_ParamsAndResults(t *testing.T) {
+	var params []SwapperMockSwapParams
+
+	swapperMock := NewSwapperMock(t).SwapMock.Set(func(x, X int, b bool, p2 ...string) (bool, error) {
+		params = append(params, SwapperMockSwapParams{X: x, X_: X, P2: b, P2_: p2})
+		return true, nil
+	})
+
+	swapperMock.Swap(1, 2, true, "a", "b")
+
+	want := SwapperMockSwapParams{X: 1, X_: 2, P2: true, P2_: []string{"a", "b"}}
+	assert.True(t, reflect.DeepEqual([]SwapperMockSwapParams{want}, params))
+
+	swapperMock = NewSwapperMock(t).SwapMock.Expect(1, 2, false).Return(false, errors.New("failed"))
+	ok, err := swapperMock.Swap(1, 2, false)
+
+	assert.Equal(t, SwapperMockSwapResults{Ok: false, R1: errors.New("failed")}, SwapperMockSwapResults{Ok: ok, R1: err})
+}
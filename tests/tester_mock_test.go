@@ -1,794 +1,2272 @@
-package tests
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
 
-// DO NOT EDIT!
-// The code below was generated with http://github.com/gojuno/minimock (dev)
+package tests
 
-//go:generate minimock -i github.com/gojuno/minimock.Tester -o ./tests/tester_mock_test.go
+//go:generate minimock -i github.com/gojuno/minimock.Tester -o ./tester_mock_test.go
 
 import (
+	mm_sync "sync"
 	mm_atomic "sync/atomic"
 	mm_time "time"
 
 	"github.com/gojuno/minimock"
+	mm_minimock "github.com/gojuno/minimock"
 )
 
 // TesterMock implements minimock.Tester
+//
+// Tester contains subset of the testing.T methods used by the generated code
 type TesterMock struct {
-	t minimock.Tester
+	state    minimock.MockState
+	mutex    mm_sync.RWMutex
+	delegate mm_minimock.Tester
 
-	funcError          func(p1 ...interface{})
-	afterErrorCounter  uint64
-	beforeErrorCounter uint64
-	ErrorMock          mTesterMockError
+	funcError func(p1 ...interface{})
+	ErrorMock *mTesterMockError
 
-	funcErrorf          func(format string, args ...interface{})
-	afterErrorfCounter  uint64
-	beforeErrorfCounter uint64
-	ErrorfMock          mTesterMockErrorf
+	funcErrorf func(format string, args ...interface{})
+	ErrorfMock *mTesterMockErrorf
 
-	funcFailNow          func()
-	afterFailNowCounter  uint64
-	beforeFailNowCounter uint64
-	FailNowMock          mTesterMockFailNow
+	funcFailNow func()
+	FailNowMock *mTesterMockFailNow
 
-	funcFatal          func(args ...interface{})
-	afterFatalCounter  uint64
-	beforeFatalCounter uint64
-	FatalMock          mTesterMockFatal
+	funcFatal func(args ...interface{})
+	FatalMock *mTesterMockFatal
 
-	funcFatalf          func(format string, args ...interface{})
-	afterFatalfCounter  uint64
-	beforeFatalfCounter uint64
-	FatalfMock          mTesterMockFatalf
+	funcFatalf func(format string, args ...interface{})
+	FatalfMock *mTesterMockFatalf
 }
 
+var _ mm_minimock.Tester = (*TesterMock)(nil)
+
 // NewTesterMock returns a mock for minimock.Tester
 func NewTesterMock(t minimock.Tester) *TesterMock {
-	m := &TesterMock{t: t}
-	if controller, ok := t.(minimock.MockController); ok {
-		controller.RegisterMocker(m)
-	}
-	m.ErrorMock = mTesterMockError{mock: m}
-	m.ErrorfMock = mTesterMockErrorf{mock: m}
-	m.FailNowMock = mTesterMockFailNow{mock: m}
-	m.FatalMock = mTesterMockFatal{mock: m}
-	m.FatalfMock = mTesterMockFatalf{mock: m}
+	m := &TesterMock{}
+	m.state.Init(m, t, "TesterMock", 0)
+
+	m.ErrorMock = &mTesterMockError{mock: m}
+	m.ErrorMock.state.Init(&m.state, "Error")
+
+	m.ErrorfMock = &mTesterMockErrorf{mock: m}
+	m.ErrorfMock.state.Init(&m.state, "Errorf")
+
+	m.FailNowMock = &mTesterMockFailNow{mock: m}
+	m.FailNowMock.state.Init(&m.state, "FailNow")
+
+	m.FatalMock = &mTesterMockFatal{mock: m}
+	m.FatalMock.state.Init(&m.state, "Fatal")
+
+	m.FatalfMock = &mTesterMockFatalf{mock: m}
+	m.FatalfMock.state.Init(&m.state, "Fatalf")
 
 	return m
 }
 
 type mTesterMockError struct {
 	mock               *TesterMock
+	state              minimock.MethodState
 	defaultExpectation *TesterMockErrorExpectation
 	expectations       []*TesterMockErrorExpectation
+	inspectError       func(p1 ...interface{})
+	compare            minimock.Comparer
+	calls              []TesterMockErrorParams
+	called             chan TesterMockErrorParams
 }
 
 // TesterMockErrorExpectation specifies expectation struct of the Tester.Error
 type TesterMockErrorExpectation struct {
-	mock   *TesterMock
-	params *TesterMockErrorParams
+	mock     *TesterMock
+	params   *TesterMockErrorParams
+	matchers map[string]minimock.Matcher
+	partial  bool
 
 	Counter uint64
 }
 
 // TesterMockErrorParams contains parameters of the Tester.Error
 type TesterMockErrorParams struct {
-	p1 []interface{}
+	P0 []interface{}
 }
 
 // Expect sets up expected params for Tester.Error
-func (m *mTesterMockError) Expect(p1 ...interface{}) *mTesterMockError {
-	if m.mock.funcError != nil {
-		m.mock.t.Fatalf("TesterMock.Error mock is already set by Set")
+func (mmError *mTesterMockError) Expect(p1 ...interface{}) *mTesterMockError {
+	if _, mm_func := mmError.current(); mm_func != nil {
+		mmError.mock.state.T().Fatalf("TesterMock.Error mock is already set by Set")
 	}
 
-	if m.defaultExpectation == nil {
-		m.defaultExpectation = &TesterMockErrorExpectation{}
-	}
+	mm_params := &TesterMockErrorParams{p1}
+	mmError.updateDefault(func(e *TesterMockErrorExpectation) {
+		if e.partial {
+			mmError.mock.state.T().Fatalf("TesterMock.Error params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
 
-	m.defaultExpectation.params = &TesterMockErrorParams{p1}
-	for _, e := range m.expectations {
-		if minimock.Equal(e.params, m.defaultExpectation.params) {
-			m.mock.t.Fatalf("Expectation set by When has same params: %#v", *m.defaultExpectation.params)
+	for _, e := range mmError.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmError.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
 		}
 	}
 
-	return m
+	return mmError
 }
 
-// Return sets up results that will be returned by Tester.Error
-func (m *mTesterMockError) Return() *TesterMock {
-	if m.mock.funcError != nil {
-		m.mock.t.Fatalf("TesterMock.Error mock is already set by Set")
+// updateDefault replaces the default expectation of Tester.Error by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmError *mTesterMockError) updateDefault(update func(e *TesterMockErrorExpectation)) {
+	mmError.state.Mutex.Lock()
+	defer mmError.state.Mutex.Unlock()
+
+	e := &TesterMockErrorExpectation{mock: mmError.mock}
+	if previous := mmError.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
 	}
 
-	if m.defaultExpectation == nil {
-		m.defaultExpectation = &TesterMockErrorExpectation{mock: m.mock}
-	}
+	update(e)
+	mmError.defaultExpectation = e
+}
 
-	return m.mock
+// current returns the default expectation and the function set up for Tester.Error
+func (mmError *mTesterMockError) current() (*TesterMockErrorExpectation, func(p1 ...interface{})) {
+	mmError.state.Mutex.RLock()
+	defer mmError.state.Mutex.RUnlock()
+
+	return mmError.defaultExpectation, mmError.mock.funcError
 }
 
-//Set uses given function f to mock the Tester.Error method
-func (m *mTesterMockError) Set(f func(p1 ...interface{})) *TesterMock {
-	if m.defaultExpectation != nil {
-		m.mock.t.Fatalf("Default expectation is already set for the Tester.Error method")
+// partialParams updates the default expectation of Tester.Error by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmError *mTesterMockError) partialParams(update func(e *TesterMockErrorExpectation)) {
+	if _, mm_func := mmError.current(); mm_func != nil {
+		mmError.mock.state.T().Fatalf("TesterMock.Error mock is already set by Set")
 	}
 
-	if len(m.expectations) > 0 {
-		m.mock.t.Fatalf("Some expectations are already set for the Tester.Error method")
-	}
+	mmError.updateDefault(func(e *TesterMockErrorExpectation) {
+		if e.params == nil {
+			e.params = &TesterMockErrorParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"P0": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
 
-	m.mock.funcError = f
-	return m.mock
+		update(e)
+	})
 }
 
-// Error implements minimock.Tester
-func (m *TesterMock) Error(p1 ...interface{}) {
-	mm_atomic.AddUint64(&m.beforeErrorCounter, 1)
-	defer mm_atomic.AddUint64(&m.afterErrorCounter, 1)
+// ExpectP0Param1 sets up the expected value of the param #1 of Tester.Error,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmError *mTesterMockError) ExpectP0Param1(P0 []interface{}) *mTesterMockError {
+	mmError.partialParams(func(e *TesterMockErrorExpectation) {
+		e.params.P0 = P0
+		delete(e.matchers, "P0")
+	})
+	return mmError
+}
 
-	for _, e := range m.ErrorMock.expectations {
-		if minimock.Equal(*e.params, TesterMockErrorParams{p1}) {
-			mm_atomic.AddUint64(&e.Counter, 1)
-			return
-		}
+// MatchP0Param1 sets up the predicate matching the param #1 of Tester.Error,
+// it's used instead of the value of the param set by Expect
+func (mmError *mTesterMockError) MatchP0Param1(f func(got []interface{}) bool) *mTesterMockError {
+	mm_matcher := minimock.Predicate("predicate func(got []interface{}) bool", func(v interface{}) bool {
+		got, _ := v.([]interface{})
+		return f(got)
+	})
+	mmError.partialParams(func(e *TesterMockErrorExpectation) {
+		e.matchers["P0"] = mm_matcher
+	})
+	return mmError
+}
+
+// Return sets up results that will be returned by Tester.Error
+func (mmError *mTesterMockError) Return() *TesterMock {
+	if _, mm_func := mmError.current(); mm_func != nil {
+		mmError.mock.state.T().Fatalf("TesterMock.Error mock is already set by Set")
 	}
 
-	if m.ErrorMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&m.ErrorMock.defaultExpectation.Counter, 1)
-		want := m.ErrorMock.defaultExpectation.params
-		got := TesterMockErrorParams{p1}
-		if want != nil && !minimock.Equal(*want, got) {
-			m.t.Errorf("TesterMock.Error got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
-		}
+	mmError.updateDefault(func(*TesterMockErrorExpectation) {})
 
-		return
+	return mmError.mock
+}
 
-	}
-	if m.funcError != nil {
-		m.funcError(p1...)
-		return
-	}
-	m.t.Fatalf("Unexpected call to TesterMock.Error. %v", p1)
+// SetComparer sets up the function comparing the expected and the actual params of Tester.Error instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmError *mTesterMockError) SetComparer(compare minimock.Comparer) *mTesterMockError {
+	mmError.state.Mutex.Lock()
+	mmError.compare = compare
+	mmError.state.Mutex.Unlock()
+	return mmError
+}
 
+// whenExpectations returns the expectations of Tester.Error set by When,
+// the expectations can be set while the method is called concurrently
+func (mmError *mTesterMockError) whenExpectations() []*TesterMockErrorExpectation {
+	mmError.state.Mutex.RLock()
+	defer mmError.state.Mutex.RUnlock()
+
+	return mmError.expectations
 }
 
-// ErrorAfterCounter returns a count of finished TesterMock.Error invocations
-func (m *TesterMock) ErrorAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&m.afterErrorCounter)
+// Inspect sets up the function called with the params of every Tester.Error call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmError *mTesterMockError) Inspect(f func(p1 ...interface{})) *mTesterMockError {
+	mmError.state.Mutex.Lock()
+	mmError.inspectError = f
+	mmError.state.Mutex.Unlock()
+	return mmError
 }
 
-// ErrorBeforeCounter returns a count of TesterMock.Error invocations
-func (m *TesterMock) ErrorBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&m.beforeErrorCounter)
+// expected returns everything set up for the Tester.Error call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer
+func (mmError *mTesterMockError) expected() (*TesterMockErrorExpectation, func(p1 ...interface{}), func(p1 ...interface{}), minimock.Comparer) {
+	mmError.state.Mutex.RLock()
+	defer mmError.state.Mutex.RUnlock()
+
+	return mmError.defaultExpectation, mmError.mock.funcError, mmError.inspectError, mmError.compare
 }
 
-// MinimockErrorDone returns true if the count of the Error invocations corresponds
-// the number of defined expectations
-func (m *TesterMock) MinimockErrorDone() bool {
-	for _, e := range m.ErrorMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			return false
+// setup returns the expectations and the function set up for Tester.Error to be checked when the mock is finished
+func (mmError *mTesterMockError) setup() (setup minimock.Setup) {
+	mmError.state.Mutex.RLock()
+	defer mmError.state.Mutex.RUnlock()
+
+	setup.Func = mmError.mock.funcError != nil
+	if e := mmError.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
 		}
 	}
 
-	// if default expectation was set then invocations count should be greater than zero
-	if m.ErrorMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterErrorCounter) < 1 {
-		return false
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcError != nil && mm_atomic.LoadUint64(&m.afterErrorCounter) < 1 {
-		return false
+	for _, e := range mmError.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
 	}
-	return true
+
+	return setup
 }
 
-// MinimockErrorInspect logs each unmet expectation
-func (m *TesterMock) MinimockErrorInspect() {
-	for _, e := range m.ErrorMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to TesterMock.Error with params: %#v", *e.params)
-		}
-	}
+// reset removes the expectations of Tester.Error, the params of the calls are removed from the history as well
+func (mmError *mTesterMockError) reset() {
+	mmError.state.Mutex.Lock()
+	mmError.defaultExpectation = nil
+	mmError.expectations = nil
+	mmError.state.Mutex.Unlock()
 
-	// if default expectation was set then invocations count should be greater than zero
-	if m.ErrorMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterErrorCounter) < 1 {
-		m.t.Errorf("Expected call to TesterMock.Error with params: %#v", *m.ErrorMock.defaultExpectation.params)
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcError != nil && mm_atomic.LoadUint64(&m.afterErrorCounter) < 1 {
-		m.t.Error("Expected call to TesterMock.Error")
-	}
+	mmError.state.History.Lock()
+	mmError.calls = nil
+	mmError.state.History.Unlock()
 }
 
-type mTesterMockErrorf struct {
-	mock               *TesterMock
-	defaultExpectation *TesterMockErrorfExpectation
-	expectations       []*TesterMockErrorfExpectation
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmError *mTesterMockError) MethodName() string {
+	return mmError.state.Name()
 }
 
-// TesterMockErrorfExpectation specifies expectation struct of the Tester.Errorf
-type TesterMockErrorfExpectation struct {
-	mock   *TesterMock
-	params *TesterMockErrorfParams
+// CallSequence returns the numbers of the Tester.Error calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmError *mTesterMockError) CallSequence() []uint64 {
+	return mmError.state.CallSequence()
+}
 
-	Counter uint64
+// WaitForCalls waits until Tester.Error is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmError *mTesterMockError) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmError.state.WaitForCalls(n, timeout)
 }
 
-// TesterMockErrorfParams contains parameters of the Tester.Errorf
-type TesterMockErrorfParams struct {
-	format string
-	args   []interface{}
+// Block makes the subsequent Tester.Error calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmError *mTesterMockError) Block() (release func()) {
+	return mmError.state.Block()
 }
 
-// Expect sets up expected params for Tester.Errorf
-func (m *mTesterMockErrorf) Expect(format string, args ...interface{}) *mTesterMockErrorf {
-	if m.mock.funcErrorf != nil {
-		m.mock.t.Fatalf("TesterMock.Errorf mock is already set by Set")
-	}
+// WaitUntilBlocked waits until at least n Tester.Error calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmError *mTesterMockError) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmError.state.WaitUntilBlocked(n, timeout)
+}
 
-	if m.defaultExpectation == nil {
-		m.defaultExpectation = &TesterMockErrorfExpectation{}
-	}
+// LimitConcurrency fails the test as soon as more than n Tester.Error calls are in flight at once
+func (mmError *mTesterMockError) LimitConcurrency(n int) *mTesterMockError {
+	mmError.state.SetLimit(n)
+	return mmError
+}
 
-	m.defaultExpectation.params = &TesterMockErrorfParams{format, args}
-	for _, e := range m.expectations {
-		if minimock.Equal(e.params, m.defaultExpectation.params) {
-			m.mock.t.Fatalf("Expectation set by When has same params: %#v", *m.defaultExpectation.params)
-		}
+// CaptureCalls creates the buffered channel receiving the params of each Tester.Error call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmError *mTesterMockError) CaptureCalls(buffer int) *mTesterMockError {
+	mmError.state.History.Lock()
+	mmError.called = make(chan TesterMockErrorParams, buffer)
+	mmError.state.History.Unlock()
+	return mmError
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Tester.Error call
+func (mmError *mTesterMockError) Called() <-chan TesterMockErrorParams {
+	mmError.state.History.Lock()
+	defer mmError.state.History.Unlock()
+
+	if mmError.called == nil {
+		mmError.mock.state.T().Fatalf("Calls of TesterMock.Error aren't captured, CaptureCalls has to be called before the calls")
 	}
 
-	return m
+	return mmError.called
 }
 
-// Return sets up results that will be returned by Tester.Errorf
-func (m *mTesterMockErrorf) Return() *TesterMock {
-	if m.mock.funcErrorf != nil {
-		m.mock.t.Fatalf("TesterMock.Errorf mock is already set by Set")
-	}
+// DroppedCalls returns the number of the Tester.Error calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmError *mTesterMockError) DroppedCalls() uint64 {
+	return mmError.state.DroppedCalls()
+}
 
-	if m.defaultExpectation == nil {
-		m.defaultExpectation = &TesterMockErrorfExpectation{mock: m.mock}
-	}
+// Times sets the exact number of the Tester.Error calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmError *mTesterMockError) Times(n uint64) *mTesterMockError {
+	mmError.state.SetTimes(n)
+	return mmError
+}
 
-	return m.mock
+// Optional excludes Tester.Error from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmError *mTesterMockError) Optional() *mTesterMockError {
+	mmError.state.SetOptional()
+	return mmError
 }
 
-//Set uses given function f to mock the Tester.Errorf method
-func (m *mTesterMockErrorf) Set(f func(format string, args ...interface{})) *TesterMock {
-	if m.defaultExpectation != nil {
-		m.mock.t.Fatalf("Default expectation is already set for the Tester.Errorf method")
+// Set uses given function f to mock the Tester.Error method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmError *mTesterMockError) Set(f func(p1 ...interface{})) *TesterMock {
+	mmError.state.Mutex.Lock()
+	defer mmError.state.Mutex.Unlock()
+
+	if mmError.defaultExpectation != nil {
+		mmError.mock.state.T().Fatalf("Default expectation is already set for the Tester.Error method")
 	}
 
-	if len(m.expectations) > 0 {
-		m.mock.t.Fatalf("Some expectations are already set for the Tester.Errorf method")
+	if len(mmError.expectations) > 0 {
+		mmError.mock.state.T().Fatalf("Some expectations are already set for the Tester.Error method")
 	}
 
-	m.mock.funcErrorf = f
-	return m.mock
+	mmError.mock.funcError = f
+	return mmError.mock
 }
 
-// Errorf implements minimock.Tester
-func (m *TesterMock) Errorf(format string, args ...interface{}) {
-	mm_atomic.AddUint64(&m.beforeErrorfCounter, 1)
-	defer mm_atomic.AddUint64(&m.afterErrorfCounter, 1)
+// Error implements minimock.Tester
+func (mmError *TesterMock) Error(p1 ...interface{}) {
+	mm_method := mmError.ErrorMock
+	mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := TesterMockErrorParams{p1}
 
-	for _, e := range m.ErrorfMock.expectations {
-		if minimock.Equal(*e.params, TesterMockErrorfParams{format, args}) {
-			mm_atomic.AddUint64(&e.Counter, 1)
-			return
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
 		}
 	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcError, mm_inspectError, mm_compare := mm_method.expected()
+	if mm_inspectError != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectError(p1...)
+		}()
+	}
 
-	if m.ErrorfMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&m.ErrorfMock.defaultExpectation.Counter, 1)
-		want := m.ErrorfMock.defaultExpectation.params
-		got := TesterMockErrorfParams{format, args}
-		if want != nil && !minimock.Equal(*want, got) {
-			m.t.Errorf("TesterMock.Errorf got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
 		}
 
 		return
 
 	}
-	if m.funcErrorf != nil {
-		m.funcErrorf(format, args...)
+	if mm_funcError != nil {
+		mm_funcError(p1...)
+		return
+	}
+	if mm_delegate := mmError.minimockDelegate(); mm_delegate != nil {
+		mm_delegate.Error(p1...)
 		return
 	}
-	m.t.Fatalf("Unexpected call to TesterMock.Errorf. %v %v", format, args)
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, p1)
 
 }
 
-// ErrorfAfterCounter returns a count of finished TesterMock.Errorf invocations
-func (m *TesterMock) ErrorfAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&m.afterErrorfCounter)
+// ErrorAfterCounter returns a count of finished TesterMock.Error invocations
+func (mmError *TesterMock) ErrorAfterCounter() uint64 {
+	return mmError.ErrorMock.state.AfterCalls()
 }
 
-// ErrorfBeforeCounter returns a count of TesterMock.Errorf invocations
-func (m *TesterMock) ErrorfBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&m.beforeErrorfCounter)
+// ErrorBeforeCounter returns a count of TesterMock.Error invocations
+func (mmError *TesterMock) ErrorBeforeCounter() uint64 {
+	return mmError.ErrorMock.state.BeforeCalls()
 }
 
-// MinimockErrorfDone returns true if the count of the Errorf invocations corresponds
-// the number of defined expectations
-func (m *TesterMock) MinimockErrorfDone() bool {
-	for _, e := range m.ErrorfMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			return false
-		}
-	}
+// ErrorCalls returns the params of all TesterMock.Error calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmError *TesterMock) ErrorCalls() []TesterMockErrorParams {
+	mmError.ErrorMock.state.History.Lock()
+	defer mmError.ErrorMock.state.History.Unlock()
 
-	// if default expectation was set then invocations count should be greater than zero
-	if m.ErrorfMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterErrorfCounter) < 1 {
-		return false
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcErrorf != nil && mm_atomic.LoadUint64(&m.afterErrorfCounter) < 1 {
-		return false
-	}
-	return true
+	calls := make([]TesterMockErrorParams, len(mmError.ErrorMock.calls))
+	copy(calls, mmError.ErrorMock.calls)
+	return calls
 }
 
-// MinimockErrorfInspect logs each unmet expectation
-func (m *TesterMock) MinimockErrorfInspect() {
-	for _, e := range m.ErrorfMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to TesterMock.Errorf with params: %#v", *e.params)
-		}
-	}
+// ErrorLastParams returns the params of the latest TesterMock.Error call and false if there were no calls
+func (mmError *TesterMock) ErrorLastParams() (params TesterMockErrorParams, ok bool) {
+	mmError.ErrorMock.state.History.Lock()
+	defer mmError.ErrorMock.state.History.Unlock()
 
-	// if default expectation was set then invocations count should be greater than zero
-	if m.ErrorfMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterErrorfCounter) < 1 {
-		m.t.Errorf("Expected call to TesterMock.Errorf with params: %#v", *m.ErrorfMock.defaultExpectation.params)
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcErrorf != nil && mm_atomic.LoadUint64(&m.afterErrorfCounter) < 1 {
-		m.t.Error("Expected call to TesterMock.Errorf")
+	if n := len(mmError.ErrorMock.calls); n > 0 {
+		return mmError.ErrorMock.calls[n-1], true
 	}
-}
 
-type mTesterMockFailNow struct {
-	mock               *TesterMock
-	defaultExpectation *TesterMockFailNowExpectation
-	expectations       []*TesterMockFailNowExpectation
+	return params, false
 }
 
-// TesterMockFailNowExpectation specifies expectation struct of the Tester.FailNow
-type TesterMockFailNowExpectation struct {
-	mock *TesterMock
+// ErrorCallTimes returns the times of all TesterMock.Error calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmError *TesterMock) ErrorCallTimes() []mm_time.Time {
+	return mmError.ErrorMock.state.CallTimes()
+}
 
-	Counter uint64
+// ErrorMaxInFlight returns the maximum number of the TesterMock.Error calls that have been in flight at once
+func (mmError *TesterMock) ErrorMaxInFlight() int {
+	return mmError.ErrorMock.state.MaxInFlight()
 }
 
-// Expect sets up expected params for Tester.FailNow
-func (m *mTesterMockFailNow) Expect() *mTesterMockFailNow {
-	if m.mock.funcFailNow != nil {
-		m.mock.t.Fatalf("TesterMock.FailNow mock is already set by Set")
-	}
+// ErrorUnexpectedCounter returns a count of TesterMock.Error invocations made without an implementation
+func (mmError *TesterMock) ErrorUnexpectedCounter() uint64 {
+	return mmError.ErrorMock.state.UnexpectedCalls()
+}
 
-	if m.defaultExpectation == nil {
-		m.defaultExpectation = &TesterMockFailNowExpectation{}
-	}
+// ErrorNotCalled returns true if TesterMock.Error hasn't been called
+func (mmError *TesterMock) ErrorNotCalled() bool {
+	return mmError.ErrorMock.state.BeforeCalls() == 0
+}
 
-	return m
+// ErrorCallCount returns a count of TesterMock.Error invocations, it's the same as ErrorBeforeCounter
+func (mmError *TesterMock) ErrorCallCount() uint64 {
+	return mmError.ErrorMock.state.BeforeCalls()
 }
 
-// Return sets up results that will be returned by Tester.FailNow
-func (m *mTesterMockFailNow) Return() *TesterMock {
-	if m.mock.funcFailNow != nil {
-		m.mock.t.Fatalf("TesterMock.FailNow mock is already set by Set")
-	}
+// MinimockErrorDone returns true if the count of the Error invocations corresponds
+// the number of defined expectations
+func (mmError *TesterMock) MinimockErrorDone() bool {
+	return mmError.ErrorMock.state.Done(mmError.ErrorMock.setup())
+}
 
-	if m.defaultExpectation == nil {
-		m.defaultExpectation = &TesterMockFailNowExpectation{mock: m.mock}
-	}
+// MinimockErrorInspect logs each unmet expectation
+func (mmError *TesterMock) MinimockErrorInspect() {
+	mmError.ErrorMock.state.Inspect(mmError.ErrorMock.setup())
+}
 
-	return m.mock
+type mTesterMockErrorf struct {
+	mock               *TesterMock
+	state              minimock.MethodState
+	defaultExpectation *TesterMockErrorfExpectation
+	expectations       []*TesterMockErrorfExpectation
+	inspectErrorf      func(format string, args ...interface{})
+	compare            minimock.Comparer
+	calls              []TesterMockErrorfParams
+	called             chan TesterMockErrorfParams
 }
 
-//Set uses given function f to mock the Tester.FailNow method
-func (m *mTesterMockFailNow) Set(f func()) *TesterMock {
-	if m.defaultExpectation != nil {
-		m.mock.t.Fatalf("Default expectation is already set for the Tester.FailNow method")
-	}
+// TesterMockErrorfExpectation specifies expectation struct of the Tester.Errorf
+type TesterMockErrorfExpectation struct {
+	mock     *TesterMock
+	params   *TesterMockErrorfParams
+	matchers map[string]minimock.Matcher
+	partial  bool
 
-	if len(m.expectations) > 0 {
-		m.mock.t.Fatalf("Some expectations are already set for the Tester.FailNow method")
-	}
+	Counter uint64
+}
 
-	m.mock.funcFailNow = f
-	return m.mock
+// TesterMockErrorfParams contains parameters of the Tester.Errorf
+type TesterMockErrorfParams struct {
+	Format string
+	Args   []interface{}
 }
 
-// FailNow implements minimock.Tester
-func (m *TesterMock) FailNow() {
-	mm_atomic.AddUint64(&m.beforeFailNowCounter, 1)
-	defer mm_atomic.AddUint64(&m.afterFailNowCounter, 1)
+// Expect sets up expected params for Tester.Errorf
+func (mmErrorf *mTesterMockErrorf) Expect(format string, args ...interface{}) *mTesterMockErrorf {
+	if _, mm_func := mmErrorf.current(); mm_func != nil {
+		mmErrorf.mock.state.T().Fatalf("TesterMock.Errorf mock is already set by Set")
+	}
 
-	if m.FailNowMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&m.FailNowMock.defaultExpectation.Counter, 1)
+	mm_params := &TesterMockErrorfParams{format, args}
+	mmErrorf.updateDefault(func(e *TesterMockErrorfExpectation) {
+		if e.partial {
+			mmErrorf.mock.state.T().Fatalf("TesterMock.Errorf params are already set by the Expect*Param* and Match*Param* helpers")
+		}
 
-		return
+		e.params = mm_params
+	})
 
+	for _, e := range mmErrorf.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmErrorf.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
 	}
-	if m.funcFailNow != nil {
-		m.funcFailNow()
-		return
-	}
-	m.t.Fatalf("Unexpected call to TesterMock.FailNow.")
 
+	return mmErrorf
 }
 
-// FailNowAfterCounter returns a count of finished TesterMock.FailNow invocations
-func (m *TesterMock) FailNowAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&m.afterFailNowCounter)
-}
+// updateDefault replaces the default expectation of Tester.Errorf by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmErrorf *mTesterMockErrorf) updateDefault(update func(e *TesterMockErrorfExpectation)) {
+	mmErrorf.state.Mutex.Lock()
+	defer mmErrorf.state.Mutex.Unlock()
+
+	e := &TesterMockErrorfExpectation{mock: mmErrorf.mock}
+	if previous := mmErrorf.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
 
-// FailNowBeforeCounter returns a count of TesterMock.FailNow invocations
-func (m *TesterMock) FailNowBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&m.beforeFailNowCounter)
+	update(e)
+	mmErrorf.defaultExpectation = e
 }
 
-// MinimockFailNowDone returns true if the count of the FailNow invocations corresponds
-// the number of defined expectations
-func (m *TesterMock) MinimockFailNowDone() bool {
-	for _, e := range m.FailNowMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			return false
-		}
-	}
+// current returns the default expectation and the function set up for Tester.Errorf
+func (mmErrorf *mTesterMockErrorf) current() (*TesterMockErrorfExpectation, func(format string, args ...interface{})) {
+	mmErrorf.state.Mutex.RLock()
+	defer mmErrorf.state.Mutex.RUnlock()
 
-	// if default expectation was set then invocations count should be greater than zero
-	if m.FailNowMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterFailNowCounter) < 1 {
-		return false
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcFailNow != nil && mm_atomic.LoadUint64(&m.afterFailNowCounter) < 1 {
-		return false
-	}
-	return true
+	return mmErrorf.defaultExpectation, mmErrorf.mock.funcErrorf
 }
 
-// MinimockFailNowInspect logs each unmet expectation
-func (m *TesterMock) MinimockFailNowInspect() {
-	for _, e := range m.FailNowMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Error("Expected call to TesterMock.FailNow")
+// partialParams updates the default expectation of Tester.Errorf by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmErrorf *mTesterMockErrorf) partialParams(update func(e *TesterMockErrorfExpectation)) {
+	if _, mm_func := mmErrorf.current(); mm_func != nil {
+		mmErrorf.mock.state.T().Fatalf("TesterMock.Errorf mock is already set by Set")
+	}
+
+	mmErrorf.updateDefault(func(e *TesterMockErrorfExpectation) {
+		if e.params == nil {
+			e.params = &TesterMockErrorfParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"Format": minimock.Anything,
+				"Args":   minimock.Anything,
+			}
 		}
-	}
 
-	// if default expectation was set then invocations count should be greater than zero
-	if m.FailNowMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterFailNowCounter) < 1 {
-		m.t.Error("Expected call to TesterMock.FailNow")
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcFailNow != nil && mm_atomic.LoadUint64(&m.afterFailNowCounter) < 1 {
-		m.t.Error("Expected call to TesterMock.FailNow")
-	}
-}
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
 
-type mTesterMockFatal struct {
-	mock               *TesterMock
-	defaultExpectation *TesterMockFatalExpectation
-	expectations       []*TesterMockFatalExpectation
+		update(e)
+	})
 }
 
-// TesterMockFatalExpectation specifies expectation struct of the Tester.Fatal
-type TesterMockFatalExpectation struct {
-	mock   *TesterMock
-	params *TesterMockFatalParams
+// ExpectFormatParam1 sets up the expected value of the param #1 of Tester.Errorf,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmErrorf *mTesterMockErrorf) ExpectFormatParam1(Format string) *mTesterMockErrorf {
+	mmErrorf.partialParams(func(e *TesterMockErrorfExpectation) {
+		e.params.Format = Format
+		delete(e.matchers, "Format")
+	})
+	return mmErrorf
+}
 
-	Counter uint64
+// MatchFormatParam1 sets up the predicate matching the param #1 of Tester.Errorf,
+// it's used instead of the value of the param set by Expect
+func (mmErrorf *mTesterMockErrorf) MatchFormatParam1(f func(got string) bool) *mTesterMockErrorf {
+	mm_matcher := minimock.Predicate("predicate func(got string) bool", func(v interface{}) bool {
+		got, _ := v.(string)
+		return f(got)
+	})
+	mmErrorf.partialParams(func(e *TesterMockErrorfExpectation) {
+		e.matchers["Format"] = mm_matcher
+	})
+	return mmErrorf
 }
 
-// TesterMockFatalParams contains parameters of the Tester.Fatal
-type TesterMockFatalParams struct {
-	args []interface{}
+// ExpectArgsParam2 sets up the expected value of the param #2 of Tester.Errorf,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmErrorf *mTesterMockErrorf) ExpectArgsParam2(Args []interface{}) *mTesterMockErrorf {
+	mmErrorf.partialParams(func(e *TesterMockErrorfExpectation) {
+		e.params.Args = Args
+		delete(e.matchers, "Args")
+	})
+	return mmErrorf
 }
 
-// Expect sets up expected params for Tester.Fatal
-func (m *mTesterMockFatal) Expect(args ...interface{}) *mTesterMockFatal {
-	if m.mock.funcFatal != nil {
-		m.mock.t.Fatalf("TesterMock.Fatal mock is already set by Set")
-	}
+// MatchArgsParam2 sets up the predicate matching the param #2 of Tester.Errorf,
+// it's used instead of the value of the param set by Expect
+func (mmErrorf *mTesterMockErrorf) MatchArgsParam2(f func(got []interface{}) bool) *mTesterMockErrorf {
+	mm_matcher := minimock.Predicate("predicate func(got []interface{}) bool", func(v interface{}) bool {
+		got, _ := v.([]interface{})
+		return f(got)
+	})
+	mmErrorf.partialParams(func(e *TesterMockErrorfExpectation) {
+		e.matchers["Args"] = mm_matcher
+	})
+	return mmErrorf
+}
 
-	if m.defaultExpectation == nil {
-		m.defaultExpectation = &TesterMockFatalExpectation{}
+// Return sets up results that will be returned by Tester.Errorf
+func (mmErrorf *mTesterMockErrorf) Return() *TesterMock {
+	if _, mm_func := mmErrorf.current(); mm_func != nil {
+		mmErrorf.mock.state.T().Fatalf("TesterMock.Errorf mock is already set by Set")
 	}
 
-	m.defaultExpectation.params = &TesterMockFatalParams{args}
-	for _, e := range m.expectations {
-		if minimock.Equal(e.params, m.defaultExpectation.params) {
-			m.mock.t.Fatalf("Expectation set by When has same params: %#v", *m.defaultExpectation.params)
-		}
-	}
+	mmErrorf.updateDefault(func(*TesterMockErrorfExpectation) {})
 
-	return m
+	return mmErrorf.mock
 }
 
-// Return sets up results that will be returned by Tester.Fatal
-func (m *mTesterMockFatal) Return() *TesterMock {
-	if m.mock.funcFatal != nil {
-		m.mock.t.Fatalf("TesterMock.Fatal mock is already set by Set")
-	}
+// SetComparer sets up the function comparing the expected and the actual params of Tester.Errorf instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmErrorf *mTesterMockErrorf) SetComparer(compare minimock.Comparer) *mTesterMockErrorf {
+	mmErrorf.state.Mutex.Lock()
+	mmErrorf.compare = compare
+	mmErrorf.state.Mutex.Unlock()
+	return mmErrorf
+}
 
-	if m.defaultExpectation == nil {
-		m.defaultExpectation = &TesterMockFatalExpectation{mock: m.mock}
-	}
+// whenExpectations returns the expectations of Tester.Errorf set by When,
+// the expectations can be set while the method is called concurrently
+func (mmErrorf *mTesterMockErrorf) whenExpectations() []*TesterMockErrorfExpectation {
+	mmErrorf.state.Mutex.RLock()
+	defer mmErrorf.state.Mutex.RUnlock()
 
-	return m.mock
+	return mmErrorf.expectations
 }
 
-//Set uses given function f to mock the Tester.Fatal method
-func (m *mTesterMockFatal) Set(f func(args ...interface{})) *TesterMock {
-	if m.defaultExpectation != nil {
-		m.mock.t.Fatalf("Default expectation is already set for the Tester.Fatal method")
-	}
+// Inspect sets up the function called with the params of every Tester.Errorf call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmErrorf *mTesterMockErrorf) Inspect(f func(format string, args ...interface{})) *mTesterMockErrorf {
+	mmErrorf.state.Mutex.Lock()
+	mmErrorf.inspectErrorf = f
+	mmErrorf.state.Mutex.Unlock()
+	return mmErrorf
+}
 
-	if len(m.expectations) > 0 {
-		m.mock.t.Fatalf("Some expectations are already set for the Tester.Fatal method")
-	}
+// expected returns everything set up for the Tester.Errorf call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer
+func (mmErrorf *mTesterMockErrorf) expected() (*TesterMockErrorfExpectation, func(format string, args ...interface{}), func(format string, args ...interface{}), minimock.Comparer) {
+	mmErrorf.state.Mutex.RLock()
+	defer mmErrorf.state.Mutex.RUnlock()
 
-	m.mock.funcFatal = f
-	return m.mock
+	return mmErrorf.defaultExpectation, mmErrorf.mock.funcErrorf, mmErrorf.inspectErrorf, mmErrorf.compare
 }
 
-// Fatal implements minimock.Tester
-func (m *TesterMock) Fatal(args ...interface{}) {
-	mm_atomic.AddUint64(&m.beforeFatalCounter, 1)
-	defer mm_atomic.AddUint64(&m.afterFatalCounter, 1)
+// setup returns the expectations and the function set up for Tester.Errorf to be checked when the mock is finished
+func (mmErrorf *mTesterMockErrorf) setup() (setup minimock.Setup) {
+	mmErrorf.state.Mutex.RLock()
+	defer mmErrorf.state.Mutex.RUnlock()
 
-	for _, e := range m.FatalMock.expectations {
-		if minimock.Equal(*e.params, TesterMockFatalParams{args}) {
-			mm_atomic.AddUint64(&e.Counter, 1)
-			return
+	setup.Func = mmErrorf.mock.funcErrorf != nil
+	if e := mmErrorf.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
 		}
 	}
 
-	if m.FatalMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&m.FatalMock.defaultExpectation.Counter, 1)
-		want := m.FatalMock.defaultExpectation.params
-		got := TesterMockFatalParams{args}
-		if want != nil && !minimock.Equal(*want, got) {
-			m.t.Errorf("TesterMock.Fatal got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+	for _, e := range mmErrorf.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
 		}
-
-		return
-
-	}
-	if m.funcFatal != nil {
-		m.funcFatal(args...)
-		return
+		setup.When = append(setup.When, when)
 	}
-	m.t.Fatalf("Unexpected call to TesterMock.Fatal. %v", args)
 
+	return setup
 }
 
-// FatalAfterCounter returns a count of finished TesterMock.Fatal invocations
-func (m *TesterMock) FatalAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&m.afterFatalCounter)
-}
+// reset removes the expectations of Tester.Errorf, the params of the calls are removed from the history as well
+func (mmErrorf *mTesterMockErrorf) reset() {
+	mmErrorf.state.Mutex.Lock()
+	mmErrorf.defaultExpectation = nil
+	mmErrorf.expectations = nil
+	mmErrorf.state.Mutex.Unlock()
 
-// FatalBeforeCounter returns a count of TesterMock.Fatal invocations
-func (m *TesterMock) FatalBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&m.beforeFatalCounter)
+	mmErrorf.state.History.Lock()
+	mmErrorf.calls = nil
+	mmErrorf.state.History.Unlock()
 }
 
-// MinimockFatalDone returns true if the count of the Fatal invocations corresponds
-// the number of defined expectations
-func (m *TesterMock) MinimockFatalDone() bool {
-	for _, e := range m.FatalMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			return false
-		}
-	}
-
-	// if default expectation was set then invocations count should be greater than zero
-	if m.FatalMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterFatalCounter) < 1 {
-		return false
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcFatal != nil && mm_atomic.LoadUint64(&m.afterFatalCounter) < 1 {
-		return false
-	}
-	return true
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmErrorf *mTesterMockErrorf) MethodName() string {
+	return mmErrorf.state.Name()
 }
 
-// MinimockFatalInspect logs each unmet expectation
-func (m *TesterMock) MinimockFatalInspect() {
-	for _, e := range m.FatalMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to TesterMock.Fatal with params: %#v", *e.params)
-		}
-	}
-
-	// if default expectation was set then invocations count should be greater than zero
-	if m.FatalMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterFatalCounter) < 1 {
-		m.t.Errorf("Expected call to TesterMock.Fatal with params: %#v", *m.FatalMock.defaultExpectation.params)
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcFatal != nil && mm_atomic.LoadUint64(&m.afterFatalCounter) < 1 {
-		m.t.Error("Expected call to TesterMock.Fatal")
-	}
+// CallSequence returns the numbers of the Tester.Errorf calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmErrorf *mTesterMockErrorf) CallSequence() []uint64 {
+	return mmErrorf.state.CallSequence()
 }
 
-type mTesterMockFatalf struct {
-	mock               *TesterMock
-	defaultExpectation *TesterMockFatalfExpectation
-	expectations       []*TesterMockFatalfExpectation
+// WaitForCalls waits until Tester.Errorf is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmErrorf *mTesterMockErrorf) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmErrorf.state.WaitForCalls(n, timeout)
 }
 
-// TesterMockFatalfExpectation specifies expectation struct of the Tester.Fatalf
-type TesterMockFatalfExpectation struct {
-	mock   *TesterMock
-	params *TesterMockFatalfParams
+// Block makes the subsequent Tester.Errorf calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmErrorf *mTesterMockErrorf) Block() (release func()) {
+	return mmErrorf.state.Block()
+}
 
-	Counter uint64
+// WaitUntilBlocked waits until at least n Tester.Errorf calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmErrorf *mTesterMockErrorf) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmErrorf.state.WaitUntilBlocked(n, timeout)
 }
 
-// TesterMockFatalfParams contains parameters of the Tester.Fatalf
-type TesterMockFatalfParams struct {
-	format string
-	args   []interface{}
+// LimitConcurrency fails the test as soon as more than n Tester.Errorf calls are in flight at once
+func (mmErrorf *mTesterMockErrorf) LimitConcurrency(n int) *mTesterMockErrorf {
+	mmErrorf.state.SetLimit(n)
+	return mmErrorf
 }
 
-// Expect sets up expected params for Tester.Fatalf
-func (m *mTesterMockFatalf) Expect(format string, args ...interface{}) *mTesterMockFatalf {
-	if m.mock.funcFatalf != nil {
-		m.mock.t.Fatalf("TesterMock.Fatalf mock is already set by Set")
-	}
+// CaptureCalls creates the buffered channel receiving the params of each Tester.Errorf call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmErrorf *mTesterMockErrorf) CaptureCalls(buffer int) *mTesterMockErrorf {
+	mmErrorf.state.History.Lock()
+	mmErrorf.called = make(chan TesterMockErrorfParams, buffer)
+	mmErrorf.state.History.Unlock()
+	return mmErrorf
+}
 
-	if m.defaultExpectation == nil {
-		m.defaultExpectation = &TesterMockFatalfExpectation{}
-	}
+// Called returns the channel set up by CaptureCalls receiving the params of each Tester.Errorf call
+func (mmErrorf *mTesterMockErrorf) Called() <-chan TesterMockErrorfParams {
+	mmErrorf.state.History.Lock()
+	defer mmErrorf.state.History.Unlock()
 
-	m.defaultExpectation.params = &TesterMockFatalfParams{format, args}
-	for _, e := range m.expectations {
-		if minimock.Equal(e.params, m.defaultExpectation.params) {
-			m.mock.t.Fatalf("Expectation set by When has same params: %#v", *m.defaultExpectation.params)
-		}
+	if mmErrorf.called == nil {
+		mmErrorf.mock.state.T().Fatalf("Calls of TesterMock.Errorf aren't captured, CaptureCalls has to be called before the calls")
 	}
 
-	return m
+	return mmErrorf.called
 }
 
-// Return sets up results that will be returned by Tester.Fatalf
-func (m *mTesterMockFatalf) Return() *TesterMock {
-	if m.mock.funcFatalf != nil {
-		m.mock.t.Fatalf("TesterMock.Fatalf mock is already set by Set")
-	}
+// DroppedCalls returns the number of the Tester.Errorf calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmErrorf *mTesterMockErrorf) DroppedCalls() uint64 {
+	return mmErrorf.state.DroppedCalls()
+}
 
-	if m.defaultExpectation == nil {
-		m.defaultExpectation = &TesterMockFatalfExpectation{mock: m.mock}
-	}
+// Times sets the exact number of the Tester.Errorf calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmErrorf *mTesterMockErrorf) Times(n uint64) *mTesterMockErrorf {
+	mmErrorf.state.SetTimes(n)
+	return mmErrorf
+}
 
-	return m.mock
+// Optional excludes Tester.Errorf from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmErrorf *mTesterMockErrorf) Optional() *mTesterMockErrorf {
+	mmErrorf.state.SetOptional()
+	return mmErrorf
 }
 
-//Set uses given function f to mock the Tester.Fatalf method
-func (m *mTesterMockFatalf) Set(f func(format string, args ...interface{})) *TesterMock {
-	if m.defaultExpectation != nil {
-		m.mock.t.Fatalf("Default expectation is already set for the Tester.Fatalf method")
+// Set uses given function f to mock the Tester.Errorf method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmErrorf *mTesterMockErrorf) Set(f func(format string, args ...interface{})) *TesterMock {
+	mmErrorf.state.Mutex.Lock()
+	defer mmErrorf.state.Mutex.Unlock()
+
+	if mmErrorf.defaultExpectation != nil {
+		mmErrorf.mock.state.T().Fatalf("Default expectation is already set for the Tester.Errorf method")
 	}
 
-	if len(m.expectations) > 0 {
-		m.mock.t.Fatalf("Some expectations are already set for the Tester.Fatalf method")
+	if len(mmErrorf.expectations) > 0 {
+		mmErrorf.mock.state.T().Fatalf("Some expectations are already set for the Tester.Errorf method")
 	}
 
-	m.mock.funcFatalf = f
-	return m.mock
+	mmErrorf.mock.funcErrorf = f
+	return mmErrorf.mock
 }
 
-// Fatalf implements minimock.Tester
-func (m *TesterMock) Fatalf(format string, args ...interface{}) {
-	mm_atomic.AddUint64(&m.beforeFatalfCounter, 1)
-	defer mm_atomic.AddUint64(&m.afterFatalfCounter, 1)
+// Errorf implements minimock.Tester
+func (mmErrorf *TesterMock) Errorf(format string, args ...interface{}) {
+	mm_method := mmErrorf.ErrorfMock
+	mm_method.state.Enter()
+	defer mm_method.state.Leave()
 
-	for _, e := range m.FatalfMock.expectations {
-		if minimock.Equal(*e.params, TesterMockFatalfParams{format, args}) {
-			mm_atomic.AddUint64(&e.Counter, 1)
-			return
+	mm_params := TesterMockErrorfParams{format, args}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
 		}
 	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcErrorf, mm_inspectErrorf, mm_compare := mm_method.expected()
+	if mm_inspectErrorf != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectErrorf(format, args...)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
 
-	if m.FatalfMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&m.FatalfMock.defaultExpectation.Counter, 1)
-		want := m.FatalfMock.defaultExpectation.params
-		got := TesterMockFatalfParams{format, args}
-		if want != nil && !minimock.Equal(*want, got) {
-			m.t.Errorf("TesterMock.Fatalf got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
 		}
 
 		return
 
 	}
-	if m.funcFatalf != nil {
-		m.funcFatalf(format, args...)
+	if mm_funcErrorf != nil {
+		mm_funcErrorf(format, args...)
+		return
+	}
+	if mm_delegate := mmErrorf.minimockDelegate(); mm_delegate != nil {
+		mm_delegate.Errorf(format, args...)
+		return
+	}
+	if mm_method.state.Lenient() {
 		return
 	}
-	m.t.Fatalf("Unexpected call to TesterMock.Fatalf. %v %v", format, args)
+	mm_method.state.UnexpectedCall(mm_params, format, args)
 
 }
 
-// FatalfAfterCounter returns a count of finished TesterMock.Fatalf invocations
-func (m *TesterMock) FatalfAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&m.afterFatalfCounter)
+// ErrorfAfterCounter returns a count of finished TesterMock.Errorf invocations
+func (mmErrorf *TesterMock) ErrorfAfterCounter() uint64 {
+	return mmErrorf.ErrorfMock.state.AfterCalls()
 }
 
-// FatalfBeforeCounter returns a count of TesterMock.Fatalf invocations
-func (m *TesterMock) FatalfBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&m.beforeFatalfCounter)
+// ErrorfBeforeCounter returns a count of TesterMock.Errorf invocations
+func (mmErrorf *TesterMock) ErrorfBeforeCounter() uint64 {
+	return mmErrorf.ErrorfMock.state.BeforeCalls()
 }
 
-// MinimockFatalfDone returns true if the count of the Fatalf invocations corresponds
-// the number of defined expectations
-func (m *TesterMock) MinimockFatalfDone() bool {
-	for _, e := range m.FatalfMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			return false
-		}
-	}
+// ErrorfCalls returns the params of all TesterMock.Errorf calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmErrorf *TesterMock) ErrorfCalls() []TesterMockErrorfParams {
+	mmErrorf.ErrorfMock.state.History.Lock()
+	defer mmErrorf.ErrorfMock.state.History.Unlock()
 
-	// if default expectation was set then invocations count should be greater than zero
-	if m.FatalfMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterFatalfCounter) < 1 {
-		return false
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcFatalf != nil && mm_atomic.LoadUint64(&m.afterFatalfCounter) < 1 {
-		return false
+	calls := make([]TesterMockErrorfParams, len(mmErrorf.ErrorfMock.calls))
+	copy(calls, mmErrorf.ErrorfMock.calls)
+	return calls
+}
+
+// ErrorfLastParams returns the params of the latest TesterMock.Errorf call and false if there were no calls
+func (mmErrorf *TesterMock) ErrorfLastParams() (params TesterMockErrorfParams, ok bool) {
+	mmErrorf.ErrorfMock.state.History.Lock()
+	defer mmErrorf.ErrorfMock.state.History.Unlock()
+
+	if n := len(mmErrorf.ErrorfMock.calls); n > 0 {
+		return mmErrorf.ErrorfMock.calls[n-1], true
 	}
-	return true
+
+	return params, false
 }
 
-// MinimockFatalfInspect logs each unmet expectation
-func (m *TesterMock) MinimockFatalfInspect() {
-	for _, e := range m.FatalfMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to TesterMock.Fatalf with params: %#v", *e.params)
+// ErrorfCallTimes returns the times of all TesterMock.Errorf calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmErrorf *TesterMock) ErrorfCallTimes() []mm_time.Time {
+	return mmErrorf.ErrorfMock.state.CallTimes()
+}
+
+// ErrorfMaxInFlight returns the maximum number of the TesterMock.Errorf calls that have been in flight at once
+func (mmErrorf *TesterMock) ErrorfMaxInFlight() int {
+	return mmErrorf.ErrorfMock.state.MaxInFlight()
+}
+
+// ErrorfUnexpectedCounter returns a count of TesterMock.Errorf invocations made without an implementation
+func (mmErrorf *TesterMock) ErrorfUnexpectedCounter() uint64 {
+	return mmErrorf.ErrorfMock.state.UnexpectedCalls()
+}
+
+// ErrorfNotCalled returns true if TesterMock.Errorf hasn't been called
+func (mmErrorf *TesterMock) ErrorfNotCalled() bool {
+	return mmErrorf.ErrorfMock.state.BeforeCalls() == 0
+}
+
+// ErrorfCallCount returns a count of TesterMock.Errorf invocations, it's the same as ErrorfBeforeCounter
+func (mmErrorf *TesterMock) ErrorfCallCount() uint64 {
+	return mmErrorf.ErrorfMock.state.BeforeCalls()
+}
+
+// MinimockErrorfDone returns true if the count of the Errorf invocations corresponds
+// the number of defined expectations
+func (mmErrorf *TesterMock) MinimockErrorfDone() bool {
+	return mmErrorf.ErrorfMock.state.Done(mmErrorf.ErrorfMock.setup())
+}
+
+// MinimockErrorfInspect logs each unmet expectation
+func (mmErrorf *TesterMock) MinimockErrorfInspect() {
+	mmErrorf.ErrorfMock.state.Inspect(mmErrorf.ErrorfMock.setup())
+}
+
+type mTesterMockFailNow struct {
+	mock               *TesterMock
+	state              minimock.MethodState
+	defaultExpectation *TesterMockFailNowExpectation
+	expectations       []*TesterMockFailNowExpectation
+	inspectFailNow     func()
+	called             chan struct{}
+}
+
+// TesterMockFailNowExpectation specifies expectation struct of the Tester.FailNow
+type TesterMockFailNowExpectation struct {
+	mock *TesterMock
+
+	Counter uint64
+}
+
+// Expect sets up expected params for Tester.FailNow
+func (mmFailNow *mTesterMockFailNow) Expect() *mTesterMockFailNow {
+	if _, mm_func := mmFailNow.current(); mm_func != nil {
+		mmFailNow.mock.state.T().Fatalf("TesterMock.FailNow mock is already set by Set")
+	}
+
+	mmFailNow.updateDefault(func(*TesterMockFailNowExpectation) {})
+
+	return mmFailNow
+}
+
+// updateDefault replaces the default expectation of Tester.FailNow by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmFailNow *mTesterMockFailNow) updateDefault(update func(e *TesterMockFailNowExpectation)) {
+	mmFailNow.state.Mutex.Lock()
+	defer mmFailNow.state.Mutex.Unlock()
+
+	e := &TesterMockFailNowExpectation{mock: mmFailNow.mock}
+	if previous := mmFailNow.defaultExpectation; previous != nil {
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmFailNow.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Tester.FailNow
+func (mmFailNow *mTesterMockFailNow) current() (*TesterMockFailNowExpectation, func()) {
+	mmFailNow.state.Mutex.RLock()
+	defer mmFailNow.state.Mutex.RUnlock()
+
+	return mmFailNow.defaultExpectation, mmFailNow.mock.funcFailNow
+}
+
+// Return sets up results that will be returned by Tester.FailNow
+func (mmFailNow *mTesterMockFailNow) Return() *TesterMock {
+	if _, mm_func := mmFailNow.current(); mm_func != nil {
+		mmFailNow.mock.state.T().Fatalf("TesterMock.FailNow mock is already set by Set")
+	}
+
+	mmFailNow.updateDefault(func(*TesterMockFailNowExpectation) {})
+
+	return mmFailNow.mock
+}
+
+// Inspect sets up the function called with the params of every Tester.FailNow call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmFailNow *mTesterMockFailNow) Inspect(f func()) *mTesterMockFailNow {
+	mmFailNow.state.Mutex.Lock()
+	mmFailNow.inspectFailNow = f
+	mmFailNow.state.Mutex.Unlock()
+	return mmFailNow
+}
+
+// expected returns everything set up for the Tester.FailNow call under a single read lock: the default expectation,
+// the functions set by Set and Inspect
+func (mmFailNow *mTesterMockFailNow) expected() (*TesterMockFailNowExpectation, func(), func()) {
+	mmFailNow.state.Mutex.RLock()
+	defer mmFailNow.state.Mutex.RUnlock()
+
+	return mmFailNow.defaultExpectation, mmFailNow.mock.funcFailNow, mmFailNow.inspectFailNow
+}
+
+// setup returns the expectations and the function set up for Tester.FailNow to be checked when the mock is finished
+func (mmFailNow *mTesterMockFailNow) setup() (setup minimock.Setup) {
+	mmFailNow.state.Mutex.RLock()
+	defer mmFailNow.state.Mutex.RUnlock()
+
+	setup.Func = mmFailNow.mock.funcFailNow != nil
+	if e := mmFailNow.defaultExpectation; e != nil {
+		setup.Default = true
+	}
+
+	for _, e := range mmFailNow.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations of Tester.FailNow
+func (mmFailNow *mTesterMockFailNow) reset() {
+	mmFailNow.state.Mutex.Lock()
+	mmFailNow.defaultExpectation = nil
+	mmFailNow.expectations = nil
+	mmFailNow.state.Mutex.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmFailNow *mTesterMockFailNow) MethodName() string {
+	return mmFailNow.state.Name()
+}
+
+// CallSequence returns the numbers of the Tester.FailNow calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmFailNow *mTesterMockFailNow) CallSequence() []uint64 {
+	return mmFailNow.state.CallSequence()
+}
+
+// WaitForCalls waits until Tester.FailNow is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmFailNow *mTesterMockFailNow) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmFailNow.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Tester.FailNow calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmFailNow *mTesterMockFailNow) Block() (release func()) {
+	return mmFailNow.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Tester.FailNow calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmFailNow *mTesterMockFailNow) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmFailNow.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Tester.FailNow calls are in flight at once
+func (mmFailNow *mTesterMockFailNow) LimitConcurrency(n int) *mTesterMockFailNow {
+	mmFailNow.state.SetLimit(n)
+	return mmFailNow
+}
+
+// CaptureCalls creates the buffered channel receiving the signal of each Tester.FailNow call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmFailNow *mTesterMockFailNow) CaptureCalls(buffer int) *mTesterMockFailNow {
+	mmFailNow.state.History.Lock()
+	mmFailNow.called = make(chan struct{}, buffer)
+	mmFailNow.state.History.Unlock()
+	return mmFailNow
+}
+
+// Called returns the channel set up by CaptureCalls receiving the signal of each Tester.FailNow call
+func (mmFailNow *mTesterMockFailNow) Called() <-chan struct{} {
+	mmFailNow.state.History.Lock()
+	defer mmFailNow.state.History.Unlock()
+
+	if mmFailNow.called == nil {
+		mmFailNow.mock.state.T().Fatalf("Calls of TesterMock.FailNow aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmFailNow.called
+}
+
+// DroppedCalls returns the number of the Tester.FailNow calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmFailNow *mTesterMockFailNow) DroppedCalls() uint64 {
+	return mmFailNow.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Tester.FailNow calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmFailNow *mTesterMockFailNow) Times(n uint64) *mTesterMockFailNow {
+	mmFailNow.state.SetTimes(n)
+	return mmFailNow
+}
+
+// Optional excludes Tester.FailNow from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmFailNow *mTesterMockFailNow) Optional() *mTesterMockFailNow {
+	mmFailNow.state.SetOptional()
+	return mmFailNow
+}
+
+// Set uses given function f to mock the Tester.FailNow method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmFailNow *mTesterMockFailNow) Set(f func()) *TesterMock {
+	mmFailNow.state.Mutex.Lock()
+	defer mmFailNow.state.Mutex.Unlock()
+
+	if mmFailNow.defaultExpectation != nil {
+		mmFailNow.mock.state.T().Fatalf("Default expectation is already set for the Tester.FailNow method")
+	}
+
+	if len(mmFailNow.expectations) > 0 {
+		mmFailNow.mock.state.T().Fatalf("Some expectations are already set for the Tester.FailNow method")
+	}
+
+	mmFailNow.mock.funcFailNow = f
+	return mmFailNow.mock
+}
+
+// FailNow implements minimock.Tester
+func (mmFailNow *TesterMock) FailNow() {
+	mm_method := mmFailNow.FailNowMock
+	mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_method.state.Record()
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- struct{}{}:
+		default:
+			mm_method.state.Drop()
 		}
 	}
+	mm_method.state.History.Unlock()
 
-	// if default expectation was set then invocations count should be greater than zero
-	if m.FatalfMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterFatalfCounter) < 1 {
-		m.t.Errorf("Expected call to TesterMock.Fatalf with params: %#v", *m.FatalfMock.defaultExpectation.params)
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcFailNow, mm_inspectFailNow := mm_method.expected()
+	if mm_inspectFailNow != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectFailNow()
+		}()
 	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcFatalf != nil && mm_atomic.LoadUint64(&m.afterFatalfCounter) < 1 {
-		m.t.Error("Expected call to TesterMock.Fatalf")
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+
+		return
+
 	}
+	if mm_funcFailNow != nil {
+		mm_funcFailNow()
+		return
+	}
+	if mm_delegate := mmFailNow.minimockDelegate(); mm_delegate != nil {
+		mm_delegate.FailNow()
+		return
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(nil)
+
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *TesterMock) MinimockFinish() {
-	if !m.minimockDone() {
-		m.MinimockErrorInspect()
+// FailNowAfterCounter returns a count of finished TesterMock.FailNow invocations
+func (mmFailNow *TesterMock) FailNowAfterCounter() uint64 {
+	return mmFailNow.FailNowMock.state.AfterCalls()
+}
+
+// FailNowBeforeCounter returns a count of TesterMock.FailNow invocations
+func (mmFailNow *TesterMock) FailNowBeforeCounter() uint64 {
+	return mmFailNow.FailNowMock.state.BeforeCalls()
+}
+
+// FailNowCallTimes returns the times of all TesterMock.FailNow calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmFailNow *TesterMock) FailNowCallTimes() []mm_time.Time {
+	return mmFailNow.FailNowMock.state.CallTimes()
+}
 
-		m.MinimockErrorfInspect()
+// FailNowMaxInFlight returns the maximum number of the TesterMock.FailNow calls that have been in flight at once
+func (mmFailNow *TesterMock) FailNowMaxInFlight() int {
+	return mmFailNow.FailNowMock.state.MaxInFlight()
+}
+
+// FailNowUnexpectedCounter returns a count of TesterMock.FailNow invocations made without an implementation
+func (mmFailNow *TesterMock) FailNowUnexpectedCounter() uint64 {
+	return mmFailNow.FailNowMock.state.UnexpectedCalls()
+}
+
+// FailNowNotCalled returns true if TesterMock.FailNow hasn't been called
+func (mmFailNow *TesterMock) FailNowNotCalled() bool {
+	return mmFailNow.FailNowMock.state.BeforeCalls() == 0
+}
+
+// FailNowCallCount returns a count of TesterMock.FailNow invocations, it's the same as FailNowBeforeCounter
+func (mmFailNow *TesterMock) FailNowCallCount() uint64 {
+	return mmFailNow.FailNowMock.state.BeforeCalls()
+}
+
+// MinimockFailNowDone returns true if the count of the FailNow invocations corresponds
+// the number of defined expectations
+func (mmFailNow *TesterMock) MinimockFailNowDone() bool {
+	return mmFailNow.FailNowMock.state.Done(mmFailNow.FailNowMock.setup())
+}
+
+// MinimockFailNowInspect logs each unmet expectation
+func (mmFailNow *TesterMock) MinimockFailNowInspect() {
+	mmFailNow.FailNowMock.state.Inspect(mmFailNow.FailNowMock.setup())
+}
+
+type mTesterMockFatal struct {
+	mock               *TesterMock
+	state              minimock.MethodState
+	defaultExpectation *TesterMockFatalExpectation
+	expectations       []*TesterMockFatalExpectation
+	inspectFatal       func(args ...interface{})
+	compare            minimock.Comparer
+	calls              []TesterMockFatalParams
+	called             chan TesterMockFatalParams
+}
 
-		m.MinimockFailNowInspect()
+// TesterMockFatalExpectation specifies expectation struct of the Tester.Fatal
+type TesterMockFatalExpectation struct {
+	mock     *TesterMock
+	params   *TesterMockFatalParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+
+	Counter uint64
+}
 
-		m.MinimockFatalInspect()
+// TesterMockFatalParams contains parameters of the Tester.Fatal
+type TesterMockFatalParams struct {
+	Args []interface{}
+}
 
-		m.MinimockFatalfInspect()
-		m.t.FailNow()
+// Expect sets up expected params for Tester.Fatal
+func (mmFatal *mTesterMockFatal) Expect(args ...interface{}) *mTesterMockFatal {
+	if _, mm_func := mmFatal.current(); mm_func != nil {
+		mmFatal.mock.state.T().Fatalf("TesterMock.Fatal mock is already set by Set")
 	}
+
+	mm_params := &TesterMockFatalParams{args}
+	mmFatal.updateDefault(func(e *TesterMockFatalExpectation) {
+		if e.partial {
+			mmFatal.mock.state.T().Fatalf("TesterMock.Fatal params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmFatal.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmFatal.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmFatal
 }
 
-// MinimockWait waits for all mocked methods to be called the expected number of times
-func (m *TesterMock) MinimockWait(timeout mm_time.Duration) {
-	timeoutCh := mm_time.After(timeout)
-	for {
-		if m.minimockDone() {
-			return
+// updateDefault replaces the default expectation of Tester.Fatal by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmFatal *mTesterMockFatal) updateDefault(update func(e *TesterMockFatalExpectation)) {
+	mmFatal.state.Mutex.Lock()
+	defer mmFatal.state.Mutex.Unlock()
+
+	e := &TesterMockFatalExpectation{mock: mmFatal.mock}
+	if previous := mmFatal.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
 		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmFatal.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Tester.Fatal
+func (mmFatal *mTesterMockFatal) current() (*TesterMockFatalExpectation, func(args ...interface{})) {
+	mmFatal.state.Mutex.RLock()
+	defer mmFatal.state.Mutex.RUnlock()
+
+	return mmFatal.defaultExpectation, mmFatal.mock.funcFatal
+}
+
+// partialParams updates the default expectation of Tester.Fatal by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmFatal *mTesterMockFatal) partialParams(update func(e *TesterMockFatalExpectation)) {
+	if _, mm_func := mmFatal.current(); mm_func != nil {
+		mmFatal.mock.state.T().Fatalf("TesterMock.Fatal mock is already set by Set")
+	}
+
+	mmFatal.updateDefault(func(e *TesterMockFatalExpectation) {
+		if e.params == nil {
+			e.params = &TesterMockFatalParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"Args": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectArgsParam1 sets up the expected value of the param #1 of Tester.Fatal,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmFatal *mTesterMockFatal) ExpectArgsParam1(Args []interface{}) *mTesterMockFatal {
+	mmFatal.partialParams(func(e *TesterMockFatalExpectation) {
+		e.params.Args = Args
+		delete(e.matchers, "Args")
+	})
+	return mmFatal
+}
+
+// MatchArgsParam1 sets up the predicate matching the param #1 of Tester.Fatal,
+// it's used instead of the value of the param set by Expect
+func (mmFatal *mTesterMockFatal) MatchArgsParam1(f func(got []interface{}) bool) *mTesterMockFatal {
+	mm_matcher := minimock.Predicate("predicate func(got []interface{}) bool", func(v interface{}) bool {
+		got, _ := v.([]interface{})
+		return f(got)
+	})
+	mmFatal.partialParams(func(e *TesterMockFatalExpectation) {
+		e.matchers["Args"] = mm_matcher
+	})
+	return mmFatal
+}
+
+// Return sets up results that will be returned by Tester.Fatal
+func (mmFatal *mTesterMockFatal) Return() *TesterMock {
+	if _, mm_func := mmFatal.current(); mm_func != nil {
+		mmFatal.mock.state.T().Fatalf("TesterMock.Fatal mock is already set by Set")
+	}
+
+	mmFatal.updateDefault(func(*TesterMockFatalExpectation) {})
+
+	return mmFatal.mock
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of Tester.Fatal instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmFatal *mTesterMockFatal) SetComparer(compare minimock.Comparer) *mTesterMockFatal {
+	mmFatal.state.Mutex.Lock()
+	mmFatal.compare = compare
+	mmFatal.state.Mutex.Unlock()
+	return mmFatal
+}
+
+// whenExpectations returns the expectations of Tester.Fatal set by When,
+// the expectations can be set while the method is called concurrently
+func (mmFatal *mTesterMockFatal) whenExpectations() []*TesterMockFatalExpectation {
+	mmFatal.state.Mutex.RLock()
+	defer mmFatal.state.Mutex.RUnlock()
+
+	return mmFatal.expectations
+}
+
+// Inspect sets up the function called with the params of every Tester.Fatal call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmFatal *mTesterMockFatal) Inspect(f func(args ...interface{})) *mTesterMockFatal {
+	mmFatal.state.Mutex.Lock()
+	mmFatal.inspectFatal = f
+	mmFatal.state.Mutex.Unlock()
+	return mmFatal
+}
+
+// expected returns everything set up for the Tester.Fatal call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer
+func (mmFatal *mTesterMockFatal) expected() (*TesterMockFatalExpectation, func(args ...interface{}), func(args ...interface{}), minimock.Comparer) {
+	mmFatal.state.Mutex.RLock()
+	defer mmFatal.state.Mutex.RUnlock()
+
+	return mmFatal.defaultExpectation, mmFatal.mock.funcFatal, mmFatal.inspectFatal, mmFatal.compare
+}
+
+// setup returns the expectations and the function set up for Tester.Fatal to be checked when the mock is finished
+func (mmFatal *mTesterMockFatal) setup() (setup minimock.Setup) {
+	mmFatal.state.Mutex.RLock()
+	defer mmFatal.state.Mutex.RUnlock()
+
+	setup.Func = mmFatal.mock.funcFatal != nil
+	if e := mmFatal.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmFatal.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations of Tester.Fatal, the params of the calls are removed from the history as well
+func (mmFatal *mTesterMockFatal) reset() {
+	mmFatal.state.Mutex.Lock()
+	mmFatal.defaultExpectation = nil
+	mmFatal.expectations = nil
+	mmFatal.state.Mutex.Unlock()
+
+	mmFatal.state.History.Lock()
+	mmFatal.calls = nil
+	mmFatal.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmFatal *mTesterMockFatal) MethodName() string {
+	return mmFatal.state.Name()
+}
+
+// CallSequence returns the numbers of the Tester.Fatal calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmFatal *mTesterMockFatal) CallSequence() []uint64 {
+	return mmFatal.state.CallSequence()
+}
+
+// WaitForCalls waits until Tester.Fatal is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmFatal *mTesterMockFatal) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmFatal.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Tester.Fatal calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmFatal *mTesterMockFatal) Block() (release func()) {
+	return mmFatal.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Tester.Fatal calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmFatal *mTesterMockFatal) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmFatal.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Tester.Fatal calls are in flight at once
+func (mmFatal *mTesterMockFatal) LimitConcurrency(n int) *mTesterMockFatal {
+	mmFatal.state.SetLimit(n)
+	return mmFatal
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Tester.Fatal call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmFatal *mTesterMockFatal) CaptureCalls(buffer int) *mTesterMockFatal {
+	mmFatal.state.History.Lock()
+	mmFatal.called = make(chan TesterMockFatalParams, buffer)
+	mmFatal.state.History.Unlock()
+	return mmFatal
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Tester.Fatal call
+func (mmFatal *mTesterMockFatal) Called() <-chan TesterMockFatalParams {
+	mmFatal.state.History.Lock()
+	defer mmFatal.state.History.Unlock()
+
+	if mmFatal.called == nil {
+		mmFatal.mock.state.T().Fatalf("Calls of TesterMock.Fatal aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmFatal.called
+}
+
+// DroppedCalls returns the number of the Tester.Fatal calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmFatal *mTesterMockFatal) DroppedCalls() uint64 {
+	return mmFatal.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Tester.Fatal calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmFatal *mTesterMockFatal) Times(n uint64) *mTesterMockFatal {
+	mmFatal.state.SetTimes(n)
+	return mmFatal
+}
+
+// Optional excludes Tester.Fatal from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmFatal *mTesterMockFatal) Optional() *mTesterMockFatal {
+	mmFatal.state.SetOptional()
+	return mmFatal
+}
+
+// Set uses given function f to mock the Tester.Fatal method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmFatal *mTesterMockFatal) Set(f func(args ...interface{})) *TesterMock {
+	mmFatal.state.Mutex.Lock()
+	defer mmFatal.state.Mutex.Unlock()
+
+	if mmFatal.defaultExpectation != nil {
+		mmFatal.mock.state.T().Fatalf("Default expectation is already set for the Tester.Fatal method")
+	}
+
+	if len(mmFatal.expectations) > 0 {
+		mmFatal.mock.state.T().Fatalf("Some expectations are already set for the Tester.Fatal method")
+	}
+
+	mmFatal.mock.funcFatal = f
+	return mmFatal.mock
+}
+
+// Fatal implements minimock.Tester
+func (mmFatal *TesterMock) Fatal(args ...interface{}) {
+	mm_method := mmFatal.FatalMock
+	mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := TesterMockFatalParams{args}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
 		select {
-		case <-timeoutCh:
-			m.MinimockFinish()
-			return
-		case <-mm_time.After(10 * mm_time.Millisecond):
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
 		}
 	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcFatal, mm_inspectFatal, mm_compare := mm_method.expected()
+	if mm_inspectFatal != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectFatal(args...)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return
+
+	}
+	if mm_funcFatal != nil {
+		mm_funcFatal(args...)
+		return
+	}
+	if mm_delegate := mmFatal.minimockDelegate(); mm_delegate != nil {
+		mm_delegate.Fatal(args...)
+		return
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, args)
+
+}
+
+// FatalAfterCounter returns a count of finished TesterMock.Fatal invocations
+func (mmFatal *TesterMock) FatalAfterCounter() uint64 {
+	return mmFatal.FatalMock.state.AfterCalls()
+}
+
+// FatalBeforeCounter returns a count of TesterMock.Fatal invocations
+func (mmFatal *TesterMock) FatalBeforeCounter() uint64 {
+	return mmFatal.FatalMock.state.BeforeCalls()
+}
+
+// FatalCalls returns the params of all TesterMock.Fatal calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmFatal *TesterMock) FatalCalls() []TesterMockFatalParams {
+	mmFatal.FatalMock.state.History.Lock()
+	defer mmFatal.FatalMock.state.History.Unlock()
+
+	calls := make([]TesterMockFatalParams, len(mmFatal.FatalMock.calls))
+	copy(calls, mmFatal.FatalMock.calls)
+	return calls
+}
+
+// FatalLastParams returns the params of the latest TesterMock.Fatal call and false if there were no calls
+func (mmFatal *TesterMock) FatalLastParams() (params TesterMockFatalParams, ok bool) {
+	mmFatal.FatalMock.state.History.Lock()
+	defer mmFatal.FatalMock.state.History.Unlock()
+
+	if n := len(mmFatal.FatalMock.calls); n > 0 {
+		return mmFatal.FatalMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// FatalCallTimes returns the times of all TesterMock.Fatal calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmFatal *TesterMock) FatalCallTimes() []mm_time.Time {
+	return mmFatal.FatalMock.state.CallTimes()
+}
+
+// FatalMaxInFlight returns the maximum number of the TesterMock.Fatal calls that have been in flight at once
+func (mmFatal *TesterMock) FatalMaxInFlight() int {
+	return mmFatal.FatalMock.state.MaxInFlight()
+}
+
+// FatalUnexpectedCounter returns a count of TesterMock.Fatal invocations made without an implementation
+func (mmFatal *TesterMock) FatalUnexpectedCounter() uint64 {
+	return mmFatal.FatalMock.state.UnexpectedCalls()
+}
+
+// FatalNotCalled returns true if TesterMock.Fatal hasn't been called
+func (mmFatal *TesterMock) FatalNotCalled() bool {
+	return mmFatal.FatalMock.state.BeforeCalls() == 0
+}
+
+// FatalCallCount returns a count of TesterMock.Fatal invocations, it's the same as FatalBeforeCounter
+func (mmFatal *TesterMock) FatalCallCount() uint64 {
+	return mmFatal.FatalMock.state.BeforeCalls()
+}
+
+// MinimockFatalDone returns true if the count of the Fatal invocations corresponds
+// the number of defined expectations
+func (mmFatal *TesterMock) MinimockFatalDone() bool {
+	return mmFatal.FatalMock.state.Done(mmFatal.FatalMock.setup())
+}
+
+// MinimockFatalInspect logs each unmet expectation
+func (mmFatal *TesterMock) MinimockFatalInspect() {
+	mmFatal.FatalMock.state.Inspect(mmFatal.FatalMock.setup())
+}
+
+type mTesterMockFatalf struct {
+	mock               *TesterMock
+	state              minimock.MethodState
+	defaultExpectation *TesterMockFatalfExpectation
+	expectations       []*TesterMockFatalfExpectation
+	inspectFatalf      func(format string, args ...interface{})
+	compare            minimock.Comparer
+	calls              []TesterMockFatalfParams
+	called             chan TesterMockFatalfParams
+}
+
+// TesterMockFatalfExpectation specifies expectation struct of the Tester.Fatalf
+type TesterMockFatalfExpectation struct {
+	mock     *TesterMock
+	params   *TesterMockFatalfParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+
+	Counter uint64
+}
+
+// TesterMockFatalfParams contains parameters of the Tester.Fatalf
+type TesterMockFatalfParams struct {
+	Format string
+	Args   []interface{}
+}
+
+// Expect sets up expected params for Tester.Fatalf
+func (mmFatalf *mTesterMockFatalf) Expect(format string, args ...interface{}) *mTesterMockFatalf {
+	if _, mm_func := mmFatalf.current(); mm_func != nil {
+		mmFatalf.mock.state.T().Fatalf("TesterMock.Fatalf mock is already set by Set")
+	}
+
+	mm_params := &TesterMockFatalfParams{format, args}
+	mmFatalf.updateDefault(func(e *TesterMockFatalfExpectation) {
+		if e.partial {
+			mmFatalf.mock.state.T().Fatalf("TesterMock.Fatalf params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmFatalf.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmFatalf.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmFatalf
+}
+
+// updateDefault replaces the default expectation of Tester.Fatalf by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmFatalf *mTesterMockFatalf) updateDefault(update func(e *TesterMockFatalfExpectation)) {
+	mmFatalf.state.Mutex.Lock()
+	defer mmFatalf.state.Mutex.Unlock()
+
+	e := &TesterMockFatalfExpectation{mock: mmFatalf.mock}
+	if previous := mmFatalf.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmFatalf.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Tester.Fatalf
+func (mmFatalf *mTesterMockFatalf) current() (*TesterMockFatalfExpectation, func(format string, args ...interface{})) {
+	mmFatalf.state.Mutex.RLock()
+	defer mmFatalf.state.Mutex.RUnlock()
+
+	return mmFatalf.defaultExpectation, mmFatalf.mock.funcFatalf
+}
+
+// partialParams updates the default expectation of Tester.Fatalf by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmFatalf *mTesterMockFatalf) partialParams(update func(e *TesterMockFatalfExpectation)) {
+	if _, mm_func := mmFatalf.current(); mm_func != nil {
+		mmFatalf.mock.state.T().Fatalf("TesterMock.Fatalf mock is already set by Set")
+	}
+
+	mmFatalf.updateDefault(func(e *TesterMockFatalfExpectation) {
+		if e.params == nil {
+			e.params = &TesterMockFatalfParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"Format": minimock.Anything,
+				"Args":   minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectFormatParam1 sets up the expected value of the param #1 of Tester.Fatalf,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmFatalf *mTesterMockFatalf) ExpectFormatParam1(Format string) *mTesterMockFatalf {
+	mmFatalf.partialParams(func(e *TesterMockFatalfExpectation) {
+		e.params.Format = Format
+		delete(e.matchers, "Format")
+	})
+	return mmFatalf
+}
+
+// MatchFormatParam1 sets up the predicate matching the param #1 of Tester.Fatalf,
+// it's used instead of the value of the param set by Expect
+func (mmFatalf *mTesterMockFatalf) MatchFormatParam1(f func(got string) bool) *mTesterMockFatalf {
+	mm_matcher := minimock.Predicate("predicate func(got string) bool", func(v interface{}) bool {
+		got, _ := v.(string)
+		return f(got)
+	})
+	mmFatalf.partialParams(func(e *TesterMockFatalfExpectation) {
+		e.matchers["Format"] = mm_matcher
+	})
+	return mmFatalf
+}
+
+// ExpectArgsParam2 sets up the expected value of the param #2 of Tester.Fatalf,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmFatalf *mTesterMockFatalf) ExpectArgsParam2(Args []interface{}) *mTesterMockFatalf {
+	mmFatalf.partialParams(func(e *TesterMockFatalfExpectation) {
+		e.params.Args = Args
+		delete(e.matchers, "Args")
+	})
+	return mmFatalf
+}
+
+// MatchArgsParam2 sets up the predicate matching the param #2 of Tester.Fatalf,
+// it's used instead of the value of the param set by Expect
+func (mmFatalf *mTesterMockFatalf) MatchArgsParam2(f func(got []interface{}) bool) *mTesterMockFatalf {
+	mm_matcher := minimock.Predicate("predicate func(got []interface{}) bool", func(v interface{}) bool {
+		got, _ := v.([]interface{})
+		return f(got)
+	})
+	mmFatalf.partialParams(func(e *TesterMockFatalfExpectation) {
+		e.matchers["Args"] = mm_matcher
+	})
+	return mmFatalf
+}
+
+// Return sets up results that will be returned by Tester.Fatalf
+func (mmFatalf *mTesterMockFatalf) Return() *TesterMock {
+	if _, mm_func := mmFatalf.current(); mm_func != nil {
+		mmFatalf.mock.state.T().Fatalf("TesterMock.Fatalf mock is already set by Set")
+	}
+
+	mmFatalf.updateDefault(func(*TesterMockFatalfExpectation) {})
+
+	return mmFatalf.mock
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of Tester.Fatalf instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmFatalf *mTesterMockFatalf) SetComparer(compare minimock.Comparer) *mTesterMockFatalf {
+	mmFatalf.state.Mutex.Lock()
+	mmFatalf.compare = compare
+	mmFatalf.state.Mutex.Unlock()
+	return mmFatalf
+}
+
+// whenExpectations returns the expectations of Tester.Fatalf set by When,
+// the expectations can be set while the method is called concurrently
+func (mmFatalf *mTesterMockFatalf) whenExpectations() []*TesterMockFatalfExpectation {
+	mmFatalf.state.Mutex.RLock()
+	defer mmFatalf.state.Mutex.RUnlock()
+
+	return mmFatalf.expectations
+}
+
+// Inspect sets up the function called with the params of every Tester.Fatalf call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmFatalf *mTesterMockFatalf) Inspect(f func(format string, args ...interface{})) *mTesterMockFatalf {
+	mmFatalf.state.Mutex.Lock()
+	mmFatalf.inspectFatalf = f
+	mmFatalf.state.Mutex.Unlock()
+	return mmFatalf
+}
+
+// expected returns everything set up for the Tester.Fatalf call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer
+func (mmFatalf *mTesterMockFatalf) expected() (*TesterMockFatalfExpectation, func(format string, args ...interface{}), func(format string, args ...interface{}), minimock.Comparer) {
+	mmFatalf.state.Mutex.RLock()
+	defer mmFatalf.state.Mutex.RUnlock()
+
+	return mmFatalf.defaultExpectation, mmFatalf.mock.funcFatalf, mmFatalf.inspectFatalf, mmFatalf.compare
+}
+
+// setup returns the expectations and the function set up for Tester.Fatalf to be checked when the mock is finished
+func (mmFatalf *mTesterMockFatalf) setup() (setup minimock.Setup) {
+	mmFatalf.state.Mutex.RLock()
+	defer mmFatalf.state.Mutex.RUnlock()
+
+	setup.Func = mmFatalf.mock.funcFatalf != nil
+	if e := mmFatalf.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmFatalf.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations of Tester.Fatalf, the params of the calls are removed from the history as well
+func (mmFatalf *mTesterMockFatalf) reset() {
+	mmFatalf.state.Mutex.Lock()
+	mmFatalf.defaultExpectation = nil
+	mmFatalf.expectations = nil
+	mmFatalf.state.Mutex.Unlock()
+
+	mmFatalf.state.History.Lock()
+	mmFatalf.calls = nil
+	mmFatalf.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmFatalf *mTesterMockFatalf) MethodName() string {
+	return mmFatalf.state.Name()
+}
+
+// CallSequence returns the numbers of the Tester.Fatalf calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmFatalf *mTesterMockFatalf) CallSequence() []uint64 {
+	return mmFatalf.state.CallSequence()
+}
+
+// WaitForCalls waits until Tester.Fatalf is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmFatalf *mTesterMockFatalf) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmFatalf.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Tester.Fatalf calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmFatalf *mTesterMockFatalf) Block() (release func()) {
+	return mmFatalf.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Tester.Fatalf calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmFatalf *mTesterMockFatalf) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmFatalf.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Tester.Fatalf calls are in flight at once
+func (mmFatalf *mTesterMockFatalf) LimitConcurrency(n int) *mTesterMockFatalf {
+	mmFatalf.state.SetLimit(n)
+	return mmFatalf
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Tester.Fatalf call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmFatalf *mTesterMockFatalf) CaptureCalls(buffer int) *mTesterMockFatalf {
+	mmFatalf.state.History.Lock()
+	mmFatalf.called = make(chan TesterMockFatalfParams, buffer)
+	mmFatalf.state.History.Unlock()
+	return mmFatalf
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Tester.Fatalf call
+func (mmFatalf *mTesterMockFatalf) Called() <-chan TesterMockFatalfParams {
+	mmFatalf.state.History.Lock()
+	defer mmFatalf.state.History.Unlock()
+
+	if mmFatalf.called == nil {
+		mmFatalf.mock.state.T().Fatalf("Calls of TesterMock.Fatalf aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmFatalf.called
+}
+
+// DroppedCalls returns the number of the Tester.Fatalf calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmFatalf *mTesterMockFatalf) DroppedCalls() uint64 {
+	return mmFatalf.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Tester.Fatalf calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmFatalf *mTesterMockFatalf) Times(n uint64) *mTesterMockFatalf {
+	mmFatalf.state.SetTimes(n)
+	return mmFatalf
+}
+
+// Optional excludes Tester.Fatalf from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmFatalf *mTesterMockFatalf) Optional() *mTesterMockFatalf {
+	mmFatalf.state.SetOptional()
+	return mmFatalf
+}
+
+// Set uses given function f to mock the Tester.Fatalf method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmFatalf *mTesterMockFatalf) Set(f func(format string, args ...interface{})) *TesterMock {
+	mmFatalf.state.Mutex.Lock()
+	defer mmFatalf.state.Mutex.Unlock()
+
+	if mmFatalf.defaultExpectation != nil {
+		mmFatalf.mock.state.T().Fatalf("Default expectation is already set for the Tester.Fatalf method")
+	}
+
+	if len(mmFatalf.expectations) > 0 {
+		mmFatalf.mock.state.T().Fatalf("Some expectations are already set for the Tester.Fatalf method")
+	}
+
+	mmFatalf.mock.funcFatalf = f
+	return mmFatalf.mock
+}
+
+// Fatalf implements minimock.Tester
+func (mmFatalf *TesterMock) Fatalf(format string, args ...interface{}) {
+	mm_method := mmFatalf.FatalfMock
+	mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := TesterMockFatalfParams{format, args}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcFatalf, mm_inspectFatalf, mm_compare := mm_method.expected()
+	if mm_inspectFatalf != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectFatalf(format, args...)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return
+
+	}
+	if mm_funcFatalf != nil {
+		mm_funcFatalf(format, args...)
+		return
+	}
+	if mm_delegate := mmFatalf.minimockDelegate(); mm_delegate != nil {
+		mm_delegate.Fatalf(format, args...)
+		return
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, format, args)
+
+}
+
+// FatalfAfterCounter returns a count of finished TesterMock.Fatalf invocations
+func (mmFatalf *TesterMock) FatalfAfterCounter() uint64 {
+	return mmFatalf.FatalfMock.state.AfterCalls()
+}
+
+// FatalfBeforeCounter returns a count of TesterMock.Fatalf invocations
+func (mmFatalf *TesterMock) FatalfBeforeCounter() uint64 {
+	return mmFatalf.FatalfMock.state.BeforeCalls()
+}
+
+// FatalfCalls returns the params of all TesterMock.Fatalf calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmFatalf *TesterMock) FatalfCalls() []TesterMockFatalfParams {
+	mmFatalf.FatalfMock.state.History.Lock()
+	defer mmFatalf.FatalfMock.state.History.Unlock()
+
+	calls := make([]TesterMockFatalfParams, len(mmFatalf.FatalfMock.calls))
+	copy(calls, mmFatalf.FatalfMock.calls)
+	return calls
+}
+
+// FatalfLastParams returns the params of the latest TesterMock.Fatalf call and false if there were no calls
+func (mmFatalf *TesterMock) FatalfLastParams() (params TesterMockFatalfParams, ok bool) {
+	mmFatalf.FatalfMock.state.History.Lock()
+	defer mmFatalf.FatalfMock.state.History.Unlock()
+
+	if n := len(mmFatalf.FatalfMock.calls); n > 0 {
+		return mmFatalf.FatalfMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// FatalfCallTimes returns the times of all TesterMock.Fatalf calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmFatalf *TesterMock) FatalfCallTimes() []mm_time.Time {
+	return mmFatalf.FatalfMock.state.CallTimes()
+}
+
+// FatalfMaxInFlight returns the maximum number of the TesterMock.Fatalf calls that have been in flight at once
+func (mmFatalf *TesterMock) FatalfMaxInFlight() int {
+	return mmFatalf.FatalfMock.state.MaxInFlight()
+}
+
+// FatalfUnexpectedCounter returns a count of TesterMock.Fatalf invocations made without an implementation
+func (mmFatalf *TesterMock) FatalfUnexpectedCounter() uint64 {
+	return mmFatalf.FatalfMock.state.UnexpectedCalls()
+}
+
+// FatalfNotCalled returns true if TesterMock.Fatalf hasn't been called
+func (mmFatalf *TesterMock) FatalfNotCalled() bool {
+	return mmFatalf.FatalfMock.state.BeforeCalls() == 0
+}
+
+// FatalfCallCount returns a count of TesterMock.Fatalf invocations, it's the same as FatalfBeforeCounter
+func (mmFatalf *TesterMock) FatalfCallCount() uint64 {
+	return mmFatalf.FatalfMock.state.BeforeCalls()
+}
+
+// MinimockFatalfDone returns true if the count of the Fatalf invocations corresponds
+// the number of defined expectations
+func (mmFatalf *TesterMock) MinimockFatalfDone() bool {
+	return mmFatalf.FatalfMock.state.Done(mmFatalf.FatalfMock.setup())
+}
+
+// MinimockFatalfInspect logs each unmet expectation
+func (mmFatalf *TesterMock) MinimockFatalfInspect() {
+	mmFatalf.FatalfMock.state.Inspect(mmFatalf.FatalfMock.setup())
+}
+
+// MinimockSetComparer sets up the function comparing the expected and the actual params of all TesterMock methods instead of minimock.Equal,
+// the params matched by the matchers aren't compared
+func (m *TesterMock) MinimockSetComparer(compare minimock.Comparer) *TesterMock {
+	m.state.SetComparer(compare)
+	return m
+}
+
+// MinimockSetSequence sets up the sequence numbering the TesterMock calls, the mocks sharing the sequence
+// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+func (m *TesterMock) MinimockSetSequence(sequence *minimock.Sequence) *TesterMock {
+	m.state.SetSequence(sequence)
+	return m
+}
+
+// MinimockSetClock sets up the function returning the current time for the history of TesterMock calls instead of time.Now
+func (m *TesterMock) MinimockSetClock(clock func() mm_time.Time) *TesterMock {
+	m.state.SetClock(clock)
+	return m
+}
+
+// MinimockSetAutoFinish enables or disables the check of TesterMock made by the Cleanup of the tester passed to NewTesterMock,
+// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+func (m *TesterMock) MinimockSetAutoFinish(enabled bool) *TesterMock {
+	m.state.SetAutoFinish(enabled)
+	return m
+}
+
+// MinimockSetDelegate sets up the implementation the calls of the TesterMock methods are forwarded to when the methods
+// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+func (m *TesterMock) MinimockSetDelegate(impl mm_minimock.Tester) *TesterMock {
+	m.mutex.Lock()
+	m.delegate = impl
+	m.mutex.Unlock()
+	return m
+}
+
+func (m *TesterMock) minimockDelegate() mm_minimock.Tester {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.delegate
+}
+
+// MinimockSetLenient enables or disables the lenient mode of TesterMock: the calls of the methods that have neither
+// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+func (m *TesterMock) MinimockSetLenient(enabled bool) *TesterMock {
+	m.state.SetLenient(enabled)
+	return m
+}
+
+// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (m *TesterMock) MinimockLenientCalls() map[string]uint64 {
+	return m.state.LenientCalls()
+}
+
+// MinimockAssertNotCalled fails the test without stopping it if any of the TesterMock methods with the given names has been called,
+// it doesn't depend on the expectations and the functions set up for the methods
+func (m *TesterMock) MinimockAssertNotCalled(methodNames ...string) {
+	m.state.AssertNotCalled(methodNames...)
+}
+
+// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+// queued by ReturnOnce of all TesterMock methods, the functions set by Set and Inspect are kept.
+// It fails the test if any of the methods is being called
+func (m *TesterMock) MinimockReset() {
+	m.state.Reset(
+		m.ErrorMock.reset,
+		m.ErrorfMock.reset,
+		m.FailNowMock.reset,
+		m.FatalMock.reset,
+		m.FatalfMock.reset,
+	)
+}
+
+// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+func (m *TesterMock) MinimockResetAll() {
+	m.MinimockReset()
+	m.ErrorMock.state.Mutex.Lock()
+	m.funcError = nil
+	m.ErrorMock.inspectError = nil
+	m.ErrorMock.state.Mutex.Unlock()
+	m.ErrorfMock.state.Mutex.Lock()
+	m.funcErrorf = nil
+	m.ErrorfMock.inspectErrorf = nil
+	m.ErrorfMock.state.Mutex.Unlock()
+	m.FailNowMock.state.Mutex.Lock()
+	m.funcFailNow = nil
+	m.FailNowMock.inspectFailNow = nil
+	m.FailNowMock.state.Mutex.Unlock()
+	m.FatalMock.state.Mutex.Lock()
+	m.funcFatal = nil
+	m.FatalMock.inspectFatal = nil
+	m.FatalMock.state.Mutex.Unlock()
+	m.FatalfMock.state.Mutex.Lock()
+	m.funcFatalf = nil
+	m.FatalfMock.inspectFatalf = nil
+	m.FatalfMock.state.Mutex.Unlock()
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *TesterMock) MinimockFinish() {
+	m.state.Finish(m.minimockDone,
+		m.MinimockErrorInspect,
+		m.MinimockErrorfInspect,
+		m.MinimockFailNowInspect,
+		m.MinimockFatalInspect,
+		m.MinimockFatalfInspect,
+	)
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *TesterMock) MinimockWait(timeout mm_time.Duration) {
+	m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
 }
 
 func (m *TesterMock) minimockDone() bool {
@@ -0,0 +1,20 @@
+// Package tree is used to test mocks of the interfaces which methods accept and return functions
+package tree
+
+import (
+	"context"
+	"io"
+)
+
+// Walker interface refers to the types of this package and to the imported packages only from the function types,
+// its mock is generated into another package to check that these types are qualified and imported
+type Walker interface {
+	Walk(fn func(ctx context.Context, n *Node) error) error
+	Reader() func() (io.Reader, error)
+	Visit(fn func(string, ...*Node)) func(...Node) int
+}
+
+// Node is a type that is referred to only from the function types
+type Node struct {
+	Name string
+}
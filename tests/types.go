@@ -1,6 +1,19 @@
 //Package tests contains tests for minimock tool and demonstrates minimock features
 package tests
 
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+	"unsafe"
+
+	billingtypes "github.com/gojuno/minimock/tests/billing/types"
+	catalogtypes "github.com/gojuno/minimock/tests/catalog/types"
+	"github.com/gojuno/minimock/tests/shipping/types"
+)
+
 type (
 	//Formatter interface is used to test code generated by minimock
 	Formatter interface {
@@ -10,4 +23,130 @@ type (
 	formatter interface {
 		Format(string, ...interface{}) string //to check if variadic functions are supported
 	}
+
+	//Recorder interface is used to test mocks generated into the same package as the interface
+	Recorder interface {
+		Record(e entry) (id int, err error) //to check if unexported types of the interface package are supported
+	}
+
+	//repository interface is used to test unexported mocks of unexported interfaces
+	repository interface {
+		Find(id int) (entry, bool)
+	}
+
+	//Service interface is used to test flattening of the interfaces embedded on several levels across packages
+	Service interface {
+		fmt.Stringer
+		Formatter
+		starter
+	}
+
+	starter interface {
+		Start(ctx context.Context) error
+		io.ReadCloser
+		io.WriterTo //to check if types of the embedded interface package are qualified
+	}
+
+	//RichError interface is used to test mocks of the interfaces with the Error() string method,
+	//embedding of the predeclared error interface isn't supported by the generator
+	RichError interface {
+		Error() string
+		Code() int
+	}
+
+	//Query interface is used to test mocks of the interfaces which methods return the interface itself
+	Query interface {
+		Where(cond string) Query
+		Run(ctx context.Context) (Rows, error)
+	}
+
+	//Rows and Row interfaces are used to test mutually recursive interfaces
+	Rows interface {
+		Next() (Row, bool)
+	}
+
+	Row interface {
+		Rows() Rows
+	}
+
+	//Handler interface is used to test mocks of the methods with unnamed and blank parameters
+	Handler interface {
+		Handle(context.Context, string, string) error
+		Skip(_ int, _ string) (_ bool)
+	}
+
+	//Locker interface is used to test mocks of the methods which params have the same names as the mock internals
+	Locker interface {
+		Lock(m sync.Locker, mm time.Time, t int) (e error)
+	}
+
+	//Cache interface is used to test mocks of the interfaces which methods have the same names as the mock members
+	Cache interface {
+		Get(key string) string
+		GetMock() string
+		GetAfterCounter() uint64
+	}
+
+	//Logger interface is used to test mocks of the methods with variadic params of named and pointer types
+	Logger interface {
+		Log(level Level, entries ...*entry) int
+		Enabled(levels ...Level) bool
+	}
+
+	//Level is a named type used in the Logger interface
+	Level int
+
+	//Checkout interface is used to test mocks of the interfaces referring to several packages with the same name
+	Checkout interface {
+		Pay(invoice billingtypes.Invoice, items []catalogtypes.Item) (types.Parcel, error)
+	}
+
+	//Allocator interface is used to test mocks of the methods with unsafe.Pointer and uintptr params
+	Allocator interface {
+		Alloc(size uintptr) unsafe.Pointer
+		Free(p unsafe.Pointer, size uintptr)
+	}
+
+	//Documented interface is used to test copying of the documentation comments into the mock
+	Documented interface {
+		//Get returns the value stored by the key,
+		//comments with */ are copied as is since they can't terminate the line comment
+		//
+		//nolint:misspell
+		Get(key string) string
+
+		/*
+			Set stores the value by the key
+		*/
+		Set(key, value string)
+	}
+
+	//Swapper interface is used to test names of the Params and Results struct fields that collide with each other
+	Swapper interface {
+		Swap(x, X int, _ bool, p2 ...string) (ok bool, _ error)
+	}
+
+	//Worker interface is used to test mocks generated with -template-version 2
+	Worker interface {
+		Do(task string) (int, error)
+		Stop()
+	}
+
+	//Options struct is used by the configurer.Configurer interface which mock is generated into this package
+	Options struct {
+		Verbose bool
+	}
+
+	//Closer alias is used to test mocks of the aliases to the interfaces from other packages
+	Closer = io.Closer
+
+	//Stringer type is used to test mocks of the named types which underlying type is an interface from another package
+	Stringer fmt.Stringer
+
+	//reader type is used to test mocks of the unexported named types which underlying type is an interface from another package
+	reader io.Reader
+
+	entry struct {
+		message string
+	}
 )
@@ -0,0 +1,65 @@
+package tests
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/gojuno/minimock/tests/tree"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalkerMock_FuncParam(t *testing.T) {
+	walkerMock := NewWalkerMock(t)
+	defer walkerMock.MinimockFinish()
+
+	walkerMock.WalkMock.Set(func(fn func(ctx context.Context, n *tree.Node) error) error {
+		return fn(context.Background(), &tree.Node{Name: "root"})
+	})
+
+	var walker tree.Walker = walkerMock
+
+	var visited []string
+	err := walker.Walk(func(ctx context.Context, n *tree.Node) error {
+		visited = append(visited, n.Name)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"root"}, visited)
+}
+
+func TestWalkerMock_FuncResult(t *testing.T) {
+	walkerMock := NewWalkerMock(t).ReaderMock.Return(func() (io.Reader, error) {
+		return strings.NewReader("data"), nil
+	})
+	defer walkerMock.MinimockFinish()
+
+	r, err := walkerMock.Reader()()
+	assert.NoError(t, err)
+
+	data, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	assert.Equal(t, "data", string(data))
+}
+
+func TestWalkerMock_VariadicFuncs(t *testing.T) {
+	walkerMock := NewWalkerMock(t)
+	defer walkerMock.MinimockFinish()
+
+	walkerMock.VisitMock.Set(func(fn func(string, ...*tree.Node)) func(...tree.Node) int {
+		fn("children", &tree.Node{Name: "a"}, &tree.Node{Name: "b"})
+		return func(nodes ...tree.Node) int { return len(nodes) }
+	})
+
+	var names []string
+	count := walkerMock.Visit(func(prefix string, nodes ...*tree.Node) {
+		for _, n := range nodes {
+			names = append(names, prefix+"/"+n.Name)
+		}
+	})
+
+	assert.Equal(t, []string{"children/a", "children/b"}, names)
+	assert.Equal(t, 2, count(tree.Node{}, tree.Node{}))
+}
@@ -0,0 +1,20 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/gojuno/minimock/tests/platform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatcherMock_PlatformSpecificMethods(t *testing.T) {
+	watcherMock := NewWatcherMock(t).
+		WatchMock.Expect("/tmp").Return(nil).
+		InotifyMock.Return(3)
+	defer watcherMock.MinimockFinish()
+
+	var watcher platform.Watcher = watcherMock
+
+	assert.NoError(t, watcher.Watch("/tmp"))
+	assert.Equal(t, 3, watcher.Inotify())
+}
@@ -0,0 +1,976 @@
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+package tests
+
+//go:generate minimock -i github.com/gojuno/minimock/tests.Worker -o ./worker_mock.go -template-version 2
+
+import (
+	mm_sync "sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock"
+)
+
+// WorkerMock implements Worker
+//
+// Worker interface is used to test mocks generated with -template-version 2
+type WorkerMock struct {
+	state    minimock.MockState
+	mutex    mm_sync.RWMutex
+	delegate Worker
+
+	funcDo func(task string) (i1 int, err error)
+	DoMock *mWorkerMockDo
+
+	funcStop func()
+	StopMock *mWorkerMockStop
+}
+
+var _ Worker = (*WorkerMock)(nil)
+
+// NewWorkerMock returns a mock for Worker
+func NewWorkerMock(t minimock.Tester) *WorkerMock {
+	m := &WorkerMock{}
+	m.state.Init(m, t, "WorkerMock", minimock.GoroutineID())
+
+	m.DoMock = &mWorkerMockDo{mock: m}
+	m.DoMock.state.Init(&m.state, "Do")
+
+	m.StopMock = &mWorkerMockStop{mock: m}
+	m.StopMock.state.Init(&m.state, "Stop")
+
+	return m
+}
+
+type mWorkerMockDo struct {
+	mock               *WorkerMock
+	state              minimock.MethodState
+	defaultExpectation *WorkerMockDoExpectation
+	expectations       []*WorkerMockDoExpectation
+	inspectDo          func(task string)
+	compare            minimock.Comparer
+	calls              []WorkerMockDoParams
+	called             chan WorkerMockDoParams
+	queued             []*WorkerMockDoResults
+	//whenResults are the results set by Then for the expectations set by When, the slice is replaced on every change
+	//so the snapshot taken by expected can be read without the lock
+	whenResults []*WorkerMockDoResults
+}
+
+// WorkerMockDoExpectation specifies expectation struct of the Worker.Do
+type WorkerMockDoExpectation struct {
+	mock     *WorkerMock
+	params   *WorkerMockDoParams
+	matchers map[string]minimock.Matcher
+	partial  bool
+	results  *WorkerMockDoResults
+	Counter  uint64
+}
+
+// WorkerMockDoParams contains parameters of the Worker.Do
+type WorkerMockDoParams struct {
+	Task string
+}
+
+// WorkerMockDoResults contains results of the Worker.Do
+type WorkerMockDoResults struct {
+	R0 int
+	R1 error
+}
+
+// Expect sets up expected params for Worker.Do
+func (mmDo *mWorkerMockDo) Expect(task string) *mWorkerMockDo {
+	if _, mm_func := mmDo.current(); mm_func != nil {
+		mmDo.mock.state.T().Fatalf("WorkerMock.Do mock is already set by Set")
+	}
+
+	mm_params := &WorkerMockDoParams{task}
+	mmDo.updateDefault(func(e *WorkerMockDoExpectation) {
+		if e.partial {
+			mmDo.mock.state.T().Fatalf("WorkerMock.Do params are already set by the Expect*Param* and Match*Param* helpers")
+		}
+
+		e.params = mm_params
+	})
+
+	for _, e := range mmDo.whenExpectations() {
+		if minimock.Equal(e.params, mm_params) {
+			mmDo.mock.state.T().Fatalf("Expectation set by When has same params: %#v", *mm_params)
+		}
+	}
+
+	return mmDo
+}
+
+// updateDefault replaces the default expectation of Worker.Do by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmDo *mWorkerMockDo) updateDefault(update func(e *WorkerMockDoExpectation)) {
+	mmDo.state.Mutex.Lock()
+	defer mmDo.state.Mutex.Unlock()
+
+	e := &WorkerMockDoExpectation{mock: mmDo.mock}
+	if previous := mmDo.defaultExpectation; previous != nil {
+		if previous.params != nil {
+			params := *previous.params
+			e.params = &params
+		}
+		if previous.matchers != nil {
+			e.matchers = make(map[string]minimock.Matcher, len(previous.matchers))
+			for name, m := range previous.matchers {
+				e.matchers[name] = m
+			}
+		}
+		e.partial = previous.partial
+		e.results = previous.results
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmDo.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Worker.Do
+func (mmDo *mWorkerMockDo) current() (*WorkerMockDoExpectation, func(task string) (i1 int, err error)) {
+	mmDo.state.Mutex.RLock()
+	defer mmDo.state.Mutex.RUnlock()
+
+	return mmDo.defaultExpectation, mmDo.mock.funcDo
+}
+
+// partialParams updates the default expectation of Worker.Do by the Expect*Param* and Match*Param* helpers,
+// only the params set by the helpers are checked unless all of them are set by Expect
+func (mmDo *mWorkerMockDo) partialParams(update func(e *WorkerMockDoExpectation)) {
+	if _, mm_func := mmDo.current(); mm_func != nil {
+		mmDo.mock.state.T().Fatalf("WorkerMock.Do mock is already set by Set")
+	}
+
+	mmDo.updateDefault(func(e *WorkerMockDoExpectation) {
+		if e.params == nil {
+			e.params = &WorkerMockDoParams{}
+			e.partial = true
+			e.matchers = map[string]minimock.Matcher{
+				"Task": minimock.Anything,
+			}
+		}
+
+		if e.matchers == nil {
+			e.matchers = map[string]minimock.Matcher{}
+		}
+
+		update(e)
+	})
+}
+
+// ExpectTaskParam1 sets up the expected value of the param #1 of Worker.Do,
+// the params that aren't set by Expect or the other Expect*Param* and Match*Param* helpers aren't checked
+func (mmDo *mWorkerMockDo) ExpectTaskParam1(Task string) *mWorkerMockDo {
+	mmDo.partialParams(func(e *WorkerMockDoExpectation) {
+		e.params.Task = Task
+		delete(e.matchers, "Task")
+	})
+	return mmDo
+}
+
+// MatchTaskParam1 sets up the predicate matching the param #1 of Worker.Do,
+// it's used instead of the value of the param set by Expect
+func (mmDo *mWorkerMockDo) MatchTaskParam1(f func(got string) bool) *mWorkerMockDo {
+	mm_matcher := minimock.Predicate("predicate func(got string) bool", func(v interface{}) bool {
+		got, _ := v.(string)
+		return f(got)
+	})
+	mmDo.partialParams(func(e *WorkerMockDoExpectation) {
+		e.matchers["Task"] = mm_matcher
+	})
+	return mmDo
+}
+
+// Return sets up results that will be returned by Worker.Do
+func (mmDo *mWorkerMockDo) Return(i1 int, err error) *WorkerMock {
+	if _, mm_func := mmDo.current(); mm_func != nil {
+		mmDo.mock.state.T().Fatalf("WorkerMock.Do mock is already set by Set")
+	}
+
+	mm_results := &WorkerMockDoResults{i1, err}
+	mmDo.updateDefault(func(e *WorkerMockDoExpectation) { e.results = mm_results })
+
+	return mmDo.mock
+}
+
+// ReturnOnce queues results that will be returned by the next call of Worker.Do,
+// queued results are returned in the same order they were queued before the results set by Return or Set
+func (mmDo *mWorkerMockDo) ReturnOnce(i1 int, err error) *mWorkerMockDo {
+	mmDo.state.Mutex.Lock()
+	mmDo.queued = append(mmDo.queued, &WorkerMockDoResults{i1, err})
+	mmDo.state.Mutex.Unlock()
+	mmDo.state.PushResults()
+	return mmDo
+}
+
+// popResults returns the next results queued by ReturnOnce or nil if the queue is empty
+func (mmDo *mWorkerMockDo) popResults() *WorkerMockDoResults {
+	mm_i, mm_ok := mmDo.state.PopResults()
+	if !mm_ok {
+		return nil
+	}
+
+	mmDo.state.Mutex.RLock()
+	defer mmDo.state.Mutex.RUnlock()
+
+	return mmDo.queued[mm_i]
+}
+
+// SetComparer sets up the function comparing the expected and the actual params of Worker.Do instead of minimock.Equal,
+// it overrides the function set by MinimockSetComparer, the params matched by the matchers aren't compared
+func (mmDo *mWorkerMockDo) SetComparer(compare minimock.Comparer) *mWorkerMockDo {
+	mmDo.state.Mutex.Lock()
+	mmDo.compare = compare
+	mmDo.state.Mutex.Unlock()
+	return mmDo
+}
+
+// whenExpectations returns the expectations of Worker.Do set by When,
+// the expectations can be set while the method is called concurrently
+func (mmDo *mWorkerMockDo) whenExpectations() []*WorkerMockDoExpectation {
+	mmDo.state.Mutex.RLock()
+	defer mmDo.state.Mutex.RUnlock()
+
+	return mmDo.expectations
+}
+
+// Inspect sets up the function called with the params of every Worker.Do call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmDo *mWorkerMockDo) Inspect(f func(task string)) *mWorkerMockDo {
+	mmDo.state.Mutex.Lock()
+	mmDo.inspectDo = f
+	mmDo.state.Mutex.Unlock()
+	return mmDo
+}
+
+// expected returns everything set up for the Worker.Do call under a single read lock: the default expectation,
+// the functions set by Set and Inspect, the comparer set by SetComparer, the expectations set by When and their results
+func (mmDo *mWorkerMockDo) expected() (*WorkerMockDoExpectation, func(task string) (i1 int, err error), func(task string), minimock.Comparer, []*WorkerMockDoExpectation, []*WorkerMockDoResults) {
+	mmDo.state.Mutex.RLock()
+	defer mmDo.state.Mutex.RUnlock()
+
+	return mmDo.defaultExpectation, mmDo.mock.funcDo, mmDo.inspectDo, mmDo.compare, mmDo.expectations, mmDo.whenResults
+}
+
+// setup returns the expectations and the function set up for Worker.Do to be checked when the mock is finished
+func (mmDo *mWorkerMockDo) setup() (setup minimock.Setup) {
+	mmDo.state.Mutex.RLock()
+	defer mmDo.state.Mutex.RUnlock()
+
+	setup.Func = mmDo.mock.funcDo != nil
+	if e := mmDo.defaultExpectation; e != nil {
+		setup.Default = true
+		if e.params != nil {
+			setup.DefaultParams = *e.params
+		}
+	}
+
+	for _, e := range mmDo.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		if e.params != nil {
+			when.Params = *e.params
+		}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations and the results queued by ReturnOnce of Worker.Do, the params of the calls are removed from the history as well
+func (mmDo *mWorkerMockDo) reset() {
+	mmDo.state.Mutex.Lock()
+	mmDo.defaultExpectation = nil
+	mmDo.expectations = nil
+	mmDo.queued = nil
+	mmDo.whenResults = nil
+	mmDo.state.Mutex.Unlock()
+
+	mmDo.state.History.Lock()
+	mmDo.calls = nil
+	mmDo.state.History.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmDo *mWorkerMockDo) MethodName() string {
+	return mmDo.state.Name()
+}
+
+// CallSequence returns the numbers of the Worker.Do calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmDo *mWorkerMockDo) CallSequence() []uint64 {
+	return mmDo.state.CallSequence()
+}
+
+// WaitForCalls waits until Worker.Do is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmDo *mWorkerMockDo) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmDo.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Worker.Do calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmDo *mWorkerMockDo) Block() (release func()) {
+	return mmDo.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Worker.Do calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmDo *mWorkerMockDo) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmDo.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Worker.Do calls are in flight at once
+func (mmDo *mWorkerMockDo) LimitConcurrency(n int) *mWorkerMockDo {
+	mmDo.state.SetLimit(n)
+	return mmDo
+}
+
+// CaptureCalls creates the buffered channel receiving the params of each Worker.Do call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmDo *mWorkerMockDo) CaptureCalls(buffer int) *mWorkerMockDo {
+	mmDo.state.History.Lock()
+	mmDo.called = make(chan WorkerMockDoParams, buffer)
+	mmDo.state.History.Unlock()
+	return mmDo
+}
+
+// Called returns the channel set up by CaptureCalls receiving the params of each Worker.Do call
+func (mmDo *mWorkerMockDo) Called() <-chan WorkerMockDoParams {
+	mmDo.state.History.Lock()
+	defer mmDo.state.History.Unlock()
+
+	if mmDo.called == nil {
+		mmDo.mock.state.T().Fatalf("Calls of WorkerMock.Do aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmDo.called
+}
+
+// DroppedCalls returns the number of the Worker.Do calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmDo *mWorkerMockDo) DroppedCalls() uint64 {
+	return mmDo.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Worker.Do calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmDo *mWorkerMockDo) Times(n uint64) *mWorkerMockDo {
+	mmDo.state.SetTimes(n)
+	return mmDo
+}
+
+// Optional excludes Worker.Do from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmDo *mWorkerMockDo) Optional() *mWorkerMockDo {
+	mmDo.state.SetOptional()
+	return mmDo
+}
+
+// Set uses given function f to mock the Worker.Do method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmDo *mWorkerMockDo) Set(f func(task string) (i1 int, err error)) *WorkerMock {
+	mmDo.state.Mutex.Lock()
+	defer mmDo.state.Mutex.Unlock()
+
+	if mmDo.defaultExpectation != nil {
+		mmDo.mock.state.T().Fatalf("Default expectation is already set for the Worker.Do method")
+	}
+
+	if len(mmDo.expectations) > 0 {
+		mmDo.mock.state.T().Fatalf("Some expectations are already set for the Worker.Do method")
+	}
+
+	mmDo.mock.funcDo = f
+	return mmDo.mock
+}
+
+// When sets expectation for the Worker.Do which will trigger the result defined by the following
+// Then helper
+func (mmDo *mWorkerMockDo) When(task string) *WorkerMockDoExpectation {
+	if _, mm_func := mmDo.current(); mm_func != nil {
+		mmDo.mock.state.T().Fatalf("WorkerMock.Do mock is already set by Set")
+	}
+
+	expectation := &WorkerMockDoExpectation{
+		mock:   mmDo.mock,
+		params: &WorkerMockDoParams{task},
+	}
+	mmDo.state.Mutex.Lock()
+	mmDo.expectations = append(mmDo.expectations, expectation)
+	mmDo.whenResults = append(mmDo.whenResults, nil)
+	mmDo.state.Mutex.Unlock()
+	return expectation
+}
+
+// Then sets up Worker.Do return parameters for the expectation previously defined by the When method
+func (mmExpectation *WorkerMockDoExpectation) Then(i1 int, err error) *WorkerMock {
+	mm_handle := mmExpectation.mock.DoMock
+	mm_handle.state.Mutex.Lock()
+	defer mm_handle.state.Mutex.Unlock()
+
+	mmExpectation.results = &WorkerMockDoResults{i1, err}
+	mm_results := make([]*WorkerMockDoResults, len(mm_handle.whenResults))
+	for i, e := range mm_handle.expectations[:len(mm_results)] {
+		mm_results[i] = e.results
+	}
+	mm_handle.whenResults = mm_results
+	return mmExpectation.mock
+}
+
+// Do implements Worker
+func (mmDo *WorkerMock) Do(task string) (i1 int, err error) {
+	mm_method := mmDo.DoMock
+	mm_call := mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_params := WorkerMockDoParams{task}
+
+	mm_comparer := mm_method.state.Record()
+	mm_method.calls = append(mm_method.calls, mm_params)
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- mm_params:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcDo, mm_inspectDo, mm_compare, mm_when, mm_whenResults := mm_method.expected()
+	if mm_inspectDo != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectDo(task)
+		}()
+	}
+
+	if mm_compare != nil {
+		mm_comparer = mm_compare
+	}
+
+	// params can't be referred by their names in the loop since they might be shadowed by the loop variable
+	for i, mm_results := range mm_whenResults {
+		// cases set by When without Then are skipped until the results are set
+		if e := mm_when[i]; mm_results != nil && minimock.Match(*e.params, mm_params, nil, mm_comparer) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return (*mm_results).R0, (*mm_results).R1
+		}
+	}
+
+	if mm_results := mm_method.popResults(); mm_results != nil {
+		if mm_expectation != nil && mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		return (*mm_results).R0, (*mm_results).R1
+	}
+
+	// results queued by ReturnOnce are the only ones set up, so the excess call fails the test
+	if (mm_expectation == nil || mm_expectation.results == nil) && mm_funcDo == nil &&
+		mm_method.state.Queued() && mm_method.state.QueueExhausted(mm_call, mm_params) {
+		return
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+		if mm_expectation.params != nil {
+			mm_method.state.CheckParams(*mm_expectation.params, mm_params, mm_expectation.matchers, mm_comparer)
+		}
+
+		if mm_expectation.results == nil {
+			mm_method.state.NoResults()
+			return
+		}
+		return (*mm_expectation.results).R0, (*mm_expectation.results).R1
+	}
+	if mm_funcDo != nil {
+		return mm_funcDo(task)
+	}
+	if mm_delegate := mmDo.minimockDelegate(); mm_delegate != nil {
+		return mm_delegate.Do(task)
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(mm_params, task)
+	return
+}
+
+// DoAfterCounter returns a count of finished WorkerMock.Do invocations
+func (mmDo *WorkerMock) DoAfterCounter() uint64 {
+	return mmDo.DoMock.state.AfterCalls()
+}
+
+// DoBeforeCounter returns a count of WorkerMock.Do invocations
+func (mmDo *WorkerMock) DoBeforeCounter() uint64 {
+	return mmDo.DoMock.state.BeforeCalls()
+}
+
+// DoCalls returns the params of all WorkerMock.Do calls in the order they were made,
+// the params are not copied so the values referred by the pointers, slices and maps can be changed after the call
+func (mmDo *WorkerMock) DoCalls() []WorkerMockDoParams {
+	mmDo.DoMock.state.History.Lock()
+	defer mmDo.DoMock.state.History.Unlock()
+
+	calls := make([]WorkerMockDoParams, len(mmDo.DoMock.calls))
+	copy(calls, mmDo.DoMock.calls)
+	return calls
+}
+
+// DoLastParams returns the params of the latest WorkerMock.Do call and false if there were no calls
+func (mmDo *WorkerMock) DoLastParams() (params WorkerMockDoParams, ok bool) {
+	mmDo.DoMock.state.History.Lock()
+	defer mmDo.DoMock.state.History.Unlock()
+
+	if n := len(mmDo.DoMock.calls); n > 0 {
+		return mmDo.DoMock.calls[n-1], true
+	}
+
+	return params, false
+}
+
+// DoCallTimes returns the times of all WorkerMock.Do calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmDo *WorkerMock) DoCallTimes() []mm_time.Time {
+	return mmDo.DoMock.state.CallTimes()
+}
+
+// DoMaxInFlight returns the maximum number of the WorkerMock.Do calls that have been in flight at once
+func (mmDo *WorkerMock) DoMaxInFlight() int {
+	return mmDo.DoMock.state.MaxInFlight()
+}
+
+// DoUnexpectedCounter returns a count of WorkerMock.Do invocations made without an implementation
+func (mmDo *WorkerMock) DoUnexpectedCounter() uint64 {
+	return mmDo.DoMock.state.UnexpectedCalls()
+}
+
+// DoNotCalled returns true if WorkerMock.Do hasn't been called
+func (mmDo *WorkerMock) DoNotCalled() bool {
+	return mmDo.DoMock.state.BeforeCalls() == 0
+}
+
+// DoCallCount returns a count of WorkerMock.Do invocations, it's the same as DoBeforeCounter
+func (mmDo *WorkerMock) DoCallCount() uint64 {
+	return mmDo.DoMock.state.BeforeCalls()
+}
+
+// MinimockDoDone returns true if the count of the Do invocations corresponds
+// the number of defined expectations
+func (mmDo *WorkerMock) MinimockDoDone() bool {
+	return mmDo.DoMock.state.Done(mmDo.DoMock.setup())
+}
+
+// MinimockDoInspect logs each unmet expectation
+func (mmDo *WorkerMock) MinimockDoInspect() {
+	mmDo.DoMock.state.Inspect(mmDo.DoMock.setup())
+}
+
+type mWorkerMockStop struct {
+	mock               *WorkerMock
+	state              minimock.MethodState
+	defaultExpectation *WorkerMockStopExpectation
+	expectations       []*WorkerMockStopExpectation
+	inspectStop        func()
+	called             chan struct{}
+}
+
+// WorkerMockStopExpectation specifies expectation struct of the Worker.Stop
+type WorkerMockStopExpectation struct {
+	mock *WorkerMock
+
+	Counter uint64
+}
+
+// Expect sets up expected params for Worker.Stop
+func (mmStop *mWorkerMockStop) Expect() *mWorkerMockStop {
+	if _, mm_func := mmStop.current(); mm_func != nil {
+		mmStop.mock.state.T().Fatalf("WorkerMock.Stop mock is already set by Set")
+	}
+
+	mmStop.updateDefault(func(*WorkerMockStopExpectation) {})
+
+	return mmStop
+}
+
+// updateDefault replaces the default expectation of Worker.Stop by its copy changed by the update function,
+// so the calls made concurrently get either the previous or the updated expectation
+func (mmStop *mWorkerMockStop) updateDefault(update func(e *WorkerMockStopExpectation)) {
+	mmStop.state.Mutex.Lock()
+	defer mmStop.state.Mutex.Unlock()
+
+	e := &WorkerMockStopExpectation{mock: mmStop.mock}
+	if previous := mmStop.defaultExpectation; previous != nil {
+		e.Counter = mm_atomic.LoadUint64(&previous.Counter)
+	}
+
+	update(e)
+	mmStop.defaultExpectation = e
+}
+
+// current returns the default expectation and the function set up for Worker.Stop
+func (mmStop *mWorkerMockStop) current() (*WorkerMockStopExpectation, func()) {
+	mmStop.state.Mutex.RLock()
+	defer mmStop.state.Mutex.RUnlock()
+
+	return mmStop.defaultExpectation, mmStop.mock.funcStop
+}
+
+// Return sets up results that will be returned by Worker.Stop
+func (mmStop *mWorkerMockStop) Return() *WorkerMock {
+	if _, mm_func := mmStop.current(); mm_func != nil {
+		mmStop.mock.state.T().Fatalf("WorkerMock.Stop mock is already set by Set")
+	}
+
+	mmStop.updateDefault(func(*WorkerMockStopExpectation) {})
+
+	return mmStop.mock
+}
+
+// Inspect sets up the function called with the params of every Worker.Stop call before the results are returned,
+// it's called for the unexpected calls as well, the panic of the function fails the test
+func (mmStop *mWorkerMockStop) Inspect(f func()) *mWorkerMockStop {
+	mmStop.state.Mutex.Lock()
+	mmStop.inspectStop = f
+	mmStop.state.Mutex.Unlock()
+	return mmStop
+}
+
+// expected returns everything set up for the Worker.Stop call under a single read lock: the default expectation,
+// the functions set by Set and Inspect
+func (mmStop *mWorkerMockStop) expected() (*WorkerMockStopExpectation, func(), func()) {
+	mmStop.state.Mutex.RLock()
+	defer mmStop.state.Mutex.RUnlock()
+
+	return mmStop.defaultExpectation, mmStop.mock.funcStop, mmStop.inspectStop
+}
+
+// setup returns the expectations and the function set up for Worker.Stop to be checked when the mock is finished
+func (mmStop *mWorkerMockStop) setup() (setup minimock.Setup) {
+	mmStop.state.Mutex.RLock()
+	defer mmStop.state.Mutex.RUnlock()
+
+	setup.Func = mmStop.mock.funcStop != nil
+	if e := mmStop.defaultExpectation; e != nil {
+		setup.Default = true
+	}
+
+	for _, e := range mmStop.expectations {
+		when := minimock.WhenSetup{Counter: &e.Counter}
+		setup.When = append(setup.When, when)
+	}
+
+	return setup
+}
+
+// reset removes the expectations of Worker.Stop
+func (mmStop *mWorkerMockStop) reset() {
+	mmStop.state.Mutex.Lock()
+	mmStop.defaultExpectation = nil
+	mmStop.expectations = nil
+	mmStop.state.Mutex.Unlock()
+}
+
+// MethodName returns the name of the mocked method, it implements minimock.Calls
+func (mmStop *mWorkerMockStop) MethodName() string {
+	return mmStop.state.Name()
+}
+
+// CallSequence returns the numbers of the Worker.Stop calls in the sequence shared by the mocks
+// created with the same controller, it implements minimock.Calls
+func (mmStop *mWorkerMockStop) CallSequence() []uint64 {
+	return mmStop.state.CallSequence()
+}
+
+// WaitForCalls waits until Worker.Stop is called at least n times and fails the test
+// if it isn't called within the timeout, the zero timeout checks the number of the calls once
+func (mmStop *mWorkerMockStop) WaitForCalls(n uint64, timeout mm_time.Duration) {
+	mmStop.state.WaitForCalls(n, timeout)
+}
+
+// Block makes the subsequent Worker.Stop calls wait until the returned function is called,
+// the calls left blocked are released by MinimockFinish. The release function can be called several times
+func (mmStop *mWorkerMockStop) Block() (release func()) {
+	return mmStop.state.Block()
+}
+
+// WaitUntilBlocked waits until at least n Worker.Stop calls are blocked by Block and fails the test
+// if they aren't blocked within the timeout, the zero timeout checks the number of the blocked calls once
+func (mmStop *mWorkerMockStop) WaitUntilBlocked(n uint64, timeout mm_time.Duration) {
+	mmStop.state.WaitUntilBlocked(n, timeout)
+}
+
+// LimitConcurrency fails the test as soon as more than n Worker.Stop calls are in flight at once
+func (mmStop *mWorkerMockStop) LimitConcurrency(n int) *mWorkerMockStop {
+	mmStop.state.SetLimit(n)
+	return mmStop
+}
+
+// CaptureCalls creates the buffered channel receiving the signal of each Worker.Stop call returned by Called,
+// the calls made when the buffer is full are dropped and counted by DroppedCalls so the mocked method never blocks.
+// The calls are recorded to the history regardless of the channel
+func (mmStop *mWorkerMockStop) CaptureCalls(buffer int) *mWorkerMockStop {
+	mmStop.state.History.Lock()
+	mmStop.called = make(chan struct{}, buffer)
+	mmStop.state.History.Unlock()
+	return mmStop
+}
+
+// Called returns the channel set up by CaptureCalls receiving the signal of each Worker.Stop call
+func (mmStop *mWorkerMockStop) Called() <-chan struct{} {
+	mmStop.state.History.Lock()
+	defer mmStop.state.History.Unlock()
+
+	if mmStop.called == nil {
+		mmStop.mock.state.T().Fatalf("Calls of WorkerMock.Stop aren't captured, CaptureCalls has to be called before the calls")
+	}
+
+	return mmStop.called
+}
+
+// DroppedCalls returns the number of the Worker.Stop calls that haven't been sent to the channel returned by Called
+// since its buffer is full
+func (mmStop *mWorkerMockStop) DroppedCalls() uint64 {
+	return mmStop.state.DroppedCalls()
+}
+
+// Times sets the exact number of the Worker.Stop calls expected by the MinimockFinish and MinimockWait,
+// Times(0) expects no calls even if the method is mocked
+func (mmStop *mWorkerMockStop) Times(n uint64) *mWorkerMockStop {
+	mmStop.state.SetTimes(n)
+	return mmStop
+}
+
+// Optional excludes Worker.Stop from the checks made by MinimockFinish and MinimockWait,
+// so the test doesn't fail if the method isn't called, the calls are still counted
+func (mmStop *mWorkerMockStop) Optional() *mWorkerMockStop {
+	mmStop.state.SetOptional()
+	return mmStop
+}
+
+// Set uses given function f to mock the Worker.Stop method,
+// the function is replaced under the lock, so it can be set up while the method is being called
+func (mmStop *mWorkerMockStop) Set(f func()) *WorkerMock {
+	mmStop.state.Mutex.Lock()
+	defer mmStop.state.Mutex.Unlock()
+
+	if mmStop.defaultExpectation != nil {
+		mmStop.mock.state.T().Fatalf("Default expectation is already set for the Worker.Stop method")
+	}
+
+	if len(mmStop.expectations) > 0 {
+		mmStop.mock.state.T().Fatalf("Some expectations are already set for the Worker.Stop method")
+	}
+
+	mmStop.mock.funcStop = f
+	return mmStop.mock
+}
+
+// Stop implements Worker
+func (mmStop *WorkerMock) Stop() {
+	mm_method := mmStop.StopMock
+	mm_method.state.Enter()
+	defer mm_method.state.Leave()
+
+	mm_method.state.Record()
+	if mm_method.called != nil {
+		select {
+		case mm_method.called <- struct{}{}:
+		default:
+			mm_method.state.Drop()
+		}
+	}
+	mm_method.state.History.Unlock()
+
+	mm_method.state.Wait()
+
+	mm_expectation, mm_funcStop, mm_inspectStop := mm_method.expected()
+	if mm_inspectStop != nil {
+		func() {
+			defer mm_method.state.RecoverInspect()
+			mm_inspectStop()
+		}()
+	}
+
+	if mm_expectation != nil {
+		mm_atomic.AddUint64(&mm_expectation.Counter, 1)
+
+		return
+
+	}
+	if mm_funcStop != nil {
+		mm_funcStop()
+		return
+	}
+	if mm_delegate := mmStop.minimockDelegate(); mm_delegate != nil {
+		mm_delegate.Stop()
+		return
+	}
+	if mm_method.state.Lenient() {
+		return
+	}
+	mm_method.state.UnexpectedCall(nil)
+
+}
+
+// StopAfterCounter returns a count of finished WorkerMock.Stop invocations
+func (mmStop *WorkerMock) StopAfterCounter() uint64 {
+	return mmStop.StopMock.state.AfterCalls()
+}
+
+// StopBeforeCounter returns a count of WorkerMock.Stop invocations
+func (mmStop *WorkerMock) StopBeforeCounter() uint64 {
+	return mmStop.StopMock.state.BeforeCalls()
+}
+
+// StopCallTimes returns the times of all WorkerMock.Stop calls in the order they were made,
+// the times are taken from the clock set by MinimockSetClock
+func (mmStop *WorkerMock) StopCallTimes() []mm_time.Time {
+	return mmStop.StopMock.state.CallTimes()
+}
+
+// StopMaxInFlight returns the maximum number of the WorkerMock.Stop calls that have been in flight at once
+func (mmStop *WorkerMock) StopMaxInFlight() int {
+	return mmStop.StopMock.state.MaxInFlight()
+}
+
+// StopUnexpectedCounter returns a count of WorkerMock.Stop invocations made without an implementation
+func (mmStop *WorkerMock) StopUnexpectedCounter() uint64 {
+	return mmStop.StopMock.state.UnexpectedCalls()
+}
+
+// StopNotCalled returns true if WorkerMock.Stop hasn't been called
+func (mmStop *WorkerMock) StopNotCalled() bool {
+	return mmStop.StopMock.state.BeforeCalls() == 0
+}
+
+// StopCallCount returns a count of WorkerMock.Stop invocations, it's the same as StopBeforeCounter
+func (mmStop *WorkerMock) StopCallCount() uint64 {
+	return mmStop.StopMock.state.BeforeCalls()
+}
+
+// MinimockStopDone returns true if the count of the Stop invocations corresponds
+// the number of defined expectations
+func (mmStop *WorkerMock) MinimockStopDone() bool {
+	return mmStop.StopMock.state.Done(mmStop.StopMock.setup())
+}
+
+// MinimockStopInspect logs each unmet expectation
+func (mmStop *WorkerMock) MinimockStopInspect() {
+	mmStop.StopMock.state.Inspect(mmStop.StopMock.setup())
+}
+
+// MinimockSetComparer sets up the function comparing the expected and the actual params of all WorkerMock methods instead of minimock.Equal,
+// the params matched by the matchers aren't compared
+func (m *WorkerMock) MinimockSetComparer(compare minimock.Comparer) *WorkerMock {
+	m.state.SetComparer(compare)
+	return m
+}
+
+// MinimockSetSequence sets up the sequence numbering the WorkerMock calls, the mocks sharing the sequence
+// can be checked by minimock.InOrder, the mocks created with the same controller or *testing.T share the sequence by default.
+// Nil sequence detaches the mock, its calls aren't numbered so they can't be checked by minimock.InOrder
+func (m *WorkerMock) MinimockSetSequence(sequence *minimock.Sequence) *WorkerMock {
+	m.state.SetSequence(sequence)
+	return m
+}
+
+// MinimockSetClock sets up the function returning the current time for the history of WorkerMock calls instead of time.Now
+func (m *WorkerMock) MinimockSetClock(clock func() mm_time.Time) *WorkerMock {
+	m.state.SetClock(clock)
+	return m
+}
+
+// MinimockSetAutoFinish enables or disables the check of WorkerMock made by the Cleanup of the tester passed to NewWorkerMock,
+// the check is enabled by default and skipped if the mock is already checked by MinimockFinish or MinimockWait
+func (m *WorkerMock) MinimockSetAutoFinish(enabled bool) *WorkerMock {
+	m.state.SetAutoFinish(enabled)
+	return m
+}
+
+// MinimockSetDelegate sets up the implementation the calls of the WorkerMock methods are forwarded to when the methods
+// have neither the expectations nor the functions set up, so only some of the methods can be mocked. The forwarded calls
+// are counted and recorded in the history as usual, the nil implementation makes the unconfigured calls fail the test again
+func (m *WorkerMock) MinimockSetDelegate(impl Worker) *WorkerMock {
+	m.mutex.Lock()
+	m.delegate = impl
+	m.mutex.Unlock()
+	return m
+}
+
+func (m *WorkerMock) minimockDelegate() Worker {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.delegate
+}
+
+// MinimockSetLenient enables or disables the lenient mode of WorkerMock: the calls of the methods that have neither
+// the expectations nor the functions set up return zero values instead of failing the test. Such calls are recorded
+// in the history as usual, counted by MinimockLenientCalls and logged by MinimockFinish
+func (m *WorkerMock) MinimockSetLenient(enabled bool) *WorkerMock {
+	m.state.SetLenient(enabled)
+	return m
+}
+
+// MinimockLenientCalls returns the number of the calls that returned zero values in the lenient mode by the names of the methods,
+// the methods that haven't been called this way are omitted
+func (m *WorkerMock) MinimockLenientCalls() map[string]uint64 {
+	return m.state.LenientCalls()
+}
+
+// MinimockAssertNotCalled fails the test without stopping it if any of the WorkerMock methods with the given names has been called,
+// it doesn't depend on the expectations and the functions set up for the methods
+func (m *WorkerMock) MinimockAssertNotCalled(methodNames ...string) {
+	m.state.AssertNotCalled(methodNames...)
+}
+
+// MinimockReset resets the counters and the history of the calls, removes the expectations and the results
+// queued by ReturnOnce of all WorkerMock methods, the functions set by Set and Inspect are kept.
+// It fails the test if any of the methods is being called
+func (m *WorkerMock) MinimockReset() {
+	m.state.Reset(
+		m.DoMock.reset,
+		m.StopMock.reset,
+	)
+}
+
+// MinimockResetAll does the same as MinimockReset and removes the functions set by Set and Inspect as well
+func (m *WorkerMock) MinimockResetAll() {
+	m.MinimockReset()
+	m.DoMock.state.Mutex.Lock()
+	m.funcDo = nil
+	m.DoMock.inspectDo = nil
+	m.DoMock.state.Mutex.Unlock()
+	m.StopMock.state.Mutex.Lock()
+	m.funcStop = nil
+	m.StopMock.inspectStop = nil
+	m.StopMock.state.Mutex.Unlock()
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *WorkerMock) MinimockFinish() {
+	m.state.Finish(m.minimockDone,
+		m.MinimockDoInspect,
+		m.MinimockStopInspect,
+	)
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *WorkerMock) MinimockWait(timeout mm_time.Duration) {
+	m.state.Wait(timeout, m.minimockDone, m.MinimockFinish)
+}
+
+func (m *WorkerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDoDone() &&
+		m.MinimockStopDone()
+}
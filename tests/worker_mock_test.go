@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerMock_UnexpectedCallOnTestGoroutine(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	tester.FatalfMock.Expect("Unexpected call to WorkerMock.Do. %v", "task").Return()
+
+	workerMock := NewWorkerMock(tester)
+	workerMock.Do("task")
+
+	assert.Equal(t, uint64(1), workerMock.DoUnexpectedCounter())
+}
+
+func TestWorkerMock_UnexpectedCallOnOtherGoroutine(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	var mutex sync.Mutex
+	var errors []string
+	tester.ErrorfMock.Set(func(format string, args ...interface{}) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		errors = append(errors, fmt.Sprintf(format, args...))
+	})
+	tester.FailNowMock.Expect().Return()
+
+	workerMock := NewWorkerMock(tester).StopMock.Return()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		//the worker gets zero values and keeps running
+		n, err := workerMock.Do("task")
+		assert.Equal(t, 0, n)
+		assert.NoError(t, err)
+		workerMock.Stop()
+	}()
+	wg.Wait()
+
+	if assert.Len(t, errors, 1) {
+		assert.Contains(t, errors[0], "Unexpected call to WorkerMock.Do. task\nthe call is made by goroutine #")
+		assert.Contains(t, errors[0], "TestWorkerMock_UnexpectedCallOnOtherGoroutine")
+	}
+
+	//the failure is escalated when the mock is finished on the test goroutine
+	workerMock.MinimockFinish()
+	assert.Equal(t, []string{"WorkerMock.Do was called 1 times without an implementation, first call params: " +
+		fmt.Sprintf("%#v", WorkerMockDoParams{"task"})}, errors[1:])
+}
+
+func TestWorkerMock_NoResultsOnOtherGoroutine(t *testing.T) {
+	tester := NewTesterMock(t)
+	defer tester.MinimockFinish()
+
+	var message string
+	tester.ErrorfMock.Set(func(format string, args ...interface{}) { message = fmt.Sprintf(format, args...) })
+
+	workerMock := NewWorkerMock(tester)
+	workerMock.DoMock.Expect("task")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		n, err := workerMock.Do("task")
+		assert.Equal(t, 0, n)
+		assert.NoError(t, err)
+	}()
+	<-done
+
+	assert.Contains(t, message, "No results are set for the WorkerMock.Do\nthe call is made by goroutine #")
+}